@@ -5,6 +5,7 @@ import type {
   ParcelAutomat,
   LockerCell,
   CreateDroneRequest,
+  CreatedDrone,
   CreateGoodRequest,
   UpdateCellRequest,
   CreateParcelAutomatRequest,
@@ -50,7 +51,7 @@ export const dronesAPI = {
   getStatus: (id: string) => apiClient.get(`/drones/${id}/status`),
   sendCommand: (id: string, command: string) => 
     apiClient.post(`http://localhost:8001/api/drones/${id}/command`, { command }),
-  create: (data: CreateDroneRequest) => apiClient.post<Drone>('/drones', data),
+  create: (data: CreateDroneRequest) => apiClient.post<CreatedDrone>('/drones', data),
   update: (id: string, data: { model: string; ip_address?: string }) =>
     apiClient.put<Drone>(`/drones/${id}`, data),
   updateStatus: (id: string, data: { status: string }) =>
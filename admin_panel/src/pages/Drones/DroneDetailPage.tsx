@@ -3,6 +3,7 @@ import { useParams, useNavigate } from "react-router-dom";
 import { dronesAPI } from "../../api";
 import type { Drone } from "../../types";
 import Button from "../../components/Button/Button";
+import { pickDroneFields } from "../../utils/droneEvents";
 import "./DroneDetailPage.css";
 
 const DroneDetailPage = () => {
@@ -15,6 +16,7 @@ const DroneDetailPage = () => {
   const canvasRef = useRef<HTMLCanvasElement>(null);
   const wsRef = useRef<WebSocket | null>(null);
   const adminWsRef = useRef<WebSocket | null>(null);
+  const seqRef = useRef(0);
 
   useEffect(() => {
     if (!droneId) return;
@@ -34,22 +36,33 @@ const DroneDetailPage = () => {
   }, [droneId]);
 
   const connectAdminWebSocket = () => {
-    const ws = new WebSocket("ws://localhost:8081/ws/admin");
+    const ws = new WebSocket(
+      `ws://localhost:8081/ws/admin?access_token=${localStorage.getItem("accessToken") ?? ""}`
+    );
     adminWsRef.current = ws;
 
+    ws.onopen = () => {
+      ws.send(JSON.stringify({ type: "subscribe", drones: [droneId] }));
+    };
+
     ws.onmessage = (event) => {
       const data = JSON.parse(event.data);
-      if (data.type === "drones_status" && data.drones && droneId) {
+      if (data.type === "snapshot" && data.drones && droneId) {
+        seqRef.current = data.seq;
         const update = data.drones.find((d: any) => d.drone_id === droneId);
-        if (update && drone) {
-          setDrone({
-            ...drone,
-            battery_level: update.battery_level,
-            status: update.status,
-            current_delivery_id: update.current_delivery_id,
-            speed: update.speed,
-            error_message: update.error_message,
-          });
+        if (update) {
+          setDrone((prev) => (prev ? { ...prev, ...pickDroneFields(update) } : prev));
+        }
+      } else if (data.type === "delta") {
+        if (data.seq !== seqRef.current + 1) {
+          ws.send(JSON.stringify({ type: "resync" }));
+          return;
+        }
+        seqRef.current = data.seq;
+        if (data.event === "drone_state" && data.drone_id === droneId) {
+          setDrone((prev) =>
+            prev ? { ...prev, ...pickDroneFields(data.payload) } : prev
+          );
         }
       }
     };
@@ -75,7 +88,10 @@ const DroneDetailPage = () => {
   const connectVideoStream = () => {
     if (!droneId) return;
 
-    const ws = new WebSocket(`ws://localhost:8081/ws/drone/${droneId}/video`);
+    const ws = new WebSocket(
+      `ws://localhost:8081/ws/drone/${droneId}/video?access_token=${localStorage.getItem("accessToken") ?? ""}`
+    );
+    ws.binaryType = "arraybuffer";
     wsRef.current = ws;
 
     ws.onopen = () => {
@@ -102,13 +118,16 @@ const DroneDetailPage = () => {
         return;
       }
 
-      const base64Data = event.data;
-      const binaryString = atob(base64Data);
-      const bytes = new Uint8Array(binaryString.length);
-      for (let i = 0; i < binaryString.length; i++) {
-        bytes[i] = binaryString.charCodeAt(i);
+      // SKVF frame: codec at byte 5, big-endian header length at byte 6, image after the header.
+      const buffer = event.data as ArrayBuffer;
+      const view = new DataView(buffer);
+      if (buffer.byteLength < 8 || view.getUint8(5) !== 1) {
+        return;
       }
-      const blob = new Blob([bytes], { type: "image/jpeg" });
+      const headerLength = view.getUint16(6);
+      const blob = new Blob([buffer.slice(headerLength)], {
+        type: "image/jpeg",
+      });
 
       const img = new Image();
 
@@ -1,11 +1,16 @@
 import { useEffect, useState, useRef } from "react";
 import { useNavigate } from "react-router-dom";
 import { dronesAPI } from "../../api";
-import type { Drone, CreateDroneRequest } from "../../types";
+import type {
+  Drone,
+  CreateDroneRequest,
+  IssuedDroneCredential,
+} from "../../types";
 import Button from "../../components/Button/Button";
 import Modal from "../../components/Modal/Modal";
 import ConfirmModal from "../../components/ConfirmModal/ConfirmModal";
 import { showSuccess, showError } from "../../utils/toast";
+import { pickDroneFields } from "../../utils/droneEvents";
 import "./DronesPage.css";
 
 const DronesPage = () => {
@@ -17,7 +22,10 @@ const DronesPage = () => {
   const [isConfirmModalOpen, setIsConfirmModalOpen] = useState(false);
   const [droneToDelete, setDroneToDelete] = useState<Drone | null>(null);
   const [editingDrone, setEditingDrone] = useState<Drone | null>(null);
+  const [issuedCredential, setIssuedCredential] =
+    useState<IssuedDroneCredential | null>(null);
   const wsRef = useRef<WebSocket | null>(null);
+  const seqRef = useRef(0);
   const [formData, setFormData] = useState<
     CreateDroneRequest & { status: string }
   >({
@@ -42,7 +50,9 @@ const DronesPage = () => {
   }, []);
 
   const connectWebSocket = () => {
-    const ws = new WebSocket("ws://localhost:8081/ws/admin");
+    const ws = new WebSocket(
+      `ws://localhost:8081/ws/admin?access_token=${localStorage.getItem("accessToken") ?? ""}`
+    );
     wsRef.current = ws;
 
     ws.onopen = () => {
@@ -52,25 +62,34 @@ const DronesPage = () => {
     ws.onmessage = (event) => {
       try {
         const data = JSON.parse(event.data);
-        if (data.type === "drones_status" && data.drones) {
+        if (data.type === "snapshot" && data.drones) {
+          seqRef.current = data.seq;
           setDrones((prevDrones) =>
             prevDrones.map((drone) => {
               const update = data.drones.find(
                 (d: any) => d.drone_id === drone.id
               );
               if (update) {
-                return {
-                  ...drone,
-                  battery_level: update.battery_level,
-                  status: update.status,
-                  current_delivery_id: update.current_delivery_id,
-                  speed: update.speed,
-                  error_message: update.error_message,
-                };
+                return { ...drone, ...pickDroneFields(update) };
               }
               return drone;
             })
           );
+        } else if (data.type === "delta") {
+          if (data.seq !== seqRef.current + 1) {
+            ws.send(JSON.stringify({ type: "resync" }));
+            return;
+          }
+          seqRef.current = data.seq;
+          if (data.event === "drone_state" && data.drone_id) {
+            setDrones((prevDrones) =>
+              prevDrones.map((drone) =>
+                drone.id === data.drone_id
+                  ? { ...drone, ...pickDroneFields(data.payload) }
+                  : drone
+              )
+            );
+          }
         }
       } catch (error) {
         console.error("Error parsing WebSocket message:", error);
@@ -104,13 +123,14 @@ const DronesPage = () => {
     setLoading(true);
 
     try {
-      await dronesAPI.create({
+      const response = await dronesAPI.create({
         model: formData.model,
         ip_address: formData.ip_address,
       });
       showSuccess("Дрон успешно создан!");
-      await loadDrones();
       closeModal();
+      setIssuedCredential(response.data.credential);
+      await loadDrones();
     } catch (error: any) {
       console.error("Failed to save drone:", error);
       const errorMsg =
@@ -187,6 +207,17 @@ const DronesPage = () => {
     setFormData({ model: "", ip_address: "", status: "idle" });
   };
 
+  const copySecret = async () => {
+    if (!issuedCredential) return;
+    try {
+      await navigator.clipboard.writeText(issuedCredential.secret);
+      showSuccess("Ключ скопирован");
+    } catch (error) {
+      console.error("Failed to copy drone secret:", error);
+      showError("Не удалось скопировать ключ");
+    }
+  };
+
   const getStatusBadge = (status: string) => {
     const badges: Record<string, { label: string; className: string }> = {
       idle: { label: "Свободен", className: "status-idle" },
@@ -357,6 +388,42 @@ const DronesPage = () => {
         </form>
       </Modal>
 
+      <Modal
+        isOpen={issuedCredential !== null}
+        onClose={() => setIssuedCredential(null)}
+        title="Ключ дрона"
+      >
+        {issuedCredential && (
+          <div className="drone-credential">
+            <p>
+              Загрузите ключ на дрон сейчас: он показывается только один раз.
+              Если ключ потерян, его можно только перевыпустить.
+            </p>
+            <div className="form-group">
+              <label>ID дрона</label>
+              <input type="text" value={issuedCredential.drone_id} readOnly />
+            </div>
+            <div className="form-group">
+              <label>Секретный ключ (версия {issuedCredential.key_version})</label>
+              <input
+                type="text"
+                value={issuedCredential.secret}
+                readOnly
+                onFocus={(e) => e.target.select()}
+              />
+            </div>
+            <div className="form-actions">
+              <Button type="button" variant="secondary" onClick={copySecret}>
+                📋 Скопировать
+              </Button>
+              <Button type="button" onClick={() => setIssuedCredential(null)}>
+                Я сохранил ключ
+              </Button>
+            </div>
+          </div>
+        )}
+      </Modal>
+
       <ConfirmModal
         isOpen={isConfirmModalOpen}
         onClose={() => {
@@ -45,6 +45,18 @@ export interface CreateDroneRequest {
   status?: string;
 }
 
+export interface IssuedDroneCredential {
+  drone_id: string;
+  key_version: number;
+  secret: string;
+  created_at: string;
+  rotated_at: string;
+}
+
+export interface CreatedDrone extends Drone {
+  credential: IssuedDroneCredential;
+}
+
 export interface CellDimensions {
   height: number;
   length: number;
@@ -0,0 +1,20 @@
+import type { Drone } from '../types';
+
+const droneFields = [
+  'battery_level',
+  'status',
+  'current_delivery_id',
+  'speed',
+  'error_message',
+] as const;
+
+export const pickDroneFields = (payload: Record<string, unknown> | undefined): Partial<Drone> => {
+  const fields: Record<string, unknown> = {};
+  if (!payload) return fields as Partial<Drone>;
+  for (const key of droneFields) {
+    if (key in payload) {
+      fields[key] = payload[key];
+    }
+  }
+  return fields as Partial<Drone>;
+};
@@ -11,6 +11,10 @@ class Settings:
     drone_ip: str = field(default_factory=lambda: os.getenv("DRONE_IP", "192.168.10.3"))
     drone_id: Optional[str] = None
 
+    # Credential issued by the orchestrator when the drone was created or its key rotated.
+    provisioned_drone_id: str = field(default_factory=lambda: os.getenv("DRONE_ID", ""))
+    drone_secret: str = field(default_factory=lambda: os.getenv("DRONE_SECRET", ""))
+
     drone_service_host: str = field(
         default_factory=lambda: os.getenv("DRONE_SERVICE_HOST", "localhost")
     )
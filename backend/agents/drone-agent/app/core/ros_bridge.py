@@ -3,8 +3,9 @@ from sensor_msgs.msg import BatteryState
 from geometry_msgs.msg import PoseStamped
 from sensor_msgs.msg import Range
 from mavros_msgs.msg import State
-from std_msgs.msg import Bool
+from std_msgs.msg import Bool, String
 import asyncio
+import json
 import logging
 from typing import Optional, Callable
 from ..models.telemetry import Telemetry, Battery, Pose, MavrosState
@@ -142,6 +143,17 @@ class ROSBridge:
             logger.error(f"Failed to send drop confirmation: {e}")
             return False
 
+    def send_flight_command(self, command: dict) -> bool:
+        try:
+            pub = rospy.Publisher("/drone/command", String, queue_size=1)
+            rospy.sleep(0.5)
+            pub.publish(String(data=json.dumps(command)))
+            logger.info(f"Flight command sent: {command.get('command')}")
+            return True
+        except Exception as e:
+            logger.error(f"Failed to send flight command: {e}")
+            return False
+
     def set_arrival_callback(self, callback: Callable):
         self.arrival_callback = callback
 
@@ -1,15 +1,36 @@
 import asyncio
+import hashlib
+import hmac
 import json
 import logging
+import uuid
 import websockets
 from typing import Optional
-from datetime import datetime
+from datetime import datetime, timezone
+from .. import __version__
 from ..config.settings import Settings
 from ..models.messages import MessageType
 from ..utils.retry import async_retry
 
 logger = logging.getLogger(__name__)
 
+PROTOCOL_VERSIONS = [1]
+
+
+def sign_challenge(secret: str, drone_id: str, nonce: str) -> str:
+    message = f"{drone_id}:{nonce}".encode()
+    return hmac.new(secret.encode(), message, hashlib.sha256).hexdigest()
+
+
+def make_envelope(version: int, msg_type: str, payload: dict) -> str:
+    return json.dumps({
+        "v": version,
+        "type": msg_type,
+        "id": str(uuid.uuid4()),
+        "timestamp": datetime.now(timezone.utc).isoformat(),
+        "payload": payload
+    })
+
 
 class WebSocketClient:
     def __init__(self, settings: Settings):
@@ -18,6 +39,8 @@ class WebSocketClient:
         self.is_connected = False
         self.on_delivery_task = None
         self.on_command = None
+        self.completed_commands = set()
+        self.protocol_version = PROTOCOL_VERSIONS[-1]
         
     async def connect(self):
         while True:
@@ -39,11 +62,13 @@ class WebSocketClient:
                 )
                 
                 data = json.loads(registration_response)
+                payload = data.get("payload", {})
                 if data.get("type") == "registered":
-                    self.settings.drone_id = data.get("drone_id")
-                    logger.info(f"Drone registered with ID: {self.settings.drone_id}")
+                    self.settings.drone_id = payload.get("drone_id")
+                    self.protocol_version = payload.get("version", self.protocol_version)
+                    logger.info(f"Drone registered with ID: {self.settings.drone_id}, protocol v{self.protocol_version}, session {payload.get('session_id')}")
                 elif data.get("type") == "error":
-                    logger.error(f"Registration failed: {data.get('message')}")
+                    logger.error(f"Registration failed: {payload.get('code')}: {payload.get('message')}")
                     raise Exception("Registration failed")
                 
                 await self._listen()
@@ -60,12 +85,26 @@ class WebSocketClient:
                 await self._reconnect()
     
     async def _register(self):
-        registration = {
-            "type": "register",
-            "ip_address": self.settings.drone_ip
-        }
-        await self.websocket.send(json.dumps(registration))
-        logger.info(f"Registration sent with IP: {self.settings.drone_ip}")
+        drone_id = self.settings.provisioned_drone_id
+        if not drone_id or not self.settings.drone_secret:
+            raise Exception("DRONE_ID and DRONE_SECRET must be set")
+
+        await self.websocket.send(make_envelope(PROTOCOL_VERSIONS[-1], MessageType.REGISTER, {
+            "drone_id": drone_id,
+            "versions": PROTOCOL_VERSIONS,
+            "agent_version": __version__
+        }))
+
+        data = json.loads(await asyncio.wait_for(self.websocket.recv(), timeout=10.0))
+        if data.get("type") != MessageType.CHALLENGE:
+            raise Exception(f"Expected challenge, got: {data}")
+        challenge = data["payload"]
+        self.protocol_version = challenge.get("version", self.protocol_version)
+
+        await self.websocket.send(make_envelope(self.protocol_version, MessageType.AUTHENTICATE, {
+            "signature": sign_challenge(self.settings.drone_secret, drone_id, challenge["nonce"])
+        }))
+        logger.info(f"Answered registration challenge with key version {challenge.get('key_version')}")
     
     async def _listen(self):
         try:
@@ -86,33 +125,49 @@ class WebSocketClient:
             if msg_type == MessageType.DELIVERY_TASK and self.on_delivery_task:
                 await self.on_delivery_task(payload)
             elif msg_type == MessageType.COMMAND and self.on_command:
-                await self.on_command(payload)
+                await self._handle_command(payload)
+            elif msg_type == MessageType.ERROR:
+                logger.warning(f"Service rejected message {payload.get('ref_id')}: {payload.get('code')}: {payload.get('message')}")
             
         except Exception as e:
             logger.error(f"Error handling message: {e}")
     
+    async def _handle_command(self, payload: dict):
+        command_id = payload.get("command_id")
+        if not command_id:
+            await self.on_command(payload)
+            return
+
+        # The service resends until it hears "executed", so a repeat must not run the command twice.
+        if command_id in self.completed_commands:
+            await self.send_command_ack(command_id, "executed")
+            return
+
+        await self.send_command_ack(command_id, "received")
+        try:
+            await self.on_command(payload)
+        except Exception as e:
+            await self.send_command_nack(command_id, str(e))
+            return
+
+        self.completed_commands.add(command_id)
+        await self.send_command_ack(command_id, "executed")
+
     @async_retry(max_attempts=3, delay=0.5)
     async def send_message(self, msg_type: MessageType, payload: dict):
         if not self.is_connected or not self.websocket:
             raise Exception("WebSocket not connected")
         
-        message = {
-            "type": msg_type,
-            "timestamp": datetime.now().isoformat(),
-            "payload": payload
-        }
-        await self.websocket.send(json.dumps(message))
+        await self.websocket.send(make_envelope(self.protocol_version, msg_type, payload))
         logger.debug(f"Sent message: {msg_type}")
     
     async def send_heartbeat(self, battery_level: float, position: dict, status: str, speed: float):
-        heartbeat = {
-            "type": "heartbeat",
+        await self.send_message(MessageType.HEARTBEAT, {
             "battery_level": battery_level,
             "position": position,
             "status": status,
             "speed": speed
-        }
-        await self.websocket.send(json.dumps(heartbeat))
+        })
     
     async def send_status_update(self, status: str, battery_level: float, position: dict, speed: float):
         await self.send_message(MessageType.STATUS_UPDATE, {
@@ -130,15 +185,23 @@ class WebSocketClient:
             "parcel_automat_id": parcel_automat_id
         })
     
+    async def send_command_ack(self, command_id: str, stage: str):
+        await self.send_message(MessageType.COMMAND_ACK, {
+            "command_id": command_id,
+            "stage": stage
+        })
+
+    async def send_command_nack(self, command_id: str, reason: str):
+        await self.send_message(MessageType.COMMAND_NACK, {
+            "command_id": command_id,
+            "reason": reason
+        })
+    
     async def send_video_frame(self, frame_base64: str, delivery_id: str = None):
-        video_message = {
-            "type": "video_frame",
-            "payload": {
-                "frame": frame_base64,
-                "delivery_id": delivery_id
-            }
-        }
-        await self.websocket.send(json.dumps(video_message))
+        await self.websocket.send(make_envelope(self.protocol_version, MessageType.VIDEO_FRAME, {
+            "frame": frame_base64,
+            "delivery_id": delivery_id
+        }))
     
     async def _reconnect(self):
         logger.info(f"Reconnecting in {self.settings.reconnect_interval} seconds...")
@@ -1,6 +1,7 @@
 #!/usr/bin/env python3
 
 import asyncio
+import os
 import signal
 import sys
 import threading
@@ -22,6 +23,16 @@ from app.services.video_service import VideoService
 
 logger = setup_logger(__name__, settings.log_level)
 
+# Commands passed on to the flight stack over ROS.
+FLIGHT_COMMANDS = (
+    "hold",
+    "land",
+    "goto",
+    "set_altitude",
+    "open_cargo_hook",
+    "close_cargo_hook",
+)
+
 
 class DroneApplication:
     def __init__(self):
@@ -83,7 +94,10 @@ class DroneApplication:
             )
 
             self.telemetry_service = TelemetryService(
-                self.ws_client, self.ros_bridge, settings.heartbeat_interval
+                self.ws_client,
+                self.ros_bridge,
+                settings.heartbeat_interval,
+                self.state_machine,
             )
 
             self.video_service = VideoService(
@@ -95,9 +109,22 @@ class DroneApplication:
             async def handle_command(payload: dict):
                 command = payload.get("command")
                 if command == "drop_cargo":
-                    await self.delivery_service.handle_drop_command(payload)
+                    ok = await self.delivery_service.handle_drop_command(payload)
+                elif command == "return_to_base":
+                    ok = await self.delivery_service.handle_return_command(payload)
+                elif command in ("abort_delivery", "cancel_delivery"):
+                    ok = await self.delivery_service.handle_abort_command(payload)
+                elif command in FLIGHT_COMMANDS:
+                    ok = self.ros_bridge.send_flight_command(payload)
+                elif command == "reboot_agent":
+                    # The supervisor restarts the agent; the delay lets the ack go out first.
+                    self.event_loop.call_later(1, lambda: os._exit(1))
+                    ok = True
                 else:
                     logger.warning(f"Unknown command: {command}")
+                    raise ValueError(f"unknown command: {command}")
+                if not ok:
+                    raise RuntimeError(f"{command} could not be executed")
 
             self.ws_client.on_command = handle_command
 
@@ -5,12 +5,16 @@ from enum import Enum
 
 class MessageType(str, Enum):
     REGISTER = "register"
+    CHALLENGE = "challenge"
+    AUTHENTICATE = "authenticate"
     REGISTERED = "registered"
     DELIVERY_TASK = "delivery_task"
     STATUS_UPDATE = "status_update"
     DELIVERY_UPDATE = "delivery_update"
     HEARTBEAT = "heartbeat"
     COMMAND = "command"
+    COMMAND_ACK = "command_ack"
+    COMMAND_NACK = "command_nack"
     VIDEO_FRAME = "video_frame"
     ERROR = "error"
 
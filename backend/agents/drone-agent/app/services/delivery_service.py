@@ -6,6 +6,12 @@ from ..models.task import DeliveryTask, DeliveryState
 
 logger = logging.getLogger(__name__)
 
+FINISHED_STATES = (
+    DeliveryState.COMPLETED,
+    DeliveryState.FAILED,
+    DeliveryState.CANCELLED,
+)
+
 
 class DeliveryService:
     def __init__(
@@ -21,6 +27,16 @@ class DeliveryService:
         self.ros_bridge = ros_bridge
 
     async def handle_delivery_task(self, payload: dict):
+        current = self.state_machine.current_task
+        if (
+            current is not None
+            and current.delivery_id == payload.get("delivery_id")
+            and current.state not in FINISHED_STATES
+        ):
+            # Sent again after a reconnect: the flight is still under way.
+            logger.info(f"Delivery {current.delivery_id} resumed in state {current.state}")
+            return
+
         try:
             task = DeliveryTask(
                 delivery_id=payload.get("delivery_id"),
@@ -114,10 +130,10 @@ class DeliveryService:
 
         self.state_machine.clear_task()
 
-    async def handle_drop_command(self, payload: dict):
+    async def handle_drop_command(self, payload: dict) -> bool:
         if not self.state_machine.current_task:
             logger.warning("Drop command received but no active task")
-            return
+            return False
 
         order_id = payload.get("order_id")
         cell_id = payload.get("cell_id")
@@ -137,3 +153,29 @@ class DeliveryService:
             logger.info("Drop confirmation sent to flight script")
         else:
             logger.error("Failed to send drop confirmation")
+        return success
+
+    async def handle_return_command(self, payload: dict) -> bool:
+        task = self.state_machine.current_task
+        home_aruco_id = payload.get("base_marker_id") or (
+            task.home_aruco_id if task else 131
+        )
+
+        logger.info(f"RETURN TO BASE COMMAND RECEIVED: home={home_aruco_id}")
+
+        self.flight_controller.terminate_current_flight()
+        if task:
+            self.state_machine.transition_to(DeliveryState.RETURNING)
+        return self.flight_controller.launch_return_flight(home_aruco_id)
+
+    async def handle_abort_command(self, payload: dict) -> bool:
+        task = self.state_machine.current_task
+        delivery_id = payload.get("delivery_id")
+        if not task or (delivery_id and task.delivery_id != delivery_id):
+            logger.warning(f"Abort received for delivery {delivery_id} but it is not active")
+            return False
+
+        logger.info(
+            f"DELIVERY {task.delivery_id} ABORTED: {payload.get('reason') or payload.get('command')}"
+        )
+        return await self.handle_return_command(payload)
@@ -1,10 +1,25 @@
 import asyncio
 import logging
+from typing import Optional
 from ..core.websocket_client import WebSocketClient
 from ..core.ros_bridge import ROSBridge
+from ..core.state_machine import StateMachine
+from ..models.task import DeliveryState
 
 logger = logging.getLogger(__name__)
 
+# Drone statuses the service understands, by delivery state. The service refuses some operator
+# commands based on them, e.g. drop_cargo unless the drone reports "delivering".
+STATUS_BY_STATE = {
+    DeliveryState.TAKING_OFF: "in_transit",
+    DeliveryState.NAVIGATING: "in_transit",
+    DeliveryState.LANDING: "landing",
+    DeliveryState.ARRIVED: "delivering",
+    DeliveryState.WAITING_CONFIRMATION: "delivering",
+    DeliveryState.DROPPING: "returning",
+    DeliveryState.RETURNING: "returning",
+}
+
 
 class TelemetryService:
     def __init__(
@@ -12,10 +27,12 @@ class TelemetryService:
         websocket_client: WebSocketClient,
         ros_bridge: ROSBridge,
         interval: int = 30,
+        state_machine: Optional[StateMachine] = None,
     ):
         self.ws_client = websocket_client
         self.ros_bridge = ros_bridge
         self.interval = interval
+        self.state_machine = state_machine
         self._task = None
         self._running = False
 
@@ -53,9 +70,7 @@ class TelemetryService:
                         "altitude": telemetry.pose.z,
                     }
 
-                status = "idle"
-                if telemetry.state:
-                    status = "flying" if telemetry.state.armed else "idle"
+                status = self._status(telemetry)
 
                 await self.ws_client.send_heartbeat(
                     battery_level=battery_level,
@@ -69,6 +84,15 @@ class TelemetryService:
             except Exception as e:
                 logger.error(f"Error in heartbeat loop: {e}")
 
+    def _status(self, telemetry) -> str:
+        if self.state_machine:
+            status = STATUS_BY_STATE.get(self.state_machine.get_state())
+            if status:
+                return status
+        if telemetry.state and telemetry.state.armed:
+            return "in_transit"
+        return "idle"
+
     async def stop(self):
         self._running = False
         if self._task:
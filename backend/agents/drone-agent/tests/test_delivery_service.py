@@ -1,7 +1,7 @@
 import pytest
 from unittest.mock import AsyncMock, MagicMock
 from app.services.delivery_service import DeliveryService
-from app.models.task import DeliveryTask
+from app.models.task import DeliveryTask, DeliveryState
 
 
 @pytest.fixture
@@ -236,3 +236,82 @@ async def test_handle_delivery_task_flight_failure():
     mock_sm.transition_to.assert_called()
     from app.models.task import DeliveryState
     assert mock_sm.transition_to.call_args[0][0] == DeliveryState.FAILED
+
+
+@pytest.mark.asyncio
+async def test_handle_delivery_task_resumed_after_reconnect():
+    from app.models.task import DeliveryState
+
+    mock_ws = AsyncMock()
+    mock_sm = MagicMock()
+    mock_sm.current_task = DeliveryTask(
+        delivery_id="test-123",
+        order_id="order-456",
+        good_id="good-789",
+        parcel_automat_id="automat-1",
+        target_aruco_id=135,
+    )
+    mock_sm.current_task.state = DeliveryState.NAVIGATING
+    mock_fc = MagicMock()
+    mock_rb = MagicMock()
+
+    service = DeliveryService(mock_ws, mock_sm, mock_fc, mock_rb)
+
+    await service.handle_delivery_task(
+        {
+            "delivery_id": "test-123",
+            "order_id": "order-456",
+            "good_id": "good-789",
+            "parcel_automat_id": "automat-1",
+        }
+    )
+
+    mock_sm.set_task.assert_not_called()
+    mock_fc.launch_delivery_flight.assert_not_called()
+
+
+@pytest.mark.asyncio
+async def test_handle_abort_command(
+    delivery_service, mock_state_machine, mock_flight_controller
+):
+    task = DeliveryTask(
+        delivery_id="test-123",
+        order_id="order-456",
+        good_id="good-789",
+        parcel_automat_id="automat-1",
+        target_aruco_id=135,
+        home_aruco_id=131,
+    )
+    mock_state_machine.current_task = task
+    mock_flight_controller.launch_return_flight = MagicMock(return_value=True)
+
+    result = await delivery_service.handle_abort_command(
+        {"command": "abort_delivery", "delivery_id": "test-123"}
+    )
+
+    assert result is True
+    mock_flight_controller.terminate_current_flight.assert_called_once()
+    mock_state_machine.transition_to.assert_called_once_with(DeliveryState.RETURNING)
+    mock_flight_controller.launch_return_flight.assert_called_once_with(131)
+
+
+@pytest.mark.asyncio
+async def test_handle_abort_command_other_delivery(
+    delivery_service, mock_state_machine, mock_flight_controller
+):
+    task = DeliveryTask(
+        delivery_id="test-123",
+        order_id="order-456",
+        good_id="good-789",
+        parcel_automat_id="automat-1",
+        target_aruco_id=135,
+        home_aruco_id=131,
+    )
+    mock_state_machine.current_task = task
+
+    result = await delivery_service.handle_abort_command(
+        {"command": "abort_delivery", "delivery_id": "test-999"}
+    )
+
+    assert result is False
+    mock_flight_controller.terminate_current_flight.assert_not_called()
@@ -2,6 +2,7 @@ import pytest
 from unittest.mock import AsyncMock, MagicMock, patch
 from app.services.telemetry_service import TelemetryService
 from app.models.telemetry import Telemetry, Battery, Pose, MavrosState
+from app.models.task import DeliveryState
 
 
 @pytest.fixture
@@ -81,7 +82,23 @@ async def test_heartbeat_sends_telemetry(mock_websocket_client, mock_ros_bridge)
     mock_websocket_client.send_heartbeat.assert_called()
     call_args = mock_websocket_client.send_heartbeat.call_args
     assert call_args.kwargs["battery_level"] == 12.5
-    assert call_args.kwargs["status"] == "flying"
+    assert call_args.kwargs["status"] == "in_transit"
+
+
+@pytest.mark.asyncio
+async def test_heartbeat_reports_delivery_state(mock_websocket_client, mock_ros_bridge):
+    state_machine = MagicMock()
+    state_machine.get_state = MagicMock(return_value=DeliveryState.WAITING_CONFIRMATION)
+    service = TelemetryService(
+        mock_websocket_client, mock_ros_bridge, interval=0.1, state_machine=state_machine
+    )
+
+    await service.start()
+    await asyncio.sleep(0.2)
+    await service.stop()
+
+    call_args = mock_websocket_client.send_heartbeat.call_args
+    assert call_args.kwargs["status"] == "delivering"
 
 
 @pytest.mark.asyncio
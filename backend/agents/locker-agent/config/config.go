@@ -16,6 +16,7 @@ type Config struct {
 	Arduino      ArduinoConfig
 	Display      DisplayConfig
 	Camera       CameraConfig
+	Keypad       KeypadConfig
 	Serial       SerialConfig
 	Log          LogConfig
 	Service      ServiceConfig
@@ -52,6 +53,12 @@ type CameraConfig struct {
 	MockMode       bool
 }
 
+type KeypadConfig struct {
+	Port     string
+	Baudrate int
+	MockMode bool
+}
+
 type SerialConfig struct {
 	TimeoutMS      int
 	WriteTimeoutMS int
@@ -97,6 +104,11 @@ func New() (*Config, error) {
 			ScanIntervalMS: getEnvAsInt("QR_SCAN_INTERVAL_MS", 100),
 			MockMode:       getEnvAsBool("CAMERA_MOCK_MODE", false),
 		},
+		Keypad: KeypadConfig{
+			Port:     getEnv("KEYPAD_PORT", "/dev/ttyUSB2"),
+			Baudrate: getEnvAsInt("KEYPAD_BAUDRATE", 9600),
+			MockMode: getEnvAsBool("KEYPAD_MOCK_MODE", false),
+		},
 		Serial: SerialConfig{
 			TimeoutMS:      getEnvAsInt("SERIAL_TIMEOUT_MS", 1000),
 			WriteTimeoutMS: getEnvAsInt("SERIAL_WRITE_TIMEOUT_MS", 500),
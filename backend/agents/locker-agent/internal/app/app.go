@@ -75,6 +75,21 @@ func Run(cfg *config.Config) {
 		}
 	}()
 
+	keypad, err := hardware.NewKeypad(
+		cfg.Keypad.Port,
+		cfg.Keypad.Baudrate,
+		cfg.Keypad.MockMode,
+		log,
+	)
+	if err != nil {
+		log.Fatal("app - Run - hardware.NewKeypad", err)
+	}
+	defer func() {
+		if err := keypad.Close(); err != nil {
+			log.Error("Failed to close keypad", err)
+		}
+	}()
+
 	orchestratorClient := orchestrator.NewClient(
 		cfg.Orchestrator.URL,
 		cfg.Orchestrator.Timeout,
@@ -88,6 +103,7 @@ func Run(cfg *config.Config) {
 		cellManager,
 		orchestratorClient,
 		qrCamera,
+		keypad,
 		display,
 		cellRepo,
 		log,
@@ -13,6 +13,7 @@ func handleError(c *gin.Context, err error) {
 	case errors.Is(err, entityError.ErrCellInvalidNumber),
 		errors.Is(err, entityError.ErrCellInvalidUUID),
 		errors.Is(err, entityError.ErrQRInvalidFormat),
+		errors.Is(err, entityError.ErrPINInvalidFormat),
 		errors.Is(err, entityError.ErrConfigValidationFailed),
 		errors.Is(err, entityError.ErrConfigMissingRequired):
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
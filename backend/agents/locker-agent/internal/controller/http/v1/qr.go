@@ -21,6 +21,7 @@ func newQRRoutes(group *gin.RouterGroup, qrScanner *usecase.QRScannerUseCase, lo
 	}
 
 	group.POST("/scan", r.scanQR)
+	group.POST("/pin", r.enterPIN)
 	group.POST("/confirm-pickup", r.confirmPickup)
 	group.POST("/confirm-loaded", r.confirmLoaded)
 }
@@ -43,6 +44,24 @@ func (r *qrRoutes) scanQR(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+func (r *qrRoutes) enterPIN(c *gin.Context) {
+	var req entity.PINEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		r.logger.Error("Failed to bind PIN entry request", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := r.qrScanner.ProcessPINEntry(c.Request.Context(), req.PIN)
+	if err != nil {
+		r.logger.Error("Failed to process PIN entry", err)
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 func (r *qrRoutes) confirmPickup(c *gin.Context) {
 	var req entity.ConfirmPickupRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -12,4 +12,5 @@ var (
 	ErrCameraScanFailed        = errors.New("camera scan failed")
 	ErrCameraNotInMockMode     = errors.New("camera not in mock mode")
 	ErrCameraChannelFull       = errors.New("camera result channel full")
+	ErrKeypadConnectionFailed  = errors.New("keypad connection failed")
 )
@@ -8,4 +8,5 @@ var (
 	ErrQRScanFailed          = errors.New("qr scan failed")
 	ErrQRConfirmPickupFailed = errors.New("failed to confirm pickup")
 	ErrQRConfirmLoadedFailed = errors.New("failed to confirm loaded")
+	ErrPINInvalidFormat      = errors.New("invalid pin format")
 )
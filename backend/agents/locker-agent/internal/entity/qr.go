@@ -21,6 +21,15 @@ type QRValidationResponse struct {
 	CellIDs []string `json:"cell_ids"`
 }
 
+type PINEntryRequest struct {
+	PIN string `json:"pin" binding:"required,len=6,numeric"`
+}
+
+type PINValidationRequest struct {
+	PIN             string `json:"pin"`
+	ParcelAutomatID string `json:"parcel_automat_id"`
+}
+
 type ConfirmPickupRequest struct {
 	CellIDs []string `json:"cell_ids" binding:"required"`
 }
@@ -23,6 +23,14 @@ type DisplayInterface interface {
 	IsMockMode() bool
 }
 
+type KeypadInterface interface {
+	Start(ctx context.Context)
+	Stop()
+	GetResultChannel() <-chan string
+	Close() error
+	IsMockMode() bool
+}
+
 type QRCameraInterface interface {
 	Start(ctx context.Context)
 	Stop()
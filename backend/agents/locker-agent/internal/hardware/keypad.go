@@ -0,0 +1,187 @@
+package hardware
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	entityError "github.com/skr1ms/SkyPostDelivery/locker-agent/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/locker-agent/pkg/logger"
+	"go.bug.st/serial"
+)
+
+const (
+	keypadSubmitKey = '#'
+	keypadClearKey  = '*'
+	keypadMaxDigits = 6
+)
+
+type Keypad struct {
+	port       serial.Port
+	mockMode   bool
+	logger     logger.Interface
+	resultChan chan string
+	stopChan   chan struct{}
+	mu         sync.Mutex
+	stopOnce   sync.Once
+	running    bool
+}
+
+func NewKeypad(portName string, baudrate int, mockMode bool, log logger.Interface) (*Keypad, error) {
+	if mockMode {
+		log.Info("Keypad running in MOCK mode", nil)
+		return &Keypad{
+			mockMode:   true,
+			logger:     log,
+			resultChan: make(chan string, 10),
+			stopChan:   make(chan struct{}),
+		}, nil
+	}
+
+	mode := &serial.Mode{
+		BaudRate: baudrate,
+		DataBits: 8,
+		Parity:   serial.NoParity,
+		StopBits: serial.OneStopBit,
+	}
+
+	port, err := serial.Open(portName, mode)
+	if err != nil {
+		log.Warn("Failed to open keypad serial port, switching to MOCK mode", err, map[string]any{
+			"port": portName,
+		})
+		return &Keypad{
+			mockMode:   true,
+			logger:     log,
+			resultChan: make(chan string, 10),
+			stopChan:   make(chan struct{}),
+		}, nil
+	}
+
+	if err := port.SetReadTimeout(500 * time.Millisecond); err != nil {
+		log.Warn("Failed to set read timeout", err)
+	}
+
+	log.Info("Keypad initialized", nil, map[string]any{
+		"port":     portName,
+		"baudrate": baudrate,
+	})
+
+	return &Keypad{
+		port:       port,
+		mockMode:   false,
+		logger:     log,
+		resultChan: make(chan string, 10),
+		stopChan:   make(chan struct{}),
+	}, nil
+}
+
+func (k *Keypad) Start(ctx context.Context) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.running {
+		return
+	}
+
+	k.stopChan = make(chan struct{})
+	k.stopOnce = sync.Once{}
+	k.running = true
+	k.logger.Info("Keypad listener started", nil, map[string]any{
+		"mock_mode": k.mockMode,
+	})
+
+	if k.mockMode {
+		return
+	}
+
+	go k.readLoop(ctx)
+}
+
+func (k *Keypad) readLoop(ctx context.Context) {
+	var input strings.Builder
+	buf := make([]byte, 16)
+
+	for {
+		select {
+		case <-ctx.Done():
+			k.logger.Info("Keypad listener stopped by context", nil)
+			return
+		case <-k.stopChan:
+			k.logger.Info("Keypad listener stopped", nil)
+			return
+		default:
+		}
+
+		n, err := k.port.Read(buf)
+		if err != nil {
+			k.logger.Error("Failed to read from keypad", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		for _, key := range buf[:n] {
+			switch {
+			case key >= '0' && key <= '9':
+				if input.Len() < keypadMaxDigits {
+					input.WriteByte(key)
+				}
+			case key == keypadClearKey:
+				input.Reset()
+			case key == keypadSubmitKey || key == '\n':
+				if input.Len() == 0 {
+					continue
+				}
+				k.submit(input.String())
+				input.Reset()
+			}
+		}
+	}
+}
+
+func (k *Keypad) submit(pin string) {
+	select {
+	case k.resultChan <- pin:
+	default:
+		k.logger.Warn("Keypad result channel full, dropping input", nil)
+	}
+}
+
+func (k *Keypad) Stop() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if !k.running {
+		return
+	}
+
+	k.stopOnce.Do(func() {
+		close(k.stopChan)
+	})
+	k.running = false
+	k.logger.Info("Keypad listener stopping", nil)
+}
+
+func (k *Keypad) GetResultChannel() <-chan string {
+	return k.resultChan
+}
+
+func (k *Keypad) Close() error {
+	k.Stop()
+
+	if k.mockMode || k.port == nil {
+		return nil
+	}
+
+	if err := k.port.Close(); err != nil {
+		return entityError.ErrKeypadConnectionFailed
+	}
+
+	k.logger.Info("Keypad serial port closed", nil)
+	return nil
+}
+
+func (k *Keypad) IsMockMode() bool {
+	return k.mockMode
+}
@@ -159,6 +159,80 @@ func (_c *MockClientInterface_ConfirmPickup_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// ValidatePIN provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) ValidatePIN(ctx context.Context, pin string, parcelAutomatID string) (*entity.QRValidationResponse, error) {
+	ret := _mock.Called(ctx, pin, parcelAutomatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidatePIN")
+	}
+
+	var r0 *entity.QRValidationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entity.QRValidationResponse, error)); ok {
+		return returnFunc(ctx, pin, parcelAutomatID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entity.QRValidationResponse); ok {
+		r0 = returnFunc(ctx, pin, parcelAutomatID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.QRValidationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, pin, parcelAutomatID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_ValidatePIN_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidatePIN'
+type MockClientInterface_ValidatePIN_Call struct {
+	*mock.Call
+}
+
+// ValidatePIN is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pin string
+//   - parcelAutomatID string
+func (_e *MockClientInterface_Expecter) ValidatePIN(ctx interface{}, pin interface{}, parcelAutomatID interface{}) *MockClientInterface_ValidatePIN_Call {
+	return &MockClientInterface_ValidatePIN_Call{Call: _e.mock.On("ValidatePIN", ctx, pin, parcelAutomatID)}
+}
+
+func (_c *MockClientInterface_ValidatePIN_Call) Run(run func(ctx context.Context, pin string, parcelAutomatID string)) *MockClientInterface_ValidatePIN_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_ValidatePIN_Call) Return(qRValidationResponse *entity.QRValidationResponse, err error) *MockClientInterface_ValidatePIN_Call {
+	_c.Call.Return(qRValidationResponse, err)
+	return _c
+}
+
+func (_c *MockClientInterface_ValidatePIN_Call) RunAndReturn(run func(ctx context.Context, pin string, parcelAutomatID string) (*entity.QRValidationResponse, error)) *MockClientInterface_ValidatePIN_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateQR provides a mock function for the type MockClientInterface
 func (_mock *MockClientInterface) ValidateQR(ctx context.Context, qrData string, parcelAutomatID string) (*entity.QRValidationResponse, error) {
 	ret := _mock.Called(ctx, qrData, parcelAutomatID)
@@ -3,7 +3,9 @@ package usecase
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/locker-agent/internal/entity"
@@ -14,10 +16,13 @@ import (
 	"github.com/skr1ms/SkyPostDelivery/locker-agent/pkg/orchestrator"
 )
 
+const compactQRPrefix = "SP:"
+
 type QRScannerUseCase struct {
 	cellManager        *CellManagerUseCase
 	orchestratorClient orchestrator.ClientInterface
 	qrCamera           hardware.QRCameraInterface
+	keypad             hardware.KeypadInterface
 	display            hardware.DisplayInterface
 	cellRepo           inmemory.CellMappingInterface
 	logger             logger.Interface
@@ -29,6 +34,7 @@ func NewQRScannerUseCase(
 	cellManager *CellManagerUseCase,
 	orchestratorClient orchestrator.ClientInterface,
 	qrCamera hardware.QRCameraInterface,
+	keypad hardware.KeypadInterface,
 	display hardware.DisplayInterface,
 	cellRepo inmemory.CellMappingInterface,
 	log logger.Interface,
@@ -37,6 +43,7 @@ func NewQRScannerUseCase(
 		cellManager:        cellManager,
 		orchestratorClient: orchestratorClient,
 		qrCamera:           qrCamera,
+		keypad:             keypad,
 		display:            display,
 		cellRepo:           cellRepo,
 		logger:             log,
@@ -81,11 +88,66 @@ func (uc *QRScannerUseCase) ProcessQRScan(ctx context.Context, qrData string) (*
 		}, nil
 	}
 
+	return uc.openValidatedCells(ctx, validationResp.CellIDs, "QR code validated and cells opened successfully"), nil
+}
+
+func (uc *QRScannerUseCase) ProcessPINEntry(ctx context.Context, pin string) (*entity.QRScanResponse, error) {
+	if !uc.cellManager.IsInitialized() {
+		return nil, entityError.ErrCellNotInitialized
+	}
+
+	if !uc.isValidPIN(pin) {
+		if uc.display != nil {
+			_ = uc.display.ShowInvalid()
+		}
+		return nil, entityError.ErrPINInvalidFormat
+	}
+
+	automatID := uc.cellManager.GetMapping().ParcelAutomatID
+
+	if uc.display != nil {
+		_ = uc.display.ShowScanning()
+	}
+
+	validationResp, err := uc.orchestratorClient.ValidatePIN(ctx, pin, automatID.String())
+	if err != nil {
+		if errors.Is(err, entityError.ErrOrchestratorRequestFailed) {
+			uc.logger.Warn("PIN rejected by orchestrator", err)
+			if uc.display != nil {
+				_ = uc.display.ShowInvalid()
+			}
+			return &entity.QRScanResponse{
+				Success: false,
+				Message: "Invalid PIN",
+			}, nil
+		}
+
+		uc.logger.Error("Failed to validate PIN with orchestrator", err)
+		if uc.display != nil {
+			_ = uc.display.ShowError("Connection error")
+		}
+		return nil, fmt.Errorf("QRScannerUseCase - ProcessPINEntry - ValidatePIN: %w", err)
+	}
+
+	if !validationResp.Success {
+		if uc.display != nil {
+			_ = uc.display.ShowInvalid()
+		}
+		return &entity.QRScanResponse{
+			Success: false,
+			Message: validationResp.Message,
+		}, nil
+	}
+
+	return uc.openValidatedCells(ctx, validationResp.CellIDs, "PIN validated and cells opened successfully"), nil
+}
+
+func (uc *QRScannerUseCase) openValidatedCells(ctx context.Context, cellIDs []string, message string) *entity.QRScanResponse {
 	if uc.display != nil {
 		_ = uc.display.ShowSuccess("")
 	}
 
-	openedCells := uc.cellManager.OpenCellsByUUIDs(ctx, validationResp.CellIDs)
+	openedCells := uc.cellManager.OpenCellsByUUIDs(ctx, cellIDs)
 
 	successCount := 0
 	for _, cell := range openedCells {
@@ -100,10 +162,10 @@ func (uc *QRScannerUseCase) ProcessQRScan(ctx context.Context, qrData string) (*
 
 	return &entity.QRScanResponse{
 		Success:     true,
-		Message:     "QR code validated and cells opened successfully",
+		Message:     message,
 		CellsOpened: openedCells,
 		CellCount:   successCount,
-	}, nil
+	}
 }
 
 func (uc *QRScannerUseCase) ConfirmPickup(ctx context.Context, cellIDs []string) error {
@@ -159,6 +221,9 @@ func (uc *QRScannerUseCase) StartScanner(ctx context.Context) {
 	uc.doneChan = make(chan struct{})
 
 	uc.qrCamera.Start(ctx)
+	if uc.keypad != nil {
+		uc.keypad.Start(ctx)
+	}
 
 	go uc.handleQRResults(ctx)
 
@@ -170,6 +235,11 @@ func (uc *QRScannerUseCase) handleQRResults(ctx context.Context) {
 
 	resultChan := uc.qrCamera.GetResultChannel()
 
+	var pinChan <-chan string
+	if uc.keypad != nil {
+		pinChan = uc.keypad.GetResultChannel()
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -200,11 +270,21 @@ func (uc *QRScannerUseCase) handleQRResults(ctx context.Context) {
 			if _, err := uc.ProcessQRScan(ctx, qrData); err != nil {
 				uc.logger.Error("Failed to process QR scan", err)
 			}
+		case pin := <-pinChan:
+			uc.logger.Info("PIN entered on keypad, processing", nil)
+
+			if _, err := uc.ProcessPINEntry(ctx, pin); err != nil {
+				uc.logger.Error("Failed to process PIN entry", err)
+			}
 		}
 	}
 }
 
 func (uc *QRScannerUseCase) isValidQRData(data string) bool {
+	if strings.HasPrefix(data, compactQRPrefix) {
+		return len(data) > len(compactQRPrefix)
+	}
+
 	var qr struct {
 		UserID string `json:"user_id"`
 		Type   string `json:"type"`
@@ -217,6 +297,18 @@ func (uc *QRScannerUseCase) isValidQRData(data string) bool {
 	return qr.UserID != "" && qr.Type != ""
 }
 
+func (uc *QRScannerUseCase) isValidPIN(pin string) bool {
+	if len(pin) != 6 {
+		return false
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 func (uc *QRScannerUseCase) StopScanner() {
 	if uc.stopChan != nil {
 		close(uc.stopChan)
@@ -225,5 +317,8 @@ func (uc *QRScannerUseCase) StopScanner() {
 		<-uc.doneChan
 	}
 	uc.qrCamera.Stop()
+	if uc.keypad != nil {
+		uc.keypad.Stop()
+	}
 	uc.logger.Info("QR scanner stopped", nil)
 }
@@ -3,10 +3,12 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/locker-agent/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/locker-agent/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/locker-agent/internal/usecase/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -115,6 +117,115 @@ func TestQRScannerUseCase_ProcessQRScan(t *testing.T) {
 	}
 }
 
+func TestQRScannerUseCase_ProcessPINEntry(t *testing.T) {
+	tests := []struct {
+		name            string
+		pin             string
+		setupMocks      func(*mocks.MockClientInterface, *mocks.MockDisplayInterface, *mocks.MockCellMappingInterface)
+		expectedErr     bool
+		expectedSuccess bool
+	}{
+		{
+			name: "successful PIN entry",
+			pin:  "123456",
+			setupMocks: func(orchestrator *mocks.MockClientInterface, display *mocks.MockDisplayInterface, cellRepo *mocks.MockCellMappingInterface) {
+				cellUUID := uuid.New()
+				cellRepo.On("GetMapping").Return(&entity.CellMapping{
+					ParcelAutomatID: uuid.New(),
+					ExternalCells:   map[int]uuid.UUID{1: cellUUID},
+				})
+				display.On("ShowScanning").Return(nil)
+				orchestrator.On("ValidatePIN", mock.Anything, "123456", mock.Anything).Return(&entity.QRValidationResponse{
+					Success: true,
+					CellIDs: []string{cellUUID.String()},
+				}, nil)
+				display.On("ShowSuccess", mock.Anything).Return(nil)
+				cellRepo.On("GetCellNumber", cellUUID).Return(1, entity.CellTypeExternal, nil)
+				cellRepo.On("GetCellUUID", 1).Return(cellUUID, nil)
+				display.On("ShowCellOpening", 1, "").Return(nil)
+				display.On("ShowCellOpened", 1).Return(nil)
+				display.On("ShowPleaseClose").Return(nil)
+			},
+			expectedSuccess: true,
+		},
+		{
+			name: "PIN rejected by orchestrator",
+			pin:  "000000",
+			setupMocks: func(orchestrator *mocks.MockClientInterface, display *mocks.MockDisplayInterface, cellRepo *mocks.MockCellMappingInterface) {
+				cellRepo.On("GetMapping").Return(&entity.CellMapping{
+					ParcelAutomatID: uuid.New(),
+					ExternalCells:   map[int]uuid.UUID{},
+				})
+				display.On("ShowScanning").Return(nil)
+				orchestrator.On("ValidatePIN", mock.Anything, "000000", mock.Anything).Return(nil, fmt.Errorf("rejected: %w", entityError.ErrOrchestratorRequestFailed))
+				display.On("ShowInvalid").Return(nil)
+			},
+			expectedSuccess: false,
+		},
+		{
+			name: "orchestrator unavailable",
+			pin:  "123456",
+			setupMocks: func(orchestrator *mocks.MockClientInterface, display *mocks.MockDisplayInterface, cellRepo *mocks.MockCellMappingInterface) {
+				cellRepo.On("GetMapping").Return(&entity.CellMapping{
+					ParcelAutomatID: uuid.New(),
+					ExternalCells:   map[int]uuid.UUID{},
+				})
+				display.On("ShowScanning").Return(nil)
+				orchestrator.On("ValidatePIN", mock.Anything, "123456", mock.Anything).Return(nil, errors.New("connection refused"))
+				display.On("ShowError", mock.Anything).Return(nil)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "malformed PIN",
+			pin:  "12ab",
+			setupMocks: func(orchestrator *mocks.MockClientInterface, display *mocks.MockDisplayInterface, cellRepo *mocks.MockCellMappingInterface) {
+				display.On("ShowInvalid").Return(nil)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrchestrator := mocks.NewMockClientInterface(t)
+			mockDisplay := mocks.NewMockDisplayInterface(t)
+			mockRepo := mocks.NewMockCellMappingInterface(t)
+			mockArduino := mocks.NewMockArduinoInterface(t)
+
+			mockCellManager := &CellManagerUseCase{
+				cellRepo: mockRepo,
+				arduino:  mockArduino,
+				display:  mockDisplay,
+				logger:   &mockLogger{},
+			}
+
+			mockRepo.On("IsInitialized").Return(true)
+			mockArduino.On("OpenCell", mock.Anything).Return(nil).Maybe()
+			tt.setupMocks(mockOrchestrator, mockDisplay, mockRepo)
+
+			uc := &QRScannerUseCase{
+				cellManager:        mockCellManager,
+				orchestratorClient: mockOrchestrator,
+				display:            mockDisplay,
+				cellRepo:           mockRepo,
+				logger:             &mockLogger{},
+			}
+
+			resp, err := uc.ProcessPINEntry(context.Background(), tt.pin)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedSuccess, resp.Success)
+		})
+	}
+}
+
 func TestQRScannerUseCase_ConfirmPickup(t *testing.T) {
 	cellIDs := []string{uuid.New().String(), uuid.New().String()}
 
@@ -229,3 +340,25 @@ func TestQRScannerUseCase_ConfirmLoaded(t *testing.T) {
 		})
 	}
 }
+
+func TestQRScannerUseCase_isValidQRData(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected bool
+	}{
+		{name: "compact payload", data: "SP:0B9ZQ7R+3", expected: true},
+		{name: "empty compact payload", data: "SP:", expected: false},
+		{name: "typed json payload", data: `{"type":"dynamic","user_id":"u"}`, expected: true},
+		{name: "json without type", data: `{"user_id":"u"}`, expected: false},
+		{name: "garbage", data: "hello", expected: false},
+	}
+
+	uc := &QRScannerUseCase{logger: &mockLogger{}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, uc.isValidQRData(tt.data))
+		})
+	}
+}
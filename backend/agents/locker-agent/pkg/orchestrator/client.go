@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/locker-agent/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/locker-agent/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/locker-agent/pkg/logger"
 )
 
@@ -48,6 +49,22 @@ func (c *Client) ValidateQR(ctx context.Context, qrData, parcelAutomatID string)
 	return &response, nil
 }
 
+func (c *Client) ValidatePIN(ctx context.Context, pin, parcelAutomatID string) (*entity.QRValidationResponse, error) {
+	url := fmt.Sprintf("%s/automats/pin-entry", c.baseURL)
+
+	req := entity.PINValidationRequest{
+		PIN:             pin,
+		ParcelAutomatID: parcelAutomatID,
+	}
+
+	var response entity.QRValidationResponse
+	if err := c.doRequest(ctx, "POST", url, req, &response); err != nil {
+		return nil, fmt.Errorf("OrchestratorClient - ValidatePIN - doRequest: %w", err)
+	}
+
+	return &response, nil
+}
+
 func (c *Client) ConfirmPickup(ctx context.Context, cellIDs []uuid.UUID) error {
 	url := fmt.Sprintf("%s/automats/confirm-pickup", c.baseURL)
 
@@ -140,6 +157,10 @@ func (c *Client) doRequest(ctx context.Context, method, url string, reqBody, res
 			continue
 		}
 
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			return fmt.Errorf("OrchestratorClient - doRequest - rejected: %d, body: %s: %w", resp.StatusCode, string(body), entityError.ErrOrchestratorRequestFailed)
+		}
+
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 			lastErr = fmt.Errorf("OrchestratorClient - doRequest - bad status: %d, body: %s", resp.StatusCode, string(body))
 			continue
@@ -9,6 +9,7 @@ import (
 
 type ClientInterface interface {
 	ValidateQR(ctx context.Context, qrData, parcelAutomatID string) (*entity.QRValidationResponse, error)
+	ValidatePIN(ctx context.Context, pin, parcelAutomatID string) (*entity.QRValidationResponse, error)
 	ConfirmPickup(ctx context.Context, cellIDs []uuid.UUID) error
 	ConfirmLoaded(ctx context.Context, orderID, lockerCellID uuid.UUID) error
 }
@@ -1,23 +1,31 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 
 	"github.com/joho/godotenv"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 )
 
 type (
 	Config struct {
 		App              `yaml:"app"`
 		HTTP             `yaml:"http"`
+		JWT              `yaml:"jwt"`
 		PG               `yaml:"postgres"`
 		RabbitMQ         `yaml:"rabbitmq"`
 		MinIO            `yaml:"minio"`
 		OrchestratorGRPC `yaml:"orchestrator_grpc"`
 		WebSocket        `yaml:"websocket"`
+		Telemetry        `yaml:"telemetry"`
+		Commands         `yaml:"commands"`
+		Redis            `yaml:"redis"`
+		Cluster          `yaml:"cluster"`
+		Liveness         `yaml:"liveness"`
 	}
 
 	App struct {
@@ -28,7 +36,12 @@ type (
 	}
 
 	HTTP struct {
-		Port string
+		Port           string
+		AllowedOrigins []string
+	}
+
+	JWT struct {
+		AccessSecret string
 	}
 
 	PG struct {
@@ -52,7 +65,43 @@ type (
 	}
 
 	WebSocket struct {
-		BroadcastInterval int
+		AdminQueueSize int
+	}
+
+	Telemetry struct {
+		PublishIntervalMs        int
+		SampleIntervalMs         int
+		IdleSampleIntervalMs     int
+		HistoryBatchSize         int
+		HistoryFlushIntervalMs   int
+		HistoryRetentionDays     int
+		HistoryIdleRetentionDays int
+	}
+
+	Commands struct {
+		AckTimeoutMs    int
+		MaxAttempts     int
+		TTLSeconds      int
+		RetryIntervalMs int
+		NoFlyZones      []entity.NoFlyZone
+	}
+
+	Redis struct {
+		URL      string
+		Password string
+		DB       int
+	}
+
+	Cluster struct {
+		InstanceID      string
+		LeaseTTLSeconds int
+	}
+
+	Liveness struct {
+		PingIntervalSeconds        int
+		PongWaitSeconds            int
+		OfflineThresholdSeconds    int
+		LostDeliveryTimeoutSeconds int
 	}
 )
 
@@ -67,7 +116,11 @@ func New() (*Config, error) {
 			LogLevel: getEnv("LOG_LEVEL", "info"),
 		},
 		HTTP: HTTP{
-			Port: getEnv("DRONE_SERVICE_HTTP_PORT", "8081"),
+			Port:           getEnv("DRONE_SERVICE_HTTP_PORT", "8081"),
+			AllowedOrigins: getEnvList("DRONE_SERVICE_ALLOWED_ORIGINS", []string{"http://localhost", "http://localhost:5173"}),
+		},
+		JWT: JWT{
+			AccessSecret: getEnv("JWT_ACCESS_SECRET", "your-secret-key-change-in-production"),
 		},
 		PG: PG{
 			URL: createDSN(),
@@ -86,9 +139,45 @@ func New() (*Config, error) {
 			URL: getEnv("GRPC_GO_ORCHESTRATOR_URL", "localhost:50052"),
 		},
 		WebSocket: WebSocket{
-			BroadcastInterval: getEnvInt("WEBSOCKET_BROADCAST_INTERVAL", 5),
+			AdminQueueSize: getEnvInt("WEBSOCKET_ADMIN_QUEUE_SIZE", 256),
+		},
+		Telemetry: Telemetry{
+			PublishIntervalMs:        getEnvInt("TELEMETRY_PUBLISH_INTERVAL_MS", 1000),
+			SampleIntervalMs:         getEnvInt("TELEMETRY_SAMPLE_INTERVAL_MS", 2000),
+			IdleSampleIntervalMs:     getEnvInt("TELEMETRY_IDLE_SAMPLE_INTERVAL_MS", 60000),
+			HistoryBatchSize:         getEnvInt("TELEMETRY_HISTORY_BATCH_SIZE", 200),
+			HistoryFlushIntervalMs:   getEnvInt("TELEMETRY_HISTORY_FLUSH_INTERVAL_MS", 5000),
+			HistoryRetentionDays:     getEnvInt("TELEMETRY_HISTORY_RETENTION_DAYS", 90),
+			HistoryIdleRetentionDays: getEnvInt("TELEMETRY_HISTORY_IDLE_RETENTION_DAYS", 7),
+		},
+		Commands: Commands{
+			AckTimeoutMs:    getEnvInt("DRONE_COMMAND_ACK_TIMEOUT_MS", 5000),
+			MaxAttempts:     getEnvInt("DRONE_COMMAND_MAX_ATTEMPTS", 5),
+			TTLSeconds:      getEnvInt("DRONE_COMMAND_TTL_SECONDS", 600),
+			RetryIntervalMs: getEnvInt("DRONE_COMMAND_RETRY_INTERVAL_MS", 1000),
+		},
+		Redis: Redis{
+			URL:      getEnv("REDIS_URL", ""),
+			Password: getEnv("REDIS_PASSWORD", ""),
+			DB:       getEnvInt("REDIS_DB", 0),
+		},
+		Cluster: Cluster{
+			InstanceID:      getEnv("DRONE_SERVICE_INSTANCE_ID", defaultInstanceID()),
+			LeaseTTLSeconds: getEnvInt("DRONE_CONNECTION_LEASE_TTL_SECONDS", 30),
 		},
+		Liveness: Liveness{
+			PingIntervalSeconds:        getEnvInt("DRONE_PING_INTERVAL_SECONDS", 10),
+			PongWaitSeconds:            getEnvInt("DRONE_PONG_WAIT_SECONDS", 25),
+			OfflineThresholdSeconds:    getEnvInt("DRONE_OFFLINE_THRESHOLD_SECONDS", 30),
+			LostDeliveryTimeoutSeconds: getEnvInt("DRONE_LOST_DELIVERY_TIMEOUT_SECONDS", 600),
+		},
+	}
+
+	noFlyZones, err := getEnvNoFlyZones("DRONE_NO_FLY_ZONES")
+	if err != nil {
+		return nil, err
 	}
+	cfg.Commands.NoFlyZones = noFlyZones
 
 	return cfg, nil
 }
@@ -109,6 +198,47 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getEnvNoFlyZones reads a JSON list of zones such as
+// [{"name":"airport","latitude":55.97,"longitude":37.41,"radius_m":3000}].
+func getEnvNoFlyZones(key string) ([]entity.NoFlyZone, error) {
+	value := os.Getenv(key)
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var zones []entity.NoFlyZone
+	if err := json.Unmarshal([]byte(value), &zones); err != nil {
+		return nil, fmt.Errorf("config - %s: %w", key, err)
+	}
+	for _, zone := range zones {
+		if zone.RadiusMeters <= 0 {
+			return nil, fmt.Errorf("config - %s: zone %q needs a positive radius_m", key, zone.Name)
+		}
+	}
+	return zones, nil
+}
+
+// defaultInstanceID is the container hostname, which is unique per replica under Docker and Kubernetes.
+func defaultInstanceID() string {
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return hostname
+	}
+	return fmt.Sprintf("drone-service-%d", os.Getpid())
+}
+
 func createDSN() string {
 	return fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=disable",
 		getEnv("POSTGRES_USER", "postgres"),
@@ -23,9 +23,204 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/drones/{drone_id}/command": {
+        "/health": {
+            "get": {
+                "description": "Service health check",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "monitoring"
+                ],
+                "summary": "Health Check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Health"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/api/drones": {
+            "get": {
+                "description": "Returns every provisioned drone with its last persisted state and, when it is connected to any replica, its connection",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drones"
+                ],
+                "summary": "List drones",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneList"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v1/api/drones/{drone_id}": {
+            "get": {
+                "description": "Returns one drone with its last persisted state and its connection, if any",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drones"
+                ],
+                "summary": "Get drone",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Drone ID",
+                        "name": "drone_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Drone"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v1/api/drones/{drone_id}/audit": {
+            "get": {
+                "description": "Returns the latest operator command attempts for a drone, newest first, with who issued them and whether they were accepted, rejected or failed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drones"
+                ],
+                "summary": "List command audit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Drone ID",
+                        "name": "drone_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum entries (1-200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.CommandAuditList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/v1/api/drones/{drone_id}/command": {
             "post": {
-                "description": "Отправляет команду управления конкретному дрону",
+                "description": "Queues an operator command for the drone. The command is validated, then checked against the drone's last reported state: the drone has to be connected, flight commands need it airborne, drop_cargo needs it at its destination, goto is refused inside a no-fly zone, open_cargo_hook is refused in flight and reboot_agent during a mission. Every attempt is written to the drone's command audit log",
                 "consumes": [
                     "application/json"
                 ],
@@ -35,17 +230,17 @@ const docTemplate = `{
                 "tags": [
                     "drones"
                 ],
-                "summary": "Отправить команду дрону",
+                "summary": "Send command to drone",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID дрона",
+                        "description": "Drone ID",
                         "name": "drone_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Команда для дрона",
+                        "description": "Command for drone",
                         "name": "request",
                         "in": "body",
                         "required": true,
@@ -55,10 +250,10 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.CommandResponse"
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneCommandStatus"
                         }
                     },
                     "400": {
@@ -66,65 +261,152 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
             }
         },
-        "/health": {
+        "/v1/api/drones/{drone_id}/commands/{command_id}": {
             "get": {
-                "description": "Проверка состояния сервиса",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the delivery status of a command sent to a drone: pending (not yet written to the drone), sent, acked, executed, failed or timed_out",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "monitoring"
+                    "drones"
+                ],
+                "summary": "Get command status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Drone ID",
+                        "name": "drone_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Command ID",
+                        "name": "command_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Health Check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Health"
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneCommandStatus"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
             }
         },
-        "/status": {
+        "/v1/api/video/stats": {
             "get": {
-                "description": "Возвращает текущий статус первого зарегистрированного дрона",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the incoming frame rate of every streaming drone and, per connected viewer, frames sent, frames dropped because the viewer fell behind, queued frames and the achieved frame rate",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "drones"
+                    "monitoring"
+                ],
+                "summary": "Video stream statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only this drone",
+                        "name": "drone_id",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Получить статус дрона",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneStatus"
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.VideoStats"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
             }
         },
         "/ws/admin": {
             "get": {
-                "description": "Устанавливает WebSocket соединение для мониторинга дронов администратором\nАдминистратор получает обновления статуса всех дронов и доставок в реальном времени",
+                "description": "Establishes WebSocket connection for drone monitoring by administrator\nThe admin first gets a welcome with its connection_id, then a snapshot of the fleet, then numbered deltas as drones, connections and deliveries change\nSend {\"type\":\"subscribe\",\"drones\":[...],\"deliveries\":[...],\"events\":[...]} to narrow the feed and {\"type\":\"resync\"} for a new snapshot",
                 "consumes": [
                     "application/json"
                 ],
@@ -134,13 +416,14 @@ const docTemplate = `{
                 "tags": [
                     "websocket"
                 ],
-                "summary": "WebSocket подключение администратора",
+                "summary": "Admin WebSocket connection",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID администратора",
-                        "name": "admin_id",
-                        "in": "query"
+                        "description": "Orchestrator access token with the admin or operator role",
+                        "name": "access_token",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -149,13 +432,25 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
                     }
                 }
             }
         },
         "/ws/drone": {
             "get": {
-                "description": "Устанавливает WebSocket соединение для дрона. Первое сообщение должно быть регистрацией с ip_address\nПоддерживаемые типы сообщений: heartbeat, status_update, delivery_update, video_frame, arrived_at_destination, cargo_dropped",
+                "description": "Establishes WebSocket connection for drone. Every text message is an envelope {\"v\":1,\"type\":\"...\",\"id\":\"\u003cuuid\u003e\",\"timestamp\":\"\u003cRFC 3339\u003e\",\"payload\":{...}} (see pkg/droneproto)\nThe drone must authenticate before anything else:\n1. drone sends register {\"drone_id\":\"...\",\"versions\":[1]} listing the protocol versions it speaks\n2. service replies challenge {\"drone_id\":\"...\",\"nonce\":\"...\",\"key_version\":N,\"version\":V} with the version it chose\n3. drone sends authenticate {\"signature\":hex(HMAC-SHA256(secret, \"\u003cdrone_id\u003e:\u003cnonce\u003e\"))}\n4. service replies registered {\"drone_id\":\"...\",\"version\":V}; every later envelope must carry v=V\nThe secret is issued by the orchestrator when the drone is created and rotated with POST /drones/{id}/credential/rotate\nSupported message types: heartbeat, status_update, delivery_update, video_frame, arrived_at_destination, cargo_dropped, command_ack, command_nack\nEvery command carries a command_id; the drone answers command_ack {\"command_id\",\"stage\":\"received\"|\"executed\"} or command_nack {\"command_id\",\"reason\"}. Unacked commands are resent, including on reconnect\nUnknown, malformed or invalid messages are answered with an error envelope {\"code\":\"...\",\"message\":\"...\",\"ref_id\":\"\u003cid of the rejected message\u003e\"}\nThe service pings the drone every DRONE_PING_INTERVAL_SECONDS and drops the connection when nothing, pongs included, arrives for DRONE_PONG_WAIT_SECONDS. A drone silent for DRONE_OFFLINE_THRESHOLD_SECONDS is marked offline and its delivery suspended as drone_lost; the delivery is resumed when it reconnects, or failed once the drone has stayed offline for DRONE_LOST_DELIVERY_TIMEOUT_SECONDS\nVideo may also be sent as binary messages: an SKVF header (drone ID, delivery ID, sequence, capture time, codec) followed by the encoded frame",
                 "consumes": [
                     "application/json"
                 ],
@@ -165,7 +460,7 @@ const docTemplate = `{
                 "tags": [
                     "websocket"
                 ],
-                "summary": "WebSocket подключение дрона",
+                "summary": "Drone WebSocket connection",
                 "responses": {
                     "101": {
                         "description": "Switching Protocols",
@@ -178,7 +473,7 @@ const docTemplate = `{
         },
         "/ws/drone/{drone_id}": {
             "get": {
-                "description": "Устанавливает WebSocket соединение для дрона. Первое сообщение должно быть регистрацией с ip_address\nПоддерживаемые типы сообщений: heartbeat, status_update, delivery_update, video_frame, arrived_at_destination, cargo_dropped",
+                "description": "Establishes WebSocket connection for drone. Every text message is an envelope {\"v\":1,\"type\":\"...\",\"id\":\"\u003cuuid\u003e\",\"timestamp\":\"\u003cRFC 3339\u003e\",\"payload\":{...}} (see pkg/droneproto)\nThe drone must authenticate before anything else:\n1. drone sends register {\"drone_id\":\"...\",\"versions\":[1]} listing the protocol versions it speaks\n2. service replies challenge {\"drone_id\":\"...\",\"nonce\":\"...\",\"key_version\":N,\"version\":V} with the version it chose\n3. drone sends authenticate {\"signature\":hex(HMAC-SHA256(secret, \"\u003cdrone_id\u003e:\u003cnonce\u003e\"))}\n4. service replies registered {\"drone_id\":\"...\",\"version\":V}; every later envelope must carry v=V\nThe secret is issued by the orchestrator when the drone is created and rotated with POST /drones/{id}/credential/rotate\nSupported message types: heartbeat, status_update, delivery_update, video_frame, arrived_at_destination, cargo_dropped, command_ack, command_nack\nEvery command carries a command_id; the drone answers command_ack {\"command_id\",\"stage\":\"received\"|\"executed\"} or command_nack {\"command_id\",\"reason\"}. Unacked commands are resent, including on reconnect\nUnknown, malformed or invalid messages are answered with an error envelope {\"code\":\"...\",\"message\":\"...\",\"ref_id\":\"\u003cid of the rejected message\u003e\"}\nThe service pings the drone every DRONE_PING_INTERVAL_SECONDS and drops the connection when nothing, pongs included, arrives for DRONE_PONG_WAIT_SECONDS. A drone silent for DRONE_OFFLINE_THRESHOLD_SECONDS is marked offline and its delivery suspended as drone_lost; the delivery is resumed when it reconnects, or failed once the drone has stayed offline for DRONE_LOST_DELIVERY_TIMEOUT_SECONDS\nVideo may also be sent as binary messages: an SKVF header (drone ID, delivery ID, sequence, capture time, codec) followed by the encoded frame",
                 "consumes": [
                     "application/json"
                 ],
@@ -188,11 +483,11 @@ const docTemplate = `{
                 "tags": [
                     "websocket"
                 ],
-                "summary": "WebSocket подключение дрона",
+                "summary": "Drone WebSocket connection",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID дрона (опционально)",
+                        "description": "Drone ID (optional, must match the register message)",
                         "name": "drone_id",
                         "in": "path"
                     }
@@ -209,7 +504,7 @@ const docTemplate = `{
         },
         "/ws/drone/{drone_id}/video": {
             "get": {
-                "description": "Устанавливает WebSocket соединение для получения видео потока от конкретного дрона\nАдминистратор подключается к этому эндпоинту для просмотра видео в реальном времени",
+                "description": "Establishes WebSocket connection for receiving video stream from specific drone\nAdministrator connects to this endpoint to view real-time video\nBy default every frame is a binary message: an SKVF header (drone ID, delivery ID, sequence, capture time, codec) followed by the encoded image\nformat=base64 sends the bare JPEG as base64 text instead, for older viewers\nEach viewer has a small queue; when it falls behind the oldest frames are dropped",
                 "consumes": [
                     "application/json"
                 ],
@@ -219,14 +514,27 @@ const docTemplate = `{
                 "tags": [
                     "websocket"
                 ],
-                "summary": "WebSocket для видео потока дрона",
+                "summary": "WebSocket for drone video stream",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID дрона",
+                        "description": "Drone ID",
                         "name": "drone_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "binary (default) or base64",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Orchestrator access token with the admin or operator role",
+                        "name": "access_token",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -241,6 +549,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error"
+                        }
                     }
                 }
             }
@@ -253,48 +573,172 @@ const docTemplate = `{
                 "command"
             ],
             "properties": {
+                "altitude": {
+                    "type": "number",
+                    "example": 40
+                },
+                "base_marker_id": {
+                    "type": "integer",
+                    "example": 131
+                },
+                "cell_id": {
+                    "type": "string",
+                    "example": "cell-1"
+                },
                 "command": {
                     "type": "string",
-                    "example": "start_delivery"
+                    "enum": [
+                        "hold",
+                        "land",
+                        "return_to_base",
+                        "goto",
+                        "set_altitude",
+                        "drop_cargo",
+                        "abort_delivery",
+                        "open_cargo_hook",
+                        "close_cargo_hook",
+                        "reboot_agent"
+                    ],
+                    "example": "goto"
                 },
-                "payload": {
-                    "type": "object",
-                    "additionalProperties": true
+                "delivery_id": {
+                    "type": "string",
+                    "example": "delivery-123"
+                },
+                "internal_cell_id": {
+                    "type": "string",
+                    "example": "internal-cell-1"
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "order-456"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "wind gusts over the drop zone"
+                },
+                "waypoint": {
+                    "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_request.Waypoint"
                 }
             }
         },
-        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.CommandResponse": {
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_request.Waypoint": {
             "type": "object",
             "properties": {
-                "message": {
+                "altitude": {
+                    "type": "number",
+                    "example": 40
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": 55.751244
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 37.618423
+                }
+            }
+        },
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.CommandAudit": {
+            "type": "object",
+            "properties": {
+                "command": {
                     "type": "string",
-                    "example": "Command successfully queued for delivery"
+                    "example": "goto"
                 },
-                "status": {
+                "command_id": {
+                    "type": "string",
+                    "example": "5b0e7c1a-3d2f-4e6b-9a8c-1f2e3d4c5b6a"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "drone_id": {
+                    "type": "string",
+                    "example": "6f1c2a4e-3b1d-4c8e-9a0f-2d5e7b9c1a3f"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "1c7e9a3b-5d2f-4b8e-a6c4-0f9d2e4b7a1c"
+                },
+                "operator_email": {
+                    "type": "string",
+                    "example": "operator@example.com"
+                },
+                "operator_id": {
                     "type": "string",
-                    "example": "command_sent"
+                    "example": "3f8a2c1e-5b7d-4e9f-8a6c-2d4b6e8f0a1c"
+                },
+                "operator_role": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "operator"
+                    ],
+                    "example": "operator"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "drone command rejected by safety interlock: goto: waypoint is inside no-fly zone \"airport\""
+                },
+                "result": {
+                    "type": "string",
+                    "enum": [
+                        "accepted",
+                        "rejected",
+                        "failed"
+                    ],
+                    "example": "rejected"
+                }
+            }
+        },
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.CommandAuditList": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.CommandAudit"
+                    }
                 }
             }
         },
-        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneStatus": {
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Drone": {
             "type": "object",
             "properties": {
                 "battery_level": {
                     "type": "number",
                     "example": 85.5
                 },
+                "connected": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "connection": {
+                    "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneConnection"
+                },
                 "current_delivery_id": {
                     "type": "string",
                     "example": "delivery-123"
                 },
                 "drone_id": {
                     "type": "string",
-                    "example": "drone-001"
+                    "example": "6f1c2a4e-3b1d-4c8e-9a0f-2d5e7b9c1a3f"
                 },
                 "error_message": {
                     "type": "string",
                     "example": ""
                 },
+                "last_updated": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string",
+                    "example": "DJI Mavic 3"
+                },
                 "position": {
                     "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Position"
                 },
@@ -308,6 +752,123 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneCommandStatus": {
+            "type": "object",
+            "properties": {
+                "acked_at": {
+                    "type": "string"
+                },
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "command": {
+                    "type": "string",
+                    "example": "drop_cargo"
+                },
+                "command_id": {
+                    "type": "string",
+                    "example": "5b0e7c1a-3d2f-4e6b-9a8c-1f2e3d4c5b6a"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "drone_id": {
+                    "type": "string",
+                    "example": "6f1c2a4e-3b1d-4c8e-9a0f-2d5e7b9c1a3f"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "max_attempts": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "sent_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "sent",
+                        "acked",
+                        "executed",
+                        "failed",
+                        "timed_out"
+                    ],
+                    "example": "acked"
+                }
+            }
+        },
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneConnection": {
+            "type": "object",
+            "properties": {
+                "agent_version": {
+                    "type": "string",
+                    "example": "1.0.0"
+                },
+                "connected_at": {
+                    "type": "string"
+                },
+                "instance_id": {
+                    "type": "string",
+                    "example": "drone-service-7c9f8b6d4-x2k4q"
+                },
+                "protocol_version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "remote_addr": {
+                    "type": "string",
+                    "example": "10.0.0.5"
+                },
+                "session_id": {
+                    "type": "string",
+                    "example": "9d2b7c4e-1a3f-4e5d-8c6b-7a9e0f1d2c3b"
+                }
+            }
+        },
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneList": {
+            "type": "object",
+            "properties": {
+                "drones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Drone"
+                    }
+                }
+            }
+        },
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneVideoStats": {
+            "type": "object",
+            "properties": {
+                "drone_id": {
+                    "type": "string",
+                    "example": "drone-001"
+                },
+                "fps": {
+                    "type": "number",
+                    "example": 15.2
+                },
+                "frames_received": {
+                    "type": "integer",
+                    "example": 5400
+                },
+                "viewers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.VideoViewerStats"
+                    }
+                }
+            }
+        },
         "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.Error": {
             "type": "object",
             "properties": {
@@ -346,6 +907,53 @@ const docTemplate = `{
                     "example": 37.618423
                 }
             }
+        },
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.VideoStats": {
+            "type": "object",
+            "properties": {
+                "drones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.DroneVideoStats"
+                    }
+                }
+            }
+        },
+        "github_com_skr1ms_SkyPostDelivery_drone-service_internal_controller_http_v1_response.VideoViewerStats": {
+            "type": "object",
+            "properties": {
+                "connected_at": {
+                    "type": "string"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "binary"
+                },
+                "fps": {
+                    "type": "number",
+                    "example": 14.9
+                },
+                "frames_dropped": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "frames_sent": {
+                    "type": "integer",
+                    "example": 5380
+                },
+                "queued": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "remote_addr": {
+                    "type": "string",
+                    "example": "10.0.0.12"
+                },
+                "viewer_id": {
+                    "type": "string",
+                    "example": "3f1e9a4c-2b7d-4f0a-9c1e-8d5b6a7f2e10"
+                }
+            }
         }
     }
 }`
@@ -353,7 +961,7 @@ const docTemplate = `{
 // SwaggerInfo holds exported Swagger Info so clients can modify it
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
-	Host:             "localhost:8001",
+	Host:             "localhost:8081",
 	BasePath:         "/api/v1",
 	Schemes:          []string{},
 	Title:            "SkyPost Drone Service API",
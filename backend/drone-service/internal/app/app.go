@@ -14,13 +14,17 @@ import (
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/middleware"
 	v1 "github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/v1"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/websocket"
+	contracts "github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo/cache"
 	repo "github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo/persistent"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/grpc"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/jwt"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/minio"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/postgres"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/rabbitmq"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/redis"
 	ginprometheus "github.com/zsais/go-gin-prometheus"
 )
 
@@ -63,21 +67,73 @@ func Run(cfg *config.Config) {
 		}
 	}()
 
+	// Without Redis the service runs as a single replica and keeps its connections in memory only.
+	var (
+		connectionRegistry contracts.ConnectionRegistry
+		messageBus         contracts.MessageBus
+	)
+	leaseTTL := time.Duration(cfg.Cluster.LeaseTTLSeconds) * time.Second
+	clusterCtx, stopCluster := context.WithCancel(ctx)
+	if cfg.Redis.URL != "" {
+		redisClient := redis.New(&cfg.Redis)
+		defer func() {
+			if err := redisClient.Close(); err != nil {
+				logger.Error("failed to close redis client", err, nil)
+			}
+		}()
+		if err := redisClient.Ping(ctx).Err(); err != nil {
+			logger.Error("app - Run - redisClient.Ping", err, nil)
+		}
+
+		connectionRegistry = cache.NewConnectionRegistry(redisClient, cfg.Cluster.InstanceID, leaseTTL)
+		bus := cache.NewMessageBus(clusterCtx, redisClient, logger)
+		defer func() {
+			if err := bus.Close(); err != nil {
+				logger.Error("failed to close message bus", err, nil)
+			}
+		}()
+		go bus.Run(clusterCtx)
+		messageBus = bus
+
+		logger.Info("Cluster mode enabled", nil, map[string]any{"instanceID": cfg.Cluster.InstanceID})
+	}
+
 	droneRepo := repo.NewDroneRepo(pg)
 	deliveryRepo := repo.NewDeliveryRepo(pg)
-	droneManager := usecase.NewDroneManagerUseCase(droneRepo, logger)
+	droneManager := usecase.NewDroneManagerUseCase(droneRepo, connectionRegistry, logger)
 
-	videoHandler := websocket.NewVideoHandler(minioClient, logger)
+	videoHandler := websocket.NewVideoHandler(minioClient, messageBus, cfg.Cluster.InstanceID, cfg.HTTP.AllowedOrigins, logger)
 
 	droneConnectionUseCase := usecase.NewDroneConnectionUseCase(droneRepo, droneManager, logger)
-	droneTelemetryUseCase := usecase.NewDroneTelemetryUseCase(droneRepo, logger)
-	droneCommandUseCase := usecase.NewDroneCommandUseCase(nil, logger)
+	telemetryRepo := repo.NewTelemetryRepo(pg)
+	telemetryHistoryUseCase := usecase.NewTelemetryHistoryUseCase(
+		telemetryRepo,
+		usecase.TelemetryHistoryPolicy{
+			SampleInterval:     time.Duration(cfg.Telemetry.SampleIntervalMs) * time.Millisecond,
+			IdleSampleInterval: time.Duration(cfg.Telemetry.IdleSampleIntervalMs) * time.Millisecond,
+			BatchSize:          cfg.Telemetry.HistoryBatchSize,
+			Retention:          time.Duration(cfg.Telemetry.HistoryRetentionDays) * 24 * time.Hour,
+			IdleRetention:      time.Duration(cfg.Telemetry.HistoryIdleRetentionDays) * 24 * time.Hour,
+		},
+		logger,
+	)
 
-	tempHandler := websocket.NewDroneWebSocketHandler(
-		droneConnectionUseCase,
-		droneTelemetryUseCase,
-		nil,
-		droneCommandUseCase,
+	droneTelemetryUseCase := usecase.NewDroneTelemetryUseCase(
+		droneRepo,
+		telemetryHistoryUseCase,
+		rabbitmqClient,
+		time.Duration(cfg.Telemetry.PublishIntervalMs)*time.Millisecond,
+		logger,
+	)
+	commandRepo := repo.NewCommandRepo(pg)
+	droneCommandUseCase := usecase.NewDroneCommandUseCase(
+		commandRepo,
+		usecase.CommandPolicy{
+			AckTimeout:  time.Duration(cfg.Commands.AckTimeoutMs) * time.Millisecond,
+			MaxAttempts: cfg.Commands.MaxAttempts,
+			TTL:         time.Duration(cfg.Commands.TTLSeconds) * time.Second,
+			BatchSize:   100,
+		},
 		logger,
 	)
 
@@ -85,57 +141,71 @@ func Run(cfg *config.Config) {
 		droneRepo,
 		deliveryRepo,
 		droneManager,
-		tempHandler,
+		droneCommandUseCase,
 		orchestratorGRPCClient,
 		rabbitmqClient,
 		logger,
 	)
 
-	droneDeliveryUseCase := usecase.NewDroneDeliveryUseCase(
-		deliveryRepo,
+	droneControlUseCase := usecase.NewDroneControlUseCase(
 		droneManager,
-		deliveryUseCase,
-		videoHandler,
-		logger,
-	)
-
-	droneWSHandler := websocket.NewDroneWebSocketHandler(
-		droneConnectionUseCase,
-		droneTelemetryUseCase,
-		droneDeliveryUseCase,
 		droneCommandUseCase,
+		deliveryUseCase,
+		repo.NewCommandAuditRepo(pg),
+		cfg.Commands.NoFlyZones,
 		logger,
 	)
 
-	droneCommandUseCase = usecase.NewDroneCommandUseCase(droneWSHandler, logger)
-
-	deliveryUseCase = usecase.NewDeliveryUseCase(
-		droneRepo,
+	droneDeliveryUseCase := usecase.NewDroneDeliveryUseCase(
 		deliveryRepo,
 		droneManager,
-		droneWSHandler,
-		orchestratorGRPCClient,
-		rabbitmqClient,
+		deliveryUseCase,
+		videoHandler,
 		logger,
 	)
 
-	droneDeliveryUseCase = usecase.NewDroneDeliveryUseCase(
-		deliveryRepo,
+	adminWSHandler := websocket.NewAdminWebSocketHandler(droneManager, messageBus, cfg.Cluster.InstanceID, cfg.AdminQueueSize, cfg.HTTP.AllowedOrigins, logger)
+	if err := adminWSHandler.Listen(clusterCtx); err != nil {
+		logger.Error("app - Run - adminWSHandler.Listen", err, nil)
+	}
+	droneConnectionUseCase.SetAdminNotifier(adminWSHandler)
+	droneTelemetryUseCase.SetAdminNotifier(adminWSHandler)
+	deliveryUseCase.SetAdminNotifier(adminWSHandler)
+
+	offlineThreshold := time.Duration(cfg.Liveness.OfflineThresholdSeconds) * time.Second
+	lostDeliveryTimeout := time.Duration(cfg.Liveness.LostDeliveryTimeoutSeconds) * time.Second
+	droneLivenessUseCase := usecase.NewDroneLivenessUseCase(
 		droneManager,
+		droneTelemetryUseCase,
 		deliveryUseCase,
-		videoHandler,
+		adminWSHandler,
+		offlineThreshold,
+		lostDeliveryTimeout,
 		logger,
 	)
 
-	droneWSHandler = websocket.NewDroneWebSocketHandler(
+	droneWSHandler := websocket.NewDroneWebSocketHandler(
 		droneConnectionUseCase,
 		droneTelemetryUseCase,
 		droneDeliveryUseCase,
 		droneCommandUseCase,
+		droneLivenessUseCase,
+		time.Duration(cfg.Liveness.PingIntervalSeconds)*time.Second,
+		time.Duration(cfg.Liveness.PongWaitSeconds)*time.Second,
+		cfg.HTTP.AllowedOrigins,
 		logger,
 	)
 
-	adminWSHandler := websocket.NewAdminWebSocketHandler(droneManager, droneRepo, cfg.BroadcastInterval, logger)
+	var droneTransport usecase.DroneNotifier = droneWSHandler
+	if messageBus != nil {
+		droneRouter := usecase.NewDroneRouterUseCase(droneWSHandler, connectionRegistry, messageBus, cfg.Cluster.InstanceID, logger)
+		if err := droneRouter.Listen(clusterCtx); err != nil {
+			logger.Error("app - Run - droneRouter.Listen", err, nil)
+		}
+		droneTransport = droneRouter
+	}
+	droneCommandUseCase.SetTransport(droneTransport)
+	go droneManager.StartLeaseWorker(clusterCtx, leaseTTL/3)
 
 	deliveryWorker := rabbitmq.NewDeliveryWorker(rabbitmqClient, deliveryUseCase, logger)
 	if err := deliveryWorker.Start(ctx); err != nil {
@@ -143,15 +213,29 @@ func Run(cfg *config.Config) {
 	}
 	logger.Info("Delivery worker started successfully", nil)
 
+	historyCtx, stopHistory := context.WithCancel(ctx)
+	historyDone := make(chan struct{})
+	go func() {
+		defer close(historyDone)
+		telemetryHistoryUseCase.StartFlushWorker(historyCtx, time.Duration(cfg.Telemetry.HistoryFlushIntervalMs)*time.Millisecond)
+	}()
+	go telemetryHistoryUseCase.StartRetentionWorker(historyCtx, time.Hour)
+
+	commandCtx, stopCommands := context.WithCancel(ctx)
+	go droneCommandUseCase.StartRetryWorker(commandCtx, time.Duration(cfg.Commands.RetryIntervalMs)*time.Millisecond)
+	go droneLivenessUseCase.StartLivenessWorker(commandCtx, offlineThreshold/3)
+
 	gin.SetMode(cfg.GinMode)
 	router := gin.New()
 
 	router.Use(gin.Recovery())
 	router.Use(middleware.Logger(logger))
-	router.Use(middleware.CORS())
+	router.Use(middleware.CORS(cfg.HTTP.AllowedOrigins))
 	prometheusMiddleware.Use(router)
 
-	v1.NewRouter(router, droneWSHandler, adminWSHandler, videoHandler, droneManager)
+	jwtMiddleware := middleware.NewJWTMiddleware(jwt.NewJWTService(cfg.JWT.AccessSecret))
+
+	v1.NewRouter(router, jwtMiddleware, droneWSHandler, adminWSHandler, videoHandler, droneManager, droneCommandUseCase, droneControlUseCase)
 
 	httpServer := &http.Server{
 		Addr:         fmt.Sprintf(":%s", cfg.Port),
@@ -179,4 +263,9 @@ func Run(cfg *config.Config) {
 	if err := httpServer.Shutdown(shutdownCtx); err != nil {
 		logger.Error("app - Run - httpServer.Shutdown", err)
 	}
+
+	stopCommands()
+	stopCluster()
+	stopHistory()
+	<-historyDone
 }
@@ -4,12 +4,15 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func CORS() gin.HandlerFunc {
+func CORS(allowedOrigins []string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		origin := c.Request.Header.Get("Origin")
 		if origin != "" {
-			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
-			c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+			if OriginAllowed(allowedOrigins, origin) {
+				c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+				c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+				c.Writer.Header().Set("Vary", "Origin")
+			}
 		} else {
 			c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		}
@@ -25,3 +28,18 @@ func CORS() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// OriginAllowed reports whether a browser origin may call the service. "*" allows every origin.
+// Requests without an Origin header come from drones and other services, not browsers, and are
+// always allowed.
+func OriginAllowed(allowedOrigins []string, origin string) bool {
+	if origin == "" {
+		return true
+	}
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+	return false
+}
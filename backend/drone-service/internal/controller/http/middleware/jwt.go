@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/jwt"
+)
+
+const (
+	AuthorizationHeader = "Authorization"
+	BearerSchema        = "Bearer "
+	// AccessTokenQuery carries the token on WebSocket upgrades, where browsers cannot set headers.
+	AccessTokenQuery = "access_token"
+	UserIDKey        = "user_id"
+	RoleKey          = "role"
+	ClaimsKey        = "jwt_claims"
+)
+
+const (
+	RoleAdmin    = "admin"
+	RoleOperator = "operator"
+)
+
+type JWTMiddleware struct {
+	JWTService *jwt.JWTService
+}
+
+func NewJWTMiddleware(jwtService *jwt.JWTService) *JWTMiddleware {
+	return &JWTMiddleware{
+		JWTService: jwtService,
+	}
+}
+
+func (j *JWTMiddleware) RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, err := j.extractToken(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Unauthorized: " + err.Error(),
+			})
+			c.Abort()
+			return
+		}
+
+		claims, err := j.JWTService.ValidateAccessToken(token)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Unauthorized: Invalid or expired token",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Set(UserIDKey, claims.UserID)
+		c.Set(RoleKey, claims.Role)
+		c.Set(ClaimsKey, claims)
+
+		c.Next()
+	}
+}
+
+func (j *JWTMiddleware) RequireRole(allowedRoles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := GetClaims(c)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Unauthorized: Token not found",
+			})
+			c.Abort()
+			return
+		}
+
+		for _, role := range allowedRoles {
+			if claims.Role == role {
+				c.Next()
+				return
+			}
+		}
+
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Forbidden: Insufficient permissions",
+		})
+		c.Abort()
+	}
+}
+
+func (j *JWTMiddleware) AdminOrOperator() gin.HandlerFunc {
+	return j.RequireRole(RoleAdmin, RoleOperator)
+}
+
+func (j *JWTMiddleware) extractToken(c *gin.Context) (string, error) {
+	authHeader := c.GetHeader(AuthorizationHeader)
+	if authHeader == "" {
+		if token := c.Query(AccessTokenQuery); token != "" {
+			return token, nil
+		}
+		return "", errors.New("authorization header missing")
+	}
+
+	if !strings.HasPrefix(authHeader, BearerSchema) {
+		return "", errors.New("invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeader, BearerSchema), nil
+}
+
+func GetUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get(UserIDKey)
+	if !exists {
+		return uuid.Nil, false
+	}
+	return userID.(uuid.UUID), true
+}
+
+func GetClaims(c *gin.Context) (*jwt.CustomClaims, bool) {
+	claims, exists := c.Get(ClaimsKey)
+	if !exists {
+		return nil, false
+	}
+	userClaims, ok := claims.(*jwt.CustomClaims)
+	return userClaims, ok
+}
@@ -1,16 +1,18 @@
 package middleware
 
 import (
+	"net/url"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
-	"time"
 )
 
 func Logger(log logger.Interface) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
 		path := c.Request.URL.Path
-		query := c.Request.URL.RawQuery
+		query := redactQuery(c.Request.URL.Query())
 
 		c.Next()
 
@@ -35,3 +37,11 @@ func Logger(log logger.Interface) gin.HandlerFunc {
 		}
 	}
 }
+
+// redactQuery keeps access tokens passed on WebSocket upgrades out of the logs.
+func redactQuery(query url.Values) string {
+	if query.Has(AccessTokenQuery) {
+		query.Set(AccessTokenQuery, "REDACTED")
+	}
+	return query.Encode()
+}
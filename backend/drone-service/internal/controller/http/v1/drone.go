@@ -1,119 +1,271 @@
 package v1
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/middleware"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/v1/request"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/v1/response"
-	_ "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
+)
+
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 200
 )
 
 type DroneHandler struct {
 	droneManager *usecase.DroneManagerUseCase
+	commandUC    *usecase.DroneCommandUseCase
+	controlUC    *usecase.DroneControlUseCase
 }
 
-func NewDroneHandler(droneManager *usecase.DroneManagerUseCase) *DroneHandler {
+func NewDroneHandler(
+	droneManager *usecase.DroneManagerUseCase,
+	commandUC *usecase.DroneCommandUseCase,
+	controlUC *usecase.DroneControlUseCase,
+) *DroneHandler {
 	return &DroneHandler{
 		droneManager: droneManager,
+		commandUC:    commandUC,
+		controlUC:    controlUC,
 	}
 }
 
-// @Summary      Get drone status
-// @Description  Returns current status of the first registered drone
+// @Summary      List drones
+// @Description  Returns every provisioned drone with its last persisted state and, when it is connected to any replica, its connection
 // @Tags         drones
-// @Accept       json
 // @Produce      json
-// @Success      200 {object} response.DroneStatus
+// @Security     Bearer
+// @Success      200 {object} response.DroneList
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
 // @Failure      500 {object} response.Error
-// @Router       /status [get]
-func (h *DroneHandler) GetStatus(c *gin.Context) {
-	drones := h.droneManager.GetAllDrones()
-
-	if len(drones) == 0 {
-		c.JSON(http.StatusOK, response.DroneStatus{
-			DroneID:      "unknown",
-			Status:       "no_drones_registered",
-			BatteryLevel: 0.0,
-			Position: response.Position{
-				Latitude:  0.0,
-				Longitude: 0.0,
-				Altitude:  0.0,
-			},
-			Speed:             0.0,
-			CurrentDeliveryID: nil,
-			ErrorMessage:      "No drones registered",
-		})
+// @Router       /v1/api/drones [get]
+func (h *DroneHandler) ListDrones(c *gin.Context) {
+	fleet, err := h.droneManager.ListFleet(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
 		return
 	}
 
-	droneID := drones[0]
-	state, err := h.droneManager.GetDroneState(c.Request.Context(), droneID)
+	drones := make([]response.Drone, 0, len(fleet))
+	for _, drone := range fleet {
+		drones = append(drones, toDroneResponse(drone))
+	}
+
+	c.JSON(http.StatusOK, response.DroneList{Drones: drones})
+}
 
+// @Summary      Get drone
+// @Description  Returns one drone with its last persisted state and its connection, if any
+// @Tags         drones
+// @Produce      json
+// @Param        drone_id path string true "Drone ID"
+// @Security     Bearer
+// @Success      200 {object} response.Drone
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      500 {object} response.Error
+// @Router       /v1/api/drones/{drone_id} [get]
+func (h *DroneHandler) GetDrone(c *gin.Context) {
+	drone, err := h.droneManager.GetFleetDrone(c.Request.Context(), c.Param("drone_id"))
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 
-	if state == nil {
-		c.JSON(http.StatusOK, response.DroneStatus{
-			DroneID:      droneID,
-			Status:       "unknown",
-			BatteryLevel: 0.0,
-			Position: response.Position{
-				Latitude:  0.0,
-				Longitude: 0.0,
-				Altitude:  0.0,
-			},
-			Speed:             0.0,
-			CurrentDeliveryID: nil,
-			ErrorMessage:      "Drone state not available",
-		})
-		return
-	}
-
-	c.JSON(http.StatusOK, response.DroneStatus{
-		DroneID:      state.DroneID,
-		Status:       string(state.Status),
-		BatteryLevel: state.BatteryLevel,
-		Position: response.Position{
-			Latitude:  state.CurrentPosition.Latitude,
-			Longitude: state.CurrentPosition.Longitude,
-			Altitude:  state.CurrentPosition.Altitude,
-		},
-		Speed:             state.Speed,
-		CurrentDeliveryID: state.CurrentDeliveryID,
-		ErrorMessage: func() string {
-			if state.ErrorMessage != nil {
-				return *state.ErrorMessage
-			}
-			return ""
-		}(),
-	})
+	c.JSON(http.StatusOK, toDroneResponse(drone))
 }
 
 // @Summary      Send command to drone
-// @Description  Sends control command to specific drone
+// @Description  Queues an operator command for the drone. The command is validated, then checked against the drone's last reported state: the drone has to be connected, flight commands need it airborne, drop_cargo needs it at its destination, goto is refused inside a no-fly zone, open_cargo_hook is refused in flight and reboot_agent during a mission. Every attempt is written to the drone's command audit log
 // @Tags         drones
 // @Accept       json
 // @Produce      json
 // @Param        drone_id path string true "Drone ID"
 // @Param        request body request.SendCommand true "Command for drone"
-// @Success      200 {object} response.CommandResponse
+// @Security     Bearer
+// @Success      202 {object} response.DroneCommandStatus
 // @Failure      400 {object} response.Error
-// @Router       /api/drones/{drone_id}/command [post]
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      409 {object} response.Error
+// @Failure      500 {object} response.Error
+// @Router       /v1/api/drones/{drone_id}/command [post]
 func (h *DroneHandler) SendCommand(c *gin.Context) {
-	droneID := c.Param("drone_id")
-
 	var req request.SendCommand
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, response.CommandResponse{
-		Status:  "command_sent",
-		Message: "Command received for drone " + droneID,
-	})
+	claims, ok := middleware.GetClaims(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, response.Error{Error: "Unauthorized: Token not found"})
+		return
+	}
+	operator := entity.Operator{
+		ID:    claims.UserID.String(),
+		Email: claims.Email,
+		Role:  claims.Role,
+	}
+
+	command, err := h.controlUC.Execute(c.Request.Context(), c.Param("drone_id"), operator, toDroneCommand(req))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, toDroneCommandStatusResponse(command))
+}
+
+// @Summary      Get command status
+// @Description  Returns the delivery status of a command sent to a drone: pending (not yet written to the drone), sent, acked, executed, failed or timed_out
+// @Tags         drones
+// @Produce      json
+// @Param        drone_id path string true "Drone ID"
+// @Param        command_id path string true "Command ID"
+// @Security     Bearer
+// @Success      200 {object} response.DroneCommandStatus
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Router       /v1/api/drones/{drone_id}/commands/{command_id} [get]
+func (h *DroneHandler) GetCommand(c *gin.Context) {
+	command, err := h.commandUC.GetCommand(c.Request.Context(), c.Param("drone_id"), c.Param("command_id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toDroneCommandStatusResponse(command))
+}
+
+// @Summary      List command audit
+// @Description  Returns the latest operator command attempts for a drone, newest first, with who issued them and whether they were accepted, rejected or failed
+// @Tags         drones
+// @Produce      json
+// @Param        drone_id path string true "Drone ID"
+// @Param        limit query int false "Maximum entries (1-200)" default(50)
+// @Security     Bearer
+// @Success      200 {object} response.CommandAuditList
+// @Failure      400 {object} response.Error
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      500 {object} response.Error
+// @Router       /v1/api/drones/{drone_id}/audit [get]
+func (h *DroneHandler) ListCommandAudit(c *gin.Context) {
+	limit := defaultAuditLimit
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxAuditLimit {
+			c.JSON(http.StatusBadRequest, response.Error{Error: fmt.Sprintf("limit must be between 1 and %d", maxAuditLimit)})
+			return
+		}
+		limit = parsed
+	}
+
+	audits, err := h.controlUC.ListAudit(c.Request.Context(), c.Param("drone_id"), limit)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	entries := make([]response.CommandAudit, 0, len(audits))
+	for _, audit := range audits {
+		entries = append(entries, response.CommandAudit{
+			ID:            audit.ID,
+			DroneID:       audit.DroneID,
+			CommandID:     audit.CommandID,
+			Command:       audit.Command,
+			Payload:       audit.Payload,
+			OperatorID:    audit.OperatorID,
+			OperatorEmail: audit.OperatorEmail,
+			OperatorRole:  audit.OperatorRole,
+			Result:        string(audit.Result),
+			Reason:        audit.Reason,
+			CreatedAt:     audit.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, response.CommandAuditList{Entries: entries})
+}
+
+func toDroneCommand(req request.SendCommand) *droneproto.Command {
+	command := &droneproto.Command{
+		Command:        droneproto.CommandName(req.Command),
+		DeliveryID:     req.DeliveryID,
+		OrderID:        req.OrderID,
+		CellID:         req.CellID,
+		InternalCellID: req.InternalCellID,
+		BaseMarkerID:   req.BaseMarkerID,
+		Altitude:       req.Altitude,
+		Reason:         req.Reason,
+	}
+	if req.Waypoint != nil {
+		command.Waypoint = &droneproto.Position{
+			Latitude:  req.Waypoint.Latitude,
+			Longitude: req.Waypoint.Longitude,
+			Altitude:  req.Waypoint.Altitude,
+		}
+	}
+	return command
+}
+
+func toDroneResponse(drone *entity.FleetDrone) response.Drone {
+	resp := response.Drone{
+		DroneID:      drone.DroneID,
+		Model:        drone.Model,
+		Status:       string(drone.Status),
+		BatteryLevel: drone.BatteryLevel,
+		Position: response.Position{
+			Latitude:  drone.CurrentPosition.Latitude,
+			Longitude: drone.CurrentPosition.Longitude,
+			Altitude:  drone.CurrentPosition.Altitude,
+		},
+		Speed:             drone.Speed,
+		CurrentDeliveryID: drone.CurrentDeliveryID,
+		LastUpdated:       drone.LastUpdated,
+		Connected:         drone.Connection != nil,
+	}
+	if drone.ErrorMessage != nil {
+		resp.ErrorMessage = *drone.ErrorMessage
+	}
+	if conn := drone.Connection; conn != nil {
+		resp.Connection = &response.DroneConnection{
+			SessionID:       conn.SessionID,
+			InstanceID:      conn.InstanceID,
+			AgentVersion:    conn.AgentVersion,
+			ProtocolVersion: conn.ProtocolVersion,
+			RemoteAddr:      conn.RemoteAddr,
+			ConnectedAt:     conn.ConnectedAt,
+		}
+	}
+	return resp
+}
+
+func toDroneCommandStatusResponse(command *entity.DroneCommand) response.DroneCommandStatus {
+	return response.DroneCommandStatus{
+		CommandID:   command.ID,
+		DroneID:     command.DroneID,
+		Command:     command.Command,
+		Status:      string(command.Status),
+		Attempts:    command.Attempts,
+		MaxAttempts: command.MaxAttempts,
+		LastError:   command.LastError,
+		CreatedAt:   command.CreatedAt,
+		SentAt:      command.SentAt,
+		AckedAt:     command.AckedAt,
+		CompletedAt: command.CompletedAt,
+		ExpiresAt:   command.ExpiresAt,
+	}
 }
@@ -17,17 +17,20 @@ func handleError(c *gin.Context, err error) {
 		errors.Is(err, entityError.ErrDroneInvalidIP),
 		errors.Is(err, entityError.ErrDroneInvalidStatus),
 		errors.Is(err, entityError.ErrDroneNothingToUpdate),
-		errors.Is(err, entityError.ErrDeliveryInvalidStatus):
+		errors.Is(err, entityError.ErrDeliveryInvalidStatus),
+		errors.Is(err, entityError.ErrDroneCommandInvalid):
 		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
 
 	case errors.Is(err, entityError.ErrDroneNotFound),
 		errors.Is(err, entityError.ErrDroneNotAvailable),
 		errors.Is(err, entityError.ErrDroneStateNotFound),
 		errors.Is(err, entityError.ErrDeliveryNotFound),
-		errors.Is(err, entityError.ErrDeliveryTaskNotFound):
+		errors.Is(err, entityError.ErrDeliveryTaskNotFound),
+		errors.Is(err, entityError.ErrDroneCommandNotFound):
 		c.JSON(http.StatusNotFound, response.Error{Error: err.Error()})
 
-	case errors.Is(err, entityError.ErrDroneCannotDelete):
+	case errors.Is(err, entityError.ErrDroneCannotDelete),
+		errors.Is(err, entityError.ErrDroneCommandRejected):
 		c.JSON(http.StatusConflict, response.Error{Error: err.Error()})
 
 	case errors.Is(err, grpcError.ErrGRPCClientNotReady),
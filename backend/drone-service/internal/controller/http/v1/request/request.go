@@ -1,6 +1,21 @@
 package request
 
+// SendCommand carries an operator command. Which fields are needed depends on the command: drop_cargo
+// needs order_id, abort_delivery needs delivery_id, goto needs waypoint and set_altitude needs altitude.
 type SendCommand struct {
-	Command string         `json:"command" binding:"required" example:"start_delivery"`
-	Payload map[string]any `json:"payload,omitempty"`
+	Command        string    `json:"command" binding:"required" example:"goto" enums:"hold,land,return_to_base,goto,set_altitude,drop_cargo,abort_delivery,open_cargo_hook,close_cargo_hook,reboot_agent"`
+	DeliveryID     string    `json:"delivery_id,omitempty" example:"delivery-123"`
+	OrderID        string    `json:"order_id,omitempty" example:"order-456"`
+	CellID         string    `json:"cell_id,omitempty" example:"cell-1"`
+	InternalCellID string    `json:"internal_cell_id,omitempty" example:"internal-cell-1"`
+	BaseMarkerID   *int      `json:"base_marker_id,omitempty" example:"131"`
+	Waypoint       *Waypoint `json:"waypoint,omitempty"`
+	Altitude       *float64  `json:"altitude,omitempty" example:"40"`
+	Reason         string    `json:"reason,omitempty" example:"wind gusts over the drop zone"`
+}
+
+type Waypoint struct {
+	Latitude  float64 `json:"latitude" example:"55.751244"`
+	Longitude float64 `json:"longitude" example:"37.618423"`
+	Altitude  float64 `json:"altitude" example:"40"`
 }
@@ -1,5 +1,10 @@
 package response
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type Error struct {
 	Error string `json:"error" example:"internal server error"`
 }
@@ -9,14 +14,31 @@ type Health struct {
 	Service string `json:"service" example:"drone-service"`
 }
 
-type DroneStatus struct {
-	DroneID           string   `json:"drone_id" example:"drone-001"`
-	Status            string   `json:"status" example:"idle"`
-	BatteryLevel      float64  `json:"battery_level" example:"85.5"`
-	Position          Position `json:"position"`
-	Speed             float64  `json:"speed" example:"15.3"`
-	CurrentDeliveryID *string  `json:"current_delivery_id" example:"delivery-123"`
-	ErrorMessage      string   `json:"error_message,omitempty" example:""`
+type DroneList struct {
+	Drones []Drone `json:"drones"`
+}
+
+type Drone struct {
+	DroneID           string           `json:"drone_id" example:"6f1c2a4e-3b1d-4c8e-9a0f-2d5e7b9c1a3f"`
+	Model             string           `json:"model" example:"DJI Mavic 3"`
+	Status            string           `json:"status" example:"idle"`
+	BatteryLevel      float64          `json:"battery_level" example:"85.5"`
+	Position          Position         `json:"position"`
+	Speed             float64          `json:"speed" example:"15.3"`
+	CurrentDeliveryID *string          `json:"current_delivery_id" example:"delivery-123"`
+	ErrorMessage      string           `json:"error_message,omitempty" example:""`
+	LastUpdated       time.Time        `json:"last_updated"`
+	Connected         bool             `json:"connected" example:"true"`
+	Connection        *DroneConnection `json:"connection,omitempty"`
+}
+
+type DroneConnection struct {
+	SessionID       string    `json:"session_id" example:"9d2b7c4e-1a3f-4e5d-8c6b-7a9e0f1d2c3b"`
+	InstanceID      string    `json:"instance_id,omitempty" example:"drone-service-7c9f8b6d4-x2k4q"`
+	AgentVersion    string    `json:"agent_version,omitempty" example:"1.0.0"`
+	ProtocolVersion int       `json:"protocol_version" example:"1"`
+	RemoteAddr      string    `json:"remote_addr" example:"10.0.0.5"`
+	ConnectedAt     time.Time `json:"connected_at"`
 }
 
 type Position struct {
@@ -25,7 +47,57 @@ type Position struct {
 	Altitude  float64 `json:"altitude" example:"150.0"`
 }
 
-type CommandResponse struct {
-	Status  string `json:"status" example:"command_sent"`
-	Message string `json:"message" example:"Command successfully queued for delivery"`
+type DroneCommandStatus struct {
+	CommandID   string     `json:"command_id" example:"5b0e7c1a-3d2f-4e6b-9a8c-1f2e3d4c5b6a"`
+	DroneID     string     `json:"drone_id" example:"6f1c2a4e-3b1d-4c8e-9a0f-2d5e7b9c1a3f"`
+	Command     string     `json:"command" example:"drop_cargo"`
+	Status      string     `json:"status" example:"acked" enums:"pending,sent,acked,executed,failed,timed_out"`
+	Attempts    int        `json:"attempts" example:"1"`
+	MaxAttempts int        `json:"max_attempts" example:"5"`
+	LastError   *string    `json:"last_error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	SentAt      *time.Time `json:"sent_at,omitempty"`
+	AckedAt     *time.Time `json:"acked_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+}
+
+type CommandAuditList struct {
+	Entries []CommandAudit `json:"entries"`
+}
+
+type CommandAudit struct {
+	ID            string          `json:"id" example:"1c7e9a3b-5d2f-4b8e-a6c4-0f9d2e4b7a1c"`
+	DroneID       string          `json:"drone_id" example:"6f1c2a4e-3b1d-4c8e-9a0f-2d5e7b9c1a3f"`
+	CommandID     *string         `json:"command_id,omitempty" example:"5b0e7c1a-3d2f-4e6b-9a8c-1f2e3d4c5b6a"`
+	Command       string          `json:"command" example:"goto"`
+	Payload       json.RawMessage `json:"payload" swaggertype:"object"`
+	OperatorID    string          `json:"operator_id" example:"3f8a2c1e-5b7d-4e9f-8a6c-2d4b6e8f0a1c"`
+	OperatorEmail string          `json:"operator_email" example:"operator@example.com"`
+	OperatorRole  string          `json:"operator_role" example:"operator" enums:"admin,operator"`
+	Result        string          `json:"result" example:"rejected" enums:"accepted,rejected,failed"`
+	Reason        *string         `json:"reason,omitempty" example:"drone command rejected by safety interlock: goto: waypoint is inside no-fly zone \"airport\""`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type VideoStats struct {
+	Drones []DroneVideoStats `json:"drones"`
+}
+
+type DroneVideoStats struct {
+	DroneID        string             `json:"drone_id" example:"drone-001"`
+	FramesReceived uint64             `json:"frames_received" example:"5400"`
+	FPS            float64            `json:"fps" example:"15.2"`
+	Viewers        []VideoViewerStats `json:"viewers"`
+}
+
+type VideoViewerStats struct {
+	ViewerID      string    `json:"viewer_id" example:"3f1e9a4c-2b7d-4f0a-9c1e-8d5b6a7f2e10"`
+	Format        string    `json:"format" example:"binary"`
+	RemoteAddr    string    `json:"remote_addr" example:"10.0.0.12"`
+	ConnectedAt   time.Time `json:"connected_at"`
+	FramesSent    uint64    `json:"frames_sent" example:"5380"`
+	FramesDropped uint64    `json:"frames_dropped" example:"20"`
+	Queued        int       `json:"queued" example:"1"`
+	FPS           float64   `json:"fps" example:"14.9"`
 }
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/middleware"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/v1/response"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/websocket"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase"
@@ -12,23 +13,34 @@ import (
 
 func NewRouter(
 	router *gin.Engine,
+	jwtMiddleware *middleware.JWTMiddleware,
 	droneWSHandler *websocket.DroneWebSocketHandler,
 	adminWSHandler *websocket.AdminWebSocketHandler,
 	videoHandler *websocket.VideoHandler,
 	droneManager *usecase.DroneManagerUseCase,
+	droneCommandUseCase *usecase.DroneCommandUseCase,
+	droneControlUseCase *usecase.DroneControlUseCase,
 ) {
-	droneHandler := NewDroneHandler(droneManager)
+	droneHandler := NewDroneHandler(droneManager, droneCommandUseCase, droneControlUseCase)
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	router.GET("/health", healthCheck)
-	router.GET("/status", droneHandler.GetStatus)
 
 	api := router.Group("/v1/api")
 	{
-		drones := api.Group("/drones")
+		drones := api.Group("/drones", jwtMiddleware.RequireAuth(), jwtMiddleware.AdminOrOperator())
 		{
+			drones.GET("", droneHandler.ListDrones)
+			drones.GET("/:drone_id", droneHandler.GetDrone)
 			drones.POST("/:drone_id/command", droneHandler.SendCommand)
+			drones.GET("/:drone_id/commands/:command_id", droneHandler.GetCommand)
+			drones.GET("/:drone_id/audit", droneHandler.ListCommandAudit)
+		}
+
+		video := api.Group("/video", jwtMiddleware.RequireAuth(), jwtMiddleware.AdminOrOperator())
+		{
+			video.GET("/stats", videoHandler.GetStats)
 		}
 	}
 
@@ -36,8 +48,12 @@ func NewRouter(
 	{
 		ws.GET("/drone", droneWSHandler.HandleDroneConnection)
 		ws.GET("/drone/:drone_id", droneWSHandler.HandleDroneConnection)
-		ws.GET("/drone/:drone_id/video", videoHandler.HandleAdminVideoConnection)
-		ws.GET("/admin", adminWSHandler.HandleAdminConnection)
+
+		operators := ws.Group("", jwtMiddleware.RequireAuth(), jwtMiddleware.AdminOrOperator())
+		{
+			operators.GET("/drone/:drone_id/video", videoHandler.HandleAdminVideoConnection)
+			operators.GET("/admin", adminWSHandler.HandleAdminConnection)
+		}
 	}
 }
 
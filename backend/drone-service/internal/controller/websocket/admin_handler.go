@@ -4,58 +4,104 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"reflect"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/middleware"
+	// Referenced by the swagger annotations of HandleAdminConnection.
+	_ "github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/v1/response"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
 )
 
+const adminWriteTimeout = 5 * time.Second
+
+// adminConn is one admin socket. seq numbers every snapshot and delta it is sent; a message dropped
+// because the admin fell behind still uses its number, so the admin sees the gap and asks for a resync.
+// known holds the drone fields the admin was last sent, which deltas are computed against.
+type adminConn struct {
+	id          string
+	adminID     string
+	remoteAddr  string
+	connectedAt time.Time
+	conn        *SafeConn
+	queue       chan []byte
+
+	mu    sync.Mutex
+	sub   entity.AdminSubscription
+	seq   uint64
+	known map[string]map[string]any
+}
+
+type adminMessage struct {
+	Type string `json:"type"`
+	entity.AdminSubscription
+}
+
+// AdminWebSocketHandler feeds admins the events of the whole fleet as they happen. With a bus, events
+// raised on this replica are relayed to the others, and events relayed by the others are fanned out here.
 type AdminWebSocketHandler struct {
-	connectedAdmins   map[string]*SafeConn
-	mu                sync.RWMutex
-	upgrader          websocket.Upgrader
-	droneManager      *usecase.DroneManagerUseCase
-	droneRepo         repo.DroneRepo
-	broadcastInterval int
-	broadcastOnce     sync.Once
-	logger            logger.Interface
+	admins       map[string]*adminConn
+	mu           sync.RWMutex
+	upgrader     websocket.Upgrader
+	droneManager *usecase.DroneManagerUseCase
+	bus          repo.MessageBus
+	instanceID   string
+	queueSize    int
+	logger       logger.Interface
 }
 
-func NewAdminWebSocketHandler(droneManager *usecase.DroneManagerUseCase, droneRepo repo.DroneRepo, broadcastInterval int, log logger.Interface) *AdminWebSocketHandler {
+func NewAdminWebSocketHandler(
+	droneManager *usecase.DroneManagerUseCase,
+	bus repo.MessageBus,
+	instanceID string,
+	queueSize int,
+	allowedOrigins []string,
+	log logger.Interface,
+) *AdminWebSocketHandler {
 	return &AdminWebSocketHandler{
-		connectedAdmins:   make(map[string]*SafeConn),
-		droneManager:      droneManager,
-		droneRepo:         droneRepo,
-		broadcastInterval: broadcastInterval,
-		logger:            log,
+		admins:       make(map[string]*adminConn),
+		droneManager: droneManager,
+		bus:          bus,
+		instanceID:   instanceID,
+		queueSize:    queueSize,
+		logger:       log,
 		upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
-				return true
+				return middleware.OriginAllowed(allowedOrigins, r.Header.Get("Origin"))
 			},
 		},
 	}
 }
 
+// Listen subscribes this replica to the events raised on the others.
+func (h *AdminWebSocketHandler) Listen(ctx context.Context) error {
+	if h.bus == nil {
+		return nil
+	}
+	return h.bus.Subscribe(ctx, entity.AdminEventsChannel(), h.handleRelayedEvent)
+}
+
 // @Summary      Admin WebSocket connection
 // @Description  Establishes WebSocket connection for drone monitoring by administrator
-// @Description  Administrator receives real-time updates of all drones and deliveries status
+// @Description  The admin first gets a welcome with its connection_id, then a snapshot of the fleet, then numbered deltas as drones, connections and deliveries change
+// @Description  Send {"type":"subscribe","drones":[...],"deliveries":[...],"events":[...]} to narrow the feed and {"type":"resync"} for a new snapshot
 // @Tags         websocket
 // @Accept       json
 // @Produce      json
-// @Param        admin_id query string false "Administrator ID"
+// @Param        access_token query string true "Orchestrator access token with the admin or operator role"
 // @Success      101 {string} string "Switching Protocols"
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
 // @Router       /ws/admin [get]
 func (h *AdminWebSocketHandler) HandleAdminConnection(c *gin.Context) {
-	adminID := c.Query("admin_id")
-	if adminID == "" {
-		adminID = "admin"
-	}
-
 	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
 		h.logger.Error("Failed to upgrade admin connection", err, nil)
@@ -65,127 +111,276 @@ func (h *AdminWebSocketHandler) HandleAdminConnection(c *gin.Context) {
 		_ = conn.Close()
 	}()
 
-	safeConn := &SafeConn{Conn: conn}
+	adminID, _ := middleware.GetUserID(c)
+	admin := &adminConn{
+		id:          uuid.NewString(),
+		adminID:     adminID.String(),
+		remoteAddr:  c.ClientIP(),
+		connectedAt: time.Now(),
+		conn:        &SafeConn{Conn: conn},
+		queue:       make(chan []byte, h.queueSize),
+		known:       make(map[string]map[string]any),
+	}
+
+	done := make(chan struct{})
+	go h.writeMessages(admin, done)
 
 	h.mu.Lock()
-	h.connectedAdmins[adminID] = safeConn
+	h.admins[admin.id] = admin
 	h.mu.Unlock()
 
-	h.broadcastOnce.Do(func() {
-		go h.startBroadcastLoop()
-	})
-
 	defer func() {
 		h.mu.Lock()
-		delete(h.connectedAdmins, adminID)
+		delete(h.admins, admin.id)
 		h.mu.Unlock()
+		close(done)
 	}()
 
+	ctx := c.Request.Context()
+	h.enqueue(admin, map[string]any{
+		"type":          "welcome",
+		"connection_id": admin.id,
+		"admin_id":      admin.adminID,
+		"event_types":   entity.AdminEventTypes,
+		"timestamp":     time.Now().Format(time.RFC3339),
+	})
+	h.sendSnapshot(ctx, admin)
+
 	for {
 		_, message, err := conn.ReadMessage()
 		if err != nil {
-			h.logger.Error("Error reading message from admin", err, map[string]any{"admin_id": adminID})
+			h.logger.Warn("Admin connection closed", err, map[string]any{
+				"connection_id": admin.id,
+				"admin_id":      admin.adminID,
+			})
 			break
 		}
 
-		if err := h.processAdminMessage(context.Background(), adminID, safeConn, message); err != nil {
-			h.logger.Error("Error processing message from admin", err, map[string]any{"admin_id": adminID})
-		}
+		h.processAdminMessage(ctx, admin, message)
 	}
 }
 
-func (h *AdminWebSocketHandler) processAdminMessage(_ context.Context, _ string, conn *SafeConn, message []byte) error {
-	var data map[string]any
-	if err := json.Unmarshal(message, &data); err != nil {
-		return err
-	}
-
-	msgType, ok := data["type"].(string)
-	if !ok {
-		return nil
+func (h *AdminWebSocketHandler) processAdminMessage(ctx context.Context, admin *adminConn, message []byte) {
+	var msg adminMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		h.sendError(admin, "message must be a JSON object")
+		return
 	}
 
-	if msgType == "ping" {
-		response := map[string]any{
+	switch msg.Type {
+	case "ping":
+		h.enqueue(admin, map[string]any{
 			"type":      "pong",
 			"timestamp": time.Now().Format(time.RFC3339),
-		}
-		return conn.WriteJSON(response)
+		})
+	case "subscribe":
+		admin.mu.Lock()
+		admin.sub = msg.AdminSubscription
+		admin.mu.Unlock()
+		h.sendSnapshot(ctx, admin)
+	case "resync":
+		h.sendSnapshot(ctx, admin)
+	default:
+		h.sendError(admin, "unknown message type: "+msg.Type)
 	}
-
-	return nil
 }
 
-func (h *AdminWebSocketHandler) startBroadcastLoop() {
-	ticker := time.NewTicker(time.Duration(h.broadcastInterval) * time.Second)
-	defer ticker.Stop()
+// sendSnapshot sends the current state of every drone the admin is subscribed to and resets what the
+// next deltas are computed against.
+func (h *AdminWebSocketHandler) sendSnapshot(ctx context.Context, admin *adminConn) {
+	fleet, err := h.droneManager.ListFleet(ctx)
+	if err != nil {
+		h.logger.Error("Failed to build admin snapshot", err, map[string]any{"connection_id": admin.id})
+		h.sendError(admin, "snapshot unavailable, retry with resync")
+		return
+	}
 
-	for range ticker.C {
-		ctx := context.Background()
-		dronesStatus := h.getAllDronesStatus(ctx)
+	admin.mu.Lock()
+	defer admin.mu.Unlock()
 
-		message := map[string]any{
-			"type":      "drones_status",
-			"timestamp": time.Now().Format(time.RFC3339),
-			"drones":    dronesStatus,
+	admin.known = make(map[string]map[string]any)
+	drones := make([]map[string]any, 0, len(fleet))
+	for _, drone := range fleet {
+		if !admin.sub.MatchesDrone(drone.DroneID, drone.CurrentDeliveryID) {
+			continue
 		}
 
-		h.broadcast(message)
+		state := normalizePayload(entity.DroneStateEvent(&drone.DroneState).Payload)
+		admin.known[drone.DroneID] = state
+
+		entry := map[string]any{
+			"drone_id":   drone.DroneID,
+			"model":      drone.Model,
+			"connected":  drone.Connection != nil,
+			"connection": drone.Connection,
+		}
+		for field, value := range state {
+			entry[field] = value
+		}
+		drones = append(drones, entry)
 	}
+
+	admin.seq++
+	h.enqueue(admin, map[string]any{
+		"type":         "snapshot",
+		"seq":          admin.seq,
+		"timestamp":    time.Now().Format(time.RFC3339),
+		"subscription": admin.sub,
+		"drones":       drones,
+	})
 }
 
-func (h *AdminWebSocketHandler) getAllDronesStatus(ctx context.Context) []map[string]any {
-	result := make([]map[string]any, 0)
+// NotifyAdmins fans the event out to the admins here and relays it to the other replicas.
+func (h *AdminWebSocketHandler) NotifyAdmins(ctx context.Context, event *entity.AdminEvent) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	event.Payload = normalizePayload(event.Payload)
+	h.dispatch(event)
 
-	drones := h.droneManager.GetAllDrones()
-	for _, droneID := range drones {
-		state, err := h.droneRepo.GetDroneState(ctx, droneID)
-		if err != nil {
-			h.logger.Error("Failed to get state for drone", err, map[string]any{"drone_id": droneID})
-			continue
-		}
+	if h.bus == nil {
+		return
+	}
 
-		droneStatus := map[string]any{
-			"drone_id":            droneID,
-			"status":              state.Status,
-			"battery_level":       state.BatteryLevel,
-			"position":            state.CurrentPosition,
-			"speed":               state.Speed,
-			"current_delivery_id": state.CurrentDeliveryID,
-			"error_message":       state.ErrorMessage,
-			"last_updated":        state.LastUpdated.Format(time.RFC3339),
-		}
+	relayed := *event
+	relayed.Origin = h.instanceID
+	message, err := json.Marshal(relayed)
+	if err != nil {
+		h.logger.Warn("Failed to encode admin event", err, map[string]any{"type": event.Type})
+		return
+	}
+	if _, err := h.bus.Publish(ctx, entity.AdminEventsChannel(), message); err != nil {
+		h.logger.Warn("Failed to relay admin event", err, map[string]any{"type": event.Type})
+	}
+}
 
-		result = append(result, droneStatus)
+// AlertAdmins sends an alert such as a drone going offline to every subscribed admin.
+func (h *AdminWebSocketHandler) AlertAdmins(alert map[string]any) {
+	event := &entity.AdminEvent{
+		Type:      entity.AdminEventAlert,
+		Payload:   alert,
+		Timestamp: time.Now(),
 	}
+	event.DroneID, _ = alert["drone_id"].(string)
+	event.DeliveryID, _ = alert["delivery_id"].(string)
+	h.NotifyAdmins(context.Background(), event)
+}
 
-	return result
+func (h *AdminWebSocketHandler) handleRelayedEvent(_ context.Context, message []byte) {
+	var event entity.AdminEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		h.logger.Warn("Failed to decode relayed admin event", err, nil)
+		return
+	}
+	if event.Origin == h.instanceID {
+		return
+	}
+	h.dispatch(&event)
 }
 
-func (h *AdminWebSocketHandler) broadcast(message map[string]any) {
+func (h *AdminWebSocketHandler) dispatch(event *entity.AdminEvent) {
 	h.mu.RLock()
-	defer h.mu.RUnlock()
+	admins := make([]*adminConn, 0, len(h.admins))
+	for _, admin := range h.admins {
+		admins = append(admins, admin)
+	}
+	h.mu.RUnlock()
 
-	for adminID, conn := range h.connectedAdmins {
-		if err := conn.WriteJSON(message); err != nil {
-			h.logger.Warn("Error broadcasting to admin", err, map[string]any{"admin_id": adminID})
-		}
+	for _, admin := range admins {
+		h.sendDelta(admin, event)
 	}
 }
 
-func (h *AdminWebSocketHandler) BroadcastToAdmins(message map[string]any) {
-	h.mu.RLock()
-	defer h.mu.RUnlock()
+// sendDelta sends the event if the admin is subscribed to it. A drone_state event only carries the
+// fields that changed since the admin last heard about the drone, and nothing at all if none did.
+func (h *AdminWebSocketHandler) sendDelta(admin *adminConn, event *entity.AdminEvent) {
+	admin.mu.Lock()
+	defer admin.mu.Unlock()
+
+	if !admin.sub.Matches(event) {
+		return
+	}
+
+	payload := event.Payload
+	if event.Type == entity.AdminEventDroneState {
+		payload = make(map[string]any)
+		known := admin.known[event.DroneID]
+		for field, value := range event.Payload {
+			if old, ok := known[field]; !ok || !reflect.DeepEqual(old, value) {
+				payload[field] = value
+			}
+		}
+		if len(payload) == 0 {
+			return
+		}
+		admin.known[event.DroneID] = event.Payload
+	}
+
+	admin.seq++
+	message := map[string]any{
+		"type":      "delta",
+		"seq":       admin.seq,
+		"event":     event.Type,
+		"timestamp": event.Timestamp.Format(time.RFC3339),
+		"payload":   payload,
+	}
+	if event.DroneID != "" {
+		message["drone_id"] = event.DroneID
+	}
+	if event.DeliveryID != "" {
+		message["delivery_id"] = event.DeliveryID
+	}
+	h.enqueue(admin, message)
+}
 
-	msg := map[string]any{
-		"type":      "drone_update",
+func (h *AdminWebSocketHandler) sendError(admin *adminConn, message string) {
+	h.enqueue(admin, map[string]any{
+		"type":      "error",
+		"message":   message,
 		"timestamp": time.Now().Format(time.RFC3339),
-		"payload":   message,
+	})
+}
+
+// enqueue never blocks: when the admin's queue is full the message is dropped.
+func (h *AdminWebSocketHandler) enqueue(admin *adminConn, message map[string]any) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		h.logger.Warn("Failed to encode admin message", err, map[string]any{"connection_id": admin.id})
+		return
 	}
 
-	for adminID, conn := range h.connectedAdmins {
-		if err := conn.WriteJSON(msg); err != nil {
-			h.logger.Warn("Error broadcasting to admin", err, map[string]any{"admin_id": adminID})
+	select {
+	case admin.queue <- data:
+	default:
+	}
+}
+
+func (h *AdminWebSocketHandler) writeMessages(admin *adminConn, done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case data := <-admin.queue:
+			_ = admin.conn.SetWriteDeadline(time.Now().Add(adminWriteTimeout))
+			if err := admin.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				h.logger.Warn("Failed to write to admin", err, map[string]any{"connection_id": admin.id})
+				// Closing the connection ends the read loop, which unregisters the admin.
+				_ = admin.conn.Close()
+				return
+			}
 		}
 	}
 }
+
+// normalizePayload gives local and relayed events the same JSON value types so deltas compare equal.
+func normalizePayload(payload map[string]any) map[string]any {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return payload
+	}
+	var normalized map[string]any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return payload
+	}
+	return normalized
+}
@@ -2,25 +2,41 @@ package websocket
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/middleware"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/videoframe"
 )
 
+const droneWriteWait = 10 * time.Second
+
+// droneSession is an authenticated drone connection and the protocol version agreed at registration.
+type droneSession struct {
+	id      string
+	conn    *SafeConn
+	version int
+}
+
 type DroneWebSocketHandler struct {
 	connUC          *usecase.DroneConnectionUseCase
 	telemetryUC     *usecase.DroneTelemetryUseCase
 	deliveryUC      *usecase.DroneDeliveryUseCase
 	commandUC       *usecase.DroneCommandUseCase
-	connectedDrones map[string]*SafeConn
-	ipToID          map[string]string
+	livenessUC      *usecase.DroneLivenessUseCase
+	pingInterval    time.Duration
+	pongWait        time.Duration
+	connectedDrones map[string]*droneSession
 	mu              sync.RWMutex
 	upgrader        websocket.Upgrader
 	logger          logger.Interface
@@ -31,6 +47,10 @@ func NewDroneWebSocketHandler(
 	telemetryUC *usecase.DroneTelemetryUseCase,
 	deliveryUC *usecase.DroneDeliveryUseCase,
 	commandUC *usecase.DroneCommandUseCase,
+	livenessUC *usecase.DroneLivenessUseCase,
+	pingInterval time.Duration,
+	pongWait time.Duration,
+	allowedOrigins []string,
 	log logger.Interface,
 ) *DroneWebSocketHandler {
 	return &DroneWebSocketHandler{
@@ -38,24 +58,36 @@ func NewDroneWebSocketHandler(
 		telemetryUC:     telemetryUC,
 		deliveryUC:      deliveryUC,
 		commandUC:       commandUC,
-		connectedDrones: make(map[string]*SafeConn),
-		ipToID:          make(map[string]string),
+		livenessUC:      livenessUC,
+		pingInterval:    pingInterval,
+		pongWait:        pongWait,
+		connectedDrones: make(map[string]*droneSession),
 		logger:          log,
 		upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
-				return true
+				return middleware.OriginAllowed(allowedOrigins, r.Header.Get("Origin"))
 			},
 		},
 	}
 }
 
 // @Summary      Drone WebSocket connection
-// @Description  Establishes WebSocket connection for drone. First message must be registration with ip_address
-// @Description  Supported message types: heartbeat, status_update, delivery_update, video_frame, arrived_at_destination, cargo_dropped
+// @Description  Establishes WebSocket connection for drone. Every text message is an envelope {"v":1,"type":"...","id":"<uuid>","timestamp":"<RFC 3339>","payload":{...}} (see pkg/droneproto)
+// @Description  The drone must authenticate before anything else:
+// @Description  1. drone sends register {"drone_id":"...","versions":[1]} listing the protocol versions it speaks
+// @Description  2. service replies challenge {"drone_id":"...","nonce":"...","key_version":N,"version":V} with the version it chose
+// @Description  3. drone sends authenticate {"signature":hex(HMAC-SHA256(secret, "<drone_id>:<nonce>"))}
+// @Description  4. service replies registered {"drone_id":"...","version":V}; every later envelope must carry v=V
+// @Description  The secret is issued by the orchestrator when the drone is created and rotated with POST /drones/{id}/credential/rotate
+// @Description  Supported message types: heartbeat, status_update, delivery_update, video_frame, arrived_at_destination, cargo_dropped, command_ack, command_nack
+// @Description  Every command carries a command_id; the drone answers command_ack {"command_id","stage":"received"|"executed"} or command_nack {"command_id","reason"}. Unacked commands are resent, including on reconnect
+// @Description  Unknown, malformed or invalid messages are answered with an error envelope {"code":"...","message":"...","ref_id":"<id of the rejected message>"}
+// @Description  The service pings the drone every DRONE_PING_INTERVAL_SECONDS and drops the connection when nothing, pongs included, arrives for DRONE_PONG_WAIT_SECONDS. A drone silent for DRONE_OFFLINE_THRESHOLD_SECONDS is marked offline and its delivery suspended as drone_lost; the delivery is resumed when it reconnects, or failed once the drone has stayed offline for DRONE_LOST_DELIVERY_TIMEOUT_SECONDS
+// @Description  Video may also be sent as binary messages: an SKVF header (drone ID, delivery ID, sequence, capture time, codec) followed by the encoded frame
 // @Tags         websocket
 // @Accept       json
 // @Produce      json
-// @Param        drone_id path string false "Drone ID (optional)"
+// @Param        drone_id path string false "Drone ID (optional, must match the register message)"
 // @Success      101 {string} string "Switching Protocols"
 // @Router       /ws/drone [get]
 // @Router       /ws/drone/{drone_id} [get]
@@ -72,179 +104,251 @@ func (h *DroneWebSocketHandler) HandleDroneConnection(c *gin.Context) {
 	safeConn := &SafeConn{Conn: conn}
 	ctx := c.Request.Context()
 
-	_, message, err := conn.ReadMessage()
+	_ = conn.SetReadDeadline(time.Now().Add(usecase.ChallengeTTL))
+	session, droneID, err := h.authenticate(ctx, conn, safeConn, c.Param("drone_id"), c.ClientIP())
 	if err != nil {
-		h.logger.Error("Failed to read registration message", err, nil)
-		return
-	}
-
-	var regData map[string]any
-	if err := json.Unmarshal(message, &regData); err != nil {
-		h.logger.Error("Failed to unmarshal registration message", err, nil)
-		return
-	}
-
-	if regData["type"] != "register" {
-		_ = safeConn.WriteJSON(map[string]string{
-			"type":    "error",
-			"message": "First message must be registration with ip_address",
-		})
-		return
-	}
-
-	ipAddress, ok := regData["ip_address"].(string)
-	if !ok || ipAddress == "" {
-		_ = safeConn.WriteJSON(map[string]string{
-			"type":    "error",
-			"message": "ip_address is required for registration",
-		})
-		return
-	}
-
-	droneID, err := h.connUC.RegisterDrone(ctx, ipAddress)
-	if err != nil {
-		_ = safeConn.WriteJSON(map[string]string{
-			"type":    "error",
-			"message": err.Error(),
-		})
+		handleWebSocketError(err, safeConn, droneproto.Version1, "", h.logger)
 		return
 	}
+	_ = conn.SetReadDeadline(time.Now().Add(h.pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(h.pongWait))
+	})
 
 	h.mu.Lock()
-	h.connectedDrones[droneID] = safeConn
-	h.ipToID[ipAddress] = droneID
+	h.connectedDrones[droneID] = session
 	h.mu.Unlock()
 
-	_ = safeConn.WriteJSON(map[string]any{
-		"type":      "registered",
-		"drone_id":  droneID,
-		"timestamp": time.Now().Format(time.RFC3339),
-	})
+	stopPing := make(chan struct{})
+	defer close(stopPing)
+	go h.pingLoop(safeConn, droneID, stopPing)
+
+	_ = safeConn.WriteProto(session.version, &droneproto.Registered{DroneID: droneID, Version: session.version, SessionID: session.id})
+	h.commandUC.ResendPending(ctx, droneID)
+	h.livenessUC.Connected(ctx, droneID)
 
 	defer func() {
 		h.mu.Lock()
-		delete(h.connectedDrones, droneID)
-		for ip, id := range h.ipToID {
-			if id == droneID {
-				delete(h.ipToID, ip)
-				break
-			}
+		current := h.connectedDrones[droneID] == session
+		if current {
+			delete(h.connectedDrones, droneID)
 		}
 		h.mu.Unlock()
-		_ = h.connUC.UnregisterDrone(ctx, droneID)
+		// A drone that already reconnected keeps its registration.
+		if current {
+			_ = h.connUC.UnregisterDrone(context.Background(), droneID)
+		}
 	}()
 
 	for {
-		_, message, err := conn.ReadMessage()
+		messageType, message, err := conn.ReadMessage()
 		if err != nil {
 			h.logger.Warn("Connection closed for drone", err, map[string]any{"drone_id": droneID})
 			break
 		}
+		_ = conn.SetReadDeadline(time.Now().Add(h.pongWait))
+		h.livenessUC.Seen(ctx, droneID)
+
+		var refID string
+		if messageType == websocket.BinaryMessage {
+			err = h.handleBinaryVideoFrame(ctx, droneID, message)
+		} else {
+			refID, err = h.processDroneMessage(ctx, session, droneID, message)
+		}
+		if err != nil {
+			handleWebSocketError(err, safeConn, session.version, refID, h.logger)
+		}
+	}
+}
+
+// pingLoop pings the drone until stop is closed. A ping that cannot be written means the connection
+// is gone; the read loop notices when its deadline passes.
+func (h *DroneWebSocketHandler) pingLoop(conn *SafeConn, droneID string, stop <-chan struct{}) {
+	ticker := time.NewTicker(h.pingInterval)
+	defer ticker.Stop()
 
-		if err := h.processDroneMessage(ctx, droneID, message); err != nil {
-			handleWebSocketError(err, safeConn, h.logger)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(droneWriteWait)); err != nil {
+				h.logger.Warn("Failed to ping drone", err, map[string]any{"drone_id": droneID})
+				return
+			}
 		}
 	}
 }
 
-func (h *DroneWebSocketHandler) processDroneMessage(ctx context.Context, droneID string, message []byte) error {
-	var data map[string]any
-	if err := json.Unmarshal(message, &data); err != nil {
-		return err
+// authenticate runs the registration handshake and returns the session once the drone has proven it
+// holds its key. When the URL names a drone the register message has to name the same one.
+func (h *DroneWebSocketHandler) authenticate(ctx context.Context, conn *websocket.Conn, safeConn *SafeConn, pathDroneID, remoteAddr string) (*droneSession, string, error) {
+	msg, err := readHandshakeMessage(conn, droneproto.TypeRegister)
+	if err != nil {
+		return nil, "", err
+	}
+	register := msg.(*droneproto.Register)
+	if pathDroneID != "" && pathDroneID != register.DroneID {
+		return nil, "", fmt.Errorf("%w: drone_id does not match the URL", entityError.ErrInvalidValue)
 	}
 
-	messageType, ok := data["type"].(string)
-	if !ok {
-		return nil
+	version, err := droneproto.Negotiate(register.Versions)
+	if err != nil {
+		return nil, "", err
 	}
 
-	switch messageType {
-	case "heartbeat":
-		return h.handleHeartbeat(ctx, droneID, data)
-	case "status_update":
-		return h.handleStatusUpdate(ctx, droneID, data)
-	case "delivery_update":
-		return h.handleDeliveryUpdate(ctx, droneID, data)
-	case "arrived_at_destination":
-		return h.handleArrivedAtDestination(ctx, droneID, data)
-	case "cargo_dropped":
-		return h.handleCargoDropped(ctx, data)
-	case "video_frame":
-		return h.handleVideoFrame(ctx, droneID, data)
+	challenge, err := h.connUC.IssueChallenge(ctx, register.DroneID, remoteAddr)
+	if err != nil {
+		return nil, "", err
 	}
 
-	return nil
-}
+	if err := safeConn.WriteProto(version, &droneproto.Challenge{
+		DroneID:    challenge.DroneID,
+		Nonce:      challenge.Nonce,
+		KeyVersion: challenge.KeyVersion,
+		Version:    version,
+	}); err != nil {
+		return nil, "", err
+	}
 
-func (h *DroneWebSocketHandler) handleHeartbeat(ctx context.Context, droneID string, data map[string]any) error {
-	payload, ok := data["payload"].(map[string]any)
-	if !ok {
-		payload = data
+	msg, err = readHandshakeMessage(conn, droneproto.TypeAuthenticate)
+	if err != nil {
+		return nil, "", err
+	}
+	answer := msg.(*droneproto.Authenticate)
+
+	droneConn := &entity.DroneConnection{
+		SessionID:       uuid.NewString(),
+		AgentVersion:    register.AgentVersion,
+		ProtocolVersion: version,
+		RemoteAddr:      remoteAddr,
+		ConnectedAt:     time.Now(),
+	}
+	if err := h.connUC.RegisterDrone(ctx, challenge, answer.Signature, droneConn); err != nil {
+		return nil, "", err
 	}
 
-	return h.telemetryUC.ProcessHeartbeat(ctx, droneID, payload)
+	return &droneSession{id: droneConn.SessionID, conn: safeConn, version: version}, challenge.DroneID, nil
 }
 
-func (h *DroneWebSocketHandler) handleStatusUpdate(ctx context.Context, droneID string, data map[string]any) error {
-	payload, ok := data["payload"].(map[string]any)
-	if !ok {
-		return nil
+func readHandshakeMessage(conn *websocket.Conn, expected droneproto.MessageType) (droneproto.Message, error) {
+	messageType, message, err := conn.ReadMessage()
+	if err != nil {
+		return nil, fmt.Errorf("read handshake message: %w", err)
+	}
+	if messageType != websocket.TextMessage {
+		return nil, fmt.Errorf("%w: handshake messages must be JSON text", droneproto.ErrMalformed)
 	}
 
-	return h.telemetryUC.ProcessStatusUpdate(ctx, droneID, payload)
+	envelope, err := droneproto.Decode(message)
+	if err != nil {
+		return nil, err
+	}
+	if envelope.Type != expected {
+		return nil, fmt.Errorf("%w: expected %s, got %s", droneproto.ErrInvalid, expected, envelope.Type)
+	}
+	return envelope.Parse()
 }
 
-func (h *DroneWebSocketHandler) handleDeliveryUpdate(ctx context.Context, droneID string, data map[string]any) error {
-	payload, ok := data["payload"].(map[string]any)
-	if !ok {
-		return nil
+// processDroneMessage dispatches one envelope and returns its ID so errors can reference it.
+func (h *DroneWebSocketHandler) processDroneMessage(ctx context.Context, session *droneSession, droneID string, message []byte) (string, error) {
+	envelope, err := droneproto.Decode(message)
+	if err != nil {
+		return "", err
+	}
+	if envelope.Version != session.version {
+		return envelope.ID, fmt.Errorf("%w: connection negotiated v%d, message has v%d", droneproto.ErrUnsupportedVersion, session.version, envelope.Version)
 	}
 
-	return h.deliveryUC.ProcessDeliveryUpdate(ctx, droneID, payload)
-}
+	msg, err := envelope.Parse()
+	if err != nil {
+		return envelope.ID, err
+	}
 
-func (h *DroneWebSocketHandler) handleArrivedAtDestination(ctx context.Context, droneID string, data map[string]any) error {
-	payload, ok := data["payload"].(map[string]any)
-	if !ok {
-		return nil
+	switch m := msg.(type) {
+	case *droneproto.Heartbeat:
+		payload := entity.HeartbeatPayload(toTelemetryPayload(m.Telemetry))
+		return envelope.ID, h.telemetryUC.ProcessHeartbeat(ctx, droneID, &payload)
+	case *droneproto.StatusUpdate:
+		payload := entity.StatusUpdatePayload(toTelemetryPayload(m.Telemetry))
+		return envelope.ID, h.telemetryUC.ProcessStatusUpdate(ctx, droneID, &payload)
+	case *droneproto.DeliveryUpdate:
+		return envelope.ID, h.deliveryUC.ProcessDeliveryUpdate(ctx, droneID, &entity.DeliveryUpdatePayload{
+			DroneStatus:     m.DroneStatus,
+			DeliveryID:      m.DeliveryID,
+			OrderID:         m.OrderID,
+			ParcelAutomatID: m.ParcelAutomatID,
+		})
+	case *droneproto.ArrivedAtDestination:
+		return envelope.ID, h.deliveryUC.ProcessArrivedAtDestination(ctx, droneID, &entity.ArrivedAtDestinationPayload{
+			OrderID:         m.OrderID,
+			ParcelAutomatID: m.ParcelAutomatID,
+		})
+	case *droneproto.CargoDropped:
+		return envelope.ID, h.deliveryUC.ProcessCargoDropped(ctx, &entity.CargoDroppedPayload{
+			OrderID:      m.OrderID,
+			LockerCellID: m.LockerCellID,
+		})
+	case *droneproto.CommandAck:
+		return envelope.ID, h.commandUC.HandleAck(ctx, droneID, m)
+	case *droneproto.CommandNack:
+		return envelope.ID, h.commandUC.HandleNack(ctx, droneID, m)
+	case *droneproto.VideoFrame:
+		return envelope.ID, h.deliveryUC.ProcessVideoFrame(ctx, &entity.VideoFrame{
+			DroneID:    droneID,
+			DeliveryID: m.DeliveryID,
+			Codec:      entity.VideoCodecJPEG,
+			Data:       m.Frame,
+		})
 	}
 
-	return h.deliveryUC.ProcessArrivedAtDestination(ctx, droneID, payload)
+	return envelope.ID, fmt.Errorf("%w: %s is not accepted after registration", droneproto.ErrUnknownType, envelope.Type)
 }
 
-func (h *DroneWebSocketHandler) handleCargoDropped(ctx context.Context, data map[string]any) error {
-	payload, ok := data["payload"].(map[string]any)
-	if !ok {
-		return nil
+func toTelemetryPayload(t droneproto.Telemetry) entity.HeartbeatPayload {
+	return entity.HeartbeatPayload{
+		Status:       t.Status,
+		BatteryLevel: t.BatteryLevel,
+		Position: entity.Position{
+			Latitude:  t.Position.Latitude,
+			Longitude: t.Position.Longitude,
+			Altitude:  t.Position.Altitude,
+		},
+		Speed:             t.Speed,
+		CurrentDeliveryID: t.CurrentDeliveryID,
+		ErrorMessage:      t.ErrorMessage,
 	}
-
-	return h.deliveryUC.ProcessCargoDropped(ctx, payload)
 }
 
-func (h *DroneWebSocketHandler) handleVideoFrame(ctx context.Context, droneID string, data map[string]any) error {
-	payload, ok := data["payload"].(map[string]any)
-	if !ok {
-		payload = data
+// handleBinaryVideoFrame accepts SKVF frames. The drone ID in the header is informational: frames are
+// always attributed to the drone that owns the connection.
+func (h *DroneWebSocketHandler) handleBinaryVideoFrame(ctx context.Context, droneID string, message []byte) error {
+	header, payload, err := videoframe.Decode(message)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entityError.ErrInvalidPayload, err.Error())
 	}
-
-	frameData, _ := payload["frame"].(string)
-	deliveryID, _ := payload["delivery_id"].(string)
-
-	if frameData != "" {
-		return h.deliveryUC.ProcessVideoFrame(ctx, droneID, []byte(frameData), deliveryID)
+	if header.DroneID != "" && header.DroneID != droneID {
+		return fmt.Errorf("%w: video frame drone_id does not match the connection", entityError.ErrInvalidValue)
 	}
 
-	return nil
+	return h.deliveryUC.ProcessVideoFrame(ctx, &entity.VideoFrame{
+		DroneID:    droneID,
+		DeliveryID: header.DeliveryID,
+		Sequence:   header.Sequence,
+		CapturedAt: header.CapturedAt,
+		Codec:      entity.VideoCodec(header.Codec.String()),
+		Data:       payload,
+	})
 }
 
-func (h *DroneWebSocketHandler) SendToDrone(ctx context.Context, droneID string, message map[string]any) error {
+func (h *DroneWebSocketHandler) SendToDrone(ctx context.Context, droneID string, message droneproto.Message) error {
 	h.mu.RLock()
-	conn, exists := h.connectedDrones[droneID]
+	session, exists := h.connectedDrones[droneID]
 	h.mu.RUnlock()
 
 	if !exists {
-		return fmt.Errorf("drone %s is not connected", droneID)
+		return fmt.Errorf("%w: %s", entityError.ErrDroneNotConnected, droneID)
 	}
 
-	return conn.WriteJSON(message)
+	return session.conn.WriteProto(session.version, message)
 }
@@ -6,27 +6,43 @@ import (
 
 	"github.com/gorilla/websocket"
 	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
 )
 
-func handleWebSocketError(err error, conn *SafeConn, logger logger.Interface) {
+// handleWebSocketError answers a drone message the service could not accept with an error frame that
+// references it by ID. Authentication failures close the connection instead.
+func handleWebSocketError(err error, conn *SafeConn, version int, refID string, logger logger.Interface) {
 	if err == nil {
 		return
 	}
 
-	logger.Error("WS Error", err, nil)
+	logger.Error("WS Error", err, map[string]any{"ref_id": refID})
 
-	response := map[string]string{
-		"status": "error",
-		"error":  err.Error(),
+	frame := &droneproto.Error{
+		Code:    droneproto.CodeInternal,
+		Message: err.Error(),
+		RefID:   refID,
 	}
 
 	switch {
-	case errors.Is(err, entityError.ErrInvalidPayload),
+	case errors.Is(err, droneproto.ErrMalformed):
+		frame.Code = droneproto.CodeMalformed
+
+	case errors.Is(err, droneproto.ErrUnknownType):
+		frame.Code = droneproto.CodeUnknownType
+
+	case errors.Is(err, droneproto.ErrUnsupportedVersion):
+		frame.Code = droneproto.CodeUnsupportedVersion
+
+	case errors.Is(err, droneproto.ErrInvalid),
+		errors.Is(err, entityError.ErrInvalidPayload),
 		errors.Is(err, entityError.ErrMissingRequiredField),
 		errors.Is(err, entityError.ErrInvalidValue):
-		response["code"] = "VALIDATION_ERROR"
-		_ = conn.WriteJSON(response)
+		frame.Code = droneproto.CodeInvalid
+
+	case errors.Is(err, entityError.ErrDroneCommandNotFound):
+		frame.Code = droneproto.CodeRejected
 
 	case errors.Is(err, entityError.ErrDroneNotFound):
 		_ = conn.WriteControl(
@@ -34,10 +50,21 @@ func handleWebSocketError(err error, conn *SafeConn, logger logger.Interface) {
 			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "drone not registered"),
 			time.Now().Add(time.Second),
 		)
+		return
+
+	case errors.Is(err, entityError.ErrDroneNoCredential),
+		errors.Is(err, entityError.ErrDroneAuthFailed),
+		errors.Is(err, entityError.ErrDroneChallengeExpired):
+		_ = conn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "authentication failed"),
+			time.Now().Add(time.Second),
+		)
+		return
 
 	default:
-		response["code"] = "INTERNAL_ERROR"
-		response["error"] = "internal server error"
-		_ = conn.WriteJSON(response)
+		frame.Message = "internal server error"
 	}
+
+	_ = conn.WriteProto(version, frame)
 }
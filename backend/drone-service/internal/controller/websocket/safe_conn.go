@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
 )
 
 type SafeConn struct {
@@ -29,3 +30,12 @@ func (c *SafeConn) WriteControl(messageType int, data []byte, deadline time.Time
 	defer c.mu.Unlock()
 	return c.Conn.WriteControl(messageType, data, deadline)
 }
+
+// WriteProto sends msg as a protocol envelope of the given version.
+func (c *SafeConn) WriteProto(version int, msg droneproto.Message) error {
+	data, err := droneproto.Encode(version, msg)
+	if err != nil {
+		return err
+	}
+	return c.WriteMessage(websocket.TextMessage, data)
+}
@@ -1,36 +1,78 @@
 package websocket
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"net/http"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/middleware"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/controller/http/v1/response"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/minio"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/videoframe"
 )
 
+const (
+	videoViewerQueueSize = 8
+	videoWriteTimeout    = 5 * time.Second
+	// videoRelayIdleBackoff is how long a drone's frames are not relayed after nobody was listening.
+	videoRelayIdleBackoff = time.Second
+
+	videoFormatBinary = "binary"
+	videoFormatBase64 = "base64"
+)
+
+// videoViewer is one admin watching a drone. Frames reach it through its own queue and writer goroutine,
+// so a slow viewer only loses its own frames.
+type videoViewer struct {
+	id          string
+	droneID     string
+	format      string
+	remoteAddr  string
+	connectedAt time.Time
+	conn        *SafeConn
+	queue       *videoframe.Queue
+	meter       videoframe.Meter
+}
+
+// VideoHandler fans frames out to viewers. With a bus, frames of drones connected here are also relayed
+// to the other replicas, and a replica with viewers of a drone it does not hold listens for them.
 type VideoHandler struct {
-	adminVideoConnections map[string]map[*SafeConn]bool
-	minioClient           *minio.Client
-	frameCounters         map[string]int
-	mu                    sync.RWMutex
-	upgrader              websocket.Upgrader
-	logger                logger.Interface
+	viewers        map[string]map[*videoViewer]struct{}
+	sources        map[string]*videoframe.Meter
+	minioClient    *minio.Client
+	frameCounters  map[string]uint32
+	bus            repo.MessageBus
+	instanceID     string
+	relayIdleUntil map[string]time.Time
+	relayMu        sync.Mutex
+	mu             sync.RWMutex
+	upgrader       websocket.Upgrader
+	logger         logger.Interface
 }
 
-func NewVideoHandler(minioClient *minio.Client, log logger.Interface) *VideoHandler {
+func NewVideoHandler(minioClient *minio.Client, bus repo.MessageBus, instanceID string, allowedOrigins []string, log logger.Interface) *VideoHandler {
 	return &VideoHandler{
-		adminVideoConnections: make(map[string]map[*SafeConn]bool),
-		minioClient:           minioClient,
-		frameCounters:         make(map[string]int),
-		logger:                log,
+		viewers:        make(map[string]map[*videoViewer]struct{}),
+		sources:        make(map[string]*videoframe.Meter),
+		minioClient:    minioClient,
+		frameCounters:  make(map[string]uint32),
+		bus:            bus,
+		instanceID:     instanceID,
+		relayIdleUntil: make(map[string]time.Time),
+		logger:         log,
 		upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
-				return true
+				return middleware.OriginAllowed(allowedOrigins, r.Header.Get("Origin"))
 			},
 		},
 	}
@@ -39,12 +81,19 @@ func NewVideoHandler(minioClient *minio.Client, log logger.Interface) *VideoHand
 // @Summary      WebSocket for drone video stream
 // @Description  Establishes WebSocket connection for receiving video stream from specific drone
 // @Description  Administrator connects to this endpoint to view real-time video
+// @Description  By default every frame is a binary message: an SKVF header (drone ID, delivery ID, sequence, capture time, codec) followed by the encoded image
+// @Description  format=base64 sends the bare JPEG as base64 text instead, for older viewers
+// @Description  Each viewer has a small queue; when it falls behind the oldest frames are dropped
 // @Tags         websocket
 // @Accept       json
 // @Produce      octet-stream
 // @Param        drone_id path string true "Drone ID"
+// @Param        format query string false "binary (default) or base64"
+// @Param        access_token query string true "Orchestrator access token with the admin or operator role"
 // @Success      101 {string} string "Switching Protocols"
 // @Failure      400 {object} response.Error "drone_id required"
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
 // @Router       /ws/drone/{drone_id}/video [get]
 func (h *VideoHandler) HandleAdminVideoConnection(c *gin.Context) {
 	droneID := c.Param("drone_id")
@@ -53,6 +102,12 @@ func (h *VideoHandler) HandleAdminVideoConnection(c *gin.Context) {
 		return
 	}
 
+	format := c.DefaultQuery("format", videoFormatBinary)
+	if format != videoFormatBinary && format != videoFormatBase64 {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "format must be binary or base64"})
+		return
+	}
+
 	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
 		h.logger.Error("Failed to upgrade connection", err, nil)
@@ -62,77 +117,311 @@ func (h *VideoHandler) HandleAdminVideoConnection(c *gin.Context) {
 		_ = conn.Close()
 	}()
 
-	safeConn := &SafeConn{Conn: conn}
-
-	h.mu.Lock()
-	if h.adminVideoConnections[droneID] == nil {
-		h.adminVideoConnections[droneID] = make(map[*SafeConn]bool)
+	viewer := &videoViewer{
+		id:          uuid.NewString(),
+		droneID:     droneID,
+		format:      format,
+		remoteAddr:  c.ClientIP(),
+		connectedAt: time.Now(),
+		conn:        &SafeConn{Conn: conn},
+		queue:       videoframe.NewQueue(videoViewerQueueSize),
 	}
-	h.adminVideoConnections[droneID][safeConn] = true
-	h.mu.Unlock()
+
+	h.addViewer(viewer)
+
+	done := make(chan struct{})
+	go h.writeFrames(viewer, done)
 
 	defer func() {
-		h.mu.Lock()
-		delete(h.adminVideoConnections[droneID], safeConn)
-		if len(h.adminVideoConnections[droneID]) == 0 {
-			delete(h.adminVideoConnections, droneID)
-		}
-		h.mu.Unlock()
+		close(done)
+		h.removeViewer(viewer)
 	}()
 
 	for {
 		_, _, err := conn.ReadMessage()
 		if err != nil {
-			h.logger.Warn("Video connection closed", err, map[string]any{"drone_id": droneID})
+			h.logger.Warn("Video connection closed", err, map[string]any{
+				"drone_id":       droneID,
+				"viewer_id":      viewer.id,
+				"frames_sent":    viewer.meter.Total(),
+				"frames_dropped": viewer.queue.Dropped(),
+			})
 			break
 		}
 	}
 }
 
-func (h *VideoHandler) BroadcastFrameToAdmins(droneID string, frameData string, deliveryID string) {
-	h.mu.RLock()
-	connections, exists := h.adminVideoConnections[droneID]
-	h.mu.RUnlock()
+// addViewer and removeViewer subscribe to a drone's relay channel with its first viewer here and leave
+// it with the last. relayMu keeps a join and a leave for the same drone from reordering.
+func (h *VideoHandler) addViewer(viewer *videoViewer) {
+	h.relayMu.Lock()
+	defer h.relayMu.Unlock()
 
-	if !exists || len(connections) == 0 {
-		return
+	h.mu.Lock()
+	if h.viewers[viewer.droneID] == nil {
+		h.viewers[viewer.droneID] = make(map[*videoViewer]struct{})
+	}
+	h.viewers[viewer.droneID][viewer] = struct{}{}
+	first := len(h.viewers[viewer.droneID]) == 1
+	h.mu.Unlock()
+
+	if first && h.bus != nil {
+		if err := h.bus.Subscribe(context.Background(), entity.VideoChannel(viewer.droneID), h.handleRelayedFrame); err != nil {
+			h.logger.Warn("Failed to subscribe to relayed video", err, map[string]any{"drone_id": viewer.droneID})
+		}
 	}
+}
+
+func (h *VideoHandler) removeViewer(viewer *videoViewer) {
+	h.relayMu.Lock()
+	defer h.relayMu.Unlock()
 
 	h.mu.Lock()
-	for conn := range connections {
-		if err := conn.WriteMessage(websocket.TextMessage, []byte(frameData)); err != nil {
-			h.logger.Warn("Failed to send video frame to admin", err, map[string]any{"drone_id": droneID})
-			delete(connections, conn)
+	delete(h.viewers[viewer.droneID], viewer)
+	last := len(h.viewers[viewer.droneID]) == 0
+	if last {
+		delete(h.viewers, viewer.droneID)
+	}
+	h.mu.Unlock()
+
+	if last && h.bus != nil {
+		if err := h.bus.Unsubscribe(context.Background(), entity.VideoChannel(viewer.droneID)); err != nil {
+			h.logger.Warn("Failed to unsubscribe from relayed video", err, map[string]any{"drone_id": viewer.droneID})
 		}
 	}
+}
+
+func (h *VideoHandler) writeFrames(viewer *videoViewer, done <-chan struct{}) {
+	messageType := websocket.BinaryMessage
+	if viewer.format == videoFormatBase64 {
+		messageType = websocket.TextMessage
+	}
+
+	for {
+		select {
+		case <-done:
+			return
+		case frame := <-viewer.queue.Frames():
+			_ = viewer.conn.SetWriteDeadline(time.Now().Add(videoWriteTimeout))
+			if err := viewer.conn.WriteMessage(messageType, frame); err != nil {
+				h.logger.Warn("Failed to send video frame to admin", err, map[string]any{
+					"drone_id":  viewer.droneID,
+					"viewer_id": viewer.id,
+				})
+				// Closing the connection ends the read loop, which unregisters the viewer.
+				_ = viewer.conn.Close()
+				return
+			}
+			viewer.meter.Mark(time.Now())
+		}
+	}
+}
+
+// BroadcastFrameToAdmins hands the frame to every viewer of the drone. Each encoding is built once and
+// shared; the lock is only held to take a snapshot of the viewers.
+func (h *VideoHandler) BroadcastFrameToAdmins(frame *entity.VideoFrame) {
+	h.mu.Lock()
+	source, ok := h.sources[frame.DroneID]
+	if !ok {
+		source = &videoframe.Meter{}
+		h.sources[frame.DroneID] = source
+	}
+	viewers := make([]*videoViewer, 0, len(h.viewers[frame.DroneID]))
+	for viewer := range h.viewers[frame.DroneID] {
+		viewers = append(viewers, viewer)
+	}
 	h.mu.Unlock()
 
-	if deliveryID != "" {
-		go h.saveFrameToMinIO(droneID, deliveryID, frameData)
+	source.Mark(time.Now())
+	if len(viewers) == 0 {
+		return
+	}
+
+	var binaryFrame, base64Frame []byte
+	for _, viewer := range viewers {
+		switch viewer.format {
+		case videoFormatBase64:
+			if frame.Codec != entity.VideoCodecJPEG {
+				continue
+			}
+			if base64Frame == nil {
+				base64Frame = []byte(base64.StdEncoding.EncodeToString(frame.Data))
+			}
+			viewer.queue.Push(base64Frame)
+		default:
+			if binaryFrame == nil {
+				encoded, err := encodeVideoFrame(frame)
+				if err != nil {
+					h.logger.Warn("Failed to encode video frame", err, map[string]any{"drone_id": frame.DroneID})
+					return
+				}
+				binaryFrame = encoded
+			}
+			viewer.queue.Push(binaryFrame)
+		}
+	}
+}
+
+func encodeVideoFrame(frame *entity.VideoFrame) ([]byte, error) {
+	codec, _ := videoframe.ParseCodec(string(frame.Codec))
+	return videoframe.Encode(videoframe.Header{
+		DroneID:    frame.DroneID,
+		DeliveryID: frame.DeliveryID,
+		Sequence:   frame.Sequence,
+		CapturedAt: frame.CapturedAt,
+		Codec:      codec,
+	}, frame.Data)
+}
+
+func (h *VideoHandler) saveFrameToMinIO(frame *entity.VideoFrame) {
+	if _, err := h.minioClient.UploadFrame(context.Background(), frame.DroneID, frame.DeliveryID, frame.Data, frame.CapturedAt, int(frame.Sequence)); err != nil {
+		h.logger.Warn("Failed to store video frame", err, map[string]any{"drone_id": frame.DroneID, "delivery_id": frame.DeliveryID})
 	}
 }
 
-func (h *VideoHandler) saveFrameToMinIO(droneID, deliveryID, frameData string) {
+func (h *VideoHandler) HandleVideoFrame(ctx context.Context, frame *entity.VideoFrame) error {
+	if frame.CapturedAt.IsZero() {
+		frame.CapturedAt = time.Now().UTC()
+	}
+	if frame.Sequence == 0 {
+		h.mu.Lock()
+		h.frameCounters[frame.DroneID]++
+		frame.Sequence = h.frameCounters[frame.DroneID]
+		h.mu.Unlock()
+	}
+
+	h.BroadcastFrameToAdmins(frame)
+	h.relayFrame(ctx, frame)
+
+	// Recordings are assembled into MJPEG, so only JPEG frames are archived.
+	if frame.DeliveryID != "" && frame.Codec == entity.VideoCodecJPEG && h.minioClient != nil {
+		go h.saveFrameToMinIO(frame)
+	}
+
+	return nil
+}
+
+// relayFrame publishes the frame for viewers on other replicas, prefixed with this replica's ID so it
+// skips its own frames. While nobody is subscribed the drone's frames are only offered once a second.
+func (h *VideoHandler) relayFrame(ctx context.Context, frame *entity.VideoFrame) {
+	if h.bus == nil {
+		return
+	}
+
+	now := time.Now()
+	h.mu.RLock()
+	idleUntil := h.relayIdleUntil[frame.DroneID]
+	h.mu.RUnlock()
+	if now.Before(idleUntil) {
+		return
+	}
+
+	encoded, err := encodeVideoFrame(frame)
+	if err != nil {
+		return
+	}
+	message := make([]byte, 0, len(h.instanceID)+1+len(encoded))
+	message = append(message, h.instanceID...)
+	message = append(message, '\n')
+	message = append(message, encoded...)
+
+	receivers, err := h.bus.Publish(ctx, entity.VideoChannel(frame.DroneID), message)
+	if err != nil {
+		h.logger.Warn("Failed to relay video frame", err, map[string]any{"drone_id": frame.DroneID})
+	}
+
 	h.mu.Lock()
-	if _, exists := h.frameCounters[droneID]; !exists {
-		h.frameCounters[droneID] = 0
+	if err != nil || receivers == 0 {
+		h.relayIdleUntil[frame.DroneID] = now.Add(videoRelayIdleBackoff)
+	} else {
+		delete(h.relayIdleUntil, frame.DroneID)
 	}
-	h.frameCounters[droneID]++
-	frameNumber := h.frameCounters[droneID]
 	h.mu.Unlock()
+}
+
+func (h *VideoHandler) handleRelayedFrame(_ context.Context, message []byte) {
+	origin, encoded, ok := bytes.Cut(message, []byte{'\n'})
+	if !ok || string(origin) == h.instanceID {
+		return
+	}
 
-	frameBytes, err := base64.StdEncoding.DecodeString(frameData)
+	header, data, err := videoframe.Decode(encoded)
 	if err != nil {
+		h.logger.Warn("Failed to decode relayed video frame", err, map[string]any{"origin": string(origin)})
 		return
 	}
 
-	_, _ = h.minioClient.UploadFrame(context.Background(), droneID, deliveryID, frameBytes, frameNumber)
+	h.BroadcastFrameToAdmins(&entity.VideoFrame{
+		DroneID:    header.DroneID,
+		DeliveryID: header.DeliveryID,
+		Sequence:   header.Sequence,
+		CapturedAt: header.CapturedAt,
+		Codec:      entity.VideoCodec(header.Codec.String()),
+		Data:       data,
+	})
 }
 
-func (h *VideoHandler) HandleVideoFrame(ctx context.Context, droneID string, frameData []byte, deliveryID string) error {
-	frameStr := string(frameData)
+// @Summary      Video stream statistics
+// @Description  Returns the incoming frame rate of every streaming drone and, per connected viewer, frames sent, frames dropped because the viewer fell behind, queued frames and the achieved frame rate
+// @Tags         monitoring
+// @Produce      json
+// @Param        drone_id query string false "Only this drone"
+// @Security     Bearer
+// @Success      200 {object} response.VideoStats
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Router       /v1/api/video/stats [get]
+func (h *VideoHandler) GetStats(c *gin.Context) {
+	filter := c.Query("drone_id")
+	now := time.Now()
 
-	h.BroadcastFrameToAdmins(droneID, frameStr, deliveryID)
+	h.mu.RLock()
+	drones := make(map[string]*response.DroneVideoStats)
+	for droneID, source := range h.sources {
+		if filter != "" && droneID != filter {
+			continue
+		}
+		drones[droneID] = &response.DroneVideoStats{
+			DroneID:        droneID,
+			FramesReceived: source.Total(),
+			FPS:            source.Rate(now),
+			Viewers:        []response.VideoViewerStats{},
+		}
+	}
+	for droneID, viewers := range h.viewers {
+		if filter != "" && droneID != filter {
+			continue
+		}
+		stats, ok := drones[droneID]
+		if !ok {
+			stats = &response.DroneVideoStats{DroneID: droneID, Viewers: []response.VideoViewerStats{}}
+			drones[droneID] = stats
+		}
+		for viewer := range viewers {
+			stats.Viewers = append(stats.Viewers, response.VideoViewerStats{
+				ViewerID:      viewer.id,
+				Format:        viewer.format,
+				RemoteAddr:    viewer.remoteAddr,
+				ConnectedAt:   viewer.connectedAt,
+				FramesSent:    viewer.meter.Total(),
+				FramesDropped: viewer.queue.Dropped(),
+				Queued:        viewer.queue.Len(),
+				FPS:           viewer.meter.Rate(now),
+			})
+		}
+	}
+	h.mu.RUnlock()
 
-	return nil
+	result := response.VideoStats{Drones: make([]response.DroneVideoStats, 0, len(drones))}
+	for _, stats := range drones {
+		sort.Slice(stats.Viewers, func(i, j int) bool {
+			return stats.Viewers[i].ConnectedAt.Before(stats.Viewers[j].ConnectedAt)
+		})
+		result.Drones = append(result.Drones, *stats)
+	}
+	sort.Slice(result.Drones, func(i, j int) bool {
+		return result.Drones[i].DroneID < result.Drones[j].DroneID
+	})
+
+	c.JSON(http.StatusOK, result)
 }
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+type AdminEventType string
+
+const (
+	AdminEventDroneState      AdminEventType = "drone_state"
+	AdminEventDroneConnection AdminEventType = "drone_connection"
+	AdminEventDeliveryStatus  AdminEventType = "delivery_status"
+	AdminEventAlert           AdminEventType = "alert"
+)
+
+var AdminEventTypes = []AdminEventType{
+	AdminEventDroneState,
+	AdminEventDroneConnection,
+	AdminEventDeliveryStatus,
+	AdminEventAlert,
+}
+
+// AdminEvent is a change admins are told about as it happens. A drone_state payload holds the drone's
+// whole state; admins are only sent the fields that changed since their last update.
+type AdminEvent struct {
+	Type       AdminEventType `json:"type"`
+	DroneID    string         `json:"drone_id,omitempty"`
+	DeliveryID string         `json:"delivery_id,omitempty"`
+	Payload    map[string]any `json:"payload"`
+	Timestamp  time.Time      `json:"timestamp"`
+	Origin     string         `json:"origin,omitempty"`
+}
+
+// AdminSubscription selects the events an admin connection receives. An empty list matches everything;
+// an event passes when its type is selected and its drone or its delivery is.
+type AdminSubscription struct {
+	Drones     []string         `json:"drones,omitempty"`
+	Deliveries []string         `json:"deliveries,omitempty"`
+	Events     []AdminEventType `json:"events,omitempty"`
+}
+
+func (s AdminSubscription) Matches(event *AdminEvent) bool {
+	if len(s.Events) > 0 && !slices.Contains(s.Events, event.Type) {
+		return false
+	}
+	if len(s.Drones) == 0 && len(s.Deliveries) == 0 {
+		return true
+	}
+	return (event.DroneID != "" && slices.Contains(s.Drones, event.DroneID)) ||
+		(event.DeliveryID != "" && slices.Contains(s.Deliveries, event.DeliveryID))
+}
+
+// MatchesDrone reports whether the drone belongs in a snapshot for this subscription.
+func (s AdminSubscription) MatchesDrone(droneID string, deliveryID *string) bool {
+	if len(s.Drones) == 0 && len(s.Deliveries) == 0 {
+		return true
+	}
+	return slices.Contains(s.Drones, droneID) ||
+		(deliveryID != nil && slices.Contains(s.Deliveries, *deliveryID))
+}
+
+// DroneStateEvent is the drone_state event for a persisted state.
+func DroneStateEvent(state *DroneState) *AdminEvent {
+	event := &AdminEvent{
+		Type:    AdminEventDroneState,
+		DroneID: state.DroneID,
+		Payload: map[string]any{
+			"status":              state.Status,
+			"battery_level":       state.BatteryLevel,
+			"position":            state.CurrentPosition,
+			"speed":               state.Speed,
+			"current_delivery_id": state.CurrentDeliveryID,
+			"error_message":       state.ErrorMessage,
+			"last_updated":        state.LastUpdated.Format(time.RFC3339),
+		},
+		Timestamp: state.LastUpdated,
+	}
+	if state.CurrentDeliveryID != nil {
+		event.DeliveryID = *state.CurrentDeliveryID
+	}
+	return event
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "encoding/json"
+
+const clusterChannelPrefix = "drone-service:"
+
+// RoutedMessage carries a service-to-drone message to the replica holding the drone's connection.
+type RoutedMessage struct {
+	DroneID string          `json:"drone_id"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+	Origin  string          `json:"origin"`
+}
+
+// InstanceChannel is the pub/sub channel a replica reads messages for its own drones from.
+func InstanceChannel(instanceID string) string {
+	return clusterChannelPrefix + "instance:" + instanceID
+}
+
+// VideoChannel is the pub/sub channel a drone's frames are relayed on to viewers on other replicas.
+func VideoChannel(droneID string) string {
+	return clusterChannelPrefix + "video:" + droneID
+}
+
+// AdminEventsChannel is the pub/sub channel every replica reads admin events from.
+func AdminEventsChannel() string {
+	return clusterChannelPrefix + "admin-events"
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type DroneCommandStatus string
+
+// A command is pending until it has been written to the drone's socket, sent until the drone acks
+// it, and acked until the drone reports the result. Executed, failed and timed_out are final.
+const (
+	DroneCommandStatusPending  DroneCommandStatus = "pending"
+	DroneCommandStatusSent     DroneCommandStatus = "sent"
+	DroneCommandStatusAcked    DroneCommandStatus = "acked"
+	DroneCommandStatusExecuted DroneCommandStatus = "executed"
+	DroneCommandStatusFailed   DroneCommandStatus = "failed"
+	DroneCommandStatusTimedOut DroneCommandStatus = "timed_out"
+)
+
+func (s DroneCommandStatus) IsFinal() bool {
+	return s == DroneCommandStatusExecuted || s == DroneCommandStatusFailed || s == DroneCommandStatusTimedOut
+}
+
+type DroneCommand struct {
+	ID            string             `json:"id"`
+	DroneID       string             `json:"drone_id"`
+	Command       string             `json:"command"`
+	Payload       json.RawMessage    `json:"payload"`
+	Status        DroneCommandStatus `json:"status"`
+	Attempts      int                `json:"attempts"`
+	MaxAttempts   int                `json:"max_attempts"`
+	LastError     *string            `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time         `json:"next_attempt_at,omitempty"`
+	ExpiresAt     time.Time          `json:"expires_at"`
+	SentAt        *time.Time         `json:"sent_at,omitempty"`
+	AckedAt       *time.Time         `json:"acked_at,omitempty"`
+	CompletedAt   *time.Time         `json:"completed_at,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+type DroneCommandAuditResult string
+
+// An operator command is accepted once it is queued for the drone, rejected when it fails validation
+// or a safety interlock, and failed when it could not be queued.
+const (
+	DroneCommandAuditAccepted DroneCommandAuditResult = "accepted"
+	DroneCommandAuditRejected DroneCommandAuditResult = "rejected"
+	DroneCommandAuditFailed   DroneCommandAuditResult = "failed"
+)
+
+// Operator is the admin panel user a command was issued by, as identified by their access token.
+type Operator struct {
+	ID    string
+	Email string
+	Role  string
+}
+
+// DroneCommandAudit records one operator command attempt, whatever its outcome. CommandID is set when
+// the command was accepted and links to its delivery status in drone_commands.
+type DroneCommandAudit struct {
+	ID            string                  `json:"id"`
+	DroneID       string                  `json:"drone_id"`
+	CommandID     *string                 `json:"command_id,omitempty"`
+	Command       string                  `json:"command"`
+	Payload       json.RawMessage         `json:"payload"`
+	OperatorID    string                  `json:"operator_id"`
+	OperatorEmail string                  `json:"operator_email"`
+	OperatorRole  string                  `json:"operator_role"`
+	Result        DroneCommandAuditResult `json:"result"`
+	Reason        *string                 `json:"reason,omitempty"`
+	CreatedAt     time.Time               `json:"created_at"`
+}
@@ -14,6 +14,10 @@ const (
 	DeliveryStatusCompleted  DeliveryStatus = "completed"
 	DeliveryStatusFailed     DeliveryStatus = "failed"
 	DeliveryStatusCancelled  DeliveryStatus = "cancelled"
+
+	// DeliveryStatusDroneLost marks a delivery whose drone went silent. It is not final: the delivery is
+	// resumed if the drone comes back and failed only once the drone has been lost for too long.
+	DeliveryStatusDroneLost DeliveryStatus = "drone_lost"
 )
 
 type GoodDimensions struct {
@@ -19,8 +19,18 @@ const (
 	DroneStatusCharging    DroneStatus = "charging"
 	DroneStatusError       DroneStatus = "error"
 	DroneStatusMaintenance DroneStatus = "maintenance"
+	DroneStatusOffline     DroneStatus = "offline"
 )
 
+// IsAirborne reports whether a drone with this status is flying or on a mission away from its base.
+func (s DroneStatus) IsAirborne() bool {
+	switch s {
+	case DroneStatusTakingOff, DroneStatusInTransit, DroneStatusDelivering, DroneStatusReturning, DroneStatusLanding:
+		return true
+	}
+	return false
+}
+
 type Position struct {
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
@@ -38,6 +48,26 @@ type DroneState struct {
 	ErrorMessage      *string     `json:"error_message,omitempty"`
 }
 
+// DroneConnection is a drone's live WebSocket session. It is shared through the connection registry so
+// every replica can see it.
+type DroneConnection struct {
+	DroneID         string    `json:"drone_id"`
+	SessionID       string    `json:"session_id"`
+	InstanceID      string    `json:"instance_id,omitempty"`
+	AgentVersion    string    `json:"agent_version,omitempty"`
+	ProtocolVersion int       `json:"protocol_version"`
+	RemoteAddr      string    `json:"remote_addr"`
+	ConnectedAt     time.Time `json:"connected_at"`
+}
+
+// FleetDrone is a provisioned drone's persisted state joined with its connection, which is nil while
+// the drone is not connected to any replica.
+type FleetDrone struct {
+	DroneState
+	Model      string
+	Connection *DroneConnection
+}
+
 type Drone struct {
 	ID           uuid.UUID
 	Model        string
@@ -47,3 +77,18 @@ type Drone struct {
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
+
+// DroneCredential is the key issued to a drone by the orchestrator when it is provisioned or rotated.
+type DroneCredential struct {
+	DroneID    string
+	Secret     string
+	KeyVersion int
+}
+
+// DroneChallenge is sent to a connecting drone, which has to answer it before it is registered.
+type DroneChallenge struct {
+	DroneID    string    `json:"drone_id"`
+	Nonce      string    `json:"nonce"`
+	KeyVersion int       `json:"key_version"`
+	IssuedAt   time.Time `json:"issued_at"`
+}
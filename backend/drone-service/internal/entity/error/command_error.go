@@ -0,0 +1,9 @@
+package error
+
+import "errors"
+
+var (
+	ErrDroneCommandNotFound = errors.New("drone command not found")
+	ErrDroneCommandInvalid  = errors.New("invalid drone command")
+	ErrDroneCommandRejected = errors.New("drone command rejected by safety interlock")
+)
@@ -0,0 +1,28 @@
+package entity
+
+import "math"
+
+const earthRadiusMeters = 6371000.0
+
+// NoFlyZone is a circular area drones must not be sent into.
+type NoFlyZone struct {
+	Name         string  `json:"name"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	RadiusMeters float64 `json:"radius_m"`
+}
+
+func (z NoFlyZone) Contains(p Position) bool {
+	return distanceMeters(z.Latitude, z.Longitude, p.Latitude, p.Longitude) <= z.RadiusMeters
+}
+
+// distanceMeters is the great-circle (haversine) distance between two coordinates.
+func distanceMeters(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1 := lat1 * math.Pi / 180
+	phi2 := lat2 * math.Pi / 180
+	dPhi := (lat2 - lat1) * math.Pi / 180
+	dLambda := (lon2 - lon1) * math.Pi / 180
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type TelemetrySample struct {
+	DroneID      string      `json:"drone_id"`
+	DeliveryID   *string     `json:"delivery_id,omitempty"`
+	Status       DroneStatus `json:"status"`
+	BatteryLevel float64     `json:"battery_level"`
+	Position     Position    `json:"position"`
+	Speed        float64     `json:"speed"`
+	RecordedAt   time.Time   `json:"recorded_at"`
+}
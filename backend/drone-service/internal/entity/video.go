@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type VideoCodec string
+
+const (
+	VideoCodecJPEG VideoCodec = "jpeg"
+	VideoCodecH264 VideoCodec = "h264"
+)
+
+// VideoFrame is one decoded frame from a drone camera, whether it arrived as binary or as base64 JSON.
+type VideoFrame struct {
+	DroneID    string
+	DeliveryID string
+	Sequence   uint32
+	CapturedAt time.Time
+	Codec      VideoCodec
+	Data       []byte
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
+)
+
+// MessageBus multiplexes every channel this replica listens on over one Redis pub/sub connection.
+// Messages are handed to their channel's handler one at a time, in the order Redis delivered them.
+type MessageBus struct {
+	rdb      *redis.Client
+	pubsub   *redis.PubSub
+	handlers map[string]func(ctx context.Context, payload []byte)
+	mu       sync.RWMutex
+	logger   logger.Interface
+}
+
+func NewMessageBus(ctx context.Context, rdb *redis.Client, log logger.Interface) *MessageBus {
+	return &MessageBus{
+		rdb:      rdb,
+		pubsub:   rdb.Subscribe(ctx),
+		handlers: make(map[string]func(ctx context.Context, payload []byte)),
+		logger:   log,
+	}
+}
+
+// Publish returns the number of replicas subscribed to the channel.
+func (b *MessageBus) Publish(ctx context.Context, channel string, payload []byte) (int64, error) {
+	receivers, err := b.rdb.Publish(ctx, channel, payload).Result()
+	if err != nil {
+		return 0, fmt.Errorf("MessageBus - Publish: %w", err)
+	}
+	return receivers, nil
+}
+
+func (b *MessageBus) Subscribe(ctx context.Context, channel string, handler func(ctx context.Context, payload []byte)) error {
+	b.mu.Lock()
+	b.handlers[channel] = handler
+	b.mu.Unlock()
+
+	if err := b.pubsub.Subscribe(ctx, channel); err != nil {
+		b.mu.Lock()
+		delete(b.handlers, channel)
+		b.mu.Unlock()
+		return fmt.Errorf("MessageBus - Subscribe: %w", err)
+	}
+	return nil
+}
+
+func (b *MessageBus) Unsubscribe(ctx context.Context, channel string) error {
+	b.mu.Lock()
+	delete(b.handlers, channel)
+	b.mu.Unlock()
+
+	if err := b.pubsub.Unsubscribe(ctx, channel); err != nil {
+		return fmt.Errorf("MessageBus - Unsubscribe: %w", err)
+	}
+	return nil
+}
+
+// Run dispatches messages until ctx is cancelled. go-redis resubscribes on its own after a reconnect.
+func (b *MessageBus) Run(ctx context.Context) {
+	messages := b.pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			b.mu.RLock()
+			handler, exists := b.handlers[msg.Channel]
+			b.mu.RUnlock()
+			if exists {
+				handler(ctx, []byte(msg.Payload))
+			}
+		}
+	}
+}
+
+func (b *MessageBus) Close() error {
+	return b.pubsub.Close()
+}
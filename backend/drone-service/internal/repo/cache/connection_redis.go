@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+)
+
+const droneConnectionKeyPrefix = "drone-service:connection:"
+
+// Entries hold the JSON-encoded connection. Only the session that created an entry may release or
+// extend it; a drone that reconnected, here or elsewhere, keeps its new entry.
+var (
+	releaseLeaseScript = redis.NewScript(`
+local value = redis.call("GET", KEYS[1])
+if value and cjson.decode(value)["session_id"] == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+	refreshLeaseScript = redis.NewScript(`
+local value = redis.call("GET", KEYS[1])
+if not value then
+	redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+	return 1
+end
+if cjson.decode(value)["session_id"] == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[3])
+end
+return 0`)
+)
+
+type ConnectionRegistry struct {
+	rdb        *redis.Client
+	instanceID string
+	ttl        time.Duration
+}
+
+func NewConnectionRegistry(rdb *redis.Client, instanceID string, ttl time.Duration) *ConnectionRegistry {
+	return &ConnectionRegistry{rdb: rdb, instanceID: instanceID, ttl: ttl}
+}
+
+func (r *ConnectionRegistry) Register(ctx context.Context, conn *entity.DroneConnection) error {
+	value, err := r.encode(conn)
+	if err != nil {
+		return fmt.Errorf("ConnectionRegistry - Register - encode: %w", err)
+	}
+
+	if err := r.rdb.Set(ctx, droneConnectionKeyPrefix+conn.DroneID, value, r.ttl).Err(); err != nil {
+		return fmt.Errorf("ConnectionRegistry - Register: %w", err)
+	}
+	return nil
+}
+
+func (r *ConnectionRegistry) Unregister(ctx context.Context, conn *entity.DroneConnection) error {
+	if err := releaseLeaseScript.Run(ctx, r.rdb, []string{droneConnectionKeyPrefix + conn.DroneID}, conn.SessionID).Err(); err != nil {
+		return fmt.Errorf("ConnectionRegistry - Unregister: %w", err)
+	}
+	return nil
+}
+
+func (r *ConnectionRegistry) Refresh(ctx context.Context, conns []*entity.DroneConnection) error {
+	if len(conns) == 0 {
+		return nil
+	}
+
+	pipe := r.rdb.Pipeline()
+	for _, conn := range conns {
+		value, err := r.encode(conn)
+		if err != nil {
+			return fmt.Errorf("ConnectionRegistry - Refresh - encode: %w", err)
+		}
+		refreshLeaseScript.Eval(ctx, pipe, []string{droneConnectionKeyPrefix + conn.DroneID}, conn.SessionID, value, r.ttl.Milliseconds())
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("ConnectionRegistry - Refresh: %w", err)
+	}
+	return nil
+}
+
+// Owner returns the instance holding the drone's connection, or "" when no replica has it.
+func (r *ConnectionRegistry) Owner(ctx context.Context, droneID string) (string, error) {
+	value, err := r.rdb.Get(ctx, droneConnectionKeyPrefix+droneID).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("ConnectionRegistry - Owner: %w", err)
+	}
+
+	var conn entity.DroneConnection
+	if err := json.Unmarshal([]byte(value), &conn); err != nil {
+		return "", fmt.Errorf("ConnectionRegistry - Owner - Unmarshal: %w", err)
+	}
+	return conn.InstanceID, nil
+}
+
+func (r *ConnectionRegistry) List(ctx context.Context) ([]*entity.DroneConnection, error) {
+	keys := make([]string, 0)
+	iter := r.rdb.Scan(ctx, 0, droneConnectionKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("ConnectionRegistry - List - Scan: %w", err)
+	}
+
+	conns := make([]*entity.DroneConnection, 0, len(keys))
+	if len(keys) == 0 {
+		return conns, nil
+	}
+
+	values, err := r.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("ConnectionRegistry - List - MGet: %w", err)
+	}
+	for _, value := range values {
+		// The lease expired between SCAN and MGET.
+		raw, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var conn entity.DroneConnection
+		if err := json.Unmarshal([]byte(raw), &conn); err != nil {
+			continue
+		}
+		conns = append(conns, &conn)
+	}
+	return conns, nil
+}
+
+func (r *ConnectionRegistry) encode(conn *entity.DroneConnection) (string, error) {
+	stored := *conn
+	stored.InstanceID = r.instanceID
+	value, err := json.Marshal(stored)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 )
@@ -10,7 +11,10 @@ type (
 	DroneRepo interface {
 		SaveDroneState(ctx context.Context, state *entity.DroneState) error
 		GetDroneState(ctx context.Context, droneID string) (*entity.DroneState, error)
-		GetDroneIDByIP(ctx context.Context, ipAddress string) (string, error)
+		GetDrone(ctx context.Context, droneID string) (*entity.FleetDrone, error)
+		ListDrones(ctx context.Context) ([]*entity.FleetDrone, error)
+		GetCredential(ctx context.Context, droneID string) (*entity.DroneCredential, error)
+		TouchCredential(ctx context.Context, droneID string) error
 		UpdateDroneBattery(ctx context.Context, droneID string, batteryLevel float64) error
 	}
 
@@ -18,5 +22,44 @@ type (
 		SaveDeliveryTask(ctx context.Context, task *entity.DeliveryTask) error
 		GetDeliveryTask(ctx context.Context, deliveryID string) (*entity.DeliveryTask, error)
 		UpdateDeliveryStatus(ctx context.Context, deliveryID string, status entity.DeliveryStatus, errorMessage *string) error
+		TransitionDeliveryStatus(ctx context.Context, deliveryID string, from, to entity.DeliveryStatus) (bool, error)
+		GetActiveDeliveryID(ctx context.Context, droneID string) (string, error)
+	}
+
+	CommandRepo interface {
+		Create(ctx context.Context, command *entity.DroneCommand) (*entity.DroneCommand, error)
+		GetByID(ctx context.Context, commandID string) (*entity.DroneCommand, error)
+		ListOpenByDrone(ctx context.Context, droneID string, now time.Time) ([]*entity.DroneCommand, error)
+		ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.DroneCommand, error)
+		MarkSent(ctx context.Context, commandID string, nextAttemptAt time.Time) (bool, error)
+		MarkUndelivered(ctx context.Context, commandID string, reason string, nextAttemptAt time.Time) error
+		Ack(ctx context.Context, commandID string) (bool, error)
+		Complete(ctx context.Context, commandID string, status entity.DroneCommandStatus, lastError *string) (bool, error)
+	}
+
+	CommandAuditRepo interface {
+		Create(ctx context.Context, audit *entity.DroneCommandAudit) error
+		ListByDrone(ctx context.Context, droneID string, limit int) ([]*entity.DroneCommandAudit, error)
+	}
+
+	// ConnectionRegistry records which replica holds each drone's WebSocket. Entries are leases that
+	// expire unless the owning replica refreshes them, so a crashed replica drops out on its own.
+	ConnectionRegistry interface {
+		Register(ctx context.Context, conn *entity.DroneConnection) error
+		Unregister(ctx context.Context, conn *entity.DroneConnection) error
+		Refresh(ctx context.Context, conns []*entity.DroneConnection) error
+		Owner(ctx context.Context, droneID string) (string, error)
+		List(ctx context.Context) ([]*entity.DroneConnection, error)
+	}
+
+	MessageBus interface {
+		Publish(ctx context.Context, channel string, payload []byte) (int64, error)
+		Subscribe(ctx context.Context, channel string, handler func(ctx context.Context, payload []byte)) error
+		Unsubscribe(ctx context.Context, channel string) error
+	}
+
+	TelemetryRepo interface {
+		InsertTelemetrySamples(ctx context.Context, samples []*entity.TelemetrySample) (int64, error)
+		DeleteTelemetryBefore(ctx context.Context, missionBefore, idleBefore time.Time, limit int) (int64, error)
 	}
 )
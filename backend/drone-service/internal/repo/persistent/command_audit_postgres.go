@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo/persistent/sqlc"
+)
+
+type CommandAuditRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewCommandAuditRepo(pg *pgxpool.Pool) *CommandAuditRepo {
+	return &CommandAuditRepo{
+		db: pg,
+		q:  sqlc.New(pg),
+	}
+}
+
+func (r *CommandAuditRepo) Create(ctx context.Context, audit *entity.DroneCommandAudit) error {
+	droneUUID, err := uuid.Parse(audit.DroneID)
+	if err != nil {
+		return entityError.ErrDroneNotFound
+	}
+	operatorUUID, err := uuid.Parse(audit.OperatorID)
+	if err != nil {
+		return fmt.Errorf("CommandAuditRepo - Create - uuid.Parse[operator_id]: %w", err)
+	}
+
+	var commandUUID pgtype.UUID
+	if audit.CommandID != nil {
+		parsed, err := uuid.Parse(*audit.CommandID)
+		if err != nil {
+			return fmt.Errorf("CommandAuditRepo - Create - uuid.Parse[command_id]: %w", err)
+		}
+		commandUUID = pgtype.UUID{Bytes: parsed, Valid: true}
+	}
+
+	row, err := r.q.CreateDroneCommandAudit(ctx, sqlc.CreateDroneCommandAuditParams{
+		DroneID:       droneUUID,
+		CommandID:     commandUUID,
+		Command:       audit.Command,
+		Payload:       audit.Payload,
+		OperatorID:    operatorUUID,
+		OperatorEmail: audit.OperatorEmail,
+		OperatorRole:  audit.OperatorRole,
+		Result:        string(audit.Result),
+		Reason:        audit.Reason,
+	})
+	if err != nil {
+		return fmt.Errorf("CommandAuditRepo - Create: %w", err)
+	}
+
+	audit.ID = row.ID.String()
+	audit.CreatedAt = row.CreatedAt.Time
+	return nil
+}
+
+func (r *CommandAuditRepo) ListByDrone(ctx context.Context, droneID string, limit int) ([]*entity.DroneCommandAudit, error) {
+	droneUUID, err := uuid.Parse(droneID)
+	if err != nil {
+		return nil, entityError.ErrDroneNotFound
+	}
+
+	rows, err := r.q.ListDroneCommandAudit(ctx, sqlc.ListDroneCommandAuditParams{
+		DroneID: droneUUID,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CommandAuditRepo - ListByDrone: %w", err)
+	}
+
+	audits := make([]*entity.DroneCommandAudit, 0, len(rows))
+	for _, row := range rows {
+		audits = append(audits, toDroneCommandAudit(row))
+	}
+	return audits, nil
+}
+
+func toDroneCommandAudit(row sqlc.DroneCommandAudit) *entity.DroneCommandAudit {
+	audit := &entity.DroneCommandAudit{
+		ID:            row.ID.String(),
+		DroneID:       row.DroneID.String(),
+		Command:       row.Command,
+		Payload:       row.Payload,
+		OperatorID:    row.OperatorID.String(),
+		OperatorEmail: row.OperatorEmail,
+		OperatorRole:  row.OperatorRole,
+		Result:        entity.DroneCommandAuditResult(row.Result),
+		Reason:        row.Reason,
+		CreatedAt:     row.CreatedAt.Time,
+	}
+	if row.CommandID.Valid {
+		commandID := uuid.UUID(row.CommandID.Bytes).String()
+		audit.CommandID = &commandID
+	}
+	return audit
+}
@@ -0,0 +1,192 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo/persistent/sqlc"
+)
+
+type CommandRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewCommandRepo(pg *pgxpool.Pool) *CommandRepo {
+	return &CommandRepo{
+		db: pg,
+		q:  sqlc.New(pg),
+	}
+}
+
+func (r *CommandRepo) Create(ctx context.Context, command *entity.DroneCommand) (*entity.DroneCommand, error) {
+	commandUUID, err := uuid.Parse(command.ID)
+	if err != nil {
+		return nil, fmt.Errorf("CommandRepo - Create - uuid.Parse[id]: %w", err)
+	}
+	droneUUID, err := uuid.Parse(command.DroneID)
+	if err != nil {
+		return nil, entityError.ErrDroneNotFound
+	}
+
+	row, err := r.q.CreateDroneCommand(ctx, sqlc.CreateDroneCommandParams{
+		ID:          commandUUID,
+		DroneID:     droneUUID,
+		Command:     command.Command,
+		Payload:     command.Payload,
+		MaxAttempts: int32(command.MaxAttempts),
+		ExpiresAt:   pgtype.Timestamp{Time: command.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		if isPgForeignKeyViolation(err) {
+			return nil, entityError.ErrDroneNotFound
+		}
+		return nil, fmt.Errorf("CommandRepo - Create: %w", err)
+	}
+
+	return toDroneCommand(row), nil
+}
+
+func (r *CommandRepo) GetByID(ctx context.Context, commandID string) (*entity.DroneCommand, error) {
+	commandUUID, err := uuid.Parse(commandID)
+	if err != nil {
+		return nil, entityError.ErrDroneCommandNotFound
+	}
+
+	row, err := r.q.GetDroneCommand(ctx, commandUUID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrDroneCommandNotFound
+		}
+		return nil, fmt.Errorf("CommandRepo - GetByID: %w", err)
+	}
+
+	return toDroneCommand(row), nil
+}
+
+func (r *CommandRepo) ListOpenByDrone(ctx context.Context, droneID string, now time.Time) ([]*entity.DroneCommand, error) {
+	droneUUID, err := uuid.Parse(droneID)
+	if err != nil {
+		return nil, nil
+	}
+
+	rows, err := r.q.ListOpenDroneCommandsByDrone(ctx, sqlc.ListOpenDroneCommandsByDroneParams{
+		DroneID: droneUUID,
+		Now:     pgtype.Timestamp{Time: now, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CommandRepo - ListOpenByDrone: %w", err)
+	}
+
+	return toDroneCommands(rows), nil
+}
+
+// ClaimDue leases the commands that are due until leaseUntil, so each is handled by one replica only.
+func (r *CommandRepo) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.DroneCommand, error) {
+	rows, err := r.q.ClaimDueDroneCommands(ctx, sqlc.ClaimDueDroneCommandsParams{
+		LeaseUntil: pgtype.Timestamp{Time: leaseUntil, Valid: true},
+		Now:        pgtype.Timestamp{Time: now, Valid: true},
+		BatchSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CommandRepo - ClaimDue: %w", err)
+	}
+
+	return toDroneCommands(rows), nil
+}
+
+func (r *CommandRepo) MarkSent(ctx context.Context, commandID string, nextAttemptAt time.Time) (bool, error) {
+	commandUUID, err := uuid.Parse(commandID)
+	if err != nil {
+		return false, entityError.ErrDroneCommandNotFound
+	}
+
+	updated, err := r.q.MarkDroneCommandSent(ctx, sqlc.MarkDroneCommandSentParams{
+		ID:            commandUUID,
+		NextAttemptAt: pgtype.Timestamp{Time: nextAttemptAt, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("CommandRepo - MarkSent: %w", err)
+	}
+	return updated > 0, nil
+}
+
+func (r *CommandRepo) MarkUndelivered(ctx context.Context, commandID string, reason string, nextAttemptAt time.Time) error {
+	commandUUID, err := uuid.Parse(commandID)
+	if err != nil {
+		return entityError.ErrDroneCommandNotFound
+	}
+
+	if _, err := r.q.MarkDroneCommandUndelivered(ctx, sqlc.MarkDroneCommandUndeliveredParams{
+		ID:            commandUUID,
+		NextAttemptAt: pgtype.Timestamp{Time: nextAttemptAt, Valid: true},
+		LastError:     &reason,
+	}); err != nil {
+		return fmt.Errorf("CommandRepo - MarkUndelivered: %w", err)
+	}
+	return nil
+}
+
+func (r *CommandRepo) Ack(ctx context.Context, commandID string) (bool, error) {
+	commandUUID, err := uuid.Parse(commandID)
+	if err != nil {
+		return false, entityError.ErrDroneCommandNotFound
+	}
+
+	updated, err := r.q.AckDroneCommand(ctx, commandUUID)
+	if err != nil {
+		return false, fmt.Errorf("CommandRepo - Ack: %w", err)
+	}
+	return updated > 0, nil
+}
+
+func (r *CommandRepo) Complete(ctx context.Context, commandID string, status entity.DroneCommandStatus, lastError *string) (bool, error) {
+	commandUUID, err := uuid.Parse(commandID)
+	if err != nil {
+		return false, entityError.ErrDroneCommandNotFound
+	}
+
+	updated, err := r.q.CompleteDroneCommand(ctx, sqlc.CompleteDroneCommandParams{
+		ID:        commandUUID,
+		Status:    string(status),
+		LastError: lastError,
+	})
+	if err != nil {
+		return false, fmt.Errorf("CommandRepo - Complete: %w", err)
+	}
+	return updated > 0, nil
+}
+
+func toDroneCommands(rows []sqlc.DroneCommand) []*entity.DroneCommand {
+	commands := make([]*entity.DroneCommand, 0, len(rows))
+	for _, row := range rows {
+		commands = append(commands, toDroneCommand(row))
+	}
+	return commands
+}
+
+func toDroneCommand(row sqlc.DroneCommand) *entity.DroneCommand {
+	return &entity.DroneCommand{
+		ID:            row.ID.String(),
+		DroneID:       row.DroneID.String(),
+		Command:       row.Command,
+		Payload:       row.Payload,
+		Status:        entity.DroneCommandStatus(row.Status),
+		Attempts:      int(row.Attempts),
+		MaxAttempts:   int(row.MaxAttempts),
+		LastError:     row.LastError,
+		NextAttemptAt: timestampToTimePtr(row.NextAttemptAt),
+		ExpiresAt:     row.ExpiresAt.Time,
+		SentAt:        timestampToTimePtr(row.SentAt),
+		AckedAt:       timestampToTimePtr(row.AckedAt),
+		CompletedAt:   timestampToTimePtr(row.CompletedAt),
+		CreatedAt:     row.CreatedAt.Time,
+		UpdatedAt:     row.UpdatedAt.Time,
+	}
+}
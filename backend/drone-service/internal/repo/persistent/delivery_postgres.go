@@ -128,6 +128,43 @@ func (r *DeliveryRepo) UpdateDeliveryStatus(ctx context.Context, deliveryID stri
 	return nil
 }
 
+// TransitionDeliveryStatus moves the delivery from one status to another and reports false when it was
+// no longer in the expected status.
+func (r *DeliveryRepo) TransitionDeliveryStatus(ctx context.Context, deliveryID string, from, to entity.DeliveryStatus) (bool, error) {
+	deliveryUUID, err := uuid.Parse(deliveryID)
+	if err != nil {
+		return false, fmt.Errorf("DeliveryRepo - TransitionDeliveryStatus - uuid.Parse: %w", err)
+	}
+
+	updated, err := r.q.TransitionDeliveryStatus(ctx, sqlc.TransitionDeliveryStatusParams{
+		ToStatus:   string(to),
+		ID:         deliveryUUID,
+		FromStatus: string(from),
+	})
+	if err != nil {
+		return false, fmt.Errorf("DeliveryRepo - TransitionDeliveryStatus: %w", err)
+	}
+	return updated > 0, nil
+}
+
+// GetActiveDeliveryID returns the delivery the drone is flying, or ErrDeliveryTaskNotFound when it has none.
+func (r *DeliveryRepo) GetActiveDeliveryID(ctx context.Context, droneID string) (string, error) {
+	droneUUID, err := uuid.Parse(droneID)
+	if err != nil {
+		return "", fmt.Errorf("DeliveryRepo - GetActiveDeliveryID - uuid.Parse: %w", err)
+	}
+
+	deliveryID, err := r.q.GetActiveDeliveryByDrone(ctx, ptrUUIDToPgUUID(&droneUUID))
+	if err != nil {
+		if isNoRows(err) {
+			return "", entityError.ErrDeliveryTaskNotFound
+		}
+		return "", fmt.Errorf("DeliveryRepo - GetActiveDeliveryID: %w", err)
+	}
+
+	return deliveryID.String(), nil
+}
+
 func ptrUUIDToPgUUID(pu *uuid.UUID) pgtype.UUID {
 	if pu == nil {
 		return pgtype.UUID{Valid: false}
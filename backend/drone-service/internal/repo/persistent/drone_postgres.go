@@ -25,16 +25,37 @@ func NewDroneRepo(pg *pgxpool.Pool) *DroneRepo {
 	}
 }
 
-func (r *DroneRepo) GetDroneIDByIP(ctx context.Context, ipAddress string) (string, error) {
-	droneID, err := r.q.GetDroneIDByIP(ctx, ipAddress)
+func (r *DroneRepo) GetCredential(ctx context.Context, droneID string) (*entity.DroneCredential, error) {
+	droneUUID, err := uuid.Parse(droneID)
+	if err != nil {
+		return nil, entityError.ErrDroneNotFound
+	}
+
+	row, err := r.q.GetDroneCredential(ctx, droneUUID)
 	if err != nil {
 		if isNoRows(err) {
-			return "", entityError.ErrDroneNotFound
+			return nil, entityError.ErrDroneNoCredential
 		}
-		return "", fmt.Errorf("DroneRepo - GetDroneIDByIP: %w", err)
+		return nil, fmt.Errorf("DroneRepo - GetCredential: %w", err)
 	}
 
-	return droneID.String(), nil
+	return &entity.DroneCredential{
+		DroneID:    row.DroneID.String(),
+		Secret:     row.Secret,
+		KeyVersion: int(row.KeyVersion),
+	}, nil
+}
+
+func (r *DroneRepo) TouchCredential(ctx context.Context, droneID string) error {
+	droneUUID, err := uuid.Parse(droneID)
+	if err != nil {
+		return fmt.Errorf("DroneRepo - TouchCredential - uuid.Parse: %w", err)
+	}
+
+	if err := r.q.TouchDroneCredential(ctx, droneUUID); err != nil {
+		return fmt.Errorf("DroneRepo - TouchCredential: %w", err)
+	}
+	return nil
 }
 
 func (r *DroneRepo) UpdateDroneBattery(ctx context.Context, droneID string, batteryLevel float64) error {
@@ -137,6 +158,65 @@ func (r *DroneRepo) GetDroneState(ctx context.Context, droneID string) (*entity.
 	return state, nil
 }
 
+func (r *DroneRepo) GetDrone(ctx context.Context, droneID string) (*entity.FleetDrone, error) {
+	droneUUID, err := uuid.Parse(droneID)
+	if err != nil {
+		return nil, entityError.ErrDroneNotFound
+	}
+
+	row, err := r.q.GetDrone(ctx, droneUUID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrDroneNotFound
+		}
+		return nil, fmt.Errorf("DroneRepo - GetDrone: %w", err)
+	}
+
+	return toFleetDrone(row), nil
+}
+
+func (r *DroneRepo) ListDrones(ctx context.Context) ([]*entity.FleetDrone, error) {
+	rows, err := r.q.ListDrones(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("DroneRepo - ListDrones: %w", err)
+	}
+
+	drones := make([]*entity.FleetDrone, 0, len(rows))
+	for _, row := range rows {
+		drones = append(drones, toFleetDrone(sqlc.GetDroneRow(row)))
+	}
+	return drones, nil
+}
+
+func toFleetDrone(row sqlc.GetDroneRow) *entity.FleetDrone {
+	drone := &entity.FleetDrone{
+		DroneState: entity.DroneState{
+			DroneID:      row.ID.String(),
+			Status:       entity.DroneStatus(row.Status),
+			BatteryLevel: numericToFloat64(row.BatteryLevel),
+			CurrentPosition: entity.Position{
+				Latitude:  numericToFloat64(row.Latitude),
+				Longitude: numericToFloat64(row.Longitude),
+				Altitude:  numericToFloat64(row.Altitude),
+			},
+			Speed:        numericToFloat64(row.Speed),
+			ErrorMessage: row.ErrorMessage,
+		},
+		Model: row.Model,
+	}
+
+	if row.UpdatedAt.Valid {
+		drone.LastUpdated = row.UpdatedAt.Time
+	}
+
+	if row.CurrentDeliveryID.Valid {
+		deliveryID := uuid.UUID(row.CurrentDeliveryID.Bytes).String()
+		drone.CurrentDeliveryID = &deliveryID
+	}
+
+	return drone
+}
+
 func float64ToNumeric(f float64) pgtype.Numeric {
 	n := pgtype.Numeric{}
 	_ = n.Scan(fmt.Sprintf("%.2f", f))
@@ -12,6 +12,22 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const getActiveDeliveryByDrone = `-- name: GetActiveDeliveryByDrone :one
+SELECT id
+FROM deliveries
+WHERE drone_id = $1
+    AND status IN ('pending', 'in_progress', 'in_transit', 'drone_lost')
+ORDER BY started_at DESC NULLS LAST
+LIMIT 1
+`
+
+func (q *Queries) GetActiveDeliveryByDrone(ctx context.Context, droneID pgtype.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getActiveDeliveryByDrone, droneID)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getDeliveryTask = `-- name: GetDeliveryTask :one
 SELECT 
     d.id,
@@ -80,6 +96,27 @@ func (q *Queries) SaveDeliveryTask(ctx context.Context, arg SaveDeliveryTaskPara
 	return err
 }
 
+const transitionDeliveryStatus = `-- name: TransitionDeliveryStatus :execrows
+UPDATE deliveries
+SET status = $1
+WHERE id = $2
+    AND status = $3
+`
+
+type TransitionDeliveryStatusParams struct {
+	ToStatus   string    `json:"to_status"`
+	ID         uuid.UUID `json:"id"`
+	FromStatus string    `json:"from_status"`
+}
+
+func (q *Queries) TransitionDeliveryStatus(ctx context.Context, arg TransitionDeliveryStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, transitionDeliveryStatus, arg.ToStatus, arg.ID, arg.FromStatus)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateDeliveryStatus = `-- name: UpdateDeliveryStatus :exec
 UPDATE deliveries
 SET 
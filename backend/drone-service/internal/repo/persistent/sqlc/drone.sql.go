@@ -12,16 +12,74 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const getDroneIDByIP = `-- name: GetDroneIDByIP :one
-SELECT id FROM drones
-WHERE ip_address = $1
+const getDrone = `-- name: GetDrone :one
+SELECT id,
+    model,
+    status,
+    battery_level,
+    latitude,
+    longitude,
+    altitude,
+    speed,
+    current_delivery_id,
+    error_message,
+    updated_at
+FROM drones
+WHERE id = $1
+`
+
+type GetDroneRow struct {
+	ID                uuid.UUID        `json:"id"`
+	Model             string           `json:"model"`
+	Status            string           `json:"status"`
+	BatteryLevel      pgtype.Numeric   `json:"battery_level"`
+	Latitude          pgtype.Numeric   `json:"latitude"`
+	Longitude         pgtype.Numeric   `json:"longitude"`
+	Altitude          pgtype.Numeric   `json:"altitude"`
+	Speed             pgtype.Numeric   `json:"speed"`
+	CurrentDeliveryID pgtype.UUID      `json:"current_delivery_id"`
+	ErrorMessage      *string          `json:"error_message"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+}
+
+func (q *Queries) GetDrone(ctx context.Context, id uuid.UUID) (GetDroneRow, error) {
+	row := q.db.QueryRow(ctx, getDrone, id)
+	var i GetDroneRow
+	err := row.Scan(
+		&i.ID,
+		&i.Model,
+		&i.Status,
+		&i.BatteryLevel,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Altitude,
+		&i.Speed,
+		&i.CurrentDeliveryID,
+		&i.ErrorMessage,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getDroneCredential = `-- name: GetDroneCredential :one
+SELECT drone_id,
+    secret,
+    key_version
+FROM drone_credentials
+WHERE drone_id = $1
 `
 
-func (q *Queries) GetDroneIDByIP(ctx context.Context, ipAddress string) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, getDroneIDByIP, ipAddress)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+type GetDroneCredentialRow struct {
+	DroneID    uuid.UUID `json:"drone_id"`
+	Secret     string    `json:"secret"`
+	KeyVersion int32     `json:"key_version"`
+}
+
+func (q *Queries) GetDroneCredential(ctx context.Context, droneID uuid.UUID) (GetDroneCredentialRow, error) {
+	row := q.db.QueryRow(ctx, getDroneCredential, droneID)
+	var i GetDroneCredentialRow
+	err := row.Scan(&i.DroneID, &i.Secret, &i.KeyVersion)
+	return i, err
 }
 
 const getDroneState = `-- name: GetDroneState :one
@@ -61,6 +119,69 @@ func (q *Queries) GetDroneState(ctx context.Context, id uuid.UUID) (GetDroneStat
 	return i, err
 }
 
+const listDrones = `-- name: ListDrones :many
+SELECT id,
+    model,
+    status,
+    battery_level,
+    latitude,
+    longitude,
+    altitude,
+    speed,
+    current_delivery_id,
+    error_message,
+    updated_at
+FROM drones
+ORDER BY created_at,
+    id
+`
+
+type ListDronesRow struct {
+	ID                uuid.UUID        `json:"id"`
+	Model             string           `json:"model"`
+	Status            string           `json:"status"`
+	BatteryLevel      pgtype.Numeric   `json:"battery_level"`
+	Latitude          pgtype.Numeric   `json:"latitude"`
+	Longitude         pgtype.Numeric   `json:"longitude"`
+	Altitude          pgtype.Numeric   `json:"altitude"`
+	Speed             pgtype.Numeric   `json:"speed"`
+	CurrentDeliveryID pgtype.UUID      `json:"current_delivery_id"`
+	ErrorMessage      *string          `json:"error_message"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+}
+
+func (q *Queries) ListDrones(ctx context.Context) ([]ListDronesRow, error) {
+	rows, err := q.db.Query(ctx, listDrones)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDronesRow
+	for rows.Next() {
+		var i ListDronesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Model,
+			&i.Status,
+			&i.BatteryLevel,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Altitude,
+			&i.Speed,
+			&i.CurrentDeliveryID,
+			&i.ErrorMessage,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const saveDroneState = `-- name: SaveDroneState :exec
 UPDATE drones
 SET 
@@ -103,6 +224,17 @@ func (q *Queries) SaveDroneState(ctx context.Context, arg SaveDroneStateParams)
 	return err
 }
 
+const touchDroneCredential = `-- name: TouchDroneCredential :exec
+UPDATE drone_credentials
+SET last_connected_at = CURRENT_TIMESTAMP
+WHERE drone_id = $1
+`
+
+func (q *Queries) TouchDroneCredential(ctx context.Context, droneID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, touchDroneCredential, droneID)
+	return err
+}
+
 const updateDroneBattery = `-- name: UpdateDroneBattery :exec
 UPDATE drones
 SET battery_level = $2, updated_at = CURRENT_TIMESTAMP
@@ -18,6 +18,9 @@ type Delivery struct {
 	Status               string           `json:"status"`
 	StartedAt            pgtype.Timestamp `json:"started_at"`
 	CompletedAt          pgtype.Timestamp `json:"completed_at"`
+	DeliveredAt          pgtype.Timestamp `json:"delivered_at"`
+	PickupRemindedAt     pgtype.Timestamp `json:"pickup_reminded_at"`
+	ArrivalNotifiedAt    pgtype.Timestamp `json:"arrival_notified_at"`
 }
 
 type Drone struct {
@@ -36,6 +39,60 @@ type Drone struct {
 	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
 }
 
+type DroneCommand struct {
+	ID            uuid.UUID        `json:"id"`
+	DroneID       uuid.UUID        `json:"drone_id"`
+	Command       string           `json:"command"`
+	Payload       []byte           `json:"payload"`
+	Status        string           `json:"status"`
+	Attempts      int32            `json:"attempts"`
+	MaxAttempts   int32            `json:"max_attempts"`
+	LastError     *string          `json:"last_error"`
+	NextAttemptAt pgtype.Timestamp `json:"next_attempt_at"`
+	ExpiresAt     pgtype.Timestamp `json:"expires_at"`
+	SentAt        pgtype.Timestamp `json:"sent_at"`
+	AckedAt       pgtype.Timestamp `json:"acked_at"`
+	CompletedAt   pgtype.Timestamp `json:"completed_at"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+}
+
+type DroneCommandAudit struct {
+	ID            uuid.UUID        `json:"id"`
+	DroneID       uuid.UUID        `json:"drone_id"`
+	CommandID     pgtype.UUID      `json:"command_id"`
+	Command       string           `json:"command"`
+	Payload       []byte           `json:"payload"`
+	OperatorID    uuid.UUID        `json:"operator_id"`
+	OperatorEmail string           `json:"operator_email"`
+	OperatorRole  string           `json:"operator_role"`
+	Result        string           `json:"result"`
+	Reason        *string          `json:"reason"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+}
+
+type DroneCredential struct {
+	DroneID         uuid.UUID        `json:"drone_id"`
+	Secret          string           `json:"secret"`
+	KeyVersion      int32            `json:"key_version"`
+	LastConnectedAt pgtype.Timestamp `json:"last_connected_at"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	RotatedAt       pgtype.Timestamp `json:"rotated_at"`
+}
+
+type DroneTelemetry struct {
+	ID           int64            `json:"id"`
+	DroneID      uuid.UUID        `json:"drone_id"`
+	DeliveryID   pgtype.UUID      `json:"delivery_id"`
+	Status       string           `json:"status"`
+	BatteryLevel float64          `json:"battery_level"`
+	Latitude     float64          `json:"latitude"`
+	Longitude    float64          `json:"longitude"`
+	Altitude     float64          `json:"altitude"`
+	Speed        float64          `json:"speed"`
+	RecordedAt   pgtype.Timestamp `json:"recorded_at"`
+}
+
 type Good struct {
 	ID                uuid.UUID `json:"id"`
 	Name              string    `json:"name"`
@@ -0,0 +1,78 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo/persistent/sqlc"
+)
+
+type TelemetryRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewTelemetryRepo(pg *pgxpool.Pool) *TelemetryRepo {
+	return &TelemetryRepo{
+		db: pg,
+		q:  sqlc.New(pg),
+	}
+}
+
+func (r *TelemetryRepo) InsertTelemetrySamples(ctx context.Context, samples []*entity.TelemetrySample) (int64, error) {
+	rows := make([]sqlc.InsertDroneTelemetryParams, 0, len(samples))
+	for _, sample := range samples {
+		droneUUID, err := uuid.Parse(sample.DroneID)
+		if err != nil {
+			continue
+		}
+
+		var deliveryID pgtype.UUID
+		if sample.DeliveryID != nil {
+			if deliveryUUID, err := uuid.Parse(*sample.DeliveryID); err == nil {
+				deliveryID = pgtype.UUID{Bytes: deliveryUUID, Valid: true}
+			}
+		}
+
+		rows = append(rows, sqlc.InsertDroneTelemetryParams{
+			DroneID:      droneUUID,
+			DeliveryID:   deliveryID,
+			Status:       string(sample.Status),
+			BatteryLevel: sample.BatteryLevel,
+			Latitude:     sample.Position.Latitude,
+			Longitude:    sample.Position.Longitude,
+			Altitude:     sample.Position.Altitude,
+			Speed:        sample.Speed,
+			RecordedAt:   pgtype.Timestamp{Time: sample.RecordedAt, Valid: true},
+		})
+	}
+
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	inserted, err := r.q.InsertDroneTelemetry(ctx, rows)
+	if err != nil {
+		return 0, fmt.Errorf("TelemetryRepo - InsertTelemetrySamples: %w", err)
+	}
+
+	return inserted, nil
+}
+
+func (r *TelemetryRepo) DeleteTelemetryBefore(ctx context.Context, missionBefore, idleBefore time.Time, limit int) (int64, error) {
+	deleted, err := r.q.DeleteDroneTelemetryBefore(ctx, sqlc.DeleteDroneTelemetryBeforeParams{
+		MissionBefore: pgtype.Timestamp{Time: missionBefore, Valid: true},
+		IdleBefore:    pgtype.Timestamp{Time: idleBefore, Valid: true},
+		BatchSize:     int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("TelemetryRepo - DeleteTelemetryBefore: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -1,9 +1,24 @@
 package usecase
 
-import "context"
+import (
+	"context"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
+)
 
 type (
 	DroneNotifier interface {
-		SendToDrone(ctx context.Context, droneID string, message map[string]any) error
+		SendToDrone(ctx context.Context, droneID string, message droneproto.Message) error
+	}
+
+	TelemetryRecorder interface {
+		Record(state *entity.DroneState)
+	}
+
+	// AdminNotifier feeds the admin WebSocket of every replica.
+	AdminNotifier interface {
+		AlertAdmins(alert map[string]any)
+		NotifyAdmins(ctx context.Context, event *entity.AdminEvent)
 	}
 )
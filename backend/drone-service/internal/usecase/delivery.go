@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
 	grpcError "github.com/skr1ms/SkyPostDelivery/drone-service/pkg/grpc"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/rabbitmq"
@@ -21,6 +23,7 @@ type DeliveryUseCase struct {
 	droneNotifier          DroneNotifier
 	orchestratorGRPCClient grpcError.OrchestratorGRPCClient
 	rabbitmqClient         rabbitmq.RabbitMQClient
+	admins                 AdminNotifier
 	logger                 logger.Interface
 }
 
@@ -44,6 +47,11 @@ func NewDeliveryUseCase(
 	}
 }
 
+// SetAdminNotifier wires the admin feed, which is created after this use case.
+func (uc *DeliveryUseCase) SetAdminNotifier(admins AdminNotifier) {
+	uc.admins = admins
+}
+
 func (uc *DeliveryUseCase) StartDelivery(
 	ctx context.Context,
 	droneID string,
@@ -82,29 +90,7 @@ func (uc *DeliveryUseCase) StartDelivery(
 			"delivery_id": "",
 		}, fmt.Errorf("DeliveryUseCase - StartDelivery - SaveDeliveryTask: %w", err)
 	}
-
-	registeredDrones := uc.droneManager.GetRegisteredDrones()
-	droneRegistered := false
-	for _, id := range registeredDrones {
-		if id == droneID {
-			droneRegistered = true
-			break
-		}
-	}
-
-	if !droneRegistered {
-		if err := uc.droneManager.RegisterDrone(ctx, droneID); err != nil {
-			uc.logger.Error("DeliveryUseCase - StartDelivery - RegisterDrone", err, map[string]any{
-				"droneID":    droneID,
-				"deliveryID": deliveryID,
-			})
-			return map[string]any{
-				"success":     false,
-				"message":     "Failed to register drone",
-				"delivery_id": "",
-			}, fmt.Errorf("DeliveryUseCase - StartDelivery - RegisterDrone: %w", err)
-		}
-	}
+	uc.notifyDeliveryStatus(ctx, task, entity.DeliveryStatusPending, nil)
 
 	if err := uc.droneManager.AssignDeliveryToDrone(ctx, droneID, deliveryID); err != nil {
 		uc.logger.Error("DeliveryUseCase - StartDelivery - AssignDeliveryToDrone", err, map[string]any{
@@ -147,27 +133,23 @@ func (uc *DeliveryUseCase) ExecuteDelivery(
 	width float64,
 	internalLockerCellID *string,
 ) error {
-	taskData := map[string]any{
-		"drone_id":          droneID,
-		"order_id":          orderID,
-		"good_id":           goodID,
-		"parcel_automat_id": parcelAutomatID,
-		"aruco_id":          arucoID,
-		"coordinates":       coordinates,
-		"weight":            weight,
-		"height":            height,
-		"length":            length,
-		"width":             width,
-		"internal_cell_id":  internalLockerCellID,
+	task := &droneproto.DeliveryTask{
+		OrderID:         orderID,
+		GoodID:          goodID,
+		ParcelAutomatID: parcelAutomatID,
+		ArucoID:         &arucoID,
+		Coordinates:     coordinates,
+		InternalCellID:  internalLockerCellID,
+		Dimensions: droneproto.Dimensions{
+			Weight: weight,
+			Height: height,
+			Length: length,
+			Width:  width,
+		},
 	}
 
 	if uc.droneNotifier != nil {
-		message := map[string]any{
-			"type":      "delivery_task",
-			"timestamp": time.Now().Format(time.RFC3339),
-			"payload":   taskData,
-		}
-		if err := uc.droneNotifier.SendToDrone(ctx, droneID, message); err != nil {
+		if err := uc.droneNotifier.SendToDrone(ctx, droneID, task); err != nil {
 			uc.logger.Error("DeliveryUseCase - ExecuteDelivery - SendToDrone", err, map[string]any{
 				"droneID": droneID,
 				"orderID": orderID,
@@ -193,22 +175,9 @@ func (uc *DeliveryUseCase) executeDelivery(task *entity.DeliveryTask) {
 		}
 		return
 	}
+	uc.notifyDeliveryStatus(ctx, task, entity.DeliveryStatusInProgress, nil)
 
-	taskData := map[string]any{
-		"delivery_id":       task.DeliveryID,
-		"order_id":          task.OrderID,
-		"good_id":           task.GoodID,
-		"parcel_automat_id": task.ParcelAutomatID,
-		"aruco_id":          task.ArucoID,
-		"coordinates":       "",
-		"internal_cell_id":  task.InternalLockerCellID,
-		"dimensions": map[string]any{
-			"weight": task.Dimensions.Weight,
-			"height": task.Dimensions.Height,
-			"length": task.Dimensions.Length,
-			"width":  task.Dimensions.Width,
-		},
-	}
+	message := deliveryTaskMessage(task)
 
 	if task.DroneID == nil {
 		uc.logger.Warn("DeliveryUseCase - executeDelivery - droneID is nil", nil, map[string]any{
@@ -220,11 +189,6 @@ func (uc *DeliveryUseCase) executeDelivery(task *entity.DeliveryTask) {
 	}
 
 	if uc.droneNotifier != nil {
-		message := map[string]any{
-			"type":      "delivery_task",
-			"timestamp": time.Now().Format(time.RFC3339),
-			"payload":   taskData,
-		}
 		if err := uc.droneNotifier.SendToDrone(ctx, *task.DroneID, message); err != nil {
 			uc.logger.Error("DeliveryUseCase - executeDelivery - SendToDrone", err, map[string]any{
 				"droneID":    *task.DroneID,
@@ -233,26 +197,179 @@ func (uc *DeliveryUseCase) executeDelivery(task *entity.DeliveryTask) {
 			})
 			_ = uc.deliveryRepo.UpdateDeliveryStatus(ctx, task.OrderID, entity.DeliveryStatusFailed, nil)
 			_ = uc.droneManager.ReleaseDrone(ctx, *task.DroneID)
+			reason := "delivery task could not be sent to the drone"
+			uc.notifyDeliveryStatus(ctx, task, entity.DeliveryStatusFailed, &reason)
 		}
 	}
 }
 
-func (uc *DeliveryUseCase) HandleReturnTask(ctx context.Context, droneID string, deliveryID string, baseMarkerID int) error {
-	returnCommand := map[string]any{
-		"type": "return_to_base",
-		"payload": map[string]any{
-			"delivery_id":    deliveryID,
-			"base_marker_id": baseMarkerID,
+func (uc *DeliveryUseCase) notifyDeliveryStatus(ctx context.Context, task *entity.DeliveryTask, status entity.DeliveryStatus, reason *string) {
+	if uc.admins == nil {
+		return
+	}
+
+	event := &entity.AdminEvent{
+		Type:       entity.AdminEventDeliveryStatus,
+		DeliveryID: task.DeliveryID,
+		Payload: map[string]any{
+			"status":   status,
+			"order_id": task.OrderID,
 		},
+		Timestamp: time.Now(),
+	}
+	if task.DroneID != nil {
+		event.DroneID = *task.DroneID
+		event.Payload["drone_id"] = *task.DroneID
+	}
+	if reason != nil {
+		event.Payload["reason"] = *reason
+	}
+	uc.admins.NotifyAdmins(ctx, event)
+}
+
+func deliveryTaskMessage(task *entity.DeliveryTask) *droneproto.DeliveryTask {
+	return &droneproto.DeliveryTask{
+		DeliveryID:      task.DeliveryID,
+		OrderID:         task.OrderID,
+		GoodID:          task.GoodID,
+		ParcelAutomatID: task.ParcelAutomatID,
+		ArucoID:         task.ArucoID,
+		InternalCellID:  task.InternalLockerCellID,
+		Dimensions: droneproto.Dimensions{
+			Weight: task.Dimensions.Weight,
+			Height: task.Dimensions.Height,
+			Length: task.Dimensions.Length,
+			Width:  task.Dimensions.Width,
+		},
+	}
+}
+
+// ActiveDeliveryID returns the delivery the drone is flying, or "" when it has none.
+func (uc *DeliveryUseCase) ActiveDeliveryID(ctx context.Context, droneID string) (string, error) {
+	deliveryID, err := uc.deliveryRepo.GetActiveDeliveryID(ctx, droneID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrDeliveryTaskNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("DeliveryUseCase - ActiveDeliveryID: %w", err)
+	}
+	return deliveryID, nil
+}
+
+// FailDelivery puts the delivery on the failure path. The drone is not released here: callers that know
+// the drone no longer carries the mission release it themselves.
+func (uc *DeliveryUseCase) FailDelivery(ctx context.Context, droneID, deliveryID, reason string) error {
+	if err := uc.deliveryRepo.UpdateDeliveryStatus(ctx, deliveryID, entity.DeliveryStatusFailed, &reason); err != nil {
+		uc.logger.Error("DeliveryUseCase - FailDelivery - UpdateDeliveryStatus", err, map[string]any{
+			"droneID":    droneID,
+			"deliveryID": deliveryID,
+		})
+		return fmt.Errorf("DeliveryUseCase - FailDelivery - UpdateDeliveryStatus: %w", err)
+	}
+
+	uc.logger.Warn("Delivery failed", nil, map[string]any{
+		"droneID":    droneID,
+		"deliveryID": deliveryID,
+		"reason":     reason,
+	})
+	uc.notifyDeliveryStatus(ctx, &entity.DeliveryTask{DeliveryID: deliveryID, DroneID: &droneID}, entity.DeliveryStatusFailed, &reason)
+
+	return nil
+}
+
+// SuspendDelivery parks the delivery of a drone that went silent as drone_lost. Nothing final happens
+// yet: ResumeDelivery picks the mission up if the drone comes back, FailLostDelivery gives up on it.
+func (uc *DeliveryUseCase) SuspendDelivery(ctx context.Context, droneID, deliveryID, reason string) error {
+	if err := uc.deliveryRepo.UpdateDeliveryStatus(ctx, deliveryID, entity.DeliveryStatusDroneLost, &reason); err != nil {
+		uc.logger.Error("DeliveryUseCase - SuspendDelivery - UpdateDeliveryStatus", err, map[string]any{
+			"droneID":    droneID,
+			"deliveryID": deliveryID,
+		})
+		return fmt.Errorf("DeliveryUseCase - SuspendDelivery - UpdateDeliveryStatus: %w", err)
+	}
+
+	uc.logger.Warn("Delivery suspended", nil, map[string]any{
+		"droneID":    droneID,
+		"deliveryID": deliveryID,
+		"reason":     reason,
+	})
+	uc.notifyDeliveryStatus(ctx, &entity.DeliveryTask{DeliveryID: deliveryID, DroneID: &droneID}, entity.DeliveryStatusDroneLost, &reason)
+
+	return nil
+}
+
+// FailLostDelivery fails a delivery suspended by SuspendDelivery. It reports false when the delivery
+// was meanwhile resumed, cancelled or already failed by another replica.
+func (uc *DeliveryUseCase) FailLostDelivery(ctx context.Context, droneID, deliveryID, reason string) (bool, error) {
+	failed, err := uc.deliveryRepo.TransitionDeliveryStatus(ctx, deliveryID, entity.DeliveryStatusDroneLost, entity.DeliveryStatusFailed)
+	if err != nil {
+		return false, fmt.Errorf("DeliveryUseCase - FailLostDelivery - TransitionDeliveryStatus: %w", err)
+	}
+	if !failed {
+		return false, nil
+	}
+
+	uc.logger.Warn("Delivery failed", nil, map[string]any{
+		"droneID":    droneID,
+		"deliveryID": deliveryID,
+		"reason":     reason,
+	})
+	uc.notifyDeliveryStatus(ctx, &entity.DeliveryTask{DeliveryID: deliveryID, DroneID: &droneID}, entity.DeliveryStatusFailed, &reason)
+
+	return true, nil
+}
+
+// ResumeDelivery sends a delivery suspended by SuspendDelivery to its drone again and puts it back in
+// progress. It reports false when the delivery was meanwhile failed, cancelled or given to another drone.
+func (uc *DeliveryUseCase) ResumeDelivery(ctx context.Context, droneID, deliveryID string) (bool, error) {
+	task, err := uc.deliveryRepo.GetDeliveryTask(ctx, deliveryID)
+	if err != nil {
+		return false, fmt.Errorf("DeliveryUseCase - ResumeDelivery - GetDeliveryTask: %w", err)
+	}
+
+	if task.Status != entity.DeliveryStatusDroneLost || task.DroneID == nil || *task.DroneID != droneID {
+		return false, nil
+	}
+
+	// Claiming the delivery first keeps a replica that is failing it at the same time from winning too.
+	resumed, err := uc.deliveryRepo.TransitionDeliveryStatus(ctx, deliveryID, entity.DeliveryStatusDroneLost, entity.DeliveryStatusInProgress)
+	if err != nil {
+		return false, fmt.Errorf("DeliveryUseCase - ResumeDelivery - TransitionDeliveryStatus: %w", err)
+	}
+	if !resumed {
+		return false, nil
 	}
 
 	if uc.droneNotifier != nil {
-		message := map[string]any{
-			"type":      "command",
-			"timestamp": time.Now().Format(time.RFC3339),
-			"payload":   returnCommand,
+		if err := uc.droneNotifier.SendToDrone(ctx, droneID, deliveryTaskMessage(task)); err != nil {
+			if _, revertErr := uc.deliveryRepo.TransitionDeliveryStatus(ctx, deliveryID, entity.DeliveryStatusInProgress, entity.DeliveryStatusDroneLost); revertErr != nil {
+				uc.logger.Error("DeliveryUseCase - ResumeDelivery - TransitionDeliveryStatus", revertErr, map[string]any{
+					"droneID":    droneID,
+					"deliveryID": deliveryID,
+				})
+			}
+			return false, fmt.Errorf("DeliveryUseCase - ResumeDelivery - SendToDrone: %w", err)
 		}
-		if err := uc.droneNotifier.SendToDrone(ctx, droneID, message); err != nil {
+	}
+
+	uc.logger.Info("Delivery resumed", nil, map[string]any{
+		"droneID":    droneID,
+		"deliveryID": deliveryID,
+	})
+	uc.notifyDeliveryStatus(ctx, task, entity.DeliveryStatusInProgress, nil)
+
+	return true, nil
+}
+
+func (uc *DeliveryUseCase) HandleReturnTask(ctx context.Context, droneID string, deliveryID string, baseMarkerID int) error {
+	returnCommand := &droneproto.Command{
+		Command:      droneproto.CommandReturnToBase,
+		DeliveryID:   deliveryID,
+		BaseMarkerID: &baseMarkerID,
+	}
+
+	if uc.droneNotifier != nil {
+		if err := uc.droneNotifier.SendToDrone(ctx, droneID, returnCommand); err != nil {
 			uc.logger.Error("DeliveryUseCase - HandleReturnTask - SendToDrone", err, map[string]any{
 				"droneID":    droneID,
 				"deliveryID": deliveryID,
@@ -272,21 +389,13 @@ func (uc *DeliveryUseCase) HandleReturnTask(ctx context.Context, droneID string,
 }
 
 func (uc *DeliveryUseCase) SendReturnCommand(ctx context.Context, droneID string, baseMarkerID int) error {
-
-	returnCommand := map[string]any{
-		"type": "return_to_base",
-		"payload": map[string]any{
-			"base_marker_id": baseMarkerID,
-		},
+	returnCommand := &droneproto.Command{
+		Command:      droneproto.CommandReturnToBase,
+		BaseMarkerID: &baseMarkerID,
 	}
 
 	if uc.droneNotifier != nil {
-		message := map[string]any{
-			"type":      "command",
-			"timestamp": time.Now().Format(time.RFC3339),
-			"payload":   returnCommand,
-		}
-		if err := uc.droneNotifier.SendToDrone(ctx, droneID, message); err != nil {
+		if err := uc.droneNotifier.SendToDrone(ctx, droneID, returnCommand); err != nil {
 			uc.logger.Error("DeliveryUseCase - SendReturnCommand - SendToDrone", err, map[string]any{
 				"droneID": droneID,
 			})
@@ -322,20 +431,15 @@ func (uc *DeliveryUseCase) HandleDroneArrived(ctx context.Context, droneID strin
 	}
 
 	if response.Success {
-		command := map[string]any{
-			"command":          "drop_cargo",
-			"order_id":         orderID,
-			"cell_id":          response.CellID,
-			"internal_cell_id": response.InternalCellID,
+		command := &droneproto.Command{
+			Command:        droneproto.CommandDropCargo,
+			OrderID:        orderID,
+			CellID:         response.CellID,
+			InternalCellID: response.InternalCellID,
 		}
 
 		if uc.droneNotifier != nil {
-			message := map[string]any{
-				"type":      "command",
-				"timestamp": time.Now().Format(time.RFC3339),
-				"payload":   command,
-			}
-			if err := uc.droneNotifier.SendToDrone(ctx, droneID, message); err != nil {
+			if err := uc.droneNotifier.SendToDrone(ctx, droneID, command); err != nil {
 				uc.logger.Warn("DeliveryUseCase - HandleDroneArrived - SendToDrone", err, map[string]any{
 					"droneID": droneID,
 					"orderID": orderID,
@@ -399,6 +503,7 @@ func (uc *DeliveryUseCase) HandleCargoDropped(ctx context.Context, orderID strin
 			"message": "Failed to update delivery status",
 		}, fmt.Errorf("DeliveryUseCase - HandleCargoDropped - UpdateDeliveryStatus: %w", err)
 	}
+	uc.notifyDeliveryStatus(ctx, task, entity.DeliveryStatusCompleted, nil)
 
 	if task.DroneID != nil {
 		if err := uc.droneManager.ReleaseDrone(ctx, *task.DroneID); err != nil {
@@ -457,14 +562,12 @@ func (uc *DeliveryUseCase) CancelDelivery(ctx context.Context, deliveryID string
 	}
 
 	if uc.droneNotifier != nil && task.DroneID != nil {
-		message := map[string]any{
-			"type":      "command",
-			"timestamp": time.Now().Format(time.RFC3339),
-			"payload": map[string]any{
-				"command": "cancel_delivery",
-			},
+		command := &droneproto.Command{
+			Command:    droneproto.CommandCancelDelivery,
+			DeliveryID: task.DeliveryID,
+			OrderID:    task.OrderID,
 		}
-		if err := uc.droneNotifier.SendToDrone(ctx, *task.DroneID, message); err != nil {
+		if err := uc.droneNotifier.SendToDrone(ctx, *task.DroneID, command); err != nil {
 			uc.logger.Warn("DeliveryUseCase - CancelDelivery - SendToDrone", err, map[string]any{
 				"droneID": *task.DroneID,
 			})
@@ -480,6 +583,7 @@ func (uc *DeliveryUseCase) CancelDelivery(ctx context.Context, deliveryID string
 			"message": "Failed to cancel delivery",
 		}, fmt.Errorf("DeliveryUseCase - CancelDelivery - UpdateDeliveryStatus: %w", err)
 	}
+	uc.notifyDeliveryStatus(ctx, task, entity.DeliveryStatusCancelled, nil)
 
 	if task.DroneID != nil {
 		if err := uc.droneManager.ReleaseDrone(ctx, *task.DroneID); err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase/mocks"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/grpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,7 +18,7 @@ func TestDeliveryUseCase_StartDelivery_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
@@ -46,8 +47,6 @@ func TestDeliveryUseCase_StartDelivery_Success(t *testing.T) {
 		Width:  15.0,
 	}
 
-	_ = mockDroneManager.RegisterDrone(ctx, droneID)
-
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
@@ -98,7 +97,7 @@ func TestDeliveryUseCase_StartDelivery_SaveTaskError(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
@@ -141,7 +140,7 @@ func TestDeliveryUseCase_ExecuteDelivery_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
@@ -164,9 +163,9 @@ func TestDeliveryUseCase_ExecuteDelivery_Success(t *testing.T) {
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
-	mockNotifier.On("SendToDrone", ctx, droneID, mock.MatchedBy(func(msg map[string]any) bool {
-		return msg["type"] == "delivery_task" &&
-			msg["payload"] != nil
+	mockNotifier.On("SendToDrone", ctx, droneID, mock.MatchedBy(func(msg droneproto.Message) bool {
+		task, ok := msg.(*droneproto.DeliveryTask)
+		return ok && task.OrderID == "order-456" && task.Dimensions.Weight == 1.5
 	})).Return(nil)
 
 	err := uc.ExecuteDelivery(
@@ -192,7 +191,7 @@ func TestDeliveryUseCase_ExecuteDelivery_NotifierError(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
@@ -238,7 +237,7 @@ func TestDeliveryUseCase_HandleReturnTask_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
@@ -269,8 +268,10 @@ func TestDeliveryUseCase_HandleReturnTask_Success(t *testing.T) {
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
-	mockNotifier.On("SendToDrone", ctx, droneID, mock.MatchedBy(func(msg map[string]any) bool {
-		return msg["type"] == "command"
+	mockNotifier.On("SendToDrone", ctx, droneID, mock.MatchedBy(func(msg droneproto.Message) bool {
+		command, ok := msg.(*droneproto.Command)
+		return ok && command.Command == droneproto.CommandReturnToBase &&
+			command.BaseMarkerID != nil && *command.BaseMarkerID == baseMarkerID
 	})).Return(nil)
 
 	mockDroneRepo.On("GetDroneState", ctx, droneID).Return(state, nil)
@@ -289,7 +290,7 @@ func TestDeliveryUseCase_HandleDroneArrived_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
@@ -321,9 +322,10 @@ func TestDeliveryUseCase_HandleDroneArrived_Success(t *testing.T) {
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
 	mockGRPCClient.On("RequestCellOpen", ctx, orderID, parcelAutomatID).Return(response, nil)
-	mockNotifier.On("SendToDrone", ctx, droneID, mock.MatchedBy(func(msg map[string]any) bool {
-		payload, ok := msg["payload"].(map[string]any)
-		return ok && payload["command"] == "drop_cargo"
+	mockNotifier.On("SendToDrone", ctx, droneID, mock.MatchedBy(func(msg droneproto.Message) bool {
+		command, ok := msg.(*droneproto.Command)
+		return ok && command.Command == droneproto.CommandDropCargo &&
+			command.OrderID == orderID && command.CellID == "cell-123"
 	})).Return(nil)
 
 	result, err := uc.HandleDroneArrived(ctx, droneID, orderID, parcelAutomatID)
@@ -339,7 +341,7 @@ func TestDeliveryUseCase_HandleDroneArrived_GRPCError(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
@@ -375,7 +377,7 @@ func TestDeliveryUseCase_HandleCargoDropped_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
@@ -433,7 +435,7 @@ func TestDeliveryUseCase_CancelDelivery_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
@@ -485,11 +487,49 @@ func TestDeliveryUseCase_CancelDelivery_Success(t *testing.T) {
 	mockDroneRepo.AssertExpectations(t)
 }
 
+func TestDeliveryUseCase_CancelDelivery_NotifiesAdmins(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
+	mockAdmins := mocks.NewMockAdminNotifier(t)
+	mockLogger := mocks.NewMockLogger(t)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
+
+	uc := NewDeliveryUseCase(mockDroneRepo, mockDeliveryRepo, mockDroneManager, nil, nil, nil, mockLogger)
+	uc.SetAdminNotifier(mockAdmins)
+
+	ctx := context.Background()
+	deliveryID := "delivery-456"
+	droneID := "drone-123"
+
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+
+	mockDeliveryRepo.On("GetDeliveryTask", ctx, deliveryID).Return(&entity.DeliveryTask{
+		DeliveryID: deliveryID,
+		OrderID:    "order-456",
+		DroneID:    &droneID,
+		Status:     entity.DeliveryStatusInProgress,
+	}, nil)
+	mockDeliveryRepo.On("UpdateDeliveryStatus", ctx, deliveryID, entity.DeliveryStatusCancelled, (*string)(nil)).Return(nil)
+	mockDroneRepo.On("GetDroneState", ctx, droneID).Return(&entity.DroneState{DroneID: droneID}, nil)
+	mockDroneRepo.On("SaveDroneState", ctx, mock.Anything).Return(nil)
+	mockAdmins.On("NotifyAdmins", ctx, mock.MatchedBy(func(event *entity.AdminEvent) bool {
+		return event.Type == entity.AdminEventDeliveryStatus &&
+			event.DeliveryID == deliveryID &&
+			event.DroneID == droneID &&
+			event.Payload["status"] == entity.DeliveryStatusCancelled &&
+			event.Payload["order_id"] == "order-456"
+	})).Return().Once()
+
+	_, err := uc.CancelDelivery(ctx, deliveryID)
+
+	assert.NoError(t, err)
+}
+
 func TestDeliveryUseCase_GetDeliveryStatus_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockNotifier := new(mocks.MockDroneNotifier)
 	mockGRPCClient := new(mocks.MockOrchestratorGRPCClient)
 	mockRabbitMQClient := mocks.NewMockRabbitMQClient(t)
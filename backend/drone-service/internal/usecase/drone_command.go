@@ -2,40 +2,312 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
 )
 
+// droneCommandLease keeps a claimed command away from other replicas while one of them handles it.
+const droneCommandLease = 30 * time.Second
+
+var errNoDroneTransport = errors.New("drone transport not attached")
+
+// CommandPolicy controls how hard the service tries to get a command to a drone. A command that has
+// not been acked AckTimeout after it was written is written again, up to MaxAttempts times; after TTL
+// it is given up as timed out even if it never reached the drone.
+type CommandPolicy struct {
+	AckTimeout  time.Duration
+	MaxAttempts int
+	TTL         time.Duration
+	BatchSize   int
+}
+
+// DroneCommandUseCase tracks every command sent to a drone in drone_commands until the drone reports
+// it executed or failed, resending it until then.
 type DroneCommandUseCase struct {
-	notifier DroneNotifier
-	logger   logger.Interface
+	commandRepo repo.CommandRepo
+	transport   DroneNotifier
+	policy      CommandPolicy
+	logger      logger.Interface
+	now         func() time.Time
 }
 
 func NewDroneCommandUseCase(
-	notifier DroneNotifier,
+	commandRepo repo.CommandRepo,
+	policy CommandPolicy,
 	logger logger.Interface,
 ) *DroneCommandUseCase {
 	return &DroneCommandUseCase{
-		notifier: notifier,
-		logger:   logger,
+		commandRepo: commandRepo,
+		policy:      policy,
+		logger:      logger,
+		now:         time.Now,
 	}
 }
 
-func (uc *DroneCommandUseCase) SendCommand(ctx context.Context, droneID string, command map[string]any) error {
-	if uc.notifier == nil {
-		uc.logger.Warn("DroneCommandUseCase - SendCommand - drone notifier not available", nil, map[string]any{
+// SetTransport attaches the connection registry commands are written to. The WebSocket handler needs
+// this use case to process acks, so the two are wired after both exist.
+func (uc *DroneCommandUseCase) SetTransport(transport DroneNotifier) {
+	uc.transport = transport
+}
+
+// SendToDrone lets the use case stand in for the WebSocket handler as a DroneNotifier: commands are
+// tracked, anything else is passed straight through.
+func (uc *DroneCommandUseCase) SendToDrone(ctx context.Context, droneID string, message droneproto.Message) error {
+	if command, ok := message.(*droneproto.Command); ok {
+		_, err := uc.SendCommand(ctx, droneID, command)
+		return err
+	}
+
+	if uc.transport == nil {
+		return fmt.Errorf("DroneCommandUseCase - SendToDrone: %w", errNoDroneTransport)
+	}
+	return uc.transport.SendToDrone(ctx, droneID, message)
+}
+
+// SendCommand records the command and tries to write it right away. A drone that is not connected is
+// not an error: the command waits for the next retry or for the drone to reconnect.
+func (uc *DroneCommandUseCase) SendCommand(ctx context.Context, droneID string, command *droneproto.Command) (*entity.DroneCommand, error) {
+	if err := command.Validate(); err != nil {
+		return nil, fmt.Errorf("DroneCommandUseCase - SendCommand - Validate: %w: %w", entityError.ErrDroneCommandInvalid, err)
+	}
+
+	command.CommandID = uuid.NewString()
+	payload, err := json.Marshal(command)
+	if err != nil {
+		return nil, fmt.Errorf("DroneCommandUseCase - SendCommand - Marshal: %w", err)
+	}
+
+	created, err := uc.commandRepo.Create(ctx, &entity.DroneCommand{
+		ID:          command.CommandID,
+		DroneID:     droneID,
+		Command:     string(command.Command),
+		Payload:     payload,
+		MaxAttempts: uc.policy.MaxAttempts,
+		ExpiresAt:   uc.now().Add(uc.policy.TTL),
+	})
+	if err != nil {
+		uc.logger.Error("DroneCommandUseCase - SendCommand - Create", err, map[string]any{
 			"droneID": droneID,
+			"command": command.Command,
 		})
-		return fmt.Errorf("DroneCommandUseCase - SendCommand - drone notifier not available")
+		return nil, fmt.Errorf("DroneCommandUseCase - SendCommand - Create: %w", err)
 	}
 
-	if err := uc.notifier.SendToDrone(ctx, droneID, command); err != nil {
-		uc.logger.Error("DroneCommandUseCase - SendCommand - SendToDrone", err, map[string]any{
-			"droneID": droneID,
+	uc.deliver(ctx, created, command)
+	return created, nil
+}
+
+func (uc *DroneCommandUseCase) GetCommand(ctx context.Context, droneID, commandID string) (*entity.DroneCommand, error) {
+	command, err := uc.commandRepo.GetByID(ctx, commandID)
+	if err != nil {
+		return nil, fmt.Errorf("DroneCommandUseCase - GetCommand: %w", err)
+	}
+	if command.DroneID != droneID {
+		return nil, fmt.Errorf("DroneCommandUseCase - GetCommand: %w", entityError.ErrDroneCommandNotFound)
+	}
+	return command, nil
+}
+
+// HandleAck records a drone's confirmation. Acks for commands that already moved on, such as a
+// second ack for a command that was resent, are ignored.
+func (uc *DroneCommandUseCase) HandleAck(ctx context.Context, droneID string, ack *droneproto.CommandAck) error {
+	command, err := uc.GetCommand(ctx, droneID, ack.CommandID)
+	if err != nil {
+		return fmt.Errorf("DroneCommandUseCase - HandleAck: %w", err)
+	}
+
+	var updated bool
+	if ack.Stage == droneproto.AckExecuted {
+		updated, err = uc.commandRepo.Complete(ctx, command.ID, entity.DroneCommandStatusExecuted, nil)
+	} else {
+		updated, err = uc.commandRepo.Ack(ctx, command.ID)
+	}
+	if err != nil {
+		uc.logger.Error("DroneCommandUseCase - HandleAck", err, map[string]any{
+			"droneID":   droneID,
+			"commandID": command.ID,
 		})
-		return fmt.Errorf("DroneCommandUseCase - SendCommand - SendToDrone: %w", err)
+		return fmt.Errorf("DroneCommandUseCase - HandleAck: %w", err)
 	}
 
+	if updated {
+		uc.logger.Info("Drone command acknowledged", nil, map[string]any{
+			"droneID":   droneID,
+			"commandID": command.ID,
+			"command":   command.Command,
+			"stage":     ack.Stage,
+		})
+	}
 	return nil
 }
+
+func (uc *DroneCommandUseCase) HandleNack(ctx context.Context, droneID string, nack *droneproto.CommandNack) error {
+	command, err := uc.GetCommand(ctx, droneID, nack.CommandID)
+	if err != nil {
+		return fmt.Errorf("DroneCommandUseCase - HandleNack: %w", err)
+	}
+
+	reason := nack.Reason
+	if reason == "" {
+		reason = "rejected by drone"
+	}
+
+	updated, err := uc.commandRepo.Complete(ctx, command.ID, entity.DroneCommandStatusFailed, &reason)
+	if err != nil {
+		uc.logger.Error("DroneCommandUseCase - HandleNack", err, map[string]any{
+			"droneID":   droneID,
+			"commandID": command.ID,
+		})
+		return fmt.Errorf("DroneCommandUseCase - HandleNack: %w", err)
+	}
+
+	if updated {
+		uc.logger.Warn("Drone command failed", nil, map[string]any{
+			"droneID":   droneID,
+			"commandID": command.ID,
+			"command":   command.Command,
+			"reason":    reason,
+		})
+	}
+	return nil
+}
+
+// ResendPending writes every open command to a drone that has just registered, whatever its attempt
+// count: the previous attempts may have gone to a connection that was already dead. Acked commands are
+// written again too, since the agent may have restarted before executing them; one it already executed
+// is answered with another executed ack.
+func (uc *DroneCommandUseCase) ResendPending(ctx context.Context, droneID string) {
+	commands, err := uc.commandRepo.ListOpenByDrone(ctx, droneID, uc.now())
+	if err != nil {
+		uc.logger.Error("DroneCommandUseCase - ResendPending - ListOpenByDrone", err, map[string]any{
+			"droneID": droneID,
+		})
+		return
+	}
+
+	for _, command := range commands {
+		uc.resend(ctx, command)
+	}
+}
+
+func (uc *DroneCommandUseCase) StartRetryWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.RetryDue(ctx)
+		}
+	}
+}
+
+// RetryDue resends commands whose ack is overdue and times out those that expired or ran out of attempts.
+// Due commands are claimed with a lease, so with several replicas each one is sent and counted once.
+// Acked commands are only listed once they expire, so a drone that never reports one executed cannot
+// keep it open forever.
+func (uc *DroneCommandUseCase) RetryDue(ctx context.Context) {
+	now := uc.now()
+	commands, err := uc.commandRepo.ClaimDue(ctx, now, now.Add(droneCommandLease), uc.policy.BatchSize)
+	if err != nil {
+		uc.logger.Error("DroneCommandUseCase - RetryDue - ClaimDue", err, nil)
+		return
+	}
+
+	for _, command := range commands {
+		switch {
+		case command.Status == entity.DroneCommandStatusAcked:
+			uc.timeout(ctx, command, "command expired before the drone reported it executed")
+		case !now.Before(command.ExpiresAt):
+			uc.timeout(ctx, command, "command expired before the drone confirmed it")
+		case command.Attempts >= command.MaxAttempts:
+			uc.timeout(ctx, command, fmt.Sprintf("no ack after %d attempts", command.Attempts))
+		default:
+			uc.resend(ctx, command)
+		}
+	}
+}
+
+func (uc *DroneCommandUseCase) resend(ctx context.Context, command *entity.DroneCommand) {
+	var message droneproto.Command
+	if err := json.Unmarshal(command.Payload, &message); err != nil {
+		reason := "stored payload is not a command"
+		if _, err := uc.commandRepo.Complete(ctx, command.ID, entity.DroneCommandStatusFailed, &reason); err != nil {
+			uc.logger.Error("DroneCommandUseCase - resend - Complete", err, map[string]any{"commandID": command.ID})
+		}
+		return
+	}
+
+	uc.deliver(ctx, command, &message)
+}
+
+// deliver writes the command once and records the outcome. The next attempt is due AckTimeout from now
+// either way.
+func (uc *DroneCommandUseCase) deliver(ctx context.Context, command *entity.DroneCommand, message *droneproto.Command) {
+	nextAttemptAt := uc.now().Add(uc.policy.AckTimeout)
+
+	sendErr := errNoDroneTransport
+	if uc.transport != nil {
+		sendErr = uc.transport.SendToDrone(ctx, command.DroneID, message)
+	}
+
+	if sendErr != nil {
+		uc.logger.Warn("DroneCommandUseCase - deliver - SendToDrone", sendErr, map[string]any{
+			"droneID":   command.DroneID,
+			"commandID": command.ID,
+			"command":   command.Command,
+		})
+		if err := uc.commandRepo.MarkUndelivered(ctx, command.ID, sendErr.Error(), nextAttemptAt); err != nil {
+			uc.logger.Error("DroneCommandUseCase - deliver - MarkUndelivered", err, map[string]any{"commandID": command.ID})
+			return
+		}
+		reason := sendErr.Error()
+		command.Status = entity.DroneCommandStatusPending
+		command.LastError = &reason
+		command.NextAttemptAt = &nextAttemptAt
+		return
+	}
+
+	sent, err := uc.commandRepo.MarkSent(ctx, command.ID, nextAttemptAt)
+	if err != nil {
+		uc.logger.Error("DroneCommandUseCase - deliver - MarkSent", err, map[string]any{"commandID": command.ID})
+		return
+	}
+	if sent {
+		sentAt := uc.now()
+		command.Status = entity.DroneCommandStatusSent
+		command.Attempts++
+		command.SentAt = &sentAt
+		command.LastError = nil
+		command.NextAttemptAt = &nextAttemptAt
+	}
+}
+
+func (uc *DroneCommandUseCase) timeout(ctx context.Context, command *entity.DroneCommand, reason string) {
+	updated, err := uc.commandRepo.Complete(ctx, command.ID, entity.DroneCommandStatusTimedOut, &reason)
+	if err != nil {
+		uc.logger.Error("DroneCommandUseCase - timeout - Complete", err, map[string]any{"commandID": command.ID})
+		return
+	}
+
+	if updated {
+		uc.logger.Warn("Drone command timed out", nil, map[string]any{
+			"droneID":   command.DroneID,
+			"commandID": command.ID,
+			"command":   command.Command,
+			"attempts":  command.Attempts,
+			"reason":    reason,
+		})
+	}
+}
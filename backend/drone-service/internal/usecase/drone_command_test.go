@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase/mocks"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testCommandPolicy = CommandPolicy{
+	AckTimeout:  5 * time.Second,
+	MaxAttempts: 3,
+	TTL:         10 * time.Minute,
+	BatchSize:   100,
+}
+
+func newTestCommandUseCase(t *testing.T) (*DroneCommandUseCase, *mocks.MockCommandRepo, *mocks.MockDroneNotifier, *mocks.MockLogger) {
+	mockCommandRepo := mocks.NewMockCommandRepo(t)
+	mockTransport := mocks.NewMockDroneNotifier(t)
+	mockLogger := mocks.NewMockLogger(t)
+
+	uc := NewDroneCommandUseCase(mockCommandRepo, testCommandPolicy, mockLogger)
+	uc.SetTransport(mockTransport)
+	return uc, mockCommandRepo, mockTransport, mockLogger
+}
+
+func TestDroneCommandUseCase_SendCommand_Delivered(t *testing.T) {
+	uc, mockCommandRepo, mockTransport, _ := newTestCommandUseCase(t)
+
+	ctx := context.Background()
+	droneID := "drone-123"
+	command := &droneproto.Command{Command: droneproto.CommandDropCargo, OrderID: "order-456", CellID: "cell-1"}
+
+	mockCommandRepo.On("Create", ctx, mock.MatchedBy(func(c *entity.DroneCommand) bool {
+		return c.ID != "" && c.DroneID == droneID && c.Command == "drop_cargo" && c.MaxAttempts == 3
+	})).Return(func(_ context.Context, c *entity.DroneCommand) (*entity.DroneCommand, error) {
+		created := *c
+		created.Status = entity.DroneCommandStatusPending
+		return &created, nil
+	})
+	mockTransport.On("SendToDrone", ctx, droneID, mock.MatchedBy(func(msg droneproto.Message) bool {
+		sent, ok := msg.(*droneproto.Command)
+		return ok && sent.CommandID != "" && sent.Command == droneproto.CommandDropCargo
+	})).Return(nil)
+	mockCommandRepo.On("MarkSent", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(true, nil)
+
+	result, err := uc.SendCommand(ctx, droneID, command)
+
+	require.NoError(t, err)
+	assert.Equal(t, command.CommandID, result.ID)
+	assert.Equal(t, entity.DroneCommandStatusSent, result.Status)
+	assert.Equal(t, 1, result.Attempts)
+
+	var stored droneproto.Command
+	require.NoError(t, json.Unmarshal(result.Payload, &stored))
+	assert.Equal(t, result.ID, stored.CommandID)
+	assert.Equal(t, "order-456", stored.OrderID)
+}
+
+func TestDroneCommandUseCase_SendCommand_DroneOffline(t *testing.T) {
+	uc, mockCommandRepo, mockTransport, mockLogger := newTestCommandUseCase(t)
+
+	ctx := context.Background()
+	droneID := "drone-123"
+	offline := errors.New("drone drone-123 is not connected")
+
+	mockCommandRepo.On("Create", ctx, mock.Anything).Return(func(_ context.Context, c *entity.DroneCommand) (*entity.DroneCommand, error) {
+		return c, nil
+	})
+	mockTransport.On("SendToDrone", ctx, droneID, mock.Anything).Return(offline)
+	mockLogger.On("Warn", "DroneCommandUseCase - deliver - SendToDrone", offline, mock.Anything).Return()
+	mockCommandRepo.On("MarkUndelivered", ctx, mock.AnythingOfType("string"), offline.Error(), mock.AnythingOfType("time.Time")).Return(nil)
+
+	result, err := uc.SendCommand(ctx, droneID, &droneproto.Command{Command: droneproto.CommandCancelDelivery})
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.DroneCommandStatusPending, result.Status)
+	require.NotNil(t, result.LastError)
+	assert.Equal(t, offline.Error(), *result.LastError)
+}
+
+func TestDroneCommandUseCase_SendCommand_Invalid(t *testing.T) {
+	uc, _, _, _ := newTestCommandUseCase(t)
+
+	_, err := uc.SendCommand(context.Background(), "drone-123", &droneproto.Command{Command: droneproto.CommandDropCargo})
+
+	assert.ErrorIs(t, err, entityError.ErrDroneCommandInvalid)
+	assert.ErrorIs(t, err, droneproto.ErrInvalid)
+}
+
+func TestDroneCommandUseCase_SendToDrone_PassesThroughOtherMessages(t *testing.T) {
+	uc, _, mockTransport, _ := newTestCommandUseCase(t)
+
+	ctx := context.Background()
+	task := &droneproto.DeliveryTask{OrderID: "order-456", ParcelAutomatID: "automat-1"}
+	mockTransport.On("SendToDrone", ctx, "drone-123", task).Return(nil)
+
+	assert.NoError(t, uc.SendToDrone(ctx, "drone-123", task))
+}
+
+func TestDroneCommandUseCase_HandleAck(t *testing.T) {
+	uc, mockCommandRepo, _, mockLogger := newTestCommandUseCase(t)
+
+	ctx := context.Background()
+	command := &entity.DroneCommand{ID: "cmd-1", DroneID: "drone-123", Command: "drop_cargo", Status: entity.DroneCommandStatusSent}
+
+	mockCommandRepo.On("GetByID", ctx, "cmd-1").Return(command, nil).Twice()
+	mockCommandRepo.On("Ack", ctx, "cmd-1").Return(true, nil).Once()
+	mockCommandRepo.On("Complete", ctx, "cmd-1", entity.DroneCommandStatusExecuted, (*string)(nil)).Return(true, nil).Once()
+	mockLogger.On("Info", "Drone command acknowledged", nil, mock.Anything).Return().Twice()
+
+	assert.NoError(t, uc.HandleAck(ctx, "drone-123", &droneproto.CommandAck{CommandID: "cmd-1", Stage: droneproto.AckReceived}))
+	assert.NoError(t, uc.HandleAck(ctx, "drone-123", &droneproto.CommandAck{CommandID: "cmd-1", Stage: droneproto.AckExecuted}))
+}
+
+func TestDroneCommandUseCase_HandleAck_OtherDrone(t *testing.T) {
+	uc, mockCommandRepo, _, _ := newTestCommandUseCase(t)
+
+	ctx := context.Background()
+	mockCommandRepo.On("GetByID", ctx, "cmd-1").Return(&entity.DroneCommand{ID: "cmd-1", DroneID: "drone-999"}, nil)
+
+	err := uc.HandleAck(ctx, "drone-123", &droneproto.CommandAck{CommandID: "cmd-1"})
+
+	assert.ErrorIs(t, err, entityError.ErrDroneCommandNotFound)
+}
+
+func TestDroneCommandUseCase_HandleNack(t *testing.T) {
+	uc, mockCommandRepo, _, mockLogger := newTestCommandUseCase(t)
+
+	ctx := context.Background()
+	mockCommandRepo.On("GetByID", ctx, "cmd-1").Return(&entity.DroneCommand{ID: "cmd-1", DroneID: "drone-123"}, nil)
+	mockCommandRepo.On("Complete", ctx, "cmd-1", entity.DroneCommandStatusFailed, mock.MatchedBy(func(reason *string) bool {
+		return reason != nil && *reason == "servo jammed"
+	})).Return(true, nil)
+	mockLogger.On("Warn", "Drone command failed", nil, mock.Anything).Return()
+
+	assert.NoError(t, uc.HandleNack(ctx, "drone-123", &droneproto.CommandNack{CommandID: "cmd-1", Reason: "servo jammed"}))
+}
+
+func TestDroneCommandUseCase_RetryDue(t *testing.T) {
+	uc, mockCommandRepo, mockTransport, mockLogger := newTestCommandUseCase(t)
+
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	payload, _ := json.Marshal(&droneproto.Command{CommandID: "cmd-due", Command: droneproto.CommandCancelDelivery})
+	commands := []*entity.DroneCommand{
+		{ID: "cmd-expired", DroneID: "drone-1", Attempts: 1, MaxAttempts: 3, ExpiresAt: now.Add(-time.Second)},
+		{ID: "cmd-exhausted", DroneID: "drone-1", Attempts: 3, MaxAttempts: 3, ExpiresAt: now.Add(time.Minute)},
+		{ID: "cmd-acked", DroneID: "drone-1", Status: entity.DroneCommandStatusAcked, Attempts: 1, MaxAttempts: 3, ExpiresAt: now.Add(-time.Second)},
+		{ID: "cmd-due", DroneID: "drone-2", Payload: payload, Attempts: 1, MaxAttempts: 3, ExpiresAt: now.Add(time.Minute)},
+	}
+
+	mockCommandRepo.On("ClaimDue", ctx, now, now.Add(droneCommandLease), 100).Return(commands, nil)
+	mockCommandRepo.On("Complete", ctx, "cmd-expired", entity.DroneCommandStatusTimedOut, mock.Anything).Return(true, nil)
+	mockCommandRepo.On("Complete", ctx, "cmd-exhausted", entity.DroneCommandStatusTimedOut, mock.Anything).Return(true, nil)
+	mockCommandRepo.On("Complete", ctx, "cmd-acked", entity.DroneCommandStatusTimedOut, mock.MatchedBy(func(reason *string) bool {
+		return reason != nil && *reason == "command expired before the drone reported it executed"
+	})).Return(true, nil)
+	mockLogger.On("Warn", "Drone command timed out", nil, mock.Anything).Return().Times(3)
+	mockTransport.On("SendToDrone", ctx, "drone-2", mock.MatchedBy(func(msg droneproto.Message) bool {
+		sent, ok := msg.(*droneproto.Command)
+		return ok && sent.CommandID == "cmd-due"
+	})).Return(nil)
+	mockCommandRepo.On("MarkSent", ctx, "cmd-due", now.Add(testCommandPolicy.AckTimeout)).Return(true, nil)
+
+	uc.RetryDue(ctx)
+}
+
+func TestDroneCommandUseCase_ResendPending(t *testing.T) {
+	uc, mockCommandRepo, mockTransport, _ := newTestCommandUseCase(t)
+
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	payload, _ := json.Marshal(&droneproto.Command{CommandID: "cmd-open", Command: droneproto.CommandCancelDelivery})
+	commands := []*entity.DroneCommand{
+		{ID: "cmd-open", DroneID: "drone-1", Status: entity.DroneCommandStatusAcked, Payload: payload, Attempts: 3, MaxAttempts: 3, ExpiresAt: now.Add(time.Minute)},
+	}
+
+	mockCommandRepo.On("ListOpenByDrone", ctx, "drone-1", now).Return(commands, nil)
+	mockTransport.On("SendToDrone", ctx, "drone-1", mock.MatchedBy(func(msg droneproto.Message) bool {
+		sent, ok := msg.(*droneproto.Command)
+		return ok && sent.CommandID == "cmd-open"
+	})).Return(nil)
+	mockCommandRepo.On("MarkSent", ctx, "cmd-open", now.Add(testCommandPolicy.AckTimeout)).Return(true, nil)
+
+	uc.ResendPending(ctx, "drone-1")
+}
@@ -3,15 +3,22 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneauth"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
 )
 
+// ChallengeTTL bounds how long a drone may take to answer its registration challenge.
+const ChallengeTTL = 30 * time.Second
+
 type DroneConnectionUseCase struct {
 	droneRepo repo.DroneRepo
 	manager   *DroneManagerUseCase
+	admins    AdminNotifier
 	logger    logger.Interface
 }
 
@@ -27,35 +34,84 @@ func NewDroneConnectionUseCase(
 	}
 }
 
-func (uc *DroneConnectionUseCase) RegisterDrone(ctx context.Context, ipAddress string) (string, error) {
-	droneID, err := uc.droneRepo.GetDroneIDByIP(ctx, ipAddress)
+// SetAdminNotifier wires the admin feed, which is created after this use case.
+func (uc *DroneConnectionUseCase) SetAdminNotifier(admins AdminNotifier) {
+	uc.admins = admins
+}
+
+// IssueChallenge starts the handshake for a drone that claims droneID. Drones without a credential are
+// refused here, before any nonce is handed out.
+func (uc *DroneConnectionUseCase) IssueChallenge(ctx context.Context, droneID, remoteAddr string) (*entity.DroneChallenge, error) {
+	credential, err := uc.droneRepo.GetCredential(ctx, droneID)
 	if err != nil {
-		uc.logger.Error("DroneConnectionUseCase - RegisterDrone - GetDroneIDByIP", err, map[string]any{
-			"ipAddress": ipAddress,
+		uc.logger.Warn("Drone connection rejected", err, map[string]any{
+			"droneID":    droneID,
+			"remoteAddr": remoteAddr,
 		})
-		return "", fmt.Errorf("DroneConnectionUseCase - RegisterDrone - GetDroneIDByIP: %w", err)
+		return nil, fmt.Errorf("DroneConnectionUseCase - IssueChallenge - GetCredential: %w", err)
 	}
 
-	if droneID == "" {
-		uc.logger.Warn("DroneConnectionUseCase - RegisterDrone - drone not found", entityError.ErrDroneNotFound, map[string]any{
-			"ipAddress": ipAddress,
-		})
-		return "", entityError.ErrDroneNotFound
+	nonce, err := droneauth.NewNonce()
+	if err != nil {
+		return nil, fmt.Errorf("DroneConnectionUseCase - IssueChallenge - NewNonce: %w", err)
 	}
 
-	if err := uc.manager.RegisterDrone(ctx, droneID); err != nil {
-		uc.logger.Error("DroneConnectionUseCase - RegisterDrone - RegisterDrone", err, map[string]any{
-			"droneID": droneID,
-		})
-		return "", fmt.Errorf("DroneConnectionUseCase - RegisterDrone - RegisterDrone: %w", err)
+	return &entity.DroneChallenge{
+		DroneID:    droneID,
+		Nonce:      nonce,
+		KeyVersion: credential.KeyVersion,
+		IssuedAt:   time.Now(),
+	}, nil
+}
+
+// RegisterDrone registers conn once signature answers the challenge. The credential is read again so a
+// key rotated during the handshake is not accepted.
+func (uc *DroneConnectionUseCase) RegisterDrone(ctx context.Context, challenge *entity.DroneChallenge, signature string, conn *entity.DroneConnection) error {
+	fields := map[string]any{
+		"droneID":      challenge.DroneID,
+		"keyVersion":   challenge.KeyVersion,
+		"remoteAddr":   conn.RemoteAddr,
+		"sessionID":    conn.SessionID,
+		"agentVersion": conn.AgentVersion,
+	}
+
+	if time.Since(challenge.IssuedAt) > ChallengeTTL {
+		uc.logger.Warn("Drone authentication failed", entityError.ErrDroneChallengeExpired, fields)
+		return entityError.ErrDroneChallengeExpired
 	}
 
-	uc.logger.Info("Drone registered", nil, map[string]any{
-		"droneID":   droneID,
-		"ipAddress": ipAddress,
+	credential, err := uc.droneRepo.GetCredential(ctx, challenge.DroneID)
+	if err != nil {
+		uc.logger.Warn("Drone authentication failed", err, fields)
+		return fmt.Errorf("DroneConnectionUseCase - RegisterDrone - GetCredential: %w", err)
+	}
+
+	if !droneauth.Verify(credential.Secret, challenge.DroneID, challenge.Nonce, signature) {
+		uc.logger.Warn("Drone authentication failed", entityError.ErrDroneAuthFailed, fields)
+		return entityError.ErrDroneAuthFailed
+	}
+
+	conn.DroneID = challenge.DroneID
+	if err := uc.manager.RegisterDrone(ctx, conn); err != nil {
+		uc.logger.Error("DroneConnectionUseCase - RegisterDrone - RegisterDrone", err, fields)
+		return fmt.Errorf("DroneConnectionUseCase - RegisterDrone - RegisterDrone: %w", err)
+	}
+
+	if err := uc.droneRepo.TouchCredential(ctx, challenge.DroneID); err != nil {
+		uc.logger.Warn("DroneConnectionUseCase - RegisterDrone - TouchCredential", err, fields)
+	}
+
+	uc.logger.Info("Drone registered", nil, fields)
+	uc.notifyAdmins(ctx, conn.DroneID, map[string]any{
+		"connected":        true,
+		"session_id":       conn.SessionID,
+		"agent_version":    conn.AgentVersion,
+		"protocol_version": conn.ProtocolVersion,
+		"remote_addr":      conn.RemoteAddr,
+		"connected_at":     conn.ConnectedAt.Format(time.RFC3339),
 	})
 
-	return droneID, nil
+	return nil
 }
 
 func (uc *DroneConnectionUseCase) UnregisterDrone(ctx context.Context, droneID string) error {
@@ -69,17 +125,21 @@ func (uc *DroneConnectionUseCase) UnregisterDrone(ctx context.Context, droneID s
 	uc.logger.Info("Drone unregistered", nil, map[string]any{
 		"droneID": droneID,
 	})
+	uc.notifyAdmins(ctx, droneID, map[string]any{
+		"connected": false,
+	})
 
 	return nil
 }
 
-func (uc *DroneConnectionUseCase) GetDroneIDByIP(ctx context.Context, ipAddress string) (string, error) {
-	droneID, err := uc.droneRepo.GetDroneIDByIP(ctx, ipAddress)
-	if err != nil {
-		uc.logger.Error("DroneConnectionUseCase - GetDroneIDByIP - GetDroneIDByIP", err, map[string]any{
-			"ipAddress": ipAddress,
-		})
-		return "", fmt.Errorf("DroneConnectionUseCase - GetDroneIDByIP: %w", err)
+func (uc *DroneConnectionUseCase) notifyAdmins(ctx context.Context, droneID string, payload map[string]any) {
+	if uc.admins == nil {
+		return
 	}
-	return droneID, nil
+	uc.admins.NotifyAdmins(ctx, &entity.AdminEvent{
+		Type:      entity.AdminEventDroneConnection,
+		DroneID:   droneID,
+		Payload:   payload,
+		Timestamp: time.Now(),
+	})
 }
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase/mocks"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneauth"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -14,56 +17,132 @@ import (
 func TestDroneConnectionUseCase_RegisterDrone_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	uc := NewDroneConnectionUseCase(mockDroneRepo, mockDroneManager, mockLogger)
 
 	ctx := context.Background()
-	ipAddress := "192.168.1.100"
 	droneID := "drone-123"
+	credential := &entity.DroneCredential{DroneID: droneID, Secret: "dsk_secret", KeyVersion: 2}
 
-	mockDroneRepo.On("GetDroneIDByIP", ctx, ipAddress).Return(droneID, nil)
-	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockDroneRepo.On("GetCredential", ctx, droneID).Return(credential, nil)
+	mockDroneRepo.On("TouchCredential", ctx, droneID).Return(nil)
+	mockLogger.On("Info", "Drone registered", nil, mock.MatchedBy(func(fields []map[string]any) bool {
+		return len(fields) == 1 && fields[0]["droneID"] == droneID && fields[0]["keyVersion"] == 2 && fields[0]["remoteAddr"] == "10.0.0.5"
+	})).Return()
+
+	challenge, err := uc.IssueChallenge(ctx, droneID, "10.0.0.5")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, challenge.KeyVersion)
+	assert.NotEmpty(t, challenge.Nonce)
+
+	conn := &entity.DroneConnection{SessionID: "session-1", AgentVersion: "1.0.0", RemoteAddr: "10.0.0.5"}
+	err = uc.RegisterDrone(ctx, challenge, droneauth.Sign("dsk_secret", droneID, challenge.Nonce), conn)
+
+	assert.NoError(t, err)
+	assert.Equal(t, droneID, conn.DroneID)
+	assert.Contains(t, mockDroneManager.GetRegisteredDrones(), droneID)
+}
+
+func TestDroneConnectionUseCase_RegisterDrone_WrongSignature(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockLogger := mocks.NewMockLogger(t)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
+
+	uc := NewDroneConnectionUseCase(mockDroneRepo, mockDroneManager, mockLogger)
 
-	result, err := uc.RegisterDrone(ctx, ipAddress)
+	ctx := context.Background()
+	droneID := "drone-123"
+	credential := &entity.DroneCredential{DroneID: droneID, Secret: "dsk_secret", KeyVersion: 1}
+
+	mockDroneRepo.On("GetCredential", ctx, droneID).Return(credential, nil)
+	mockLogger.On("Warn", "Drone authentication failed", entityError.ErrDroneAuthFailed, mock.Anything).Return()
 
+	challenge, err := uc.IssueChallenge(ctx, droneID, "10.0.0.5")
 	assert.NoError(t, err)
-	assert.Equal(t, droneID, result)
-	mockDroneRepo.AssertExpectations(t)
+
+	err = uc.RegisterDrone(ctx, challenge, droneauth.Sign("dsk_stolen", droneID, challenge.Nonce), &entity.DroneConnection{RemoteAddr: "10.0.0.5"})
+
+	assert.True(t, errors.Is(err, entityError.ErrDroneAuthFailed))
+	assert.NotContains(t, mockDroneManager.GetRegisteredDrones(), droneID)
+	mockDroneRepo.AssertNotCalled(t, "TouchCredential", mock.Anything, mock.Anything)
 }
 
-func TestDroneConnectionUseCase_RegisterDrone_NotFound(t *testing.T) {
+func TestDroneConnectionUseCase_RegisterDrone_RotatedDuringHandshake(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	uc := NewDroneConnectionUseCase(mockDroneRepo, mockDroneManager, mockLogger)
 
 	ctx := context.Background()
-	ipAddress := "192.168.1.100"
+	droneID := "drone-123"
+
+	mockDroneRepo.On("GetCredential", ctx, droneID).
+		Return(&entity.DroneCredential{DroneID: droneID, Secret: "dsk_old", KeyVersion: 1}, nil).Once()
+	mockDroneRepo.On("GetCredential", ctx, droneID).
+		Return(&entity.DroneCredential{DroneID: droneID, Secret: "dsk_new", KeyVersion: 2}, nil).Once()
+	mockLogger.On("Warn", "Drone authentication failed", entityError.ErrDroneAuthFailed, mock.Anything).Return()
 
-	mockDroneRepo.On("GetDroneIDByIP", ctx, ipAddress).Return("", nil)
-	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+	challenge, err := uc.IssueChallenge(ctx, droneID, "10.0.0.5")
+	assert.NoError(t, err)
 
-	result, err := uc.RegisterDrone(ctx, ipAddress)
+	err = uc.RegisterDrone(ctx, challenge, droneauth.Sign("dsk_old", droneID, challenge.Nonce), &entity.DroneConnection{RemoteAddr: "10.0.0.5"})
 
-	assert.Error(t, err)
-	assert.True(t, errors.Is(err, entityError.ErrDroneNotFound))
-	assert.Empty(t, result)
-	mockDroneRepo.AssertExpectations(t)
+	assert.True(t, errors.Is(err, entityError.ErrDroneAuthFailed))
+}
+
+func TestDroneConnectionUseCase_RegisterDrone_ChallengeExpired(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockLogger := mocks.NewMockLogger(t)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
+
+	uc := NewDroneConnectionUseCase(mockDroneRepo, mockDroneManager, mockLogger)
+
+	ctx := context.Background()
+	challenge := &entity.DroneChallenge{
+		DroneID:  "drone-123",
+		Nonce:    "abcd",
+		IssuedAt: time.Now().Add(-ChallengeTTL - time.Second),
+	}
+
+	mockLogger.On("Warn", "Drone authentication failed", entityError.ErrDroneChallengeExpired, mock.Anything).Return()
+
+	err := uc.RegisterDrone(ctx, challenge, droneauth.Sign("dsk_secret", "drone-123", "abcd"), &entity.DroneConnection{RemoteAddr: "10.0.0.5"})
+
+	assert.True(t, errors.Is(err, entityError.ErrDroneChallengeExpired))
+	assert.Empty(t, mockDroneManager.GetRegisteredDrones())
+}
+
+func TestDroneConnectionUseCase_IssueChallenge_NoCredential(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockLogger := mocks.NewMockLogger(t)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
+
+	uc := NewDroneConnectionUseCase(mockDroneRepo, mockDroneManager, mockLogger)
+
+	ctx := context.Background()
+
+	mockDroneRepo.On("GetCredential", ctx, "drone-123").Return(nil, entityError.ErrDroneNoCredential)
+	mockLogger.On("Warn", "Drone connection rejected", entityError.ErrDroneNoCredential, mock.Anything).Return()
+
+	challenge, err := uc.IssueChallenge(ctx, "drone-123", "10.0.0.5")
+
+	assert.True(t, errors.Is(err, entityError.ErrDroneNoCredential))
+	assert.Nil(t, challenge)
 }
 
 func TestDroneConnectionUseCase_UnregisterDrone_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	uc := NewDroneConnectionUseCase(mockDroneRepo, mockDroneManager, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
 
-	_ = mockDroneManager.RegisterDrone(ctx, droneID)
+	_ = mockDroneManager.RegisterDrone(ctx, &entity.DroneConnection{DroneID: droneID, SessionID: "session-1"})
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
 
 	err := uc.UnregisterDrone(ctx, droneID)
@@ -72,22 +151,34 @@ func TestDroneConnectionUseCase_UnregisterDrone_Success(t *testing.T) {
 	assert.NotContains(t, mockDroneManager.GetRegisteredDrones(), droneID)
 }
 
-func TestDroneConnectionUseCase_GetDroneIDByIP_Success(t *testing.T) {
+func TestDroneConnectionUseCase_NotifiesAdminsOfConnection(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockAdmins := mocks.NewMockAdminNotifier(t)
 	mockLogger := mocks.NewMockLogger(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	uc := NewDroneConnectionUseCase(mockDroneRepo, mockDroneManager, mockLogger)
+	uc.SetAdminNotifier(mockAdmins)
 
 	ctx := context.Background()
-	ipAddress := "192.168.1.100"
 	droneID := "drone-123"
 
-	mockDroneRepo.On("GetDroneIDByIP", ctx, ipAddress).Return(droneID, nil)
-
-	result, err := uc.GetDroneIDByIP(ctx, ipAddress)
-
+	mockDroneRepo.On("GetCredential", ctx, droneID).Return(&entity.DroneCredential{DroneID: droneID, Secret: "dsk_secret", KeyVersion: 1}, nil)
+	mockDroneRepo.On("TouchCredential", ctx, droneID).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockAdmins.On("NotifyAdmins", ctx, mock.MatchedBy(func(event *entity.AdminEvent) bool {
+		return event.Type == entity.AdminEventDroneConnection && event.DroneID == droneID &&
+			event.Payload["connected"] == true && event.Payload["session_id"] == "session-1"
+	})).Return().Once()
+	mockAdmins.On("NotifyAdmins", ctx, mock.MatchedBy(func(event *entity.AdminEvent) bool {
+		return event.Type == entity.AdminEventDroneConnection && event.DroneID == droneID &&
+			event.Payload["connected"] == false
+	})).Return().Once()
+
+	challenge, err := uc.IssueChallenge(ctx, droneID, "10.0.0.5")
 	assert.NoError(t, err)
-	assert.Equal(t, droneID, result)
-	mockDroneRepo.AssertExpectations(t)
+
+	conn := &entity.DroneConnection{SessionID: "session-1", RemoteAddr: "10.0.0.5"}
+	assert.NoError(t, uc.RegisterDrone(ctx, challenge, droneauth.Sign("dsk_secret", droneID, challenge.Nonce), conn))
+	assert.NoError(t, uc.UnregisterDrone(ctx, droneID))
 }
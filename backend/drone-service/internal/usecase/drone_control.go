@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
+)
+
+// DroneControlUseCase runs the commands operators send from the admin panel. A command has to pass the
+// drone protocol's validation and then the safety interlocks, which check it against the drone's last
+// reported state. Every attempt on a known drone is written to the command audit log, whatever its outcome.
+type DroneControlUseCase struct {
+	droneManager *DroneManagerUseCase
+	commandUC    *DroneCommandUseCase
+	deliveryUC   *DeliveryUseCase
+	auditRepo    repo.CommandAuditRepo
+	noFlyZones   []entity.NoFlyZone
+	logger       logger.Interface
+}
+
+func NewDroneControlUseCase(
+	droneManager *DroneManagerUseCase,
+	commandUC *DroneCommandUseCase,
+	deliveryUC *DeliveryUseCase,
+	auditRepo repo.CommandAuditRepo,
+	noFlyZones []entity.NoFlyZone,
+	logger logger.Interface,
+) *DroneControlUseCase {
+	return &DroneControlUseCase{
+		droneManager: droneManager,
+		commandUC:    commandUC,
+		deliveryUC:   deliveryUC,
+		auditRepo:    auditRepo,
+		noFlyZones:   noFlyZones,
+		logger:       logger,
+	}
+}
+
+// Execute checks an operator's command and queues it for the drone. An aborted delivery is failed as
+// soon as the command is queued; the drone flies back to base on its own.
+func (uc *DroneControlUseCase) Execute(ctx context.Context, droneID string, operator entity.Operator, command *droneproto.Command) (*entity.DroneCommand, error) {
+	drone, err := uc.droneManager.GetFleetDrone(ctx, droneID)
+	if err != nil {
+		return nil, fmt.Errorf("DroneControlUseCase - Execute - GetFleetDrone: %w", err)
+	}
+
+	if err := uc.check(drone, command); err != nil {
+		uc.audit(ctx, droneID, operator, command, nil, entity.DroneCommandAuditRejected, err)
+		uc.logger.Warn("Drone command rejected", err, map[string]any{
+			"droneID":    droneID,
+			"command":    command.Command,
+			"operatorID": operator.ID,
+		})
+		return nil, fmt.Errorf("DroneControlUseCase - Execute: %w", err)
+	}
+
+	created, err := uc.commandUC.SendCommand(ctx, droneID, command)
+	if err != nil {
+		uc.audit(ctx, droneID, operator, command, nil, entity.DroneCommandAuditFailed, err)
+		return nil, fmt.Errorf("DroneControlUseCase - Execute - SendCommand: %w", err)
+	}
+	uc.audit(ctx, droneID, operator, command, &created.ID, entity.DroneCommandAuditAccepted, nil)
+
+	uc.logger.Info("Drone command accepted", nil, map[string]any{
+		"droneID":    droneID,
+		"commandID":  created.ID,
+		"command":    command.Command,
+		"operatorID": operator.ID,
+	})
+
+	if command.Command == droneproto.CommandAbortDelivery {
+		uc.abortDelivery(ctx, droneID, command.DeliveryID, operator)
+	}
+
+	return created, nil
+}
+
+func (uc *DroneControlUseCase) ListAudit(ctx context.Context, droneID string, limit int) ([]*entity.DroneCommandAudit, error) {
+	if _, err := uc.droneManager.GetFleetDrone(ctx, droneID); err != nil {
+		return nil, fmt.Errorf("DroneControlUseCase - ListAudit - GetFleetDrone: %w", err)
+	}
+
+	audits, err := uc.auditRepo.ListByDrone(ctx, droneID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("DroneControlUseCase - ListAudit - ListByDrone: %w", err)
+	}
+	return audits, nil
+}
+
+// check applies the safety interlocks. Cancelling a delivery is left to the orchestrator: operators
+// abort it instead, which also fails the delivery.
+func (uc *DroneControlUseCase) check(drone *entity.FleetDrone, command *droneproto.Command) error {
+	if err := command.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", entityError.ErrDroneCommandInvalid, err)
+	}
+	if command.Command == droneproto.CommandCancelDelivery {
+		return fmt.Errorf("%w: cancel_delivery is not an operator command, use abort_delivery", entityError.ErrDroneCommandInvalid)
+	}
+
+	if drone.Connection == nil {
+		return fmt.Errorf("%w: drone is not connected", entityError.ErrDroneCommandRejected)
+	}
+
+	status := drone.Status
+	switch command.Command {
+	case droneproto.CommandHold, droneproto.CommandLand:
+		if !status.IsAirborne() && status != entity.DroneStatusError {
+			return rejected(command, "drone is not flying (status %s)", status)
+		}
+	case droneproto.CommandReturnToBase, droneproto.CommandSetAltitude:
+		if !status.IsAirborne() {
+			return rejected(command, "drone is not flying (status %s)", status)
+		}
+	case droneproto.CommandGoto:
+		if !status.IsAirborne() {
+			return rejected(command, "drone is not flying (status %s)", status)
+		}
+		waypoint := entity.Position{Latitude: command.Waypoint.Latitude, Longitude: command.Waypoint.Longitude}
+		for _, zone := range uc.noFlyZones {
+			if zone.Contains(waypoint) {
+				return rejected(command, "waypoint is inside no-fly zone %q", zone.Name)
+			}
+		}
+	case droneproto.CommandDropCargo:
+		if status != entity.DroneStatusDelivering || drone.CurrentDeliveryID == nil {
+			return rejected(command, "drone is not at its destination (status %s)", status)
+		}
+	case droneproto.CommandAbortDelivery:
+		if drone.CurrentDeliveryID == nil || *drone.CurrentDeliveryID != command.DeliveryID {
+			return rejected(command, "drone is not flying delivery %s", command.DeliveryID)
+		}
+	case droneproto.CommandOpenCargoHook:
+		if status.IsAirborne() && status != entity.DroneStatusDelivering {
+			return rejected(command, "cargo hook cannot be opened in flight (status %s)", status)
+		}
+	case droneproto.CommandRebootAgent:
+		if status.IsAirborne() || drone.CurrentDeliveryID != nil {
+			return rejected(command, "drone is on a mission (status %s)", status)
+		}
+	}
+	return nil
+}
+
+func rejected(command *droneproto.Command, format string, args ...any) error {
+	return fmt.Errorf("%w: %s: %s", entityError.ErrDroneCommandRejected, command.Command, fmt.Sprintf(format, args...))
+}
+
+func (uc *DroneControlUseCase) abortDelivery(ctx context.Context, droneID, deliveryID string, operator entity.Operator) {
+	reason := "aborted by operator " + operator.Email
+	if err := uc.deliveryUC.FailDelivery(ctx, droneID, deliveryID, reason); err != nil {
+		uc.logger.Error("DroneControlUseCase - abortDelivery - FailDelivery", err, map[string]any{
+			"droneID":    droneID,
+			"deliveryID": deliveryID,
+		})
+		return
+	}
+
+	// Releasing the drone keeps the delivery from being resumed if the drone drops offline on its way back.
+	if err := uc.droneManager.ReleaseDrone(ctx, droneID); err != nil {
+		uc.logger.Warn("DroneControlUseCase - abortDelivery - ReleaseDrone", err, map[string]any{
+			"droneID": droneID,
+		})
+	}
+}
+
+// audit records the attempt. A failed write is logged rather than returned: by then the command has
+// either been refused or already queued for the drone.
+func (uc *DroneControlUseCase) audit(
+	ctx context.Context,
+	droneID string,
+	operator entity.Operator,
+	command *droneproto.Command,
+	commandID *string,
+	result entity.DroneCommandAuditResult,
+	cause error,
+) {
+	payload, err := json.Marshal(command)
+	if err != nil {
+		payload = []byte("{}")
+	}
+
+	record := &entity.DroneCommandAudit{
+		DroneID:       droneID,
+		CommandID:     commandID,
+		Command:       string(command.Command),
+		Payload:       payload,
+		OperatorID:    operator.ID,
+		OperatorEmail: operator.Email,
+		OperatorRole:  operator.Role,
+		Result:        result,
+	}
+	if cause != nil {
+		reason := cause.Error()
+		record.Reason = &reason
+	}
+
+	if err := uc.auditRepo.Create(ctx, record); err != nil {
+		uc.logger.Error("DroneControlUseCase - audit - Create", err, map[string]any{
+			"droneID":    droneID,
+			"command":    command.Command,
+			"operatorID": operator.ID,
+			"result":     result,
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase/mocks"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type controlMocks struct {
+	droneRepo    *mocks.MockDroneRepo
+	deliveryRepo *mocks.MockDeliveryRepo
+	commandRepo  *mocks.MockCommandRepo
+	auditRepo    *mocks.MockCommandAuditRepo
+	transport    *mocks.MockDroneNotifier
+	logger       *mocks.MockLogger
+}
+
+var testOperator = entity.Operator{
+	ID:    "3f8a2c1e-5b7d-4e9f-8a6c-2d4b6e8f0a1c",
+	Email: "operator@example.com",
+	Role:  "operator",
+}
+
+var testNoFlyZone = entity.NoFlyZone{
+	Name:         "airport",
+	Latitude:     55.9736,
+	Longitude:    37.4125,
+	RadiusMeters: 3000,
+}
+
+func newTestControlUseCase(t *testing.T) (*DroneControlUseCase, *controlMocks) {
+	m := &controlMocks{
+		droneRepo:    mocks.NewMockDroneRepo(t),
+		deliveryRepo: mocks.NewMockDeliveryRepo(t),
+		commandRepo:  mocks.NewMockCommandRepo(t),
+		auditRepo:    mocks.NewMockCommandAuditRepo(t),
+		transport:    mocks.NewMockDroneNotifier(t),
+		logger:       mocks.NewMockLogger(t),
+	}
+
+	manager := NewDroneManagerUseCase(m.droneRepo, nil, m.logger)
+	commandUC := NewDroneCommandUseCase(m.commandRepo, testCommandPolicy, m.logger)
+	commandUC.SetTransport(m.transport)
+	delivery := NewDeliveryUseCase(m.droneRepo, m.deliveryRepo, manager, m.transport, nil, nil, m.logger)
+
+	uc := NewDroneControlUseCase(manager, commandUC, delivery, m.auditRepo, []entity.NoFlyZone{testNoFlyZone}, m.logger)
+	return uc, m
+}
+
+// withDrone makes droneID known with the given state and, when connected, registers its connection.
+func (m *controlMocks) withDrone(t *testing.T, uc *DroneControlUseCase, state entity.DroneState, connected bool) {
+	t.Helper()
+	m.droneRepo.On("GetDrone", mock.Anything, state.DroneID).Return(func(context.Context, string) (*entity.FleetDrone, error) {
+		return &entity.FleetDrone{DroneState: state, Model: "DJI"}, nil
+	})
+	if connected {
+		require.NoError(t, uc.droneManager.RegisterDrone(context.Background(), testConnection(state.DroneID)))
+	}
+}
+
+func (m *controlMocks) expectQueued(ctx context.Context, droneID string) {
+	m.commandRepo.On("Create", ctx, mock.Anything).Return(func(_ context.Context, c *entity.DroneCommand) (*entity.DroneCommand, error) {
+		created := *c
+		created.Status = entity.DroneCommandStatusPending
+		return &created, nil
+	}).Once()
+	m.transport.On("SendToDrone", ctx, droneID, mock.AnythingOfType("*droneproto.Command")).Return(nil).Once()
+	m.commandRepo.On("MarkSent", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+	m.logger.On("Info", "Drone command accepted", nil, mock.Anything).Return()
+}
+
+func (m *controlMocks) expectAudit(ctx context.Context, result entity.DroneCommandAuditResult, command droneproto.CommandName) {
+	m.auditRepo.On("Create", ctx, mock.MatchedBy(func(a *entity.DroneCommandAudit) bool {
+		if a.Result != result || a.Command != string(command) || a.OperatorID != testOperator.ID || a.OperatorEmail != testOperator.Email {
+			return false
+		}
+		if result == entity.DroneCommandAuditAccepted {
+			return a.CommandID != nil && a.Reason == nil
+		}
+		return a.CommandID == nil && a.Reason != nil
+	})).Return(nil).Once()
+}
+
+func TestDroneControlUseCase_Execute_GotoAccepted(t *testing.T) {
+	uc, m := newTestControlUseCase(t)
+
+	ctx := context.Background()
+	droneID := "drone-1"
+	m.withDrone(t, uc, entity.DroneState{DroneID: droneID, Status: entity.DroneStatusInTransit}, true)
+	m.expectQueued(ctx, droneID)
+	m.expectAudit(ctx, entity.DroneCommandAuditAccepted, droneproto.CommandGoto)
+
+	command := &droneproto.Command{
+		Command:  droneproto.CommandGoto,
+		Waypoint: &droneproto.Position{Latitude: 55.7558, Longitude: 37.6173, Altitude: 40},
+	}
+	result, err := uc.Execute(ctx, droneID, testOperator, command)
+
+	require.NoError(t, err)
+	assert.Equal(t, command.CommandID, result.ID)
+	assert.Equal(t, entity.DroneCommandStatusSent, result.Status)
+}
+
+func TestDroneControlUseCase_Execute_Interlocks(t *testing.T) {
+	deliveryID := "delivery-1"
+
+	tests := []struct {
+		name    string
+		state   entity.DroneState
+		command *droneproto.Command
+	}{
+		{
+			name:  "goto into no-fly zone",
+			state: entity.DroneState{Status: entity.DroneStatusInTransit},
+			command: &droneproto.Command{
+				Command:  droneproto.CommandGoto,
+				Waypoint: &droneproto.Position{Latitude: 55.9800, Longitude: 37.4100, Altitude: 40},
+			},
+		},
+		{
+			name:    "drop cargo before reaching the destination",
+			state:   entity.DroneState{Status: entity.DroneStatusInTransit, CurrentDeliveryID: &deliveryID},
+			command: &droneproto.Command{Command: droneproto.CommandDropCargo, OrderID: "order-1"},
+		},
+		{
+			name:    "return to base while landed",
+			state:   entity.DroneState{Status: entity.DroneStatusIdle},
+			command: &droneproto.Command{Command: droneproto.CommandReturnToBase},
+		},
+		{
+			name:    "open cargo hook in flight",
+			state:   entity.DroneState{Status: entity.DroneStatusReturning},
+			command: &droneproto.Command{Command: droneproto.CommandOpenCargoHook},
+		},
+		{
+			name:    "abort another delivery",
+			state:   entity.DroneState{Status: entity.DroneStatusInTransit, CurrentDeliveryID: &deliveryID},
+			command: &droneproto.Command{Command: droneproto.CommandAbortDelivery, DeliveryID: "delivery-2"},
+		},
+		{
+			name:    "reboot on a mission",
+			state:   entity.DroneState{Status: entity.DroneStatusIdle, CurrentDeliveryID: &deliveryID},
+			command: &droneproto.Command{Command: droneproto.CommandRebootAgent},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, m := newTestControlUseCase(t)
+
+			ctx := context.Background()
+			tt.state.DroneID = "drone-1"
+			m.withDrone(t, uc, tt.state, true)
+			m.expectAudit(ctx, entity.DroneCommandAuditRejected, tt.command.Command)
+			m.logger.On("Warn", "Drone command rejected", mock.Anything, mock.Anything).Return()
+
+			result, err := uc.Execute(ctx, "drone-1", testOperator, tt.command)
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, entityError.ErrDroneCommandRejected)
+		})
+	}
+}
+
+func TestDroneControlUseCase_Execute_DroneNotConnected(t *testing.T) {
+	uc, m := newTestControlUseCase(t)
+
+	ctx := context.Background()
+	m.withDrone(t, uc, entity.DroneState{DroneID: "drone-1", Status: entity.DroneStatusInTransit}, false)
+	m.expectAudit(ctx, entity.DroneCommandAuditRejected, droneproto.CommandHold)
+	m.logger.On("Warn", "Drone command rejected", mock.Anything, mock.Anything).Return()
+
+	_, err := uc.Execute(ctx, "drone-1", testOperator, &droneproto.Command{Command: droneproto.CommandHold})
+
+	assert.ErrorIs(t, err, entityError.ErrDroneCommandRejected)
+}
+
+func TestDroneControlUseCase_Execute_InvalidCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		command *droneproto.Command
+	}{
+		{name: "altitude above the ceiling", command: &droneproto.Command{Command: droneproto.CommandSetAltitude, Altitude: floatPtr(150)}},
+		{name: "goto without waypoint", command: &droneproto.Command{Command: droneproto.CommandGoto}},
+		{name: "cancel delivery", command: &droneproto.Command{Command: droneproto.CommandCancelDelivery}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, m := newTestControlUseCase(t)
+
+			ctx := context.Background()
+			m.withDrone(t, uc, entity.DroneState{DroneID: "drone-1", Status: entity.DroneStatusInTransit}, true)
+			m.expectAudit(ctx, entity.DroneCommandAuditRejected, tt.command.Command)
+			m.logger.On("Warn", "Drone command rejected", mock.Anything, mock.Anything).Return()
+
+			_, err := uc.Execute(ctx, "drone-1", testOperator, tt.command)
+
+			assert.ErrorIs(t, err, entityError.ErrDroneCommandInvalid)
+		})
+	}
+}
+
+func TestDroneControlUseCase_Execute_AbortDelivery(t *testing.T) {
+	uc, m := newTestControlUseCase(t)
+
+	ctx := context.Background()
+	droneID := "drone-1"
+	deliveryID := "delivery-1"
+	state := entity.DroneState{DroneID: droneID, Status: entity.DroneStatusInTransit, CurrentDeliveryID: &deliveryID}
+	m.withDrone(t, uc, state, true)
+	m.expectQueued(ctx, droneID)
+	m.expectAudit(ctx, entity.DroneCommandAuditAccepted, droneproto.CommandAbortDelivery)
+
+	m.deliveryRepo.On("UpdateDeliveryStatus", ctx, deliveryID, entity.DeliveryStatusFailed, mock.MatchedBy(func(reason *string) bool {
+		return reason != nil && *reason == "aborted by operator operator@example.com"
+	})).Return(nil).Once()
+	m.logger.On("Warn", "Delivery failed", nil, mock.Anything).Return()
+	m.droneRepo.On("GetDroneState", ctx, droneID).Return(&state, nil).Once()
+	m.droneRepo.On("SaveDroneState", ctx, mock.MatchedBy(func(s *entity.DroneState) bool {
+		return s.CurrentDeliveryID == nil
+	})).Return(nil).Once()
+	m.logger.On("Info", "Drone released", nil, mock.Anything).Return()
+
+	_, err := uc.Execute(ctx, droneID, testOperator, &droneproto.Command{
+		Command:    droneproto.CommandAbortDelivery,
+		DeliveryID: deliveryID,
+	})
+
+	require.NoError(t, err)
+}
+
+func TestDroneControlUseCase_Execute_UnknownDroneNotAudited(t *testing.T) {
+	uc, m := newTestControlUseCase(t)
+
+	ctx := context.Background()
+	m.droneRepo.On("GetDrone", ctx, "drone-404").Return(nil, entityError.ErrDroneNotFound)
+
+	_, err := uc.Execute(ctx, "drone-404", testOperator, &droneproto.Command{Command: droneproto.CommandHold})
+
+	assert.ErrorIs(t, err, entityError.ErrDroneNotFound)
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
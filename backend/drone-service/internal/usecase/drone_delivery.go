@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/mitchellh/mapstructure"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
@@ -12,7 +11,7 @@ import (
 )
 
 type VideoHandler interface {
-	HandleVideoFrame(ctx context.Context, droneID string, frameData []byte, deliveryID string) error
+	HandleVideoFrame(ctx context.Context, frame *entity.VideoFrame) error
 }
 
 type DroneDeliveryUseCase struct {
@@ -39,14 +38,9 @@ func NewDroneDeliveryUseCase(
 	}
 }
 
-func (uc *DroneDeliveryUseCase) ProcessDeliveryUpdate(ctx context.Context, droneID string, payload map[string]any) error {
-	var dup entity.DeliveryUpdatePayload
-	decoder, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		TagName: "json",
-		Result:  &dup,
-	})
-	if err := decoder.Decode(payload); err != nil {
-		return fmt.Errorf("DroneDeliveryUseCase - ProcessDeliveryUpdate - Decode: %w", err)
+func (uc *DroneDeliveryUseCase) ProcessDeliveryUpdate(ctx context.Context, droneID string, dup *entity.DeliveryUpdatePayload) error {
+	if dup == nil {
+		return fmt.Errorf("DroneDeliveryUseCase - ProcessDeliveryUpdate: %w", entityError.ErrInvalidPayload)
 	}
 
 	droneStatus := dup.DroneStatus
@@ -103,14 +97,9 @@ func (uc *DroneDeliveryUseCase) ProcessDeliveryUpdate(ctx context.Context, drone
 	return nil
 }
 
-func (uc *DroneDeliveryUseCase) ProcessArrivedAtDestination(ctx context.Context, droneID string, payload map[string]any) error {
-	var aad entity.ArrivedAtDestinationPayload
-	decoder, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		TagName: "json",
-		Result:  &aad,
-	})
-	if err := decoder.Decode(payload); err != nil {
-		return fmt.Errorf("DroneDeliveryUseCase - ProcessArrivedAtDestination - Decode: %w", err)
+func (uc *DroneDeliveryUseCase) ProcessArrivedAtDestination(ctx context.Context, droneID string, aad *entity.ArrivedAtDestinationPayload) error {
+	if aad == nil {
+		return fmt.Errorf("DroneDeliveryUseCase - ProcessArrivedAtDestination: %w", entityError.ErrInvalidPayload)
 	}
 
 	orderID := aad.OrderID
@@ -139,14 +128,9 @@ func (uc *DroneDeliveryUseCase) ProcessArrivedAtDestination(ctx context.Context,
 	return nil
 }
 
-func (uc *DroneDeliveryUseCase) ProcessCargoDropped(ctx context.Context, payload map[string]any) error {
-	var cd entity.CargoDroppedPayload
-	decoder, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		TagName: "json",
-		Result:  &cd,
-	})
-	if err := decoder.Decode(payload); err != nil {
-		return fmt.Errorf("DroneDeliveryUseCase - ProcessCargoDropped - Decode: %w", err)
+func (uc *DroneDeliveryUseCase) ProcessCargoDropped(ctx context.Context, cd *entity.CargoDroppedPayload) error {
+	if cd == nil {
+		return fmt.Errorf("DroneDeliveryUseCase - ProcessCargoDropped: %w", entityError.ErrInvalidPayload)
 	}
 
 	orderID := cd.OrderID
@@ -174,19 +158,19 @@ func (uc *DroneDeliveryUseCase) ProcessCargoDropped(ctx context.Context, payload
 	return nil
 }
 
-func (uc *DroneDeliveryUseCase) ProcessVideoFrame(ctx context.Context, droneID string, frameData []byte, deliveryID string) error {
+func (uc *DroneDeliveryUseCase) ProcessVideoFrame(ctx context.Context, frame *entity.VideoFrame) error {
 	if uc.videoHandler == nil {
 		uc.logger.Warn("DroneDeliveryUseCase - ProcessVideoFrame - videoHandler not configured", nil, map[string]any{
-			"droneID":    droneID,
-			"deliveryID": deliveryID,
+			"droneID":    frame.DroneID,
+			"deliveryID": frame.DeliveryID,
 		})
 		return nil
 	}
 
-	if err := uc.videoHandler.HandleVideoFrame(ctx, droneID, frameData, deliveryID); err != nil {
+	if err := uc.videoHandler.HandleVideoFrame(ctx, frame); err != nil {
 		uc.logger.Error("DroneDeliveryUseCase - ProcessVideoFrame - HandleVideoFrame", err, map[string]any{
-			"droneID":    droneID,
-			"deliveryID": deliveryID,
+			"droneID":    frame.DroneID,
+			"deliveryID": frame.DeliveryID,
 		})
 		return fmt.Errorf("DroneDeliveryUseCase - ProcessVideoFrame - HandleVideoFrame: %w", err)
 	}
@@ -11,17 +11,13 @@ import (
 )
 
 type mockVideoHandler struct {
-	called     bool
-	droneID    string
-	frameData  []byte
-	deliveryID string
+	called bool
+	frame  *entity.VideoFrame
 }
 
-func (m *mockVideoHandler) HandleVideoFrame(ctx context.Context, droneID string, frameData []byte, deliveryID string) error {
+func (m *mockVideoHandler) HandleVideoFrame(ctx context.Context, frame *entity.VideoFrame) error {
 	m.called = true
-	m.droneID = droneID
-	m.frameData = frameData
-	m.deliveryID = deliveryID
+	m.frame = frame
 	return nil
 }
 
@@ -29,16 +25,16 @@ func TestDroneDeliveryUseCase_ProcessDeliveryUpdate_ArrivedAtLocker(t *testing.T
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	uc := NewDroneDeliveryUseCase(mockDeliveryRepo, mockDroneManager, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
 	deliveryID := "delivery-456"
-	payload := map[string]any{
-		"drone_status": "arrived_at_locker",
-		"delivery_id":  deliveryID,
+	payload := &entity.DeliveryUpdatePayload{
+		DroneStatus: "arrived_at_locker",
+		DeliveryID:  deliveryID,
 	}
 
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
@@ -56,7 +52,7 @@ func TestDroneDeliveryUseCase_ProcessDeliveryUpdate_Returning(t *testing.T) {
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	uc := NewDroneDeliveryUseCase(mockDeliveryRepo, mockDroneManager, nil, nil, mockLogger)
 
@@ -79,9 +75,9 @@ func TestDroneDeliveryUseCase_ProcessDeliveryUpdate_Returning(t *testing.T) {
 		return s.Status == entity.DroneStatusIdle && s.CurrentDeliveryID == nil
 	})).Return(nil)
 
-	payload := map[string]any{
-		"drone_status": "returning",
-		"delivery_id":  deliveryID,
+	payload := &entity.DeliveryUpdatePayload{
+		DroneStatus: "returning",
+		DeliveryID:  deliveryID,
 	}
 
 	err := uc.ProcessDeliveryUpdate(ctx, droneID, payload)
@@ -94,7 +90,7 @@ func TestDroneDeliveryUseCase_ProcessVideoFrame_Success(t *testing.T) {
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 	mockVideoHandler := &mockVideoHandler{}
 
 	uc := NewDroneDeliveryUseCase(mockDeliveryRepo, mockDroneManager, nil, mockVideoHandler, mockLogger)
@@ -106,20 +102,20 @@ func TestDroneDeliveryUseCase_ProcessVideoFrame_Success(t *testing.T) {
 
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
-	err := uc.ProcessVideoFrame(ctx, droneID, frameData, deliveryID)
+	err := uc.ProcessVideoFrame(ctx, &entity.VideoFrame{DroneID: droneID, DeliveryID: deliveryID, Codec: entity.VideoCodecJPEG, Data: frameData})
 
 	assert.NoError(t, err)
 	assert.True(t, mockVideoHandler.called)
-	assert.Equal(t, droneID, mockVideoHandler.droneID)
-	assert.Equal(t, frameData, mockVideoHandler.frameData)
-	assert.Equal(t, deliveryID, mockVideoHandler.deliveryID)
+	assert.Equal(t, droneID, mockVideoHandler.frame.DroneID)
+	assert.Equal(t, frameData, mockVideoHandler.frame.Data)
+	assert.Equal(t, deliveryID, mockVideoHandler.frame.DeliveryID)
 }
 
 func TestDroneDeliveryUseCase_ProcessVideoFrame_NilHandler(t *testing.T) {
 	mockDeliveryRepo := mocks.NewMockDeliveryRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
-	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	mockDroneManager := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	uc := NewDroneDeliveryUseCase(mockDeliveryRepo, mockDroneManager, nil, nil, mockLogger)
 
@@ -130,7 +126,7 @@ func TestDroneDeliveryUseCase_ProcessVideoFrame_NilHandler(t *testing.T) {
 
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
 
-	err := uc.ProcessVideoFrame(ctx, droneID, frameData, deliveryID)
+	err := uc.ProcessVideoFrame(ctx, &entity.VideoFrame{DroneID: droneID, DeliveryID: deliveryID, Codec: entity.VideoCodecJPEG, Data: frameData})
 
 	assert.NoError(t, err)
 }
@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
+)
+
+// DroneLivenessUseCase remembers when each drone was last heard from on this replica. A drone silent for
+// longer than offlineThreshold, whether its socket is still open or not, is marked offline and its
+// delivery suspended as drone_lost; when it is heard from again the delivery is resumed. A drone that
+// stays offline for lostDeliveryTimeout on top of that has its delivery failed for good.
+type DroneLivenessUseCase struct {
+	manager          *DroneManagerUseCase
+	telemetry        *DroneTelemetryUseCase
+	delivery         *DeliveryUseCase
+	admins           AdminNotifier
+	offlineThreshold time.Duration
+	// lostDeliveryTimeout is measured from the moment the drone was marked offline.
+	lostDeliveryTimeout time.Duration
+	logger              logger.Interface
+	now                 func() time.Time
+
+	mu       sync.Mutex
+	lastSeen map[string]time.Time
+	offline  map[string]bool
+}
+
+func NewDroneLivenessUseCase(
+	manager *DroneManagerUseCase,
+	telemetry *DroneTelemetryUseCase,
+	delivery *DeliveryUseCase,
+	admins AdminNotifier,
+	offlineThreshold time.Duration,
+	lostDeliveryTimeout time.Duration,
+	logger logger.Interface,
+) *DroneLivenessUseCase {
+	return &DroneLivenessUseCase{
+		manager:             manager,
+		telemetry:           telemetry,
+		delivery:            delivery,
+		admins:              admins,
+		offlineThreshold:    offlineThreshold,
+		lostDeliveryTimeout: lostDeliveryTimeout,
+		logger:              logger,
+		now:                 time.Now,
+		lastSeen:            make(map[string]time.Time),
+		offline:             make(map[string]bool),
+	}
+}
+
+// Connected is called once a drone has registered. The drone may have been marked offline by another
+// replica, so the persisted state decides whether its mission is resumed.
+func (uc *DroneLivenessUseCase) Connected(ctx context.Context, droneID string) {
+	uc.seen(droneID)
+	uc.recover(ctx, droneID)
+}
+
+// Seen is called for every message a drone sends.
+func (uc *DroneLivenessUseCase) Seen(ctx context.Context, droneID string) {
+	if uc.seen(droneID) {
+		uc.recover(ctx, droneID)
+	}
+}
+
+func (uc *DroneLivenessUseCase) seen(droneID string) (wasOffline bool) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	wasOffline = uc.offline[droneID]
+	delete(uc.offline, droneID)
+	uc.lastSeen[droneID] = uc.now()
+	return wasOffline
+}
+
+func (uc *DroneLivenessUseCase) StartLivenessWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.CheckLiveness(ctx)
+		}
+	}
+}
+
+// CheckLiveness marks offline every drone that has been silent for longer than the threshold. Drones
+// that moved to another replica are forgotten: that replica watches them now. It then fails the
+// deliveries of drones that have stayed offline past lostDeliveryTimeout.
+func (uc *DroneLivenessUseCase) CheckLiveness(ctx context.Context) {
+	now := uc.now()
+
+	stale := make(map[string]time.Time)
+	uc.mu.Lock()
+	for droneID, at := range uc.lastSeen {
+		if !uc.offline[droneID] && now.Sub(at) > uc.offlineThreshold {
+			stale[droneID] = at
+		}
+	}
+	uc.mu.Unlock()
+
+	for droneID, at := range stale {
+		if uc.manager.IsConnectedElsewhere(ctx, droneID) {
+			uc.forget(droneID, at)
+			continue
+		}
+		uc.markOffline(ctx, droneID, at, now)
+	}
+
+	uc.failLostDeliveries(ctx, now)
+}
+
+// failLostDeliveries works from the persisted fleet state rather than this replica's memory, so a
+// delivery is failed even if the replica that suspended it has since restarted. Only one replica wins
+// the transition and alerts.
+func (uc *DroneLivenessUseCase) failLostDeliveries(ctx context.Context, now time.Time) {
+	fleet, err := uc.manager.ListFleet(ctx)
+	if err != nil {
+		uc.logger.Warn("DroneLivenessUseCase - failLostDeliveries - ListFleet", err, nil)
+		return
+	}
+
+	for _, drone := range fleet {
+		if drone.Status != entity.DroneStatusOffline || drone.CurrentDeliveryID == nil || drone.Connection != nil {
+			continue
+		}
+		lostFor := now.Sub(drone.LastUpdated)
+		if lostFor <= uc.lostDeliveryTimeout {
+			continue
+		}
+
+		deliveryID := *drone.CurrentDeliveryID
+		reason := fmt.Sprintf("drone offline for %s", lostFor.Round(time.Second))
+		failed, err := uc.delivery.FailLostDelivery(ctx, drone.DroneID, deliveryID, reason)
+		if err != nil {
+			uc.logger.Warn("DroneLivenessUseCase - failLostDeliveries - FailLostDelivery", err, map[string]any{
+				"droneID":    drone.DroneID,
+				"deliveryID": deliveryID,
+			})
+			continue
+		}
+		if !failed {
+			continue
+		}
+
+		uc.notifyAdmins(map[string]any{
+			"severity":    "error",
+			"event":       "delivery_lost",
+			"message":     fmt.Sprintf("Drone lost: %s, delivery %s failed", reason, deliveryID),
+			"drone_id":    drone.DroneID,
+			"delivery_id": deliveryID,
+		})
+	}
+}
+
+func (uc *DroneLivenessUseCase) forget(droneID string, at time.Time) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if uc.lastSeen[droneID].Equal(at) {
+		delete(uc.lastSeen, droneID)
+	}
+}
+
+func (uc *DroneLivenessUseCase) markOffline(ctx context.Context, droneID string, lastSeen, now time.Time) {
+	fields := map[string]any{
+		"droneID":  droneID,
+		"lastSeen": lastSeen,
+	}
+
+	state, err := uc.manager.GetDroneState(ctx, droneID)
+	if err != nil {
+		uc.logger.Warn("DroneLivenessUseCase - markOffline - GetDroneState", err, fields)
+		return
+	}
+	// Another replica that also saw the drone got here first.
+	if state.Status == entity.DroneStatusOffline {
+		uc.setOffline(droneID)
+		return
+	}
+
+	deliveryID, err := uc.delivery.ActiveDeliveryID(ctx, droneID)
+	if err != nil {
+		uc.logger.Warn("DroneLivenessUseCase - markOffline - ActiveDeliveryID", err, fields)
+		return
+	}
+
+	reason := fmt.Sprintf("no heartbeat for %s", now.Sub(lastSeen).Round(time.Second))
+	if err := uc.telemetry.MarkOffline(ctx, droneID, deliveryID, reason); err != nil {
+		uc.logger.Error("DroneLivenessUseCase - markOffline - MarkOffline", err, fields)
+		return
+	}
+	uc.setOffline(droneID)
+
+	if deliveryID != "" {
+		if err := uc.delivery.SuspendDelivery(ctx, droneID, deliveryID, reason); err != nil {
+			uc.logger.Warn("DroneLivenessUseCase - markOffline - SuspendDelivery", err, fields)
+		}
+	}
+
+	uc.logger.Warn("Drone offline", nil, map[string]any{
+		"droneID":    droneID,
+		"lastSeen":   lastSeen,
+		"deliveryID": deliveryID,
+	})
+
+	alert := map[string]any{
+		"severity":  "error",
+		"event":     "drone_offline",
+		"message":   "Drone offline: " + reason,
+		"drone_id":  droneID,
+		"last_seen": lastSeen.Format(time.RFC3339),
+	}
+	if deliveryID != "" {
+		alert["delivery_id"] = deliveryID
+		alert["message"] = fmt.Sprintf("Drone offline: %s, delivery %s suspended", reason, deliveryID)
+	}
+	uc.notifyAdmins(alert)
+}
+
+// setOffline makes the drone's next message recover it, even if it spoke up while being marked.
+func (uc *DroneLivenessUseCase) setOffline(droneID string) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.offline[droneID] = true
+}
+
+func (uc *DroneLivenessUseCase) recover(ctx context.Context, droneID string) {
+	state, err := uc.manager.GetDroneState(ctx, droneID)
+	if err != nil {
+		uc.logger.Warn("DroneLivenessUseCase - recover - GetDroneState", err, map[string]any{
+			"droneID": droneID,
+		})
+		return
+	}
+	if state.Status != entity.DroneStatusOffline {
+		return
+	}
+
+	alert := map[string]any{
+		"severity": "info",
+		"event":    "drone_online",
+		"message":  "Drone back online",
+		"drone_id": droneID,
+	}
+
+	if state.CurrentDeliveryID != nil {
+		deliveryID := *state.CurrentDeliveryID
+		resumed, err := uc.delivery.ResumeDelivery(ctx, droneID, deliveryID)
+		if err != nil {
+			uc.logger.Error("DroneLivenessUseCase - recover - ResumeDelivery", err, map[string]any{
+				"droneID":    droneID,
+				"deliveryID": deliveryID,
+			})
+		}
+		if resumed {
+			alert["delivery_id"] = deliveryID
+			alert["message"] = "Drone back online, delivery " + deliveryID + " resumed"
+		}
+	}
+
+	uc.logger.Info("Drone back online", nil, map[string]any{
+		"droneID":    droneID,
+		"deliveryID": alert["delivery_id"],
+	})
+	uc.notifyAdmins(alert)
+}
+
+func (uc *DroneLivenessUseCase) notifyAdmins(alert map[string]any) {
+	if uc.admins != nil {
+		uc.admins.AlertAdmins(alert)
+	}
+}
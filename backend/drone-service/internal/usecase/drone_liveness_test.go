@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase/mocks"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
+	"github.com/stretchr/testify/mock"
+)
+
+type livenessMocks struct {
+	droneRepo    *mocks.MockDroneRepo
+	deliveryRepo *mocks.MockDeliveryRepo
+	notifier     *mocks.MockDroneNotifier
+	admins       *mocks.MockAdminNotifier
+	logger       *mocks.MockLogger
+}
+
+func newTestLivenessUseCase(t *testing.T) (*DroneLivenessUseCase, *livenessMocks) {
+	m := &livenessMocks{
+		droneRepo:    mocks.NewMockDroneRepo(t),
+		deliveryRepo: mocks.NewMockDeliveryRepo(t),
+		notifier:     mocks.NewMockDroneNotifier(t),
+		admins:       mocks.NewMockAdminNotifier(t),
+		logger:       mocks.NewMockLogger(t),
+	}
+
+	manager := NewDroneManagerUseCase(m.droneRepo, nil, m.logger)
+	telemetry := NewDroneTelemetryUseCase(m.droneRepo, nil, nil, time.Second, m.logger)
+	delivery := NewDeliveryUseCase(m.droneRepo, m.deliveryRepo, manager, m.notifier, nil, nil, m.logger)
+
+	uc := NewDroneLivenessUseCase(manager, telemetry, delivery, m.admins, 30*time.Second, 10*time.Minute, m.logger)
+	return uc, m
+}
+
+func TestDroneLivenessUseCase_CheckLiveness_MarksSilentDroneOffline(t *testing.T) {
+	uc, m := newTestLivenessUseCase(t)
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	uc.Seen(ctx, "drone-1")
+	uc.Seen(ctx, "drone-2")
+
+	uc.mu.Lock()
+	uc.lastSeen["drone-1"] = now.Add(-31 * time.Second)
+	uc.mu.Unlock()
+
+	m.droneRepo.On("GetDroneState", ctx, "drone-1").Return(&entity.DroneState{
+		DroneID: "drone-1",
+		Status:  entity.DroneStatusInTransit,
+	}, nil)
+	m.deliveryRepo.On("GetActiveDeliveryID", ctx, "drone-1").Return("delivery-1", nil)
+	m.droneRepo.On("SaveDroneState", ctx, mock.MatchedBy(func(state *entity.DroneState) bool {
+		return state.Status == entity.DroneStatusOffline &&
+			state.CurrentDeliveryID != nil && *state.CurrentDeliveryID == "delivery-1" &&
+			state.ErrorMessage != nil && *state.ErrorMessage == "no heartbeat for 31s"
+	})).Return(nil).Once()
+	m.deliveryRepo.On("UpdateDeliveryStatus", ctx, "delivery-1", entity.DeliveryStatusDroneLost, mock.Anything).Return(nil).Once()
+	m.logger.On("Warn", "Delivery suspended", nil, mock.Anything).Return()
+	m.logger.On("Warn", "Drone offline", nil, mock.Anything).Return()
+	m.droneRepo.On("ListDrones", ctx).Return(nil, nil)
+	m.admins.On("AlertAdmins", mock.MatchedBy(func(alert map[string]any) bool {
+		return alert["event"] == "drone_offline" && alert["drone_id"] == "drone-1" && alert["delivery_id"] == "delivery-1"
+	})).Return().Once()
+
+	uc.CheckLiveness(ctx)
+	// Already offline: not marked again.
+	uc.CheckLiveness(ctx)
+}
+
+func TestDroneLivenessUseCase_CheckLiveness_SkipsDroneAlreadyOffline(t *testing.T) {
+	uc, m := newTestLivenessUseCase(t)
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now.Add(-time.Minute) }
+	uc.Seen(ctx, "drone-1")
+	uc.now = func() time.Time { return now }
+
+	m.droneRepo.On("GetDroneState", ctx, "drone-1").Return(&entity.DroneState{
+		DroneID: "drone-1",
+		Status:  entity.DroneStatusOffline,
+	}, nil).Once()
+	m.droneRepo.On("ListDrones", ctx).Return(nil, nil)
+
+	uc.CheckLiveness(ctx)
+	uc.CheckLiveness(ctx)
+}
+
+func TestDroneLivenessUseCase_Connected_ResumesDelivery(t *testing.T) {
+	uc, m := newTestLivenessUseCase(t)
+
+	ctx := context.Background()
+	droneID := "drone-1"
+	deliveryID := "delivery-1"
+
+	m.droneRepo.On("GetDroneState", ctx, droneID).Return(&entity.DroneState{
+		DroneID:           droneID,
+		Status:            entity.DroneStatusOffline,
+		CurrentDeliveryID: &deliveryID,
+	}, nil)
+	m.deliveryRepo.On("GetDeliveryTask", ctx, deliveryID).Return(&entity.DeliveryTask{
+		DeliveryID:      deliveryID,
+		OrderID:         "order-1",
+		ParcelAutomatID: "automat-1",
+		DroneID:         &droneID,
+		Status:          entity.DeliveryStatusDroneLost,
+	}, nil)
+	m.deliveryRepo.On("TransitionDeliveryStatus", ctx, deliveryID, entity.DeliveryStatusDroneLost, entity.DeliveryStatusInProgress).Return(true, nil).Once()
+	m.notifier.On("SendToDrone", ctx, droneID, mock.MatchedBy(func(msg droneproto.Message) bool {
+		task, ok := msg.(*droneproto.DeliveryTask)
+		return ok && task.DeliveryID == deliveryID && task.OrderID == "order-1"
+	})).Return(nil).Once()
+	m.logger.On("Info", "Delivery resumed", nil, mock.Anything).Return()
+	m.logger.On("Info", "Drone back online", nil, mock.Anything).Return()
+	m.admins.On("AlertAdmins", mock.MatchedBy(func(alert map[string]any) bool {
+		return alert["event"] == "drone_online" && alert["delivery_id"] == deliveryID
+	})).Return().Once()
+
+	uc.Connected(ctx, droneID)
+}
+
+func TestDroneLivenessUseCase_Connected_DoesNotResumeCancelledDelivery(t *testing.T) {
+	uc, m := newTestLivenessUseCase(t)
+
+	ctx := context.Background()
+	droneID := "drone-1"
+	deliveryID := "delivery-1"
+
+	m.droneRepo.On("GetDroneState", ctx, droneID).Return(&entity.DroneState{
+		DroneID:           droneID,
+		Status:            entity.DroneStatusOffline,
+		CurrentDeliveryID: &deliveryID,
+	}, nil)
+	m.deliveryRepo.On("GetDeliveryTask", ctx, deliveryID).Return(&entity.DeliveryTask{
+		DeliveryID: deliveryID,
+		DroneID:    &droneID,
+		Status:     entity.DeliveryStatusCancelled,
+	}, nil)
+	m.logger.On("Info", "Drone back online", nil, mock.Anything).Return()
+	m.admins.On("AlertAdmins", mock.MatchedBy(func(alert map[string]any) bool {
+		_, resumed := alert["delivery_id"]
+		return alert["event"] == "drone_online" && !resumed
+	})).Return().Once()
+
+	uc.Connected(ctx, droneID)
+}
+
+func TestDroneLivenessUseCase_CheckLiveness_FailsDeliveryOfDroneLostTooLong(t *testing.T) {
+	uc, m := newTestLivenessUseCase(t)
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	lostID := "delivery-1"
+	recentID := "delivery-2"
+
+	m.droneRepo.On("ListDrones", ctx).Return([]*entity.FleetDrone{
+		{DroneState: entity.DroneState{
+			DroneID:           "drone-1",
+			Status:            entity.DroneStatusOffline,
+			CurrentDeliveryID: &lostID,
+			LastUpdated:       now.Add(-11 * time.Minute),
+		}},
+		{DroneState: entity.DroneState{
+			DroneID:           "drone-2",
+			Status:            entity.DroneStatusOffline,
+			CurrentDeliveryID: &recentID,
+			LastUpdated:       now.Add(-time.Minute),
+		}},
+	}, nil)
+	m.deliveryRepo.On("TransitionDeliveryStatus", ctx, lostID, entity.DeliveryStatusDroneLost, entity.DeliveryStatusFailed).Return(true, nil).Once()
+	m.logger.On("Warn", "Delivery failed", nil, mock.Anything).Return()
+	m.admins.On("AlertAdmins", mock.MatchedBy(func(alert map[string]any) bool {
+		return alert["event"] == "delivery_lost" && alert["drone_id"] == "drone-1" && alert["delivery_id"] == lostID
+	})).Return().Once()
+
+	uc.CheckLiveness(ctx)
+}
+
+func TestDroneLivenessUseCase_CheckLiveness_LostDeliveryFailedElsewhereNotAlerted(t *testing.T) {
+	uc, m := newTestLivenessUseCase(t)
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	deliveryID := "delivery-1"
+
+	m.droneRepo.On("ListDrones", ctx).Return([]*entity.FleetDrone{
+		{DroneState: entity.DroneState{
+			DroneID:           "drone-1",
+			Status:            entity.DroneStatusOffline,
+			CurrentDeliveryID: &deliveryID,
+			LastUpdated:       now.Add(-time.Hour),
+		}},
+	}, nil)
+	m.deliveryRepo.On("TransitionDeliveryStatus", ctx, deliveryID, entity.DeliveryStatusDroneLost, entity.DeliveryStatusFailed).Return(false, nil).Once()
+
+	uc.CheckLiveness(ctx)
+}
+
+func TestDroneLivenessUseCase_Seen_OnlineDroneDoesNotTouchState(t *testing.T) {
+	uc, _ := newTestLivenessUseCase(t)
+
+	uc.Seen(context.Background(), "drone-1")
+	uc.Seen(context.Background(), "drone-1")
+}
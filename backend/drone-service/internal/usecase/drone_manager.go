@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
@@ -11,46 +12,57 @@ import (
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
 )
 
+// DroneManagerUseCase keeps the drones connected to this replica and, when a registry is configured,
+// publishes their connections so every replica sees the whole fleet. registry is nil when the service
+// runs alone. The fleet itself is the drones table; connections only say which drones are reachable.
 type DroneManagerUseCase struct {
-	droneRepo        repo.DroneRepo
-	registeredDrones map[string]bool
-	mu               sync.RWMutex
-	logger           logger.Interface
+	droneRepo   repo.DroneRepo
+	registry    repo.ConnectionRegistry
+	connections map[string]*entity.DroneConnection
+	mu          sync.RWMutex
+	logger      logger.Interface
 }
 
-func NewDroneManagerUseCase(droneRepo repo.DroneRepo, logger logger.Interface) *DroneManagerUseCase {
+func NewDroneManagerUseCase(droneRepo repo.DroneRepo, registry repo.ConnectionRegistry, logger logger.Interface) *DroneManagerUseCase {
 	return &DroneManagerUseCase{
-		droneRepo:        droneRepo,
-		registeredDrones: make(map[string]bool),
-		logger:           logger,
+		droneRepo:   droneRepo,
+		registry:    registry,
+		connections: make(map[string]*entity.DroneConnection),
+		logger:      logger,
 	}
 }
 
-func (uc *DroneManagerUseCase) RegisterDrone(ctx context.Context, droneID string) error {
+// RegisterDrone does not fail when the registry is unreachable: the drone is still served by this
+// replica and the lease worker publishes it once the registry is back.
+func (uc *DroneManagerUseCase) RegisterDrone(ctx context.Context, conn *entity.DroneConnection) error {
 	uc.mu.Lock()
-	defer uc.mu.Unlock()
+	uc.connections[conn.DroneID] = conn
+	uc.mu.Unlock()
 
-	uc.registeredDrones[droneID] = true
+	if uc.registry != nil {
+		if err := uc.registry.Register(ctx, conn); err != nil {
+			uc.logger.Warn("DroneManagerUseCase - RegisterDrone - Register", err, map[string]any{
+				"droneID":   conn.DroneID,
+				"sessionID": conn.SessionID,
+			})
+		}
+	}
 	return nil
 }
 
+// GetFreeDrone picks a connected, idle drone with enough battery for a delivery.
 func (uc *DroneManagerUseCase) GetFreeDrone(ctx context.Context) (string, error) {
-	uc.mu.RLock()
-	defer uc.mu.RUnlock()
+	fleet, err := uc.ListFleet(ctx)
+	if err != nil {
+		return "", fmt.Errorf("DroneManagerUseCase - GetFreeDrone - ListFleet: %w", err)
+	}
 
-	for droneID := range uc.registeredDrones {
-		state, err := uc.droneRepo.GetDroneState(ctx, droneID)
-		if err != nil {
-			uc.logger.Warn("DroneManagerUseCase - GetFreeDrone - GetDroneState", err, map[string]any{
-				"droneID": droneID,
-			})
+	for _, drone := range fleet {
+		if drone.Connection == nil {
 			continue
 		}
-
-		if state != nil && state.Status == entity.DroneStatusIdle && state.CurrentDeliveryID == nil {
-			if state.BatteryLevel > 30.0 {
-				return droneID, nil
-			}
+		if drone.Status == entity.DroneStatusIdle && drone.CurrentDeliveryID == nil && drone.BatteryLevel > 30.0 {
+			return drone.DroneID, nil
 		}
 	}
 
@@ -126,9 +138,18 @@ func (uc *DroneManagerUseCase) ReleaseDrone(ctx context.Context, droneID string)
 
 func (uc *DroneManagerUseCase) UnregisterDrone(ctx context.Context, droneID string) error {
 	uc.mu.Lock()
-	defer uc.mu.Unlock()
+	conn, ok := uc.connections[droneID]
+	delete(uc.connections, droneID)
+	uc.mu.Unlock()
 
-	delete(uc.registeredDrones, droneID)
+	if ok && uc.registry != nil {
+		if err := uc.registry.Unregister(ctx, conn); err != nil {
+			uc.logger.Warn("DroneManagerUseCase - UnregisterDrone - Unregister", err, map[string]any{
+				"droneID":   droneID,
+				"sessionID": conn.SessionID,
+			})
+		}
+	}
 	return nil
 }
 
@@ -143,17 +164,116 @@ func (uc *DroneManagerUseCase) GetDroneState(ctx context.Context, droneID string
 	return state, nil
 }
 
-func (uc *DroneManagerUseCase) GetAllDrones() []string {
+// ListFleet returns every provisioned drone with its connection, if it has one.
+func (uc *DroneManagerUseCase) ListFleet(ctx context.Context) ([]*entity.FleetDrone, error) {
+	drones, err := uc.droneRepo.ListDrones(ctx)
+	if err != nil {
+		uc.logger.Error("DroneManagerUseCase - ListFleet - ListDrones", err, nil)
+		return nil, fmt.Errorf("DroneManagerUseCase - ListFleet - ListDrones: %w", err)
+	}
+
+	connections := uc.connectionsByDrone(ctx)
+	for _, drone := range drones {
+		drone.Connection = connections[drone.DroneID]
+	}
+	return drones, nil
+}
+
+func (uc *DroneManagerUseCase) GetFleetDrone(ctx context.Context, droneID string) (*entity.FleetDrone, error) {
+	drone, err := uc.droneRepo.GetDrone(ctx, droneID)
+	if err != nil {
+		return nil, fmt.Errorf("DroneManagerUseCase - GetFleetDrone - GetDrone: %w", err)
+	}
+
+	drone.Connection = uc.connectionsByDrone(ctx)[droneID]
+	return drone, nil
+}
+
+// Connections returns the connections held by any replica. If the registry cannot be read it falls
+// back to this replica's own connections.
+func (uc *DroneManagerUseCase) Connections(ctx context.Context) []*entity.DroneConnection {
+	if uc.registry == nil {
+		return uc.localConnections()
+	}
+
+	conns, err := uc.registry.List(ctx)
+	if err != nil {
+		uc.logger.Warn("DroneManagerUseCase - Connections - List", err, nil)
+		return uc.localConnections()
+	}
+	return conns
+}
+
+func (uc *DroneManagerUseCase) connectionsByDrone(ctx context.Context) map[string]*entity.DroneConnection {
+	conns := uc.Connections(ctx)
+	byDrone := make(map[string]*entity.DroneConnection, len(conns))
+	for _, conn := range conns {
+		byDrone[conn.DroneID] = conn
+	}
+	return byDrone
+}
+
+// IsConnectedElsewhere reports whether another replica holds the drone's connection.
+func (uc *DroneManagerUseCase) IsConnectedElsewhere(ctx context.Context, droneID string) bool {
+	uc.mu.RLock()
+	_, local := uc.connections[droneID]
+	uc.mu.RUnlock()
+
+	if local || uc.registry == nil {
+		return false
+	}
+
+	owner, err := uc.registry.Owner(ctx, droneID)
+	if err != nil {
+		uc.logger.Warn("DroneManagerUseCase - IsConnectedElsewhere - Owner", err, map[string]any{
+			"droneID": droneID,
+		})
+		return false
+	}
+	return owner != ""
+}
+
+// GetRegisteredDrones returns the drones connected to this replica.
+func (uc *DroneManagerUseCase) GetRegisteredDrones() []string {
 	uc.mu.RLock()
 	defer uc.mu.RUnlock()
 
-	drones := make([]string, 0, len(uc.registeredDrones))
-	for droneID := range uc.registeredDrones {
+	drones := make([]string, 0, len(uc.connections))
+	for droneID := range uc.connections {
 		drones = append(drones, droneID)
 	}
 	return drones
 }
 
-func (uc *DroneManagerUseCase) GetRegisteredDrones() []string {
-	return uc.GetAllDrones()
+func (uc *DroneManagerUseCase) localConnections() []*entity.DroneConnection {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	conns := make([]*entity.DroneConnection, 0, len(uc.connections))
+	for _, conn := range uc.connections {
+		conns = append(conns, conn)
+	}
+	return conns
+}
+
+// StartLeaseWorker keeps this replica's registry entries alive. interval must be well below the lease
+// TTL the registry was created with.
+func (uc *DroneManagerUseCase) StartLeaseWorker(ctx context.Context, interval time.Duration) {
+	if uc.registry == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := uc.registry.Refresh(ctx, uc.localConnections()); err != nil {
+				uc.logger.Warn("DroneManagerUseCase - StartLeaseWorker - Refresh", err, nil)
+			}
+		}
+	}
 }
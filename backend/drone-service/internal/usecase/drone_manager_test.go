@@ -15,12 +15,12 @@ import (
 func TestDroneManagerUseCase_RegisterDrone_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
 
-	err := uc.RegisterDrone(ctx, droneID)
+	err := uc.RegisterDrone(ctx, testConnection(droneID))
 
 	assert.NoError(t, err)
 	drones := uc.GetRegisteredDrones()
@@ -30,12 +30,12 @@ func TestDroneManagerUseCase_RegisterDrone_Success(t *testing.T) {
 func TestDroneManagerUseCase_UnregisterDrone_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
 
-	_ = uc.RegisterDrone(ctx, droneID)
+	_ = uc.RegisterDrone(ctx, testConnection(droneID))
 	assert.Contains(t, uc.GetRegisteredDrones(), droneID)
 
 	err := uc.UnregisterDrone(ctx, droneID)
@@ -46,12 +46,12 @@ func TestDroneManagerUseCase_UnregisterDrone_Success(t *testing.T) {
 func TestDroneManagerUseCase_GetFreeDrone_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
 
-	_ = uc.RegisterDrone(ctx, droneID)
+	_ = uc.RegisterDrone(ctx, testConnection(droneID))
 
 	state := &entity.DroneState{
 		DroneID:           droneID,
@@ -60,7 +60,7 @@ func TestDroneManagerUseCase_GetFreeDrone_Success(t *testing.T) {
 		CurrentDeliveryID: nil,
 	}
 
-	mockDroneRepo.On("GetDroneState", ctx, droneID).Return(state, nil)
+	mockDroneRepo.On("ListDrones", ctx).Return([]*entity.FleetDrone{{DroneState: *state}}, nil)
 
 	freeDrone, err := uc.GetFreeDrone(ctx)
 
@@ -72,12 +72,12 @@ func TestDroneManagerUseCase_GetFreeDrone_Success(t *testing.T) {
 func TestDroneManagerUseCase_GetFreeDrone_NoFreeDrone(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
 
-	_ = uc.RegisterDrone(ctx, droneID)
+	_ = uc.RegisterDrone(ctx, testConnection(droneID))
 
 	state := &entity.DroneState{
 		DroneID:           droneID,
@@ -88,7 +88,7 @@ func TestDroneManagerUseCase_GetFreeDrone_NoFreeDrone(t *testing.T) {
 
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
-	mockDroneRepo.On("GetDroneState", ctx, droneID).Return(state, nil)
+	mockDroneRepo.On("ListDrones", ctx).Return([]*entity.FleetDrone{{DroneState: *state}}, nil)
 
 	freeDrone, err := uc.GetFreeDrone(ctx)
 
@@ -101,12 +101,12 @@ func TestDroneManagerUseCase_GetFreeDrone_NoFreeDrone(t *testing.T) {
 func TestDroneManagerUseCase_GetFreeDrone_LowBattery(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
 
-	_ = uc.RegisterDrone(ctx, droneID)
+	_ = uc.RegisterDrone(ctx, testConnection(droneID))
 
 	state := &entity.DroneState{
 		DroneID:           droneID,
@@ -117,7 +117,7 @@ func TestDroneManagerUseCase_GetFreeDrone_LowBattery(t *testing.T) {
 
 	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
-	mockDroneRepo.On("GetDroneState", ctx, droneID).Return(state, nil)
+	mockDroneRepo.On("ListDrones", ctx).Return([]*entity.FleetDrone{{DroneState: *state}}, nil)
 
 	freeDrone, err := uc.GetFreeDrone(ctx)
 
@@ -130,7 +130,7 @@ func TestDroneManagerUseCase_GetFreeDrone_LowBattery(t *testing.T) {
 func TestDroneManagerUseCase_AssignDeliveryToDrone_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
@@ -164,7 +164,7 @@ func TestDroneManagerUseCase_AssignDeliveryToDrone_Success(t *testing.T) {
 func TestDroneManagerUseCase_AssignDeliveryToDrone_GetStateError(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
@@ -184,7 +184,7 @@ func TestDroneManagerUseCase_AssignDeliveryToDrone_GetStateError(t *testing.T) {
 func TestDroneManagerUseCase_ReleaseDrone_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
@@ -216,7 +216,7 @@ func TestDroneManagerUseCase_ReleaseDrone_Success(t *testing.T) {
 func TestDroneManagerUseCase_GetDroneState_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
@@ -239,7 +239,7 @@ func TestDroneManagerUseCase_GetDroneState_Success(t *testing.T) {
 func TestDroneManagerUseCase_GetDroneState_Error(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
@@ -256,21 +256,162 @@ func TestDroneManagerUseCase_GetDroneState_Error(t *testing.T) {
 	mockDroneRepo.AssertExpectations(t)
 }
 
-func TestDroneManagerUseCase_GetAllDrones(t *testing.T) {
+func TestDroneManagerUseCase_GetFreeDrone_SkipsDisconnectedDrone(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
+
+	ctx := context.Background()
+	_ = uc.RegisterDrone(ctx, testConnection("drone-2"))
+
+	mockDroneRepo.On("ListDrones", ctx).Return([]*entity.FleetDrone{
+		{DroneState: entity.DroneState{DroneID: "drone-1", Status: entity.DroneStatusIdle, BatteryLevel: 95.0}},
+		{DroneState: entity.DroneState{DroneID: "drone-2", Status: entity.DroneStatusIdle, BatteryLevel: 60.0}},
+	}, nil)
+
+	freeDrone, err := uc.GetFreeDrone(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "drone-2", freeDrone)
+}
+
+func TestDroneManagerUseCase_ListFleet_JoinsConnections(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
+
+	ctx := context.Background()
+	conn := testConnection("drone-1")
+	_ = uc.RegisterDrone(ctx, conn)
+
+	mockDroneRepo.On("ListDrones", ctx).Return([]*entity.FleetDrone{
+		{DroneState: entity.DroneState{DroneID: "drone-1", Status: entity.DroneStatusIdle}, Model: "DJI Mavic 3"},
+		{DroneState: entity.DroneState{DroneID: "drone-2", Status: entity.DroneStatusIdle}, Model: "DJI Mavic 3"},
+	}, nil)
+
+	fleet, err := uc.ListFleet(ctx)
+
+	assert.NoError(t, err)
+	assert.Len(t, fleet, 2)
+	assert.Equal(t, conn, fleet[0].Connection)
+	assert.Nil(t, fleet[1].Connection)
+}
+
+func TestDroneManagerUseCase_ListFleet_RepoError(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
+
+	ctx := context.Background()
+	dbErr := errors.New("database error")
+	mockDroneRepo.On("ListDrones", ctx).Return(nil, dbErr)
+	mockLogger.On("Error", "DroneManagerUseCase - ListFleet - ListDrones", dbErr, mock.Anything).Return()
+
+	fleet, err := uc.ListFleet(ctx)
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Nil(t, fleet)
+}
+
+func TestDroneManagerUseCase_GetFleetDrone_NotFound(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneManagerUseCase(mockDroneRepo, nil, mockLogger)
+
+	ctx := context.Background()
+	mockDroneRepo.On("GetDrone", ctx, "drone-1").Return(nil, entityError.ErrDroneNotFound)
+
+	drone, err := uc.GetFleetDrone(ctx, "drone-1")
+
+	assert.True(t, errors.Is(err, entityError.ErrDroneNotFound))
+	assert.Nil(t, drone)
+}
+
+func TestDroneManagerUseCase_RegisterDrone_PublishesToRegistry(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
 	mockLogger := mocks.NewMockLogger(t)
-	uc := NewDroneManagerUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneManagerUseCase(mockDroneRepo, mockRegistry, mockLogger)
 
 	ctx := context.Background()
+	conn := testConnection("drone-1")
+	mockRegistry.On("Register", ctx, conn).Return(nil)
+	mockRegistry.On("Unregister", ctx, conn).Return(nil)
+
+	assert.NoError(t, uc.RegisterDrone(ctx, conn))
+	assert.Contains(t, uc.GetRegisteredDrones(), "drone-1")
+
+	assert.NoError(t, uc.UnregisterDrone(ctx, "drone-1"))
+	assert.Empty(t, uc.GetRegisteredDrones())
+}
 
-	_ = uc.RegisterDrone(ctx, "drone-1")
-	_ = uc.RegisterDrone(ctx, "drone-2")
-	_ = uc.RegisterDrone(ctx, "drone-3")
+func TestDroneManagerUseCase_RegisterDrone_RegistryDown(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneManagerUseCase(mockDroneRepo, mockRegistry, mockLogger)
+
+	ctx := context.Background()
+	conn := testConnection("drone-1")
+	redisErr := errors.New("connection refused")
+	mockRegistry.On("Register", ctx, conn).Return(redisErr)
+	mockLogger.On("Warn", "DroneManagerUseCase - RegisterDrone - Register", redisErr, mock.Anything).Return()
+
+	assert.NoError(t, uc.RegisterDrone(ctx, conn))
+	assert.Contains(t, uc.GetRegisteredDrones(), "drone-1")
+}
+
+func TestDroneManagerUseCase_Connections_FromRegistry(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneManagerUseCase(mockDroneRepo, mockRegistry, mockLogger)
+
+	ctx := context.Background()
+	conns := []*entity.DroneConnection{testConnection("drone-local"), testConnection("drone-elsewhere")}
+	mockRegistry.On("List", ctx).Return(conns, nil)
+
+	assert.Equal(t, conns, uc.Connections(ctx))
+}
 
-	drones := uc.GetAllDrones()
+func TestDroneManagerUseCase_Connections_RegistryDown(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneManagerUseCase(mockDroneRepo, mockRegistry, mockLogger)
+
+	ctx := context.Background()
+	conn := testConnection("drone-local")
+	redisErr := errors.New("connection refused")
+	mockRegistry.On("Register", ctx, conn).Return(nil)
+	mockRegistry.On("List", ctx).Return(nil, redisErr)
+	mockLogger.On("Warn", "DroneManagerUseCase - Connections - List", redisErr, mock.Anything).Return()
+
+	_ = uc.RegisterDrone(ctx, conn)
+
+	assert.Equal(t, []*entity.DroneConnection{conn}, uc.Connections(ctx))
+}
+
+func TestDroneManagerUseCase_GetFreeDrone_OnOtherReplica(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneManagerUseCase(mockDroneRepo, mockRegistry, mockLogger)
+
+	ctx := context.Background()
+	mockRegistry.On("List", ctx).Return([]*entity.DroneConnection{testConnection("drone-elsewhere")}, nil)
+	mockDroneRepo.On("ListDrones", ctx).Return([]*entity.FleetDrone{{DroneState: entity.DroneState{
+		DroneID:      "drone-elsewhere",
+		Status:       entity.DroneStatusIdle,
+		BatteryLevel: 90.0,
+	}}}, nil)
+
+	freeDrone, err := uc.GetFreeDrone(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "drone-elsewhere", freeDrone)
+}
 
-	assert.Len(t, drones, 3)
-	assert.Contains(t, drones, "drone-1")
-	assert.Contains(t, drones, "drone-2")
-	assert.Contains(t, drones, "drone-3")
+func testConnection(droneID string) *entity.DroneConnection {
+	return &entity.DroneConnection{DroneID: droneID, SessionID: "session-" + droneID}
 }
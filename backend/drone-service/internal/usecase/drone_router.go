@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
+)
+
+// DroneRouterUseCase delivers messages to a drone whichever replica holds its WebSocket. Messages for
+// drones on another replica go to that replica's channel on the bus, which writes them to the drone.
+type DroneRouterUseCase struct {
+	local      DroneNotifier
+	registry   repo.ConnectionRegistry
+	bus        repo.MessageBus
+	instanceID string
+	logger     logger.Interface
+}
+
+func NewDroneRouterUseCase(
+	local DroneNotifier,
+	registry repo.ConnectionRegistry,
+	bus repo.MessageBus,
+	instanceID string,
+	logger logger.Interface,
+) *DroneRouterUseCase {
+	return &DroneRouterUseCase{
+		local:      local,
+		registry:   registry,
+		bus:        bus,
+		instanceID: instanceID,
+		logger:     logger,
+	}
+}
+
+// Listen subscribes this replica to messages routed to it by the others.
+func (uc *DroneRouterUseCase) Listen(ctx context.Context) error {
+	if err := uc.bus.Subscribe(ctx, entity.InstanceChannel(uc.instanceID), uc.handleRouted); err != nil {
+		return fmt.Errorf("DroneRouterUseCase - Listen: %w", err)
+	}
+	return nil
+}
+
+// SendToDrone returns ErrDroneNotConnected when no live replica holds the drone. A message handed to
+// another replica is not confirmed; commands rely on their acks for that.
+func (uc *DroneRouterUseCase) SendToDrone(ctx context.Context, droneID string, message droneproto.Message) error {
+	err := uc.local.SendToDrone(ctx, droneID, message)
+	if !errors.Is(err, entityError.ErrDroneNotConnected) {
+		return err
+	}
+
+	owner, err := uc.registry.Owner(ctx, droneID)
+	if err != nil {
+		return fmt.Errorf("DroneRouterUseCase - SendToDrone - Owner: %w", err)
+	}
+	if owner == "" || owner == uc.instanceID {
+		return fmt.Errorf("DroneRouterUseCase - SendToDrone: %w: %s", entityError.ErrDroneNotConnected, droneID)
+	}
+
+	if err := message.Validate(); err != nil {
+		return fmt.Errorf("DroneRouterUseCase - SendToDrone - Validate: %w", err)
+	}
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("DroneRouterUseCase - SendToDrone - Marshal: %w", err)
+	}
+	routed, err := json.Marshal(entity.RoutedMessage{
+		DroneID: droneID,
+		Type:    string(message.MessageType()),
+		Payload: payload,
+		Origin:  uc.instanceID,
+	})
+	if err != nil {
+		return fmt.Errorf("DroneRouterUseCase - SendToDrone - Marshal: %w", err)
+	}
+
+	receivers, err := uc.bus.Publish(ctx, entity.InstanceChannel(owner), routed)
+	if err != nil {
+		return fmt.Errorf("DroneRouterUseCase - SendToDrone - Publish: %w", err)
+	}
+	if receivers == 0 {
+		return fmt.Errorf("DroneRouterUseCase - SendToDrone: %w: replica %s holding %s is gone", entityError.ErrDroneNotConnected, owner, droneID)
+	}
+	return nil
+}
+
+func (uc *DroneRouterUseCase) handleRouted(ctx context.Context, data []byte) {
+	var routed entity.RoutedMessage
+	if err := json.Unmarshal(data, &routed); err != nil {
+		uc.logger.Warn("DroneRouterUseCase - handleRouted - Unmarshal", err, nil)
+		return
+	}
+
+	message := &droneproto.Relayed{Type: droneproto.MessageType(routed.Type), Payload: routed.Payload}
+	if err := uc.local.SendToDrone(ctx, routed.DroneID, message); err != nil {
+		uc.logger.Warn("DroneRouterUseCase - handleRouted - SendToDrone", err, map[string]any{
+			"droneID": routed.DroneID,
+			"type":    routed.Type,
+			"origin":  routed.Origin,
+		})
+	}
+}
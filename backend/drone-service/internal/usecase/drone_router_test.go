@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase/mocks"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var errLocalNotConnected = fmt.Errorf("%w: drone-1", entityError.ErrDroneNotConnected)
+
+func TestDroneRouterUseCase_SendToDrone_Local(t *testing.T) {
+	mockLocal := mocks.NewMockDroneNotifier(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
+	mockBus := mocks.NewMockMessageBus(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneRouterUseCase(mockLocal, mockRegistry, mockBus, "replica-a", mockLogger)
+
+	ctx := context.Background()
+	command := &droneproto.Command{Command: droneproto.CommandCancelDelivery}
+	mockLocal.On("SendToDrone", ctx, "drone-1", command).Return(nil)
+
+	assert.NoError(t, uc.SendToDrone(ctx, "drone-1", command))
+}
+
+func TestDroneRouterUseCase_SendToDrone_OtherReplica(t *testing.T) {
+	mockLocal := mocks.NewMockDroneNotifier(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
+	mockBus := mocks.NewMockMessageBus(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneRouterUseCase(mockLocal, mockRegistry, mockBus, "replica-a", mockLogger)
+
+	ctx := context.Background()
+	command := &droneproto.Command{CommandID: "cmd-1", Command: droneproto.CommandCancelDelivery}
+	mockLocal.On("SendToDrone", ctx, "drone-1", command).Return(errLocalNotConnected)
+	mockRegistry.On("Owner", ctx, "drone-1").Return("replica-b", nil)
+	mockBus.On("Publish", ctx, entity.InstanceChannel("replica-b"), mock.MatchedBy(func(data []byte) bool {
+		var routed entity.RoutedMessage
+		if err := json.Unmarshal(data, &routed); err != nil {
+			return false
+		}
+		var sent droneproto.Command
+		return json.Unmarshal(routed.Payload, &sent) == nil &&
+			routed.DroneID == "drone-1" && routed.Type == "command" && routed.Origin == "replica-a" && sent.CommandID == "cmd-1"
+	})).Return(int64(1), nil)
+
+	assert.NoError(t, uc.SendToDrone(ctx, "drone-1", command))
+}
+
+func TestDroneRouterUseCase_SendToDrone_NotConnectedAnywhere(t *testing.T) {
+	mockLocal := mocks.NewMockDroneNotifier(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
+	mockBus := mocks.NewMockMessageBus(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneRouterUseCase(mockLocal, mockRegistry, mockBus, "replica-a", mockLogger)
+
+	ctx := context.Background()
+	command := &droneproto.Command{Command: droneproto.CommandCancelDelivery}
+	mockLocal.On("SendToDrone", ctx, "drone-1", command).Return(errLocalNotConnected)
+	mockRegistry.On("Owner", ctx, "drone-1").Return("", nil)
+
+	err := uc.SendToDrone(ctx, "drone-1", command)
+
+	assert.ErrorIs(t, err, entityError.ErrDroneNotConnected)
+}
+
+func TestDroneRouterUseCase_SendToDrone_OwnerGone(t *testing.T) {
+	mockLocal := mocks.NewMockDroneNotifier(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
+	mockBus := mocks.NewMockMessageBus(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneRouterUseCase(mockLocal, mockRegistry, mockBus, "replica-a", mockLogger)
+
+	ctx := context.Background()
+	command := &droneproto.Command{Command: droneproto.CommandCancelDelivery}
+	mockLocal.On("SendToDrone", ctx, "drone-1", command).Return(errLocalNotConnected)
+	mockRegistry.On("Owner", ctx, "drone-1").Return("replica-b", nil)
+	mockBus.On("Publish", ctx, entity.InstanceChannel("replica-b"), mock.Anything).Return(int64(0), nil)
+
+	err := uc.SendToDrone(ctx, "drone-1", command)
+
+	assert.ErrorIs(t, err, entityError.ErrDroneNotConnected)
+}
+
+func TestDroneRouterUseCase_HandleRouted(t *testing.T) {
+	mockLocal := mocks.NewMockDroneNotifier(t)
+	mockRegistry := mocks.NewMockConnectionRegistry(t)
+	mockBus := mocks.NewMockMessageBus(t)
+	mockLogger := mocks.NewMockLogger(t)
+	uc := NewDroneRouterUseCase(mockLocal, mockRegistry, mockBus, "replica-b", mockLogger)
+
+	ctx := context.Background()
+	payload := json.RawMessage(`{"command_id":"cmd-1","command":"cancel_delivery"}`)
+	data, err := json.Marshal(entity.RoutedMessage{DroneID: "drone-1", Type: "command", Payload: payload, Origin: "replica-a"})
+	require.NoError(t, err)
+
+	mockLocal.On("SendToDrone", ctx, "drone-1", mock.MatchedBy(func(msg droneproto.Message) bool {
+		relayed, ok := msg.(*droneproto.Relayed)
+		return ok && relayed.Type == droneproto.TypeCommand && string(relayed.Payload) == string(payload)
+	})).Return(nil)
+
+	uc.handleRouted(ctx, data)
+}
@@ -4,39 +4,65 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
-	"github.com/mitchellh/mapstructure"
+	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	entityError "github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/rabbitmq"
 )
 
+const telemetryPublishTimeout = 2 * time.Second
+
+// DroneTelemetryUseCase persists drone state and forwards it to the orchestrator telemetry exchange.
+// Heartbeats are published at most once per publishInterval per drone; status changes are published immediately.
 type DroneTelemetryUseCase struct {
-	droneRepo repo.DroneRepo
-	logger    logger.Interface
+	droneRepo       repo.DroneRepo
+	history         TelemetryRecorder
+	rabbitmqClient  rabbitmq.RabbitMQClient
+	admins          AdminNotifier
+	publishInterval time.Duration
+	logger          logger.Interface
+
+	mu        sync.Mutex
+	published map[string]publishedTelemetry
+}
+
+type publishedTelemetry struct {
+	status entity.DroneStatus
+	at     time.Time
 }
 
 func NewDroneTelemetryUseCase(
 	droneRepo repo.DroneRepo,
+	history TelemetryRecorder,
+	rabbitmqClient rabbitmq.RabbitMQClient,
+	publishInterval time.Duration,
 	logger logger.Interface,
 ) *DroneTelemetryUseCase {
 	return &DroneTelemetryUseCase{
-		droneRepo: droneRepo,
-		logger:    logger,
+		droneRepo:       droneRepo,
+		history:         history,
+		rabbitmqClient:  rabbitmqClient,
+		publishInterval: publishInterval,
+		logger:          logger,
+		published:       make(map[string]publishedTelemetry),
 	}
 }
 
-func (uc *DroneTelemetryUseCase) parseHeartbeatPayload(droneID string, payload map[string]any) (*entity.DroneState, error) {
-	var hb entity.HeartbeatPayload
-	decoder, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		TagName: "json",
-		Result:  &hb,
-	})
-	if err := decoder.Decode(payload); err != nil {
-		return nil, fmt.Errorf("DroneTelemetryUseCase - parseHeartbeatPayload - Decode: %w", err)
+// SetAdminNotifier wires the admin feed, which is created after this use case.
+func (uc *DroneTelemetryUseCase) SetAdminNotifier(admins AdminNotifier) {
+	uc.admins = admins
+}
+
+func (uc *DroneTelemetryUseCase) parseHeartbeatPayload(droneID string, payload *entity.HeartbeatPayload) (*entity.DroneState, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("DroneTelemetryUseCase - parseHeartbeatPayload: %w", entityError.ErrInvalidPayload)
 	}
+	hb := *payload
 
 	if hb.Status == "" {
 		hb.Status = "idle"
@@ -70,15 +96,11 @@ func (uc *DroneTelemetryUseCase) parseHeartbeatPayload(droneID string, payload m
 	return state, nil
 }
 
-func (uc *DroneTelemetryUseCase) parseStatusUpdatePayload(droneID string, payload map[string]any) (*entity.DroneState, error) {
-	var su entity.StatusUpdatePayload
-	decoder, _ := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		TagName: "json",
-		Result:  &su,
-	})
-	if err := decoder.Decode(payload); err != nil {
-		return nil, fmt.Errorf("DroneTelemetryUseCase - parseStatusUpdatePayload - Decode: %w", err)
+func (uc *DroneTelemetryUseCase) parseStatusUpdatePayload(droneID string, payload *entity.StatusUpdatePayload) (*entity.DroneState, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("DroneTelemetryUseCase - parseStatusUpdatePayload: %w", entityError.ErrInvalidPayload)
 	}
+	su := *payload
 
 	state := &entity.DroneState{
 		DroneID:         droneID,
@@ -100,7 +122,7 @@ func (uc *DroneTelemetryUseCase) parseStatusUpdatePayload(droneID string, payloa
 	return state, nil
 }
 
-func (uc *DroneTelemetryUseCase) ProcessHeartbeat(ctx context.Context, droneID string, payload map[string]any) error {
+func (uc *DroneTelemetryUseCase) ProcessHeartbeat(ctx context.Context, droneID string, payload *entity.HeartbeatPayload) error {
 	state, err := uc.parseHeartbeatPayload(droneID, payload)
 	if err != nil {
 		if errors.Is(err, entityError.ErrInvalidPayload) || errors.Is(err, entityError.ErrInvalidValue) {
@@ -129,10 +151,14 @@ func (uc *DroneTelemetryUseCase) ProcessHeartbeat(ctx context.Context, droneID s
 		return fmt.Errorf("DroneTelemetryUseCase - ProcessHeartbeat - SaveDroneState: %w", err)
 	}
 
+	uc.recordHistory(state)
+	uc.publishState(ctx, state, false)
+	uc.notifyAdmins(ctx, state)
+
 	return nil
 }
 
-func (uc *DroneTelemetryUseCase) ProcessStatusUpdate(ctx context.Context, droneID string, payload map[string]any) error {
+func (uc *DroneTelemetryUseCase) ProcessStatusUpdate(ctx context.Context, droneID string, payload *entity.StatusUpdatePayload) error {
 	state, err := uc.parseStatusUpdatePayload(droneID, payload)
 	if err != nil {
 		if errors.Is(err, entityError.ErrInvalidPayload) {
@@ -151,5 +177,100 @@ func (uc *DroneTelemetryUseCase) ProcessStatusUpdate(ctx context.Context, droneI
 		return fmt.Errorf("DroneTelemetryUseCase - ProcessStatusUpdate - SaveDroneState: %w", err)
 	}
 
+	uc.recordHistory(state)
+	uc.publishState(ctx, state, true)
+	uc.notifyAdmins(ctx, state)
+
 	return nil
 }
+
+// MarkOffline records that the drone stopped reporting. Its last position is kept, and deliveryID, when
+// set, is kept as its current delivery so the mission can be resumed when it reconnects.
+func (uc *DroneTelemetryUseCase) MarkOffline(ctx context.Context, droneID, deliveryID, reason string) error {
+	state, err := uc.droneRepo.GetDroneState(ctx, droneID)
+	if err != nil {
+		return fmt.Errorf("DroneTelemetryUseCase - MarkOffline - GetDroneState: %w", err)
+	}
+
+	state.Status = entity.DroneStatusOffline
+	state.ErrorMessage = &reason
+	state.LastUpdated = time.Now()
+	if deliveryID != "" {
+		state.CurrentDeliveryID = &deliveryID
+	}
+
+	if err := uc.droneRepo.SaveDroneState(ctx, state); err != nil {
+		uc.logger.Error("DroneTelemetryUseCase - MarkOffline - SaveDroneState", err, map[string]any{
+			"droneID": droneID,
+		})
+		return fmt.Errorf("DroneTelemetryUseCase - MarkOffline - SaveDroneState: %w", err)
+	}
+
+	uc.publishState(ctx, state, true)
+	uc.notifyAdmins(ctx, state)
+
+	return nil
+}
+
+func (uc *DroneTelemetryUseCase) recordHistory(state *entity.DroneState) {
+	if uc.history != nil {
+		uc.history.Record(state)
+	}
+}
+
+// notifyAdmins is not throttled: admins see every state the drone reports.
+func (uc *DroneTelemetryUseCase) notifyAdmins(ctx context.Context, state *entity.DroneState) {
+	if uc.admins != nil {
+		uc.admins.NotifyAdmins(ctx, entity.DroneStateEvent(state))
+	}
+}
+
+// publishState forwards the state to the telemetry exchange unless the drone was published less than
+// publishInterval ago with the same status. Publishing is best effort: the state is already persisted.
+func (uc *DroneTelemetryUseCase) publishState(ctx context.Context, state *entity.DroneState, force bool) {
+	if uc.rabbitmqClient == nil {
+		return
+	}
+
+	if !uc.shouldPublish(state, force) {
+		return
+	}
+
+	update := rabbitmq.DroneStatusUpdate{
+		DroneID:      state.DroneID,
+		Status:       string(state.Status),
+		BatteryLevel: state.BatteryLevel,
+		Latitude:     state.CurrentPosition.Latitude,
+		Longitude:    state.CurrentPosition.Longitude,
+		Altitude:     state.CurrentPosition.Altitude,
+		Speed:        state.Speed,
+		UpdatedAt:    state.LastUpdated.Unix(),
+	}
+	if state.CurrentDeliveryID != nil {
+		if _, err := uuid.Parse(*state.CurrentDeliveryID); err == nil {
+			update.CurrentDeliveryID = *state.CurrentDeliveryID
+		}
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, telemetryPublishTimeout)
+	defer cancel()
+
+	if err := uc.rabbitmqClient.PublishToExchange(publishCtx, rabbitmq.ExchangeDroneTelemetry, "", update); err != nil {
+		uc.logger.Warn("DroneTelemetryUseCase - publishState - PublishToExchange", err, map[string]any{
+			"droneID": state.DroneID,
+		})
+	}
+}
+
+func (uc *DroneTelemetryUseCase) shouldPublish(state *entity.DroneState, force bool) bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	last, ok := uc.published[state.DroneID]
+	if !force && ok && last.status == state.Status && state.LastUpdated.Sub(last.at) < uc.publishInterval {
+		return false
+	}
+
+	uc.published[state.DroneID] = publishedTelemetry{status: state.Status, at: state.LastUpdated}
+	return true
+}
@@ -2,10 +2,13 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase/mocks"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/rabbitmq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -14,20 +17,20 @@ func TestDroneTelemetryUseCase_ProcessHeartbeat_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
 
-	uc := NewDroneTelemetryUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneTelemetryUseCase(mockDroneRepo, nil, nil, 0, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
-	payload := map[string]any{
-		"status":              "flying",
-		"battery_level":       75.5,
-		"current_delivery_id": "delivery-456",
-		"position": map[string]any{
-			"latitude":  55.7558,
-			"longitude": 37.6173,
-			"altitude":  100.0,
+	payload := &entity.HeartbeatPayload{
+		Status:            "flying",
+		BatteryLevel:      75.5,
+		CurrentDeliveryID: "delivery-456",
+		Position: entity.Position{
+			Latitude:  55.7558,
+			Longitude: 37.6173,
+			Altitude:  100.0,
 		},
-		"speed": 15.5,
+		Speed: 15.5,
 	}
 
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
@@ -50,11 +53,11 @@ func TestDroneTelemetryUseCase_ProcessHeartbeat_DefaultValues(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
 
-	uc := NewDroneTelemetryUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneTelemetryUseCase(mockDroneRepo, nil, nil, 0, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
-	payload := map[string]any{}
+	payload := &entity.HeartbeatPayload{}
 
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
@@ -75,20 +78,20 @@ func TestDroneTelemetryUseCase_ProcessStatusUpdate_Success(t *testing.T) {
 	mockDroneRepo := mocks.NewMockDroneRepo(t)
 	mockLogger := mocks.NewMockLogger(t)
 
-	uc := NewDroneTelemetryUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneTelemetryUseCase(mockDroneRepo, nil, nil, 0, mockLogger)
 
 	ctx := context.Background()
 	droneID := "drone-123"
-	payload := map[string]any{
-		"status":              "delivering",
-		"battery_level":       80.0,
-		"current_delivery_id": "delivery-789",
-		"position": map[string]any{
-			"latitude":  55.7558,
-			"longitude": 37.6173,
-			"altitude":  150.0,
+	payload := &entity.StatusUpdatePayload{
+		Status:            "delivering",
+		BatteryLevel:      80.0,
+		CurrentDeliveryID: "delivery-789",
+		Position: entity.Position{
+			Latitude:  55.7558,
+			Longitude: 37.6173,
+			Altitude:  150.0,
 		},
-		"speed": 20.0,
+		Speed: 20.0,
 	}
 
 	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
@@ -103,3 +106,106 @@ func TestDroneTelemetryUseCase_ProcessStatusUpdate_Success(t *testing.T) {
 	assert.NoError(t, err)
 	mockDroneRepo.AssertExpectations(t)
 }
+
+func TestDroneTelemetryUseCase_ProcessHeartbeat_PublishThrottled(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockRabbitMQ := mocks.NewMockRabbitMQClient(t)
+	mockLogger := mocks.NewMockLogger(t)
+
+	uc := NewDroneTelemetryUseCase(mockDroneRepo, nil, mockRabbitMQ, time.Minute, mockLogger)
+
+	ctx := context.Background()
+	droneID := "6f1c2a4e-3b1d-4c8e-9a0f-2d5e7b9c1a3f"
+	deliveryID := "0b7f3c2d-8e4a-4f6b-a1c9-5d2e3f4a6b7c"
+	payload := &entity.HeartbeatPayload{
+		Status:            "in_transit",
+		BatteryLevel:      80.0,
+		CurrentDeliveryID: deliveryID,
+		Speed:             12.0,
+	}
+
+	mockDroneRepo.On("UpdateDroneBattery", ctx, droneID, 80.0).Return(nil).Twice()
+	mockDroneRepo.On("SaveDroneState", ctx, mock.AnythingOfType("*entity.DroneState")).Return(nil).Twice()
+	mockRabbitMQ.On("PublishToExchange", mock.Anything, rabbitmq.ExchangeDroneTelemetry, "", mock.MatchedBy(func(u rabbitmq.DroneStatusUpdate) bool {
+		return u.DroneID == droneID && u.Status == "in_transit" && u.CurrentDeliveryID == deliveryID && u.Speed == 12.0
+	})).Return(nil).Once()
+
+	assert.NoError(t, uc.ProcessHeartbeat(ctx, droneID, payload))
+	assert.NoError(t, uc.ProcessHeartbeat(ctx, droneID, payload))
+}
+
+func TestDroneTelemetryUseCase_PublishOnStatusChange(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockRabbitMQ := mocks.NewMockRabbitMQClient(t)
+	mockLogger := mocks.NewMockLogger(t)
+
+	uc := NewDroneTelemetryUseCase(mockDroneRepo, nil, mockRabbitMQ, time.Minute, mockLogger)
+
+	ctx := context.Background()
+	droneID := "6f1c2a4e-3b1d-4c8e-9a0f-2d5e7b9c1a3f"
+
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+	mockDroneRepo.On("UpdateDroneBattery", ctx, droneID, mock.AnythingOfType("float64")).Return(nil)
+	mockDroneRepo.On("SaveDroneState", ctx, mock.AnythingOfType("*entity.DroneState")).Return(nil)
+	mockRabbitMQ.On("PublishToExchange", mock.Anything, rabbitmq.ExchangeDroneTelemetry, "", mock.MatchedBy(func(u rabbitmq.DroneStatusUpdate) bool {
+		return u.Status == "idle" && u.CurrentDeliveryID == ""
+	})).Return(nil).Once()
+	mockRabbitMQ.On("PublishToExchange", mock.Anything, rabbitmq.ExchangeDroneTelemetry, "", mock.MatchedBy(func(u rabbitmq.DroneStatusUpdate) bool {
+		return u.Status == "in_transit"
+	})).Return(errors.New("channel closed")).Once()
+	mockRabbitMQ.On("PublishToExchange", mock.Anything, rabbitmq.ExchangeDroneTelemetry, "", mock.MatchedBy(func(u rabbitmq.DroneStatusUpdate) bool {
+		return u.Status == "returning"
+	})).Return(nil).Once()
+
+	assert.NoError(t, uc.ProcessHeartbeat(ctx, droneID, &entity.HeartbeatPayload{Status: "idle", CurrentDeliveryID: "delivery-456"}))
+	assert.NoError(t, uc.ProcessHeartbeat(ctx, droneID, &entity.HeartbeatPayload{Status: "in_transit"}))
+	assert.NoError(t, uc.ProcessStatusUpdate(ctx, droneID, &entity.StatusUpdatePayload{Status: "returning"}))
+}
+
+func TestDroneTelemetryUseCase_ProcessStatusUpdate_RecordsHistory(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockHistory := mocks.NewMockTelemetryRecorder(t)
+	mockLogger := mocks.NewMockLogger(t)
+
+	uc := NewDroneTelemetryUseCase(mockDroneRepo, mockHistory, nil, 0, mockLogger)
+
+	ctx := context.Background()
+	droneID := "drone-123"
+
+	mockDroneRepo.On("SaveDroneState", ctx, mock.AnythingOfType("*entity.DroneState")).Return(nil)
+	mockHistory.On("Record", mock.MatchedBy(func(s *entity.DroneState) bool {
+		return s.DroneID == droneID && s.Status == entity.DroneStatusDelivering
+	})).Return().Once()
+
+	assert.NoError(t, uc.ProcessStatusUpdate(ctx, droneID, &entity.StatusUpdatePayload{Status: "delivering"}))
+}
+
+func TestDroneTelemetryUseCase_ProcessHeartbeat_NotifiesAdminsUnthrottled(t *testing.T) {
+	mockDroneRepo := mocks.NewMockDroneRepo(t)
+	mockAdmins := mocks.NewMockAdminNotifier(t)
+	mockLogger := mocks.NewMockLogger(t)
+
+	uc := NewDroneTelemetryUseCase(mockDroneRepo, nil, nil, time.Minute, mockLogger)
+	uc.SetAdminNotifier(mockAdmins)
+
+	ctx := context.Background()
+	droneID := "drone-123"
+	payload := &entity.HeartbeatPayload{
+		Status:            "in_transit",
+		BatteryLevel:      75.5,
+		CurrentDeliveryID: "delivery-456",
+	}
+
+	mockDroneRepo.On("UpdateDroneBattery", ctx, droneID, 75.5).Return(nil)
+	mockDroneRepo.On("SaveDroneState", ctx, mock.Anything).Return(nil)
+	mockAdmins.On("NotifyAdmins", ctx, mock.MatchedBy(func(event *entity.AdminEvent) bool {
+		return event.Type == entity.AdminEventDroneState &&
+			event.DroneID == droneID &&
+			event.DeliveryID == "delivery-456" &&
+			event.Payload["status"] == entity.DroneStatusInTransit &&
+			event.Payload["battery_level"] == 75.5
+	})).Return().Twice()
+
+	assert.NoError(t, uc.ProcessHeartbeat(ctx, droneID, payload))
+	assert.NoError(t, uc.ProcessHeartbeat(ctx, droneID, payload))
+}
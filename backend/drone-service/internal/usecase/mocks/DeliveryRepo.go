@@ -38,6 +38,72 @@ func (_m *MockDeliveryRepo) EXPECT() *MockDeliveryRepo_Expecter {
 	return &MockDeliveryRepo_Expecter{mock: &_m.Mock}
 }
 
+// GetActiveDeliveryID provides a mock function for the type MockDeliveryRepo
+func (_mock *MockDeliveryRepo) GetActiveDeliveryID(ctx context.Context, droneID string) (string, error) {
+	ret := _mock.Called(ctx, droneID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveDeliveryID")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, droneID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, droneID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, droneID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveryRepo_GetActiveDeliveryID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveDeliveryID'
+type MockDeliveryRepo_GetActiveDeliveryID_Call struct {
+	*mock.Call
+}
+
+// GetActiveDeliveryID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - droneID string
+func (_e *MockDeliveryRepo_Expecter) GetActiveDeliveryID(ctx interface{}, droneID interface{}) *MockDeliveryRepo_GetActiveDeliveryID_Call {
+	return &MockDeliveryRepo_GetActiveDeliveryID_Call{Call: _e.mock.On("GetActiveDeliveryID", ctx, droneID)}
+}
+
+func (_c *MockDeliveryRepo_GetActiveDeliveryID_Call) Run(run func(ctx context.Context, droneID string)) *MockDeliveryRepo_GetActiveDeliveryID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveryRepo_GetActiveDeliveryID_Call) Return(s string, err error) *MockDeliveryRepo_GetActiveDeliveryID_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockDeliveryRepo_GetActiveDeliveryID_Call) RunAndReturn(run func(ctx context.Context, droneID string) (string, error)) *MockDeliveryRepo_GetActiveDeliveryID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDeliveryTask provides a mock function for the type MockDeliveryRepo
 func (_mock *MockDeliveryRepo) GetDeliveryTask(ctx context.Context, deliveryID string) (*entity.DeliveryTask, error) {
 	ret := _mock.Called(ctx, deliveryID)
@@ -163,6 +229,84 @@ func (_c *MockDeliveryRepo_SaveDeliveryTask_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// TransitionDeliveryStatus provides a mock function for the type MockDeliveryRepo
+func (_mock *MockDeliveryRepo) TransitionDeliveryStatus(ctx context.Context, deliveryID string, from entity.DeliveryStatus, to entity.DeliveryStatus) (bool, error) {
+	ret := _mock.Called(ctx, deliveryID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionDeliveryStatus")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, entity.DeliveryStatus, entity.DeliveryStatus) (bool, error)); ok {
+		return returnFunc(ctx, deliveryID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, entity.DeliveryStatus, entity.DeliveryStatus) bool); ok {
+		r0 = returnFunc(ctx, deliveryID, from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, entity.DeliveryStatus, entity.DeliveryStatus) error); ok {
+		r1 = returnFunc(ctx, deliveryID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveryRepo_TransitionDeliveryStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionDeliveryStatus'
+type MockDeliveryRepo_TransitionDeliveryStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionDeliveryStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryID string
+//   - from entity.DeliveryStatus
+//   - to entity.DeliveryStatus
+func (_e *MockDeliveryRepo_Expecter) TransitionDeliveryStatus(ctx interface{}, deliveryID interface{}, from interface{}, to interface{}) *MockDeliveryRepo_TransitionDeliveryStatus_Call {
+	return &MockDeliveryRepo_TransitionDeliveryStatus_Call{Call: _e.mock.On("TransitionDeliveryStatus", ctx, deliveryID, from, to)}
+}
+
+func (_c *MockDeliveryRepo_TransitionDeliveryStatus_Call) Run(run func(ctx context.Context, deliveryID string, from entity.DeliveryStatus, to entity.DeliveryStatus)) *MockDeliveryRepo_TransitionDeliveryStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 entity.DeliveryStatus
+		if args[2] != nil {
+			arg2 = args[2].(entity.DeliveryStatus)
+		}
+		var arg3 entity.DeliveryStatus
+		if args[3] != nil {
+			arg3 = args[3].(entity.DeliveryStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveryRepo_TransitionDeliveryStatus_Call) Return(b bool, err error) *MockDeliveryRepo_TransitionDeliveryStatus_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockDeliveryRepo_TransitionDeliveryStatus_Call) RunAndReturn(run func(ctx context.Context, deliveryID string, from entity.DeliveryStatus, to entity.DeliveryStatus) (bool, error)) *MockDeliveryRepo_TransitionDeliveryStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDeliveryStatus provides a mock function for the type MockDeliveryRepo
 func (_mock *MockDeliveryRepo) UpdateDeliveryStatus(ctx context.Context, deliveryID string, status entity.DeliveryStatus, errorMessage *string) error {
 	ret := _mock.Called(ctx, deliveryID, status, errorMessage)
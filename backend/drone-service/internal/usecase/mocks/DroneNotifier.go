@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/droneproto"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,7 +39,7 @@ func (_m *MockDroneNotifier) EXPECT() *MockDroneNotifier_Expecter {
 }
 
 // SendToDrone provides a mock function for the type MockDroneNotifier
-func (_mock *MockDroneNotifier) SendToDrone(ctx context.Context, droneID string, message map[string]any) error {
+func (_mock *MockDroneNotifier) SendToDrone(ctx context.Context, droneID string, message droneproto.Message) error {
 	ret := _mock.Called(ctx, droneID, message)
 
 	if len(ret) == 0 {
@@ -46,7 +47,7 @@ func (_mock *MockDroneNotifier) SendToDrone(ctx context.Context, droneID string,
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]any) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, droneproto.Message) error); ok {
 		r0 = returnFunc(ctx, droneID, message)
 	} else {
 		r0 = ret.Error(0)
@@ -62,12 +63,12 @@ type MockDroneNotifier_SendToDrone_Call struct {
 // SendToDrone is a helper method to define mock.On call
 //   - ctx context.Context
 //   - droneID string
-//   - message map[string]any
+//   - message droneproto.Message
 func (_e *MockDroneNotifier_Expecter) SendToDrone(ctx interface{}, droneID interface{}, message interface{}) *MockDroneNotifier_SendToDrone_Call {
 	return &MockDroneNotifier_SendToDrone_Call{Call: _e.mock.On("SendToDrone", ctx, droneID, message)}
 }
 
-func (_c *MockDroneNotifier_SendToDrone_Call) Run(run func(ctx context.Context, droneID string, message map[string]any)) *MockDroneNotifier_SendToDrone_Call {
+func (_c *MockDroneNotifier_SendToDrone_Call) Run(run func(ctx context.Context, droneID string, message droneproto.Message)) *MockDroneNotifier_SendToDrone_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -77,9 +78,9 @@ func (_c *MockDroneNotifier_SendToDrone_Call) Run(run func(ctx context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 map[string]any
+		var arg2 droneproto.Message
 		if args[2] != nil {
-			arg2 = args[2].(map[string]any)
+			arg2 = args[2].(droneproto.Message)
 		}
 		run(
 			arg0,
@@ -95,7 +96,7 @@ func (_c *MockDroneNotifier_SendToDrone_Call) Return(err error) *MockDroneNotifi
 	return _c
 }
 
-func (_c *MockDroneNotifier_SendToDrone_Call) RunAndReturn(run func(ctx context.Context, droneID string, message map[string]any) error) *MockDroneNotifier_SendToDrone_Call {
+func (_c *MockDroneNotifier_SendToDrone_Call) RunAndReturn(run func(ctx context.Context, droneID string, message droneproto.Message) error) *MockDroneNotifier_SendToDrone_Call {
 	_c.Call.Return(run)
 	return _c
 }
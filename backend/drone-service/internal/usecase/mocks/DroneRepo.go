@@ -38,45 +38,47 @@ func (_m *MockDroneRepo) EXPECT() *MockDroneRepo_Expecter {
 	return &MockDroneRepo_Expecter{mock: &_m.Mock}
 }
 
-// GetDroneIDByIP provides a mock function for the type MockDroneRepo
-func (_mock *MockDroneRepo) GetDroneIDByIP(ctx context.Context, ipAddress string) (string, error) {
-	ret := _mock.Called(ctx, ipAddress)
+// GetCredential provides a mock function for the type MockDroneRepo
+func (_mock *MockDroneRepo) GetCredential(ctx context.Context, droneID string) (*entity.DroneCredential, error) {
+	ret := _mock.Called(ctx, droneID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDroneIDByIP")
+		panic("no return value specified for GetCredential")
 	}
 
-	var r0 string
+	var r0 *entity.DroneCredential
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return returnFunc(ctx, ipAddress)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entity.DroneCredential, error)); ok {
+		return returnFunc(ctx, droneID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = returnFunc(ctx, ipAddress)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entity.DroneCredential); ok {
+		r0 = returnFunc(ctx, droneID)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.DroneCredential)
+		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, ipAddress)
+		r1 = returnFunc(ctx, droneID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockDroneRepo_GetDroneIDByIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDroneIDByIP'
-type MockDroneRepo_GetDroneIDByIP_Call struct {
+// MockDroneRepo_GetCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredential'
+type MockDroneRepo_GetCredential_Call struct {
 	*mock.Call
 }
 
-// GetDroneIDByIP is a helper method to define mock.On call
+// GetCredential is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ipAddress string
-func (_e *MockDroneRepo_Expecter) GetDroneIDByIP(ctx interface{}, ipAddress interface{}) *MockDroneRepo_GetDroneIDByIP_Call {
-	return &MockDroneRepo_GetDroneIDByIP_Call{Call: _e.mock.On("GetDroneIDByIP", ctx, ipAddress)}
+//   - droneID string
+func (_e *MockDroneRepo_Expecter) GetCredential(ctx interface{}, droneID interface{}) *MockDroneRepo_GetCredential_Call {
+	return &MockDroneRepo_GetCredential_Call{Call: _e.mock.On("GetCredential", ctx, droneID)}
 }
 
-func (_c *MockDroneRepo_GetDroneIDByIP_Call) Run(run func(ctx context.Context, ipAddress string)) *MockDroneRepo_GetDroneIDByIP_Call {
+func (_c *MockDroneRepo_GetCredential_Call) Run(run func(ctx context.Context, droneID string)) *MockDroneRepo_GetCredential_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -94,12 +96,80 @@ func (_c *MockDroneRepo_GetDroneIDByIP_Call) Run(run func(ctx context.Context, i
 	return _c
 }
 
-func (_c *MockDroneRepo_GetDroneIDByIP_Call) Return(s string, err error) *MockDroneRepo_GetDroneIDByIP_Call {
-	_c.Call.Return(s, err)
+func (_c *MockDroneRepo_GetCredential_Call) Return(droneCredential *entity.DroneCredential, err error) *MockDroneRepo_GetCredential_Call {
+	_c.Call.Return(droneCredential, err)
 	return _c
 }
 
-func (_c *MockDroneRepo_GetDroneIDByIP_Call) RunAndReturn(run func(ctx context.Context, ipAddress string) (string, error)) *MockDroneRepo_GetDroneIDByIP_Call {
+func (_c *MockDroneRepo_GetCredential_Call) RunAndReturn(run func(ctx context.Context, droneID string) (*entity.DroneCredential, error)) *MockDroneRepo_GetCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDrone provides a mock function for the type MockDroneRepo
+func (_mock *MockDroneRepo) GetDrone(ctx context.Context, droneID string) (*entity.FleetDrone, error) {
+	ret := _mock.Called(ctx, droneID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDrone")
+	}
+
+	var r0 *entity.FleetDrone
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entity.FleetDrone, error)); ok {
+		return returnFunc(ctx, droneID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entity.FleetDrone); ok {
+		r0 = returnFunc(ctx, droneID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.FleetDrone)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, droneID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDroneRepo_GetDrone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDrone'
+type MockDroneRepo_GetDrone_Call struct {
+	*mock.Call
+}
+
+// GetDrone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - droneID string
+func (_e *MockDroneRepo_Expecter) GetDrone(ctx interface{}, droneID interface{}) *MockDroneRepo_GetDrone_Call {
+	return &MockDroneRepo_GetDrone_Call{Call: _e.mock.On("GetDrone", ctx, droneID)}
+}
+
+func (_c *MockDroneRepo_GetDrone_Call) Run(run func(ctx context.Context, droneID string)) *MockDroneRepo_GetDrone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDroneRepo_GetDrone_Call) Return(fleetDrone *entity.FleetDrone, err error) *MockDroneRepo_GetDrone_Call {
+	_c.Call.Return(fleetDrone, err)
+	return _c
+}
+
+func (_c *MockDroneRepo_GetDrone_Call) RunAndReturn(run func(ctx context.Context, droneID string) (*entity.FleetDrone, error)) *MockDroneRepo_GetDrone_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -172,6 +242,68 @@ func (_c *MockDroneRepo_GetDroneState_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// ListDrones provides a mock function for the type MockDroneRepo
+func (_mock *MockDroneRepo) ListDrones(ctx context.Context) ([]*entity.FleetDrone, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDrones")
+	}
+
+	var r0 []*entity.FleetDrone
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*entity.FleetDrone, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*entity.FleetDrone); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.FleetDrone)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDroneRepo_ListDrones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDrones'
+type MockDroneRepo_ListDrones_Call struct {
+	*mock.Call
+}
+
+// ListDrones is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDroneRepo_Expecter) ListDrones(ctx interface{}) *MockDroneRepo_ListDrones_Call {
+	return &MockDroneRepo_ListDrones_Call{Call: _e.mock.On("ListDrones", ctx)}
+}
+
+func (_c *MockDroneRepo_ListDrones_Call) Run(run func(ctx context.Context)) *MockDroneRepo_ListDrones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDroneRepo_ListDrones_Call) Return(fleetDrones []*entity.FleetDrone, err error) *MockDroneRepo_ListDrones_Call {
+	_c.Call.Return(fleetDrones, err)
+	return _c
+}
+
+func (_c *MockDroneRepo_ListDrones_Call) RunAndReturn(run func(ctx context.Context) ([]*entity.FleetDrone, error)) *MockDroneRepo_ListDrones_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveDroneState provides a mock function for the type MockDroneRepo
 func (_mock *MockDroneRepo) SaveDroneState(ctx context.Context, state *entity.DroneState) error {
 	ret := _mock.Called(ctx, state)
@@ -229,6 +361,63 @@ func (_c *MockDroneRepo_SaveDroneState_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// TouchCredential provides a mock function for the type MockDroneRepo
+func (_mock *MockDroneRepo) TouchCredential(ctx context.Context, droneID string) error {
+	ret := _mock.Called(ctx, droneID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchCredential")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, droneID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDroneRepo_TouchCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchCredential'
+type MockDroneRepo_TouchCredential_Call struct {
+	*mock.Call
+}
+
+// TouchCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - droneID string
+func (_e *MockDroneRepo_Expecter) TouchCredential(ctx interface{}, droneID interface{}) *MockDroneRepo_TouchCredential_Call {
+	return &MockDroneRepo_TouchCredential_Call{Call: _e.mock.On("TouchCredential", ctx, droneID)}
+}
+
+func (_c *MockDroneRepo_TouchCredential_Call) Run(run func(ctx context.Context, droneID string)) *MockDroneRepo_TouchCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDroneRepo_TouchCredential_Call) Return(err error) *MockDroneRepo_TouchCredential_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDroneRepo_TouchCredential_Call) RunAndReturn(run func(ctx context.Context, droneID string) error) *MockDroneRepo_TouchCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDroneBattery provides a mock function for the type MockDroneRepo
 func (_mock *MockDroneRepo) UpdateDroneBattery(ctx context.Context, droneID string, batteryLevel float64) error {
 	ret := _mock.Called(ctx, droneID, batteryLevel)
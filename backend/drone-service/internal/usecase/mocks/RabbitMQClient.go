@@ -99,3 +99,72 @@ func (_c *MockRabbitMQClient_Publish_Call) RunAndReturn(run func(ctx context.Con
 	_c.Call.Return(run)
 	return _c
 }
+
+// PublishToExchange provides a mock function for the type MockRabbitMQClient
+func (_mock *MockRabbitMQClient) PublishToExchange(ctx context.Context, exchange string, routingKey string, message any) error {
+	ret := _mock.Called(ctx, exchange, routingKey, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishToExchange")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, any) error); ok {
+		r0 = returnFunc(ctx, exchange, routingKey, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRabbitMQClient_PublishToExchange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishToExchange'
+type MockRabbitMQClient_PublishToExchange_Call struct {
+	*mock.Call
+}
+
+// PublishToExchange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exchange string
+//   - routingKey string
+//   - message any
+func (_e *MockRabbitMQClient_Expecter) PublishToExchange(ctx interface{}, exchange interface{}, routingKey interface{}, message interface{}) *MockRabbitMQClient_PublishToExchange_Call {
+	return &MockRabbitMQClient_PublishToExchange_Call{Call: _e.mock.On("PublishToExchange", ctx, exchange, routingKey, message)}
+}
+
+func (_c *MockRabbitMQClient_PublishToExchange_Call) Run(run func(ctx context.Context, exchange string, routingKey string, message any)) *MockRabbitMQClient_PublishToExchange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRabbitMQClient_PublishToExchange_Call) Return(err error) *MockRabbitMQClient_PublishToExchange_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRabbitMQClient_PublishToExchange_Call) RunAndReturn(run func(ctx context.Context, exchange string, routingKey string, message any) error) *MockRabbitMQClient_PublishToExchange_Call {
+	_c.Call.Return(run)
+	return _c
+}
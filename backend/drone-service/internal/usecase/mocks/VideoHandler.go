@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,16 +39,16 @@ func (_m *MockVideoHandler) EXPECT() *MockVideoHandler_Expecter {
 }
 
 // HandleVideoFrame provides a mock function for the type MockVideoHandler
-func (_mock *MockVideoHandler) HandleVideoFrame(ctx context.Context, droneID string, frameData []byte, deliveryID string) error {
-	ret := _mock.Called(ctx, droneID, frameData, deliveryID)
+func (_mock *MockVideoHandler) HandleVideoFrame(ctx context.Context, frame *entity.VideoFrame) error {
+	ret := _mock.Called(ctx, frame)
 
 	if len(ret) == 0 {
 		panic("no return value specified for HandleVideoFrame")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, string) error); ok {
-		r0 = returnFunc(ctx, droneID, frameData, deliveryID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.VideoFrame) error); ok {
+		r0 = returnFunc(ctx, frame)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -61,36 +62,24 @@ type MockVideoHandler_HandleVideoFrame_Call struct {
 
 // HandleVideoFrame is a helper method to define mock.On call
 //   - ctx context.Context
-//   - droneID string
-//   - frameData []byte
-//   - deliveryID string
-func (_e *MockVideoHandler_Expecter) HandleVideoFrame(ctx interface{}, droneID interface{}, frameData interface{}, deliveryID interface{}) *MockVideoHandler_HandleVideoFrame_Call {
-	return &MockVideoHandler_HandleVideoFrame_Call{Call: _e.mock.On("HandleVideoFrame", ctx, droneID, frameData, deliveryID)}
+//   - frame *entity.VideoFrame
+func (_e *MockVideoHandler_Expecter) HandleVideoFrame(ctx interface{}, frame interface{}) *MockVideoHandler_HandleVideoFrame_Call {
+	return &MockVideoHandler_HandleVideoFrame_Call{Call: _e.mock.On("HandleVideoFrame", ctx, frame)}
 }
 
-func (_c *MockVideoHandler_HandleVideoFrame_Call) Run(run func(ctx context.Context, droneID string, frameData []byte, deliveryID string)) *MockVideoHandler_HandleVideoFrame_Call {
+func (_c *MockVideoHandler_HandleVideoFrame_Call) Run(run func(ctx context.Context, frame *entity.VideoFrame)) *MockVideoHandler_HandleVideoFrame_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *entity.VideoFrame
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 []byte
-		if args[2] != nil {
-			arg2 = args[2].([]byte)
-		}
-		var arg3 string
-		if args[3] != nil {
-			arg3 = args[3].(string)
+			arg1 = args[1].(*entity.VideoFrame)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
@@ -101,7 +90,7 @@ func (_c *MockVideoHandler_HandleVideoFrame_Call) Return(err error) *MockVideoHa
 	return _c
 }
 
-func (_c *MockVideoHandler_HandleVideoFrame_Call) RunAndReturn(run func(ctx context.Context, droneID string, frameData []byte, deliveryID string) error) *MockVideoHandler_HandleVideoFrame_Call {
+func (_c *MockVideoHandler_HandleVideoFrame_Call) RunAndReturn(run func(ctx context.Context, frame *entity.VideoFrame) error) *MockVideoHandler_HandleVideoFrame_Call {
 	_c.Call.Return(run)
 	return _c
 }
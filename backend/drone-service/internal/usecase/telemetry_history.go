@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/pkg/logger"
+)
+
+const telemetryRetentionBatchSize = 5000
+
+// TelemetryHistoryPolicy controls how much of the drone telemetry stream ends up in drone_telemetry.
+// Samples of a drone on a delivery are kept every SampleInterval, samples of a drone without a delivery
+// every IdleSampleInterval. A status or delivery change is always recorded. Samples outside a delivery are
+// deleted after IdleRetention, all other samples after Retention.
+type TelemetryHistoryPolicy struct {
+	SampleInterval     time.Duration
+	IdleSampleInterval time.Duration
+	BatchSize          int
+	MaxBuffered        int
+	Retention          time.Duration
+	IdleRetention      time.Duration
+}
+
+type TelemetryHistoryUseCase struct {
+	telemetryRepo repo.TelemetryRepo
+	policy        TelemetryHistoryPolicy
+	logger        logger.Interface
+	now           func() time.Time
+
+	mu      sync.Mutex
+	buffer  []*entity.TelemetrySample
+	last    map[string]*entity.TelemetrySample
+	flushCh chan struct{}
+
+	flushMu sync.Mutex
+}
+
+func NewTelemetryHistoryUseCase(
+	telemetryRepo repo.TelemetryRepo,
+	policy TelemetryHistoryPolicy,
+	logger logger.Interface,
+) *TelemetryHistoryUseCase {
+	if policy.BatchSize <= 0 {
+		policy.BatchSize = 1
+	}
+	if policy.MaxBuffered < policy.BatchSize {
+		policy.MaxBuffered = policy.BatchSize * 10
+	}
+
+	return &TelemetryHistoryUseCase{
+		telemetryRepo: telemetryRepo,
+		policy:        policy,
+		logger:        logger,
+		now:           time.Now,
+		last:          make(map[string]*entity.TelemetrySample),
+		flushCh:       make(chan struct{}, 1),
+	}
+}
+
+// Record buffers the state as a telemetry sample unless it is downsampled away. It never blocks on the database:
+// a full batch only wakes up the flush worker.
+func (uc *TelemetryHistoryUseCase) Record(state *entity.DroneState) {
+	sample := &entity.TelemetrySample{
+		DroneID:      state.DroneID,
+		DeliveryID:   state.CurrentDeliveryID,
+		Status:       state.Status,
+		BatteryLevel: state.BatteryLevel,
+		Position:     state.CurrentPosition,
+		Speed:        state.Speed,
+		RecordedAt:   state.LastUpdated,
+	}
+	if sample.RecordedAt.IsZero() {
+		sample.RecordedAt = uc.now()
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if !uc.keep(sample) {
+		return
+	}
+	uc.last[sample.DroneID] = sample
+
+	if len(uc.buffer) >= uc.policy.MaxBuffered {
+		uc.buffer = uc.buffer[1:]
+	}
+	uc.buffer = append(uc.buffer, sample)
+
+	if len(uc.buffer) >= uc.policy.BatchSize {
+		select {
+		case uc.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (uc *TelemetryHistoryUseCase) keep(sample *entity.TelemetrySample) bool {
+	last, ok := uc.last[sample.DroneID]
+	if !ok {
+		return true
+	}
+	if last.Status != sample.Status || !equalStringPtr(last.DeliveryID, sample.DeliveryID) {
+		return true
+	}
+
+	interval := uc.policy.SampleInterval
+	if sample.DeliveryID == nil {
+		interval = uc.policy.IdleSampleInterval
+	}
+	return sample.RecordedAt.Sub(last.RecordedAt) >= interval
+}
+
+// StartFlushWorker writes buffered samples every interval or as soon as a batch is full.
+// The remaining samples are flushed when ctx is cancelled.
+func (uc *TelemetryHistoryUseCase) StartFlushWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			uc.Flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			uc.Flush(ctx)
+		case <-uc.flushCh:
+			uc.Flush(ctx)
+		}
+	}
+}
+
+// Flush writes all buffered samples in batches. A failed batch is put back into the buffer
+// so that it is retried on the next flush, within the MaxBuffered limit.
+func (uc *TelemetryHistoryUseCase) Flush(ctx context.Context) {
+	uc.flushMu.Lock()
+	defer uc.flushMu.Unlock()
+
+	for {
+		uc.mu.Lock()
+		n := min(len(uc.buffer), uc.policy.BatchSize)
+		batch := uc.buffer[:n:n]
+		uc.buffer = uc.buffer[n:]
+		uc.mu.Unlock()
+
+		if len(batch) == 0 {
+			return
+		}
+
+		if _, err := uc.telemetryRepo.InsertTelemetrySamples(ctx, batch); err != nil {
+			uc.logger.Error("TelemetryHistoryUseCase - Flush - InsertTelemetrySamples", err, map[string]any{
+				"samples": len(batch),
+			})
+			uc.requeue(batch)
+			return
+		}
+	}
+}
+
+func (uc *TelemetryHistoryUseCase) requeue(batch []*entity.TelemetrySample) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	buffer := append(batch, uc.buffer...)
+	if dropped := len(buffer) - uc.policy.MaxBuffered; dropped > 0 {
+		uc.logger.Warn("TelemetryHistoryUseCase - requeue - buffer full, dropping oldest samples", nil, map[string]any{
+			"dropped": dropped,
+		})
+		buffer = buffer[dropped:]
+	}
+	uc.buffer = buffer
+}
+
+func (uc *TelemetryHistoryUseCase) StartRetentionWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.applyRetention(ctx)
+		}
+	}
+}
+
+func (uc *TelemetryHistoryUseCase) applyRetention(ctx context.Context) {
+	now := uc.now()
+	missionBefore := now.Add(-uc.policy.Retention)
+	idleBefore := now.Add(-uc.policy.IdleRetention)
+
+	var total int64
+	for {
+		deleted, err := uc.telemetryRepo.DeleteTelemetryBefore(ctx, missionBefore, idleBefore, telemetryRetentionBatchSize)
+		if err != nil {
+			uc.logger.Error("TelemetryHistoryUseCase - applyRetention - DeleteTelemetryBefore", err, nil)
+			return
+		}
+		total += deleted
+		if deleted < telemetryRetentionBatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if total > 0 {
+		uc.logger.Info("Expired drone telemetry deleted", nil, map[string]any{
+			"deleted": total,
+		})
+	}
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
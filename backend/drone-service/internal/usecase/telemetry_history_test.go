@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/drone-service/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestTelemetryHistory(t *testing.T, policy TelemetryHistoryPolicy) (*TelemetryHistoryUseCase, *mocks.MockTelemetryRepo, *mocks.MockLogger) {
+	mockTelemetryRepo := mocks.NewMockTelemetryRepo(t)
+	mockLogger := mocks.NewMockLogger(t)
+	return NewTelemetryHistoryUseCase(mockTelemetryRepo, policy, mockLogger), mockTelemetryRepo, mockLogger
+}
+
+func TestTelemetryHistoryUseCase_Record_Downsampling(t *testing.T) {
+	uc, _, _ := newTestTelemetryHistory(t, TelemetryHistoryPolicy{
+		SampleInterval:     2 * time.Second,
+		IdleSampleInterval: time.Minute,
+		BatchSize:          100,
+	})
+
+	droneID := "6f1c2a4e-3b1d-4c8e-9a0f-2d5e7b9c1a3f"
+	deliveryID := "0b7f3c2d-8e4a-4f6b-a1c9-5d2e3f4a6b7c"
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	uc.Record(&entity.DroneState{DroneID: droneID, Status: entity.DroneStatusIdle, LastUpdated: start})
+	uc.Record(&entity.DroneState{DroneID: droneID, Status: entity.DroneStatusIdle, LastUpdated: start.Add(30 * time.Second)})
+	uc.Record(&entity.DroneState{DroneID: droneID, Status: entity.DroneStatusInTransit, CurrentDeliveryID: &deliveryID, LastUpdated: start.Add(31 * time.Second)})
+	uc.Record(&entity.DroneState{DroneID: droneID, Status: entity.DroneStatusInTransit, CurrentDeliveryID: &deliveryID, LastUpdated: start.Add(32 * time.Second)})
+	uc.Record(&entity.DroneState{DroneID: droneID, Status: entity.DroneStatusInTransit, CurrentDeliveryID: &deliveryID, LastUpdated: start.Add(33 * time.Second)})
+	uc.Record(&entity.DroneState{DroneID: droneID, Status: entity.DroneStatusIdle, LastUpdated: start.Add(34 * time.Second)})
+
+	var recorded []time.Duration
+	for _, sample := range uc.buffer {
+		recorded = append(recorded, sample.RecordedAt.Sub(start))
+	}
+	assert.Equal(t, []time.Duration{0, 31 * time.Second, 33 * time.Second, 34 * time.Second}, recorded)
+}
+
+func TestTelemetryHistoryUseCase_Flush_Batches(t *testing.T) {
+	uc, mockTelemetryRepo, _ := newTestTelemetryHistory(t, TelemetryHistoryPolicy{BatchSize: 2})
+
+	ctx := context.Background()
+	for _, droneID := range []string{"a", "b", "c"} {
+		uc.Record(&entity.DroneState{DroneID: droneID, Status: entity.DroneStatusIdle})
+	}
+
+	mockTelemetryRepo.On("InsertTelemetrySamples", ctx, mock.MatchedBy(func(s []*entity.TelemetrySample) bool { return len(s) == 2 })).Return(int64(2), nil).Once()
+	mockTelemetryRepo.On("InsertTelemetrySamples", ctx, mock.MatchedBy(func(s []*entity.TelemetrySample) bool { return len(s) == 1 })).Return(int64(1), nil).Once()
+
+	uc.Flush(ctx)
+
+	assert.Empty(t, uc.buffer)
+}
+
+func TestTelemetryHistoryUseCase_Flush_RequeuesOnError(t *testing.T) {
+	uc, mockTelemetryRepo, mockLogger := newTestTelemetryHistory(t, TelemetryHistoryPolicy{BatchSize: 2, MaxBuffered: 3})
+
+	ctx := context.Background()
+	for _, droneID := range []string{"a", "b"} {
+		uc.Record(&entity.DroneState{DroneID: droneID, Status: entity.DroneStatusIdle})
+	}
+
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockTelemetryRepo.On("InsertTelemetrySamples", ctx, mock.Anything).Return(int64(0), errors.New("connection reset")).Once()
+
+	uc.Flush(ctx)
+	assert.Len(t, uc.buffer, 2)
+
+	uc.Record(&entity.DroneState{DroneID: "c", Status: entity.DroneStatusIdle})
+	uc.Record(&entity.DroneState{DroneID: "d", Status: entity.DroneStatusIdle})
+	assert.Len(t, uc.buffer, 3)
+	assert.Equal(t, "b", uc.buffer[0].DroneID)
+}
+
+func TestTelemetryHistoryUseCase_ApplyRetention(t *testing.T) {
+	uc, mockTelemetryRepo, mockLogger := newTestTelemetryHistory(t, TelemetryHistoryPolicy{
+		BatchSize:     1,
+		Retention:     90 * 24 * time.Hour,
+		IdleRetention: 7 * 24 * time.Hour,
+	})
+
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	missionBefore := now.Add(-90 * 24 * time.Hour)
+	idleBefore := now.Add(-7 * 24 * time.Hour)
+	mockTelemetryRepo.On("DeleteTelemetryBefore", ctx, missionBefore, idleBefore, telemetryRetentionBatchSize).Return(int64(telemetryRetentionBatchSize), nil).Once()
+	mockTelemetryRepo.On("DeleteTelemetryBefore", ctx, missionBefore, idleBefore, telemetryRetentionBatchSize).Return(int64(10), nil).Once()
+	mockLogger.On("Info", mock.Anything, nil, []map[string]any{{"deleted": int64(telemetryRetentionBatchSize + 10)}}).Return().Once()
+
+	uc.applyRetention(ctx)
+}
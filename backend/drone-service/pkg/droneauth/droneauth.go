@@ -0,0 +1,37 @@
+// Package droneauth implements the challenge-response a drone answers before the drone-service registers
+// it: the service sends a random nonce and the drone proves it holds its key by returning
+// hex(HMAC-SHA256(secret, "<drone_id>:<nonce>")).
+package droneauth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const NonceBytes = 32
+
+func NewNonce() (string, error) {
+	buf := make([]byte, NonceBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func Sign(secret, droneID, nonce string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(droneID + ":" + nonce))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify compares in constant time. A signature that is not valid hex never matches.
+func Verify(secret, droneID, nonce, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	want, _ := hex.DecodeString(Sign(secret, droneID, nonce))
+	return hmac.Equal(got, want)
+}
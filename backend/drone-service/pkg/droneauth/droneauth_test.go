@@ -0,0 +1,33 @@
+package droneauth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	nonce, err := NewNonce()
+	require.NoError(t, err)
+	assert.Len(t, nonce, 2*NonceBytes)
+
+	signature := Sign("dsk_secret", "drone-1", nonce)
+
+	assert.True(t, Verify("dsk_secret", "drone-1", nonce, signature))
+	assert.True(t, Verify("dsk_secret", "drone-1", nonce, strings.ToUpper(signature)))
+	assert.False(t, Verify("dsk_other", "drone-1", nonce, signature))
+	assert.False(t, Verify("dsk_secret", "drone-2", nonce, signature))
+	assert.False(t, Verify("dsk_secret", "drone-1", nonce+"0", signature))
+	assert.False(t, Verify("dsk_secret", "drone-1", nonce, "not-hex"))
+	assert.False(t, Verify("dsk_secret", "drone-1", nonce, ""))
+}
+
+func TestNewNonce_Unique(t *testing.T) {
+	a, err := NewNonce()
+	require.NoError(t, err)
+	b, err := NewNonce()
+	require.NoError(t, err)
+	assert.NotEqual(t, a, b)
+}
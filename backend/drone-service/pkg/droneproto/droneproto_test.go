@@ -0,0 +1,137 @@
+package droneproto
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	marker := 7
+	data, err := Encode(Version1, &Command{Command: CommandReturnToBase, BaseMarkerID: &marker})
+	require.NoError(t, err)
+
+	envelope, err := Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, Version1, envelope.Version)
+	assert.Equal(t, TypeCommand, envelope.Type)
+	assert.NotEmpty(t, envelope.ID)
+	assert.False(t, envelope.Timestamp.IsZero())
+
+	var command Command
+	require.NoError(t, json.Unmarshal(envelope.Payload, &command))
+	assert.Equal(t, CommandReturnToBase, command.Command)
+	require.NotNil(t, command.BaseMarkerID)
+	assert.Equal(t, 7, *command.BaseMarkerID)
+}
+
+func TestEncode_Relayed(t *testing.T) {
+	payload, err := json.Marshal(&Command{CommandID: "cmd-1", Command: CommandCancelDelivery})
+	require.NoError(t, err)
+
+	data, err := Encode(Version1, &Relayed{Type: TypeCommand, Payload: payload})
+	require.NoError(t, err)
+
+	envelope, err := Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, TypeCommand, envelope.Type)
+	assert.JSONEq(t, string(payload), string(envelope.Payload))
+}
+
+func TestEncode_RejectsInvalidMessage(t *testing.T) {
+	_, err := Encode(Version1, &Command{Command: CommandDropCargo})
+	assert.ErrorIs(t, err, ErrInvalid)
+}
+
+func TestCommand_Validate(t *testing.T) {
+	altitude := 40.0
+	tooHigh := MaxAltitude + 1
+
+	tests := []struct {
+		name    string
+		command Command
+		valid   bool
+	}{
+		{"hold", Command{Command: CommandHold}, true},
+		{"goto", Command{Command: CommandGoto, Waypoint: &Position{Latitude: 55.75, Longitude: 37.61, Altitude: 50}}, true},
+		{"goto without waypoint", Command{Command: CommandGoto}, false},
+		{"goto off the map", Command{Command: CommandGoto, Waypoint: &Position{Latitude: 95, Longitude: 37.61, Altitude: 50}}, false},
+		{"goto on the ground", Command{Command: CommandGoto, Waypoint: &Position{Latitude: 55.75, Longitude: 37.61}}, false},
+		{"set altitude", Command{Command: CommandSetAltitude, Altitude: &altitude}, true},
+		{"set altitude without altitude", Command{Command: CommandSetAltitude}, false},
+		{"set altitude above ceiling", Command{Command: CommandSetAltitude, Altitude: &tooHigh}, false},
+		{"abort delivery", Command{Command: CommandAbortDelivery, DeliveryID: "d"}, true},
+		{"abort delivery without delivery", Command{Command: CommandAbortDelivery}, false},
+		{"unknown", Command{Command: "barrel_roll"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.command.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalid)
+			}
+		})
+	}
+}
+
+func TestParse_Heartbeat(t *testing.T) {
+	envelope, err := Decode([]byte(`{"v":1,"type":"heartbeat","id":"a","payload":{"status":"in_transit","battery_level":80,"position":{"latitude":55.7,"longitude":37.6,"altitude":100},"speed":12}}`))
+	require.NoError(t, err)
+
+	msg, err := envelope.Parse()
+	require.NoError(t, err)
+	heartbeat, ok := msg.(*Heartbeat)
+	require.True(t, ok)
+	assert.Equal(t, "in_transit", heartbeat.Status)
+	assert.Equal(t, 80.0, heartbeat.BatteryLevel)
+	assert.Equal(t, 55.7, heartbeat.Position.Latitude)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    error
+	}{
+		{"not json", `{`, ErrMalformed},
+		{"missing type", `{"v":1,"payload":{}}`, ErrMalformed},
+		{"unknown type", `{"v":1,"type":"teleport","payload":{}}`, ErrUnknownType},
+		{"missing payload", `{"v":1,"type":"cargo_dropped"}`, ErrMalformed},
+		{"wrong field type", `{"v":1,"type":"heartbeat","payload":{"battery_level":"full"}}`, ErrMalformed},
+		{"battery out of range", `{"v":1,"type":"heartbeat","payload":{"battery_level":120}}`, ErrInvalid},
+		{"unknown status", `{"v":1,"type":"status_update","payload":{"status":"flying"}}`, ErrInvalid},
+		{"missing order", `{"v":1,"type":"arrived_at_destination","payload":{"parcel_automat_id":"p"}}`, ErrInvalid},
+		{"service message", `{"v":1,"type":"delivery_task","payload":{}}`, ErrUnknownType},
+		{"ack without command", `{"v":1,"type":"command_ack","payload":{"stage":"received"}}`, ErrInvalid},
+		{"unknown ack stage", `{"v":1,"type":"command_ack","payload":{"command_id":"c","stage":"done"}}`, ErrInvalid},
+		{"nack without command", `{"v":1,"type":"command_nack","payload":{"reason":"busy"}}`, ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope, err := Decode([]byte(tt.message))
+			if err == nil {
+				_, err = envelope.Parse()
+			}
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	version, err := Negotiate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, Version1, version)
+
+	version, err = Negotiate([]int{3, 1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, Version1, version)
+
+	_, err = Negotiate([]int{2, 3})
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
@@ -0,0 +1,156 @@
+// Package droneproto is the wire protocol between drones and the drone-service. Every WebSocket text
+// message is an Envelope whose payload is one of the message structs in this package; binary messages
+// carry video frames (see pkg/videoframe).
+package droneproto
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Version1 is the first versioned protocol. Drones offer the versions they speak in Register and the
+// service answers with the one it chose in Challenge and Registered.
+const Version1 = 1
+
+var SupportedVersions = []int{Version1}
+
+var (
+	ErrMalformed          = errors.New("malformed message")
+	ErrUnknownType        = errors.New("unknown message type")
+	ErrUnsupportedVersion = errors.New("unsupported protocol version")
+	ErrInvalid            = errors.New("invalid message")
+)
+
+type MessageType string
+
+const (
+	TypeRegister     MessageType = "register"
+	TypeChallenge    MessageType = "challenge"
+	TypeAuthenticate MessageType = "authenticate"
+	TypeRegistered   MessageType = "registered"
+	TypeError        MessageType = "error"
+
+	TypeHeartbeat            MessageType = "heartbeat"
+	TypeStatusUpdate         MessageType = "status_update"
+	TypeDeliveryUpdate       MessageType = "delivery_update"
+	TypeArrivedAtDestination MessageType = "arrived_at_destination"
+	TypeCargoDropped         MessageType = "cargo_dropped"
+	TypeVideoFrame           MessageType = "video_frame"
+	TypeCommandAck           MessageType = "command_ack"
+	TypeCommandNack          MessageType = "command_nack"
+
+	TypeDeliveryTask MessageType = "delivery_task"
+	TypeCommand      MessageType = "command"
+)
+
+// Message is implemented by every payload struct.
+type Message interface {
+	MessageType() MessageType
+	Validate() error
+}
+
+type Envelope struct {
+	Version   int             `json:"v"`
+	Type      MessageType     `json:"type"`
+	ID        string          `json:"id"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+}
+
+// Encode wraps msg in an envelope with a fresh ID.
+func Encode(version int, msg Message) ([]byte, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("droneproto - Encode: %w", err)
+	}
+	return json.Marshal(Envelope{
+		Version:   version,
+		Type:      msg.MessageType(),
+		ID:        uuid.NewString(),
+		Timestamp: time.Now().UTC(),
+		Payload:   payload,
+	})
+}
+
+// Decode parses the envelope only. The payload is decoded by Parse once the caller knows the type is
+// expected in the current state of the connection.
+func Decode(data []byte) (*Envelope, error) {
+	var envelope Envelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+	}
+	if envelope.Type == "" {
+		return &envelope, fmt.Errorf("%w: type is required", ErrMalformed)
+	}
+	return &envelope, nil
+}
+
+// Parse decodes and validates the payload of a drone-to-service message.
+func (e *Envelope) Parse() (Message, error) {
+	var msg Message
+	switch e.Type {
+	case TypeRegister:
+		msg = &Register{}
+	case TypeAuthenticate:
+		msg = &Authenticate{}
+	case TypeHeartbeat:
+		msg = &Heartbeat{}
+	case TypeStatusUpdate:
+		msg = &StatusUpdate{}
+	case TypeDeliveryUpdate:
+		msg = &DeliveryUpdate{}
+	case TypeArrivedAtDestination:
+		msg = &ArrivedAtDestination{}
+	case TypeCargoDropped:
+		msg = &CargoDropped{}
+	case TypeVideoFrame:
+		msg = &VideoFrame{}
+	case TypeCommandAck:
+		msg = &CommandAck{}
+	case TypeCommandNack:
+		msg = &CommandNack{}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownType, e.Type)
+	}
+
+	if len(e.Payload) == 0 {
+		return nil, fmt.Errorf("%w: %s has no payload", ErrMalformed, e.Type)
+	}
+	if err := json.Unmarshal(e.Payload, msg); err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrMalformed, e.Type, err.Error())
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Negotiate picks the highest version both sides support. A drone that offers nothing speaks Version1.
+func Negotiate(offered []int) (int, error) {
+	if len(offered) == 0 {
+		return Version1, nil
+	}
+	best := 0
+	for _, v := range offered {
+		for _, supported := range SupportedVersions {
+			if v == supported && v > best {
+				best = v
+			}
+		}
+	}
+	if best == 0 {
+		return 0, fmt.Errorf("%w: offered %v, supported %v", ErrUnsupportedVersion, offered, SupportedVersions)
+	}
+	return best, nil
+}
+
+func invalid(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalid, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,221 @@
+package droneproto
+
+import "encoding/json"
+
+// Handshake.
+
+type Register struct {
+	DroneID      string `json:"drone_id"`
+	Versions     []int  `json:"versions,omitempty"`
+	AgentVersion string `json:"agent_version,omitempty"`
+}
+
+type Challenge struct {
+	DroneID    string `json:"drone_id"`
+	Nonce      string `json:"nonce"`
+	KeyVersion int    `json:"key_version"`
+	Version    int    `json:"version"`
+}
+
+type Authenticate struct {
+	Signature string `json:"signature"`
+}
+
+type Registered struct {
+	DroneID   string `json:"drone_id"`
+	Version   int    `json:"version"`
+	SessionID string `json:"session_id,omitempty"`
+}
+
+// Error answers a message the service could not accept. RefID is the ID of that message when it had one.
+type Error struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+	RefID   string    `json:"ref_id,omitempty"`
+}
+
+type ErrorCode string
+
+const (
+	CodeMalformed          ErrorCode = "malformed"
+	CodeUnknownType        ErrorCode = "unknown_type"
+	CodeUnsupportedVersion ErrorCode = "unsupported_version"
+	CodeInvalid            ErrorCode = "invalid"
+	CodeRejected           ErrorCode = "rejected"
+	CodeInternal           ErrorCode = "internal"
+)
+
+// Drone to service.
+
+type Position struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Altitude  float64 `json:"altitude"`
+}
+
+type Telemetry struct {
+	Status            string   `json:"status"`
+	BatteryLevel      float64  `json:"battery_level"`
+	Position          Position `json:"position"`
+	Speed             float64  `json:"speed"`
+	CurrentDeliveryID string   `json:"current_delivery_id,omitempty"`
+	ErrorMessage      string   `json:"error_message,omitempty"`
+}
+
+type Heartbeat struct {
+	Telemetry
+}
+
+type StatusUpdate struct {
+	Telemetry
+}
+
+type DeliveryUpdate struct {
+	DroneStatus     string `json:"drone_status"`
+	DeliveryID      string `json:"delivery_id,omitempty"`
+	OrderID         string `json:"order_id,omitempty"`
+	ParcelAutomatID string `json:"parcel_automat_id,omitempty"`
+}
+
+type ArrivedAtDestination struct {
+	OrderID         string `json:"order_id"`
+	ParcelAutomatID string `json:"parcel_automat_id"`
+}
+
+type CargoDropped struct {
+	OrderID      string `json:"order_id"`
+	LockerCellID string `json:"locker_cell_id,omitempty"`
+}
+
+// VideoFrame is the JSON fallback for drones that cannot send binary frames. Frame is base64 in JSON.
+type VideoFrame struct {
+	Frame      []byte `json:"frame"`
+	DeliveryID string `json:"delivery_id,omitempty"`
+}
+
+type AckStage string
+
+const (
+	AckReceived AckStage = "received"
+	AckExecuted AckStage = "executed"
+)
+
+// CommandAck confirms a command: first when the drone has accepted it, then when it has carried it
+// out. A drone that only acks once should send executed.
+type CommandAck struct {
+	CommandID string   `json:"command_id"`
+	Stage     AckStage `json:"stage,omitempty"`
+}
+
+// CommandNack reports that the drone refused a command or could not carry it out.
+type CommandNack struct {
+	CommandID string `json:"command_id"`
+	Reason    string `json:"reason"`
+}
+
+// Service to drone.
+
+type Dimensions struct {
+	Weight float64 `json:"weight"`
+	Height float64 `json:"height"`
+	Length float64 `json:"length"`
+	Width  float64 `json:"width"`
+}
+
+type DeliveryTask struct {
+	DeliveryID      string     `json:"delivery_id,omitempty"`
+	OrderID         string     `json:"order_id"`
+	GoodID          string     `json:"good_id"`
+	ParcelAutomatID string     `json:"parcel_automat_id"`
+	ArucoID         *int       `json:"aruco_id"`
+	Coordinates     string     `json:"coordinates"`
+	InternalCellID  *string    `json:"internal_cell_id"`
+	Dimensions      Dimensions `json:"dimensions"`
+}
+
+type CommandName string
+
+const (
+	CommandReturnToBase   CommandName = "return_to_base"
+	CommandDropCargo      CommandName = "drop_cargo"
+	CommandCancelDelivery CommandName = "cancel_delivery"
+	CommandHold           CommandName = "hold"
+	CommandLand           CommandName = "land"
+	CommandGoto           CommandName = "goto"
+	CommandSetAltitude    CommandName = "set_altitude"
+	CommandAbortDelivery  CommandName = "abort_delivery"
+	CommandOpenCargoHook  CommandName = "open_cargo_hook"
+	CommandCloseCargoHook CommandName = "close_cargo_hook"
+	CommandRebootAgent    CommandName = "reboot_agent"
+)
+
+// MaxAltitude is the highest altitude, in meters, a drone may be sent to.
+const MaxAltitude = 120.0
+
+// Command is the single shape of every instruction sent to a drone: the name plus the arguments that
+// command uses. CommandID stays the same when the command is re-sent, so the drone can ignore repeats.
+type Command struct {
+	CommandID      string      `json:"command_id,omitempty"`
+	Command        CommandName `json:"command"`
+	DeliveryID     string      `json:"delivery_id,omitempty"`
+	OrderID        string      `json:"order_id,omitempty"`
+	BaseMarkerID   *int        `json:"base_marker_id,omitempty"`
+	CellID         string      `json:"cell_id,omitempty"`
+	InternalCellID string      `json:"internal_cell_id,omitempty"`
+	Waypoint       *Position   `json:"waypoint,omitempty"`
+	Altitude       *float64    `json:"altitude,omitempty"`
+	Reason         string      `json:"reason,omitempty"`
+}
+
+// Relayed is a message that was encoded and validated on another service replica and is written to
+// the drone as is.
+type Relayed struct {
+	Type    MessageType
+	Payload json.RawMessage
+}
+
+func (m *Relayed) MessageType() MessageType { return m.Type }
+
+func (m *Relayed) Validate() error { return nil }
+
+func (m *Relayed) MarshalJSON() ([]byte, error) {
+	if len(m.Payload) == 0 {
+		return []byte("{}"), nil
+	}
+	return m.Payload, nil
+}
+
+var (
+	_ Message = (*Register)(nil)
+	_ Message = (*Challenge)(nil)
+	_ Message = (*Authenticate)(nil)
+	_ Message = (*Registered)(nil)
+	_ Message = (*Error)(nil)
+	_ Message = (*Heartbeat)(nil)
+	_ Message = (*StatusUpdate)(nil)
+	_ Message = (*DeliveryUpdate)(nil)
+	_ Message = (*ArrivedAtDestination)(nil)
+	_ Message = (*CargoDropped)(nil)
+	_ Message = (*VideoFrame)(nil)
+	_ Message = (*CommandAck)(nil)
+	_ Message = (*CommandNack)(nil)
+	_ Message = (*DeliveryTask)(nil)
+	_ Message = (*Command)(nil)
+	_ Message = (*Relayed)(nil)
+)
+
+func (*Register) MessageType() MessageType             { return TypeRegister }
+func (*Challenge) MessageType() MessageType            { return TypeChallenge }
+func (*Authenticate) MessageType() MessageType         { return TypeAuthenticate }
+func (*Registered) MessageType() MessageType           { return TypeRegistered }
+func (*Error) MessageType() MessageType                { return TypeError }
+func (*Heartbeat) MessageType() MessageType            { return TypeHeartbeat }
+func (*StatusUpdate) MessageType() MessageType         { return TypeStatusUpdate }
+func (*DeliveryUpdate) MessageType() MessageType       { return TypeDeliveryUpdate }
+func (*ArrivedAtDestination) MessageType() MessageType { return TypeArrivedAtDestination }
+func (*CargoDropped) MessageType() MessageType         { return TypeCargoDropped }
+func (*VideoFrame) MessageType() MessageType           { return TypeVideoFrame }
+func (*CommandAck) MessageType() MessageType           { return TypeCommandAck }
+func (*CommandNack) MessageType() MessageType          { return TypeCommandNack }
+func (*DeliveryTask) MessageType() MessageType         { return TypeDeliveryTask }
+func (*Command) MessageType() MessageType              { return TypeCommand }
@@ -0,0 +1,183 @@
+package droneproto
+
+// Drone statuses accepted in telemetry. They mirror entity.DroneStatus on the service side.
+var droneStatuses = map[string]bool{
+	"idle":        true,
+	"taking_off":  true,
+	"picking_up":  true,
+	"in_transit":  true,
+	"delivering":  true,
+	"returning":   true,
+	"landing":     true,
+	"charging":    true,
+	"error":       true,
+	"maintenance": true,
+}
+
+func (m *Register) Validate() error {
+	if m.DroneID == "" {
+		return invalid("register: drone_id is required")
+	}
+	return nil
+}
+
+func (m *Challenge) Validate() error {
+	if m.Nonce == "" {
+		return invalid("challenge: nonce is required")
+	}
+	return nil
+}
+
+func (m *Authenticate) Validate() error {
+	if m.Signature == "" {
+		return invalid("authenticate: signature is required")
+	}
+	return nil
+}
+
+func (m *Registered) Validate() error {
+	if m.DroneID == "" {
+		return invalid("registered: drone_id is required")
+	}
+	return nil
+}
+
+func (m *Error) Validate() error {
+	if m.Code == "" {
+		return invalid("error: code is required")
+	}
+	return nil
+}
+
+func (m *Telemetry) validate(kind MessageType) error {
+	if m.Status != "" && !droneStatuses[m.Status] {
+		return invalid("%s: unknown status %q", kind, m.Status)
+	}
+	if m.BatteryLevel < 0 || m.BatteryLevel > 100 {
+		return invalid("%s: battery_level must be between 0 and 100", kind)
+	}
+	if m.Position.Latitude < -90 || m.Position.Latitude > 90 {
+		return invalid("%s: latitude must be between -90 and 90", kind)
+	}
+	if m.Position.Longitude < -180 || m.Position.Longitude > 180 {
+		return invalid("%s: longitude must be between -180 and 180", kind)
+	}
+	if m.Speed < 0 {
+		return invalid("%s: speed must not be negative", kind)
+	}
+	return nil
+}
+
+func (m *Heartbeat) Validate() error {
+	return m.validate(TypeHeartbeat)
+}
+
+func (m *StatusUpdate) Validate() error {
+	if m.Status == "" {
+		return invalid("status_update: status is required")
+	}
+	return m.validate(TypeStatusUpdate)
+}
+
+func (m *DeliveryUpdate) Validate() error {
+	if m.DroneStatus == "" {
+		return invalid("delivery_update: drone_status is required")
+	}
+	return nil
+}
+
+func (m *ArrivedAtDestination) Validate() error {
+	if m.OrderID == "" {
+		return invalid("arrived_at_destination: order_id is required")
+	}
+	if m.ParcelAutomatID == "" {
+		return invalid("arrived_at_destination: parcel_automat_id is required")
+	}
+	return nil
+}
+
+func (m *CargoDropped) Validate() error {
+	if m.OrderID == "" {
+		return invalid("cargo_dropped: order_id is required")
+	}
+	return nil
+}
+
+func (m *VideoFrame) Validate() error {
+	if len(m.Frame) == 0 {
+		return invalid("video_frame: frame is required")
+	}
+	return nil
+}
+
+func (m *CommandAck) Validate() error {
+	if m.CommandID == "" {
+		return invalid("command_ack: command_id is required")
+	}
+	switch m.Stage {
+	case "", AckReceived, AckExecuted:
+		return nil
+	}
+	return invalid("command_ack: unknown stage %q", m.Stage)
+}
+
+func (m *CommandNack) Validate() error {
+	if m.CommandID == "" {
+		return invalid("command_nack: command_id is required")
+	}
+	return nil
+}
+
+func (m *DeliveryTask) Validate() error {
+	if m.OrderID == "" {
+		return invalid("delivery_task: order_id is required")
+	}
+	if m.ParcelAutomatID == "" {
+		return invalid("delivery_task: parcel_automat_id is required")
+	}
+	return nil
+}
+
+func (m *Command) Validate() error {
+	switch m.Command {
+	case CommandReturnToBase, CommandCancelDelivery, CommandHold, CommandLand,
+		CommandOpenCargoHook, CommandCloseCargoHook, CommandRebootAgent:
+		return nil
+	case CommandDropCargo:
+		if m.OrderID == "" {
+			return invalid("drop_cargo: order_id is required")
+		}
+		return nil
+	case CommandGoto:
+		if m.Waypoint == nil {
+			return invalid("goto: waypoint is required")
+		}
+		if m.Waypoint.Latitude < -90 || m.Waypoint.Latitude > 90 {
+			return invalid("goto: latitude must be between -90 and 90")
+		}
+		if m.Waypoint.Longitude < -180 || m.Waypoint.Longitude > 180 {
+			return invalid("goto: longitude must be between -180 and 180")
+		}
+		return validateAltitude(m.Command, m.Waypoint.Altitude)
+	case CommandSetAltitude:
+		if m.Altitude == nil {
+			return invalid("set_altitude: altitude is required")
+		}
+		return validateAltitude(m.Command, *m.Altitude)
+	case CommandAbortDelivery:
+		if m.DeliveryID == "" {
+			return invalid("abort_delivery: delivery_id is required")
+		}
+		return nil
+	case "":
+		return invalid("command: command is required")
+	}
+	return invalid("command: unknown command %q", m.Command)
+}
+
+func validateAltitude(command CommandName, altitude float64) error {
+	if altitude <= 0 || altitude > MaxAltitude {
+		return invalid("%s: altitude must be above 0 and at most %g m", command, MaxAltitude)
+	}
+	return nil
+}
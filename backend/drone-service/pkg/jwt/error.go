@@ -0,0 +1,9 @@
+package jwt
+
+import "errors"
+
+var (
+	ErrTokenInvalid           = errors.New("invalid token")
+	ErrTokenInvalidType       = errors.New("invalid token type")
+	ErrTokenUnexpectedSigning = errors.New("unexpected signing method")
+)
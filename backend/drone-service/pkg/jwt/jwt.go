@@ -0,0 +1,54 @@
+package jwt
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const TokenTypeAccess = "access"
+
+// JWTService validates the access tokens issued by the orchestrator. The drone-service never issues
+// tokens itself, so it only needs the access secret.
+type JWTService struct {
+	accessSecret []byte
+}
+
+// CustomClaims mirrors the orchestrator's go-orchestrator/pkg/jwt claims.
+type CustomClaims struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Email     string    `json:"email"`
+	FullName  string    `json:"full_name"`
+	Role      string    `json:"role,omitempty"`
+	TokenType string    `json:"token_type"`
+	jwt.RegisteredClaims
+}
+
+func NewJWTService(accessSecret string) *JWTService {
+	return &JWTService{
+		accessSecret: []byte(accessSecret),
+	}
+}
+
+func (j *JWTService) ValidateAccessToken(tokenString string) (*CustomClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("JWTService - ValidateAccessToken - ValidateSigningMethod[alg=%v]: %w", token.Header["alg"], ErrTokenUnexpectedSigning)
+		}
+		return j.accessSecret, nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("JWTService - ValidateAccessToken - ParseWithClaims: %w", err)
+	}
+
+	if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid {
+		if claims.TokenType != TokenTypeAccess {
+			return nil, ErrTokenInvalidType
+		}
+		return claims, nil
+	}
+
+	return nil, ErrTokenInvalid
+}
@@ -0,0 +1,94 @@
+package jwt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func signToken(t *testing.T, method jwt.SigningMethod, secret any, claims *CustomClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(secret)
+	assert.NoError(t, err)
+	return token
+}
+
+func testClaims(tokenType string, expiresAt time.Time) *CustomClaims {
+	return &CustomClaims{
+		UserID:    uuid.New(),
+		Email:     "admin@example.com",
+		FullName:  "Admin User",
+		Role:      "admin",
+		TokenType: tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    "skypost-delivery-orchestrator",
+		},
+	}
+}
+
+func TestJWTService_ValidateAccessToken_Success(t *testing.T) {
+	service := NewJWTService("test-access")
+	claims := testClaims(TokenTypeAccess, time.Now().Add(15*time.Minute))
+	token := signToken(t, jwt.SigningMethodHS256, []byte("test-access"), claims)
+
+	validated, err := service.ValidateAccessToken(token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, claims.UserID, validated.UserID)
+	assert.Equal(t, claims.Email, validated.Email)
+	assert.Equal(t, "admin", validated.Role)
+}
+
+func TestJWTService_ValidateAccessToken_WrongSecret(t *testing.T) {
+	service := NewJWTService("test-access")
+	token := signToken(t, jwt.SigningMethodHS256, []byte("other-secret"), testClaims(TokenTypeAccess, time.Now().Add(time.Minute)))
+
+	validated, err := service.ValidateAccessToken(token)
+
+	assert.Error(t, err)
+	assert.Nil(t, validated)
+}
+
+func TestJWTService_ValidateAccessToken_Expired(t *testing.T) {
+	service := NewJWTService("test-access")
+	token := signToken(t, jwt.SigningMethodHS256, []byte("test-access"), testClaims(TokenTypeAccess, time.Now().Add(-time.Minute)))
+
+	validated, err := service.ValidateAccessToken(token)
+
+	assert.ErrorIs(t, err, jwt.ErrTokenExpired)
+	assert.Nil(t, validated)
+}
+
+func TestJWTService_ValidateAccessToken_RefreshToken(t *testing.T) {
+	service := NewJWTService("test-access")
+	token := signToken(t, jwt.SigningMethodHS256, []byte("test-access"), testClaims("refresh", time.Now().Add(time.Minute)))
+
+	validated, err := service.ValidateAccessToken(token)
+
+	assert.ErrorIs(t, err, ErrTokenInvalidType)
+	assert.Nil(t, validated)
+}
+
+func TestJWTService_ValidateAccessToken_UnexpectedSigningMethod(t *testing.T) {
+	service := NewJWTService("test-access")
+	token := signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, testClaims(TokenTypeAccess, time.Now().Add(time.Minute)))
+
+	validated, err := service.ValidateAccessToken(token)
+
+	assert.Error(t, err)
+	assert.Nil(t, validated)
+}
+
+func TestJWTService_ValidateAccessToken_Malformed(t *testing.T) {
+	service := NewJWTService("test-access")
+
+	validated, err := service.ValidateAccessToken("not-a-token")
+
+	assert.Error(t, err)
+	assert.Nil(t, validated)
+}
@@ -31,14 +31,17 @@ func New(cfg *config.MinIO) (*Client, error) {
 	}, nil
 }
 
-func (c *Client) UploadFrame(ctx context.Context, droneID, deliveryID string, frameData []byte, frameNumber int) (string, error) {
-	timestamp := time.Now().Format("20060102_150405")
-	objectName := fmt.Sprintf("%s/%s/%s_frame_%d.jpg", droneID, deliveryID, timestamp, frameNumber)
+// UploadFrame stores a JPEG frame of a delivery. Object names start with the zero-padded capture time in
+// nanoseconds, so listing the delivery prefix returns frames in capture order even across service restarts.
+// The sequence number only breaks ties between frames captured in the same nanosecond.
+func (c *Client) UploadFrame(ctx context.Context, droneID, deliveryID string, frameData []byte, capturedAt time.Time, sequence int) (string, error) {
+	objectName := fmt.Sprintf("deliveries/%s/frames/%020d-%06d.jpg", deliveryID, capturedAt.UnixNano(), sequence%1000000)
 
 	reader := bytes.NewReader(frameData)
 
 	_, err := c.client.PutObject(ctx, c.bucketName, objectName, reader, int64(len(frameData)), minio.PutObjectOptions{
-		ContentType: "image/jpeg",
+		ContentType:  "image/jpeg",
+		UserMetadata: map[string]string{"drone-id": droneID},
 	})
 	if err != nil {
 		return "", fmt.Errorf("MinioClient - UploadFrame - PutObject: %w", err)
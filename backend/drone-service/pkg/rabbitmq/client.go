@@ -14,6 +14,7 @@ import (
 
 type RabbitMQClient interface {
 	Publish(ctx context.Context, queue string, message any) error
+	PublishToExchange(ctx context.Context, exchange, routingKey string, message any) error
 }
 
 type DeliveryHandler interface {
@@ -93,14 +94,22 @@ func (c *Client) init(conn *amqp.Connection) error {
 		return err
 	}
 
+	if err := ch.ExchangeDeclare(ExchangeDroneTelemetry, amqp.ExchangeFanout, true, false, false, false, nil); err != nil {
+		_ = ch.Close()
+		return fmt.Errorf("RabbitMQClient - init - ExchangeDeclare[%s]: %w", ExchangeDroneTelemetry, err)
+	}
+
 	c.mu.Lock()
 	c.conn = conn
 	c.channel = ch
 	c.isReady = true
 	c.notifyConfirm = ch.NotifyPublish(make(chan amqp.Confirmation, 1))
 	c.notifyReturn = ch.NotifyReturn(make(chan amqp.Return, 10))
+	confirms := c.notifyConfirm
 	c.mu.Unlock()
 
+	go c.handleConfirms(confirms)
+
 	c.logger.Info("RabbitMQ client initialized successfully", nil, nil)
 	return nil
 }
@@ -174,6 +183,16 @@ func (c *Client) handleReturns() {
 	}
 }
 
+// handleConfirms drains publisher confirms for one channel. The channel blocks once the confirm buffer is full,
+// so confirmations must be read even though publishers do not wait for them.
+func (c *Client) handleConfirms(confirms <-chan amqp.Confirmation) {
+	for confirm := range confirms {
+		if !confirm.Ack {
+			c.logger.Warn("Message nacked by broker", nil, map[string]any{"tag": confirm.DeliveryTag})
+		}
+	}
+}
+
 func (c *Client) Consume(ctx context.Context, queue string, handler func(context.Context, amqp.Delivery) error) error {
 	c.mu.RLock()
 	ch := c.channel
@@ -238,6 +257,39 @@ func (c *Client) Publish(ctx context.Context, queue string, message any) error {
 	)
 }
 
+// PublishToExchange sends a transient message that expires after 10 seconds, which suits telemetry:
+// a late position sample is worthless, so it is better dropped than queued.
+func (c *Client) PublishToExchange(ctx context.Context, exchange, routingKey string, message any) error {
+	c.mu.RLock()
+	ch := c.channel
+	isReady := c.isReady
+	c.mu.RUnlock()
+
+	if !isReady {
+		return fmt.Errorf("RabbitMQClient - PublishToExchange - ClientNotReady")
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("RabbitMQClient - PublishToExchange - Marshal: %w", err)
+	}
+
+	return ch.PublishWithContext(
+		ctx,
+		exchange,
+		routingKey,
+		false,
+		false,
+		amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Transient,
+			Expiration:   "10000",
+			Timestamp:    time.Now(),
+			Body:         body,
+		},
+	)
+}
+
 func (c *Client) Close() error {
 	close(c.done)
 
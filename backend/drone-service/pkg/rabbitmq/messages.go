@@ -0,0 +1,13 @@
+package rabbitmq
+
+type DroneStatusUpdate struct {
+	DroneID           string  `json:"drone_id"`
+	Status            string  `json:"status"`
+	BatteryLevel      float64 `json:"battery_level"`
+	Latitude          float64 `json:"latitude"`
+	Longitude         float64 `json:"longitude"`
+	Altitude          float64 `json:"altitude"`
+	Speed             float64 `json:"speed"`
+	CurrentDeliveryID string  `json:"current_delivery_id,omitempty"`
+	UpdatedAt         int64   `json:"updated_at"`
+}
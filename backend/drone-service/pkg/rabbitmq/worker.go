@@ -13,6 +13,8 @@ const (
 	QueueDeliveries         = "deliveries"
 	QueueDeliveriesPriority = "deliveries.priority"
 	QueueDeliveryReturn     = "delivery.return"
+
+	ExchangeDroneTelemetry = "drone.telemetry"
 )
 
 type DeliveryWorker struct {
@@ -0,0 +1,111 @@
+package videoframe
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Queue is a bounded frame buffer for one viewer. Push never blocks: when the viewer falls behind the
+// oldest frame is dropped, because live video should show the newest picture rather than catch up.
+type Queue struct {
+	ch      chan []byte
+	mu      sync.Mutex
+	pushed  atomic.Uint64
+	dropped atomic.Uint64
+}
+
+func NewQueue(size int) *Queue {
+	if size < 1 {
+		size = 1
+	}
+	return &Queue{ch: make(chan []byte, size)}
+}
+
+// Push enqueues a frame and reports whether an older frame had to be dropped to make room.
+func (q *Queue) Push(frame []byte) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.pushed.Add(1)
+	dropped := false
+	for {
+		select {
+		case q.ch <- frame:
+			return dropped
+		default:
+		}
+
+		select {
+		case <-q.ch:
+			q.dropped.Add(1)
+			dropped = true
+		default:
+		}
+	}
+}
+
+func (q *Queue) Frames() <-chan []byte {
+	return q.ch
+}
+
+func (q *Queue) Len() int {
+	return len(q.ch)
+}
+
+func (q *Queue) Pushed() uint64 {
+	return q.pushed.Load()
+}
+
+func (q *Queue) Dropped() uint64 {
+	return q.dropped.Load()
+}
+
+const meterWindow = time.Second
+
+// Meter measures a frame rate over consecutive one second windows.
+type Meter struct {
+	mu          sync.Mutex
+	total       uint64
+	windowStart time.Time
+	windowCount int
+	rate        float64
+}
+
+func (m *Meter) Mark(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.total++
+	if m.windowStart.IsZero() {
+		m.windowStart = now
+	}
+	m.windowCount++
+
+	if elapsed := now.Sub(m.windowStart); elapsed >= meterWindow {
+		m.rate = float64(m.windowCount) / elapsed.Seconds()
+		m.windowStart = now
+		m.windowCount = 0
+	}
+}
+
+// Rate returns frames per second of the last complete window. When no frame completed a window for
+// two windows the stream has stalled and the rate of the open window is returned instead.
+func (m *Meter) Rate(now time.Time) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.windowStart.IsZero() {
+		return 0
+	}
+	if elapsed := now.Sub(m.windowStart); elapsed >= 2*meterWindow {
+		return float64(m.windowCount) / elapsed.Seconds()
+	}
+	return m.rate
+}
+
+func (m *Meter) Total() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.total
+}
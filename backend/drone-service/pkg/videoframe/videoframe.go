@@ -0,0 +1,153 @@
+package videoframe
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Binary frame layout, all integers big-endian:
+//
+//	0   4  magic "SKVF"
+//	4   1  version
+//	5   1  codec
+//	6   2  header length, offset of the payload
+//	8   4  sequence
+//	12  8  capture time, Unix nanoseconds
+//	20  1  drone ID length, followed by the drone ID
+//	    1  delivery ID length, followed by the delivery ID
+//
+// Readers must skip to the header length rather than assume the header ends after the delivery ID,
+// so fields can be appended without breaking older viewers.
+const (
+	Magic         = "SKVF"
+	Version       = 1
+	fixedSize     = 20
+	maxIDLength   = 255
+	MaxHeaderSize = fixedSize + 2 + 2*maxIDLength
+)
+
+type Codec uint8
+
+const (
+	CodecJPEG Codec = 1
+	CodecH264 Codec = 2
+)
+
+var (
+	ErrInvalidMagic = errors.New("video frame has no SKVF magic")
+	ErrUnsupported  = errors.New("unsupported video frame version")
+	ErrTruncated    = errors.New("video frame is truncated")
+	ErrIDTooLong    = errors.New("video frame id exceeds 255 bytes")
+	ErrUnknownCodec = errors.New("unknown video codec")
+	ErrHeaderLength = errors.New("video frame header length is invalid")
+	ErrEmptyPayload = errors.New("video frame has no payload")
+)
+
+func (c Codec) String() string {
+	switch c {
+	case CodecJPEG:
+		return "jpeg"
+	case CodecH264:
+		return "h264"
+	}
+	return fmt.Sprintf("codec(%d)", uint8(c))
+}
+
+func ParseCodec(name string) (Codec, bool) {
+	switch name {
+	case "jpeg", "mjpeg":
+		return CodecJPEG, true
+	case "h264":
+		return CodecH264, true
+	}
+	return 0, false
+}
+
+type Header struct {
+	DroneID    string
+	DeliveryID string
+	Sequence   uint32
+	CapturedAt time.Time
+	Codec      Codec
+}
+
+func Encode(h Header, payload []byte) ([]byte, error) {
+	if len(h.DroneID) > maxIDLength || len(h.DeliveryID) > maxIDLength {
+		return nil, ErrIDTooLong
+	}
+
+	headerLen := fixedSize + 1 + len(h.DroneID) + 1 + len(h.DeliveryID)
+	buf := make([]byte, headerLen, headerLen+len(payload))
+
+	copy(buf[0:4], Magic)
+	buf[4] = Version
+	buf[5] = byte(h.Codec)
+	binary.BigEndian.PutUint16(buf[6:8], uint16(headerLen))
+	binary.BigEndian.PutUint32(buf[8:12], h.Sequence)
+	var nanos int64
+	if !h.CapturedAt.IsZero() {
+		nanos = h.CapturedAt.UnixNano()
+	}
+	binary.BigEndian.PutUint64(buf[12:20], uint64(nanos))
+
+	offset := fixedSize
+	buf[offset] = byte(len(h.DroneID))
+	offset += 1 + copy(buf[offset+1:], h.DroneID)
+	buf[offset] = byte(len(h.DeliveryID))
+	copy(buf[offset+1:], h.DeliveryID)
+
+	return append(buf, payload...), nil
+}
+
+// Decode parses a binary frame. The returned payload aliases data.
+func Decode(data []byte) (Header, []byte, error) {
+	var h Header
+	if len(data) < fixedSize+2 {
+		return h, nil, ErrTruncated
+	}
+	if string(data[0:4]) != Magic {
+		return h, nil, ErrInvalidMagic
+	}
+	if data[4] != Version {
+		return h, nil, fmt.Errorf("%w: %d", ErrUnsupported, data[4])
+	}
+
+	h.Codec = Codec(data[5])
+	if h.Codec != CodecJPEG && h.Codec != CodecH264 {
+		return h, nil, fmt.Errorf("%w: %d", ErrUnknownCodec, data[5])
+	}
+
+	headerLen := int(binary.BigEndian.Uint16(data[6:8]))
+	if headerLen < fixedSize+2 || headerLen > len(data) {
+		return h, nil, ErrHeaderLength
+	}
+
+	h.Sequence = binary.BigEndian.Uint32(data[8:12])
+	if nanos := int64(binary.BigEndian.Uint64(data[12:20])); nanos != 0 {
+		h.CapturedAt = time.Unix(0, nanos).UTC()
+	}
+
+	offset := fixedSize
+	droneLen := int(data[offset])
+	offset++
+	if offset+droneLen+1 > headerLen {
+		return h, nil, ErrHeaderLength
+	}
+	h.DroneID = string(data[offset : offset+droneLen])
+	offset += droneLen
+
+	deliveryLen := int(data[offset])
+	offset++
+	if offset+deliveryLen > headerLen {
+		return h, nil, ErrHeaderLength
+	}
+	h.DeliveryID = string(data[offset : offset+deliveryLen])
+
+	payload := data[headerLen:]
+	if len(payload) == 0 {
+		return h, nil, ErrEmptyPayload
+	}
+	return h, payload, nil
+}
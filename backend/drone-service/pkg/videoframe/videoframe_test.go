@@ -0,0 +1,105 @@
+package videoframe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	capturedAt := time.Date(2026, 3, 1, 10, 0, 0, 123456789, time.UTC)
+	header := Header{
+		DroneID:    "6f1c2f0e-8a55-4c55-9f52-4b8f3c5f9d11",
+		DeliveryID: "0d8a1c8e-21f4-4a6c-a0a5-5b7d7d2f0e3c",
+		Sequence:   42,
+		CapturedAt: capturedAt,
+		Codec:      CodecJPEG,
+	}
+	payload := []byte{0xFF, 0xD8, 0x01, 0x02, 0xFF, 0xD9}
+
+	data, err := Encode(header, payload)
+	require.NoError(t, err)
+	assert.Equal(t, Magic, string(data[:4]))
+
+	decoded, decodedPayload, err := Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, header, decoded)
+	assert.Equal(t, payload, decodedPayload)
+}
+
+func TestDecode_SkipsUnknownHeaderFields(t *testing.T) {
+	data, err := Encode(Header{DroneID: "d1", Codec: CodecH264}, []byte{1, 2, 3})
+	require.NoError(t, err)
+
+	// A newer sender appends two header bytes and raises the header length accordingly.
+	headerLen := int(data[6])<<8 | int(data[7])
+	extended := append(append(append([]byte{}, data[:headerLen]...), 0xAA, 0xBB), data[headerLen:]...)
+	extended[7] += 2
+
+	header, payload, err := Decode(extended)
+	require.NoError(t, err)
+	assert.Equal(t, "d1", header.DroneID)
+	assert.Empty(t, header.DeliveryID)
+	assert.True(t, header.CapturedAt.IsZero())
+	assert.Equal(t, []byte{1, 2, 3}, payload)
+}
+
+func TestDecode_Errors(t *testing.T) {
+	valid, err := Encode(Header{DroneID: "d1", Codec: CodecJPEG}, []byte{1})
+	require.NoError(t, err)
+
+	_, _, err = Decode(valid[:10])
+	assert.ErrorIs(t, err, ErrTruncated)
+
+	badMagic := append([]byte{}, valid...)
+	badMagic[0] = 'X'
+	_, _, err = Decode(badMagic)
+	assert.ErrorIs(t, err, ErrInvalidMagic)
+
+	badCodec := append([]byte{}, valid...)
+	badCodec[5] = 9
+	_, _, err = Decode(badCodec)
+	assert.ErrorIs(t, err, ErrUnknownCodec)
+
+	badLength := append([]byte{}, valid...)
+	badLength[6], badLength[7] = 0xFF, 0xFF
+	_, _, err = Decode(badLength)
+	assert.ErrorIs(t, err, ErrHeaderLength)
+
+	_, _, err = Decode(valid[:len(valid)-1])
+	assert.ErrorIs(t, err, ErrEmptyPayload)
+
+	_, err = Encode(Header{DroneID: string(make([]byte, 256))}, nil)
+	assert.ErrorIs(t, err, ErrIDTooLong)
+}
+
+func TestQueue_DropsOldestWhenFull(t *testing.T) {
+	q := NewQueue(2)
+
+	assert.False(t, q.Push([]byte("1")))
+	assert.False(t, q.Push([]byte("2")))
+	assert.True(t, q.Push([]byte("3")))
+
+	assert.Equal(t, uint64(3), q.Pushed())
+	assert.Equal(t, uint64(1), q.Dropped())
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, []byte("2"), <-q.Frames())
+	assert.Equal(t, []byte("3"), <-q.Frames())
+}
+
+func TestMeter_Rate(t *testing.T) {
+	var m Meter
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	assert.Zero(t, m.Rate(start))
+
+	for i := 0; i <= 10; i++ {
+		m.Mark(start.Add(time.Duration(i) * 100 * time.Millisecond))
+	}
+	assert.InDelta(t, 11.0, m.Rate(start.Add(time.Second)), 0.01)
+	assert.Equal(t, uint64(11), m.Total())
+
+	assert.Zero(t, m.Rate(start.Add(5*time.Second)))
+}
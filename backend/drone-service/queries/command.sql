@@ -0,0 +1,78 @@
+-- name: CreateDroneCommand :one
+INSERT INTO drone_commands (
+        id,
+        drone_id,
+        command,
+        payload,
+        status,
+        max_attempts,
+        expires_at
+    )
+VALUES ($1, $2, $3, $4, 'pending', $5, $6)
+RETURNING *;
+-- name: GetDroneCommand :one
+SELECT *
+FROM drone_commands
+WHERE id = $1;
+-- name: ListOpenDroneCommandsByDrone :many
+SELECT *
+FROM drone_commands
+WHERE drone_id = sqlc.arg(drone_id)
+    AND status IN ('pending', 'sent', 'acked')
+    AND expires_at > sqlc.arg(now)
+ORDER BY created_at;
+-- name: ClaimDueDroneCommands :many
+UPDATE drone_commands
+SET next_attempt_at = sqlc.arg(lease_until),
+    updated_at = NOW()
+WHERE id IN (
+        SELECT id
+        FROM drone_commands
+        WHERE (
+                status IN ('pending', 'sent')
+                AND next_attempt_at <= sqlc.arg(now)
+            )
+            OR (
+                status IN ('pending', 'sent', 'acked')
+                AND expires_at <= sqlc.arg(now)
+            )
+        ORDER BY created_at
+        LIMIT sqlc.arg(batch_size) FOR
+        UPDATE SKIP LOCKED
+    )
+RETURNING *;
+-- name: MarkDroneCommandSent :execrows
+UPDATE drone_commands
+SET status = 'sent',
+    attempts = attempts + 1,
+    sent_at = NOW(),
+    next_attempt_at = $2,
+    last_error = NULL,
+    updated_at = NOW()
+WHERE id = $1
+    AND status IN ('pending', 'sent');
+-- name: MarkDroneCommandUndelivered :execrows
+UPDATE drone_commands
+SET status = 'pending',
+    next_attempt_at = $2,
+    last_error = $3,
+    updated_at = NOW()
+WHERE id = $1
+    AND status IN ('pending', 'sent');
+-- name: AckDroneCommand :execrows
+UPDATE drone_commands
+SET status = 'acked',
+    acked_at = NOW(),
+    next_attempt_at = NULL,
+    updated_at = NOW()
+WHERE id = $1
+    AND status IN ('pending', 'sent');
+-- name: CompleteDroneCommand :execrows
+UPDATE drone_commands
+SET status = $2,
+    last_error = $3,
+    completed_at = NOW(),
+    next_attempt_at = NULL,
+    updated_at = NOW()
+WHERE id = $1
+    AND status IN ('pending', 'sent', 'acked');
@@ -0,0 +1,20 @@
+-- name: CreateDroneCommandAudit :one
+INSERT INTO drone_command_audit (
+        drone_id,
+        command_id,
+        command,
+        payload,
+        operator_id,
+        operator_email,
+        operator_role,
+        result,
+        reason
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+-- name: ListDroneCommandAudit :many
+SELECT *
+FROM drone_command_audit
+WHERE drone_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
\ No newline at end of file
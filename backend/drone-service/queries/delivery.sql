@@ -25,4 +25,16 @@ SET status = $2,
         WHEN $2 = 'completed' THEN CURRENT_TIMESTAMP
         ELSE completed_at
     END
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+-- name: TransitionDeliveryStatus :execrows
+UPDATE deliveries
+SET status = sqlc.arg(to_status)
+WHERE id = sqlc.arg(id)
+    AND status = sqlc.arg(from_status);
+-- name: GetActiveDeliveryByDrone :one
+SELECT id
+FROM deliveries
+WHERE drone_id = $1
+    AND status IN ('pending', 'in_progress', 'in_transit', 'drone_lost')
+ORDER BY started_at DESC NULLS LAST
+LIMIT 1;
\ No newline at end of file
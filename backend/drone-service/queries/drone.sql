@@ -1,7 +1,13 @@
--- name: GetDroneIDByIP :one
-SELECT id
-FROM drones
-WHERE ip_address = $1;
+-- name: GetDroneCredential :one
+SELECT drone_id,
+    secret,
+    key_version
+FROM drone_credentials
+WHERE drone_id = $1;
+-- name: TouchDroneCredential :exec
+UPDATE drone_credentials
+SET last_connected_at = CURRENT_TIMESTAMP
+WHERE drone_id = $1;
 -- name: UpdateDroneBattery :exec
 UPDATE drones
 SET battery_level = $2,
@@ -31,4 +37,33 @@ SELECT id,
     error_message,
     updated_at
 FROM drones
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+-- name: GetDrone :one
+SELECT id,
+    model,
+    status,
+    battery_level,
+    latitude,
+    longitude,
+    altitude,
+    speed,
+    current_delivery_id,
+    error_message,
+    updated_at
+FROM drones
+WHERE id = $1;
+-- name: ListDrones :many
+SELECT id,
+    model,
+    status,
+    battery_level,
+    latitude,
+    longitude,
+    altitude,
+    speed,
+    current_delivery_id,
+    error_message,
+    updated_at
+FROM drones
+ORDER BY created_at,
+    id;
\ No newline at end of file
@@ -0,0 +1,25 @@
+-- name: InsertDroneTelemetry :copyfrom
+INSERT INTO drone_telemetry (
+        drone_id,
+        delivery_id,
+        status,
+        battery_level,
+        latitude,
+        longitude,
+        altitude,
+        speed,
+        recorded_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+-- name: DeleteDroneTelemetryBefore :execrows
+DELETE FROM drone_telemetry
+WHERE id IN (
+        SELECT id
+        FROM drone_telemetry
+        WHERE recorded_at < sqlc.arg(mission_before)
+            OR (
+                delivery_id IS NULL
+                AND recorded_at < sqlc.arg(idle_before)
+            )
+        LIMIT sqlc.arg(batch_size)
+    );
@@ -23,6 +23,8 @@ type (
 		SMSAero       `yaml:"smsaero"`
 		RabbitMQ      `yaml:"rabbitmq"`
 		Firebase      `yaml:"firebase"`
+		Notification  `yaml:"notification"`
+		SMTP          `yaml:"smtp"`
 		AdminPanelURL `yaml:"admin_panel_url"`
 		FirstAdmin    `yaml:"full_admin"`
 		SecondAdmin   `yaml:"second_admin"`
@@ -62,7 +64,11 @@ type (
 	}
 
 	QR struct {
-		HMACSecret string
+		HMACSecret     string
+		KeyID          string
+		PreviousKeys   map[string]string
+		KeyGracePeriod time.Duration
+		PINPepper      string
 	}
 
 	MinIO struct {
@@ -90,6 +96,20 @@ type (
 		ProjectID       string
 	}
 
+	Notification struct {
+		SMSFallbackLimit  int
+		SMSFallbackWindow time.Duration
+	}
+
+	SMTP struct {
+		Host     string
+		Port     int
+		Username string
+		Password string
+		From     string
+		StartTLS bool
+	}
+
 	AdminPanelURL struct {
 		URL string
 	}
@@ -113,6 +133,11 @@ type (
 func New() (*Config, error) {
 	_ = godotenv.Load()
 
+	previousQRKeys, err := parseKeyList(getEnv("QR_PREVIOUS_KEYS", ""))
+	if err != nil {
+		return nil, fmt.Errorf("config - New - QR_PREVIOUS_KEYS: %w", err)
+	}
+
 	cfg := &Config{
 		App: App{
 			Name:     getEnv("APP_NAME", "skypost-delivery"),
@@ -142,7 +167,11 @@ func New() (*Config, error) {
 			RefreshTTL:    3 * 24 * time.Hour,
 		},
 		QR: QR{
-			HMACSecret: getEnv("QR_HMAC_SECRET", "your-hmac-secret-key-change-in-production"),
+			HMACSecret:     getEnv("QR_HMAC_SECRET", "your-hmac-secret-key-change-in-production"),
+			KeyID:          getEnv("QR_KEY_ID", "v1"),
+			PreviousKeys:   previousQRKeys,
+			KeyGracePeriod: getEnvDuration("QR_KEY_GRACE_PERIOD", 7*24*time.Hour),
+			PINPepper:      getEnv("QR_PICKUP_PIN_PEPPER", ""),
 		},
 		MinIO: MinIO{
 			Endpoint:      getEnv("MINIO_ENDPOINT", "localhost:9000"),
@@ -165,6 +194,18 @@ func New() (*Config, error) {
 			CredentialsFile: getEnv("FIREBASE_CREDENTIALS_FILE_IN_DOCKER", ""),
 			ProjectID:       getEnv("FIREBASE_PROJECT_ID", ""),
 		},
+		Notification: Notification{
+			SMSFallbackLimit:  getEnvInt("NOTIFICATION_SMS_FALLBACK_LIMIT", 5),
+			SMSFallbackWindow: getEnvDuration("NOTIFICATION_SMS_FALLBACK_WINDOW", 24*time.Hour),
+		},
+		SMTP: SMTP{
+			Host:     getEnv("SMTP_HOST", ""),
+			Port:     getEnvInt("SMTP_PORT", 1025),
+			Username: getEnv("SMTP_USERNAME", ""),
+			Password: getEnv("SMTP_PASSWORD", ""),
+			From:     getEnv("SMTP_FROM", "SkyPost Delivery <noreply@skypost.local>"),
+			StartTLS: getEnv("SMTP_STARTTLS", "false") == "true",
+		},
 		AdminPanelURL: AdminPanelURL{
 			URL: getEnv("ADMIN_PANEL_URL", "http://localhost:3000"),
 		},
@@ -205,6 +246,35 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return defaultValue
+		}
+		return duration
+	}
+	return defaultValue
+}
+
+// parseKeyList parses "kid:secret,kid:secret" into a key ID to secret map.
+func parseKeyList(value string) (map[string]string, error) {
+	keys := make(map[string]string)
+	if value == "" {
+		return keys, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		id, secret, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || id == "" || secret == "" {
+			return nil, fmt.Errorf("invalid key entry %q", entry)
+		}
+		keys[id] = secret
+	}
+
+	return keys, nil
+}
+
 func createDSN() string {
 	return fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=disable",
 		getEnv("POSTGRES_USER", "postgres"),
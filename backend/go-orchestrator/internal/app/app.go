@@ -18,6 +18,7 @@ import (
 	grpcserver "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/grpc/server"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/middleware"
 	v1 "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/cache"
 	repo "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/webapi"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
@@ -71,6 +72,12 @@ func Run(cfg *config.Config) {
 		logger.Error("app - Run - minio.New", err, nil)
 	}
 
+	recordingStore, err := minio.NewRecordingStore(&cfg.MinIO)
+	if err != nil {
+		logger.Error("app - Run - minio.NewRecordingStore", err, nil)
+		return
+	}
+
 	jwtService := jwt.NewJWTService(
 		cfg.AccessSecret,
 		cfg.RefreshSecret,
@@ -82,17 +89,31 @@ func Run(cfg *config.Config) {
 	goodRepo := repo.NewGoodRepo(pg)
 	orderRepo := repo.NewOrderRepo(pg)
 	droneRepo := repo.NewDroneRepo(pg)
+	droneCredentialRepo := repo.NewDroneCredentialRepo(pg)
 	lockerRepo := repo.NewLockerRepo(pg)
 	internalLockerRepo := repo.NewInternalLockerRepo(pg)
 	deliveryRepo := repo.NewDeliveryRepo(pg)
 	parcelAutomatRepo := repo.NewParcelAutomatRepo(pg)
 	deviceRepo := repo.NewDeviceRepo(pg)
-
-	qrAdapter := webapi.NewQRAdapter(qrGenerator)
-	qrUC := usecase.NewQRUseCase(qrGenerator, userRepo, minioClient, logger)
+	pickupGrantRepo := repo.NewPickupGrantRepo(pg)
+	pickupPINRepo := repo.NewPickupPINRepo(pg)
+	userQRSecretRepo := repo.NewUserQRSecretRepo(pg)
+	qrNonceCache := cache.NewQRNonceCache(rdb)
+	notificationDeliveryRepo := repo.NewNotificationDeliveryRepo(pg)
+	notificationPreferencesRepo := repo.NewNotificationPreferencesRepo(pg)
+	scheduledNotificationRepo := repo.NewScheduledNotificationRepo(pg)
+	webhookSubscriptionRepo := repo.NewWebhookSubscriptionRepo(pg)
+	webhookDeliveryRepo := repo.NewWebhookDeliveryRepo(pg)
+	telemetryRepo := repo.NewTelemetryRepo(pg)
+	deliveryRecordingRepo := repo.NewDeliveryRecordingRepo(pg)
+	smsRateLimiter := cache.NewSMSRateLimiter(rdb)
+
+	qrAdapter := webapi.NewQRAdapter(qrGenerator, userQRSecretRepo)
+	qrUC := usecase.NewQRUseCase(qrGenerator, userRepo, userQRSecretRepo, qrNonceCache, minioClient, logger)
 	orangePIAdapter := webapi.NewOrangePIAdapter()
 
 	smsWebAPI := webapi.NewSMSAeroAPI(cfg.SMSAero.Email, cfg.APIKey, cfg.BaseURL)
+	webhookClient := webapi.NewWebhookClient(10 * time.Second)
 
 	rabbitmqClient, err := rabbitmq.NewClient(&cfg.RabbitMQ, logger)
 	if err != nil {
@@ -119,14 +140,52 @@ func Run(cfg *config.Config) {
 		pushSender = webapi.NewNoopSender()
 	}
 
-	notificationUC := usecase.NewNotificationUseCase(deviceRepo, pushSender, logger)
-	userUC := usecase.NewUserUseCase(userRepo, smsWebAPI, qrAdapter, jwtService, validator.New(), logger)
+	var emailSender webapi.EmailSender
+	if cfg.SMTP.Host != "" {
+		emailSender, err = webapi.NewSMTPEmailSender(webapi.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+			StartTLS: cfg.SMTP.StartTLS,
+		})
+		if err != nil {
+			logger.Error("app - Run - SMTPEmailSender.New", err, nil)
+			emailSender = webapi.NewNoopEmailSender()
+		}
+	} else {
+		emailSender = webapi.NewNoopEmailSender()
+	}
+
+	pickupPINUC := usecase.NewPickupPINUseCase(pickupPINRepo, orderRepo, deliveryRepo, qrGenerator, cache.NewPickupPINThrottle(rdb), logger)
+	notificationUC := usecase.NewNotificationUseCase(
+		deviceRepo,
+		pushSender,
+		userRepo,
+		smsWebAPI,
+		emailSender,
+		notificationDeliveryRepo,
+		notificationPreferencesRepo,
+		scheduledNotificationRepo,
+		pickupPINUC,
+		smsRateLimiter,
+		usecase.SMSFallbackPolicy{Limit: cfg.SMSFallbackLimit, Window: cfg.SMSFallbackWindow},
+		logger,
+	)
+	webhookUC := usecase.NewWebhookUseCase(webhookSubscriptionRepo, webhookDeliveryRepo, webhookClient, logger)
+	userUC := usecase.NewUserUseCase(userRepo, smsWebAPI, qrAdapter, notificationUC, jwtService, validator.New(), logger)
 	goodUC := usecase.NewGoodUseCase(goodRepo, logger)
-	orderUC := usecase.NewOrderUseCase(orderRepo, goodRepo, droneRepo, deliveryRepo, parcelAutomatRepo, lockerRepo, internalLockerRepo, rabbitmqClient, logger)
-	droneUC := usecase.NewDroneUseCase(droneRepo, logger)
-	deliveryUC := usecase.NewDeliveryUseCase(deliveryRepo, orderRepo, lockerRepo, internalLockerRepo, rabbitmqClient, notificationUC, logger)
+	orderUC := usecase.NewOrderUseCase(orderRepo, goodRepo, droneRepo, deliveryRepo, parcelAutomatRepo, lockerRepo, internalLockerRepo, rabbitmqClient, notificationUC, webhookUC, logger)
+	recordingUC := usecase.NewDeliveryRecordingUseCase(deliveryRecordingRepo, deliveryRepo, recordingStore, logger)
+	deliveryUC := usecase.NewDeliveryUseCase(deliveryRepo, orderRepo, lockerRepo, internalLockerRepo, parcelAutomatRepo, rabbitmqClient, notificationUC, webhookUC, pickupPINUC, recordingUC, logger)
+	fleetUC := usecase.NewFleetStateUseCase(droneRepo, rabbitmqClient, deliveryUC, logger)
+	droneUC := usecase.NewDroneUseCase(droneRepo, droneCredentialRepo, fleetUC, logger)
+	orderTrackingUC := usecase.NewOrderTrackingUseCase(orderRepo, deliveryRepo, parcelAutomatRepo, fleetUC, logger)
 	lockerUC := usecase.NewLockerUseCase(lockerRepo, logger)
-	parcelAutomatUC := usecase.NewParcelAutomatUseCase(parcelAutomatRepo, lockerRepo, internalLockerRepo, orderRepo, deliveryRepo, qrUC, orangePIAdapter, logger)
+	pickupGrantUC := usecase.NewPickupGrantUseCase(pickupGrantRepo, orderRepo, userRepo, qrGenerator, notificationUC, logger)
+	telemetryUC := usecase.NewTelemetryUseCase(droneRepo, deliveryRepo, telemetryRepo, logger)
+	parcelAutomatUC := usecase.NewParcelAutomatUseCase(parcelAutomatRepo, lockerRepo, internalLockerRepo, orderRepo, deliveryRepo, qrUC, pickupGrantUC, pickupPINUC, notificationUC, webhookUC, orangePIAdapter, logger)
 
 	go orderUC.StartPendingOrdersWorker(ctx, 30*time.Second)
 	logger.Info("Started pending orders worker (checking every 30s)", nil, nil)
@@ -134,6 +193,24 @@ func Run(cfg *config.Config) {
 	go deliveryUC.StartConfirmationConsumer(ctx)
 	logger.Info("Started delivery confirmation consumer", nil, nil)
 
+	if err := fleetUC.StartTelemetryConsumer(); err != nil {
+		logger.Error("app - Run - fleetUC.StartTelemetryConsumer", err, nil)
+	} else {
+		logger.Info("Started drone telemetry consumer", nil, nil)
+	}
+
+	go notificationUC.StartScheduledNotificationsWorker(ctx, time.Minute)
+	logger.Info("Started scheduled notifications worker (checking every 1m)", nil, nil)
+
+	go deliveryUC.StartPickupReminderWorker(ctx, time.Hour)
+	logger.Info("Started pickup reminder worker (checking every 1h)", nil, nil)
+
+	go webhookUC.StartDeliveryWorker(ctx, 10*time.Second)
+	logger.Info("Started webhook delivery worker (checking every 10s)", nil, nil)
+
+	go recordingUC.StartAssemblyWorker(ctx, 30*time.Second)
+	logger.Info("Started delivery recording worker (checking every 30s)", nil, nil)
+
 	gin.SetMode(cfg.GinMode)
 	router := gin.New()
 
@@ -150,7 +227,7 @@ func Run(cfg *config.Config) {
 
 	jwtMiddleware := middleware.NewJWTMiddleware(jwtService)
 
-	v1.NewRouter(router, userUC, goodUC, orderUC, droneUC, deliveryUC, lockerUC, parcelAutomatUC, qrUC, notificationUC, jwtMiddleware, limiter)
+	v1.NewRouter(router, userUC, goodUC, orderUC, orderTrackingUC, droneUC, fleetUC, deliveryUC, lockerUC, parcelAutomatUC, qrUC, notificationUC, pickupGrantUC, pickupPINUC, webhookUC, telemetryUC, recordingUC, jwtMiddleware, limiter)
 
 	httpServer := &http.Server{
 		Addr:    ":" + cfg.HTTP.Port,
@@ -18,10 +18,12 @@ type Limiter struct {
 const (
 	UserRateLimit  = 200
 	QrRateLimit    = 30
+	PinRateLimit   = 5
 	OrderRateLimit = 300
 
 	UserPeriod  = "5-M"
 	QrPeriod    = "1-M"
+	PinPeriod   = "1-M"
 	OrderPeriod = "5-M"
 
 	DefaultPeriod = "24-M"
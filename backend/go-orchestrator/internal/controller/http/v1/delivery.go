@@ -2,6 +2,7 @@ package v1
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -15,13 +16,14 @@ type deliveryRoutes struct {
 	uc *usecase.DeliveryUseCase
 }
 
-func newDeliveryRoutes(g *gin.RouterGroup, uc *usecase.DeliveryUseCase) {
+func newDeliveryRoutes(g *gin.RouterGroup, uc *usecase.DeliveryUseCase, adminOnly gin.HandlerFunc) {
 	r := &deliveryRoutes{uc: uc}
 
 	group := g.Group("/deliveries")
 	{
 		group.GET("/:id", r.get)
 		group.PUT("/:id/status", r.updateStatus)
+		group.POST("/:id/eta", adminOnly, r.reportETA)
 		group.POST("/confirm-loaded", r.confirmGoodsLoaded)
 	}
 }
@@ -86,6 +88,40 @@ func (r *deliveryRoutes) updateStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, response.Success{Success: true})
 }
 
+// @Summary      Report delivery ETA
+// @Description  Reports the estimated time until the drone reaches the parcel automat; notifies the customer once it drops to 5 minutes. The orchestrator also estimates it from drone telemetry; this endpoint is for admins and services with a better estimate
+// @Tags         deliveries
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Delivery ID"
+// @Param        request body request.ReportDeliveryETARequest true "Seconds until arrival"
+// @Success      200 {object} response.Success
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /deliveries/{id}/eta [post]
+func (r *deliveryRoutes) reportETA(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid delivery ID"})
+		return
+	}
+
+	var req request.ReportDeliveryETARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
+		return
+	}
+
+	if err := r.uc.ReportETA(c.Request.Context(), id, time.Duration(req.ETASeconds)*time.Second); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Success{Success: true})
+}
+
 // @Summary      Confirm goods loaded
 // @Description  Confirms goods loading from cell to drone
 // @Tags         deliveries
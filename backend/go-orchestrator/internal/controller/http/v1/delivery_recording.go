@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/response"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
+)
+
+type deliveryRecordingRoutes struct {
+	uc *usecase.DeliveryRecordingUseCase
+}
+
+func newDeliveryRecordingRoutes(protected *gin.RouterGroup, uc *usecase.DeliveryRecordingUseCase, adminOnly gin.HandlerFunc) {
+	r := &deliveryRecordingRoutes{uc: uc}
+
+	protected.GET("/deliveries/:id/video", adminOnly, r.getVideo)
+}
+
+// @Summary      Delivery video
+// @Description  Returns presigned links to the MJPEG AVI assembled from the frames the drone streamed during the delivery and to a JSON manifest listing the frames in capture order. Links expire after 15 minutes. Recordings are assembled in the background after the delivery is finished; until then the endpoint answers 202 with the current status
+// @Tags         deliveries
+// @Produce      json
+// @Param        id path string true "Delivery ID"
+// @Success      200 {object} response.DeliveryVideo
+// @Success      202 {object} response.DeliveryVideo
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      409 {object} response.Error
+// @Security     Bearer
+// @Router       /deliveries/{id}/video [get]
+func (r *deliveryRecordingRoutes) getVideo(c *gin.Context) {
+	deliveryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid delivery ID"})
+		return
+	}
+
+	video, err := r.uc.GetVideo(c.Request.Context(), deliveryID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	recording := video.Recording
+	resp := response.DeliveryVideo{
+		DeliveryID: recording.DeliveryID,
+		Status:     recording.Status,
+		FrameCount: recording.FrameCount,
+		DurationMs: recording.DurationMs,
+		SizeBytes:  recording.SizeBytes,
+	}
+	if recording.Status != entity.DeliveryRecordingReady {
+		c.JSON(http.StatusAccepted, resp)
+		return
+	}
+
+	resp.VideoURL = video.VideoURL
+	resp.ManifestURL = video.ManifestURL
+	resp.ExpiresAt = &video.ExpiresAt
+	c.JSON(http.StatusOK, resp)
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/request"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/response"
-	_ "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
 )
 
@@ -15,7 +15,7 @@ type droneRoutes struct {
 	uc *usecase.DroneUseCase
 }
 
-func newDroneRoutes(g *gin.RouterGroup, uc *usecase.DroneUseCase) {
+func newDroneRoutes(g *gin.RouterGroup, uc *usecase.DroneUseCase, adminOnly gin.HandlerFunc) {
 	r := &droneRoutes{uc: uc}
 
 	group := g.Group("/drones")
@@ -27,6 +27,8 @@ func newDroneRoutes(g *gin.RouterGroup, uc *usecase.DroneUseCase) {
 		group.PUT("/:id", r.update)
 		group.PATCH("/:id/status", r.updateStatus)
 		group.DELETE("/:id", r.delete)
+		group.GET("/:id/credential", adminOnly, r.getCredential)
+		group.POST("/:id/credential/rotate", adminOnly, r.rotateCredential)
 	}
 }
 
@@ -106,12 +108,12 @@ func (r *droneRoutes) getDroneByID(c *gin.Context) {
 }
 
 // @Summary      Create drone
-// @Description  Creates a new drone with specified model
+// @Description  Creates a new drone with specified model and issues its first credential. The secret is returned only once and must be loaded onto the drone
 // @Tags         drones
 // @Accept       json
 // @Produce      json
 // @Param        request body request.CreateDroneRequest true "Drone model"
-// @Success      201 {object} entity.Drone
+// @Success      201 {object} response.CreatedDrone
 // @Failure      400 {object} response.Error
 // @Failure      500 {object} response.Error
 // @Security     Bearer
@@ -123,13 +125,16 @@ func (r *droneRoutes) create(c *gin.Context) {
 		return
 	}
 
-	drone, err := r.uc.Create(c.Request.Context(), req.Model, req.IPAddress)
+	drone, credential, err := r.uc.Create(c.Request.Context(), req.Model, req.IPAddress)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, drone)
+	c.JSON(http.StatusCreated, response.CreatedDrone{
+		Drone:      *drone,
+		Credential: toIssuedDroneCredentialResponse(credential),
+	})
 }
 
 // @Summary      Update drone
@@ -229,3 +234,74 @@ func (r *droneRoutes) delete(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// @Summary      Get drone credential
+// @Description  Returns the version of the drone's key, when it was rotated and when the drone last authenticated with it. The secret itself is never returned here
+// @Tags         drones
+// @Produce      json
+// @Param        id path string true "Drone ID"
+// @Success      200 {object} response.DroneCredential
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /drones/{id}/credential [get]
+func (r *droneRoutes) getCredential(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid drone ID"})
+		return
+	}
+
+	credential, err := r.uc.GetCredential(c.Request.Context(), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toDroneCredentialResponse(credential))
+}
+
+// @Summary      Rotate drone credential
+// @Description  Issues a new key for the drone and invalidates the old one. The drone authenticates to the drone-service with HMAC-SHA256(secret, "<drone_id>:<nonce>"); the secret is returned only once
+// @Tags         drones
+// @Produce      json
+// @Param        id path string true "Drone ID"
+// @Success      200 {object} response.IssuedDroneCredential
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /drones/{id}/credential/rotate [post]
+func (r *droneRoutes) rotateCredential(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid drone ID"})
+		return
+	}
+
+	credential, err := r.uc.RotateCredential(c.Request.Context(), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toIssuedDroneCredentialResponse(credential))
+}
+
+func toDroneCredentialResponse(credential *entity.DroneCredential) response.DroneCredential {
+	return response.DroneCredential{
+		DroneID:         credential.DroneID,
+		KeyVersion:      credential.KeyVersion,
+		LastConnectedAt: credential.LastConnectedAt,
+		CreatedAt:       credential.CreatedAt,
+		RotatedAt:       credential.RotatedAt,
+	}
+}
+
+func toIssuedDroneCredentialResponse(credential *entity.DroneCredential) response.IssuedDroneCredential {
+	return response.IssuedDroneCredential{
+		DroneCredential: toDroneCredentialResponse(credential),
+		Secret:          credential.Secret,
+	}
+}
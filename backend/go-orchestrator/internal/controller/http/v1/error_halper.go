@@ -29,11 +29,25 @@ func handleError(c *gin.Context, err error) {
 		errors.Is(err, entityError.ErrVerificationCodeExpired),
 		errors.Is(err, entityError.ErrPasswordNotSet),
 		errors.Is(err, entityError.ErrUserNotFoundByEmail),
-		errors.Is(err, entityError.ErrUserEmailMismatch):
+		errors.Is(err, entityError.ErrUserEmailMismatch),
+		errors.Is(err, entityError.ErrPickupGrantInvalidExpiry),
+		errors.Is(err, entityError.ErrPickupGrantInvalidPhone),
+		errors.Is(err, entityError.ErrPickupGrantOrderNotEligible),
+		errors.Is(err, entityError.ErrPickupPINOrderNotEligible),
+		errors.Is(err, entityError.ErrNotificationUnknownEvent),
+		errors.Is(err, entityError.ErrNotificationInvalidChannel),
+		errors.Is(err, entityError.ErrNotificationInvalidQuietHours),
+		errors.Is(err, entityError.ErrNotificationInvalidTimezone),
+		errors.Is(err, entityError.ErrWebhookInvalidURL),
+		errors.Is(err, entityError.ErrWebhookInvalidEvent),
+		errors.Is(err, entityError.ErrWebhookNoEvents),
+		errors.Is(err, entityError.ErrTelemetryInvalidRange),
+		errors.Is(err, entityError.ErrTelemetryTooManyPoints):
 		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
 
 	case errors.Is(err, entityError.ErrDroneNotFound),
 		errors.Is(err, entityError.ErrDroneNotAvailable),
+		errors.Is(err, entityError.ErrDroneCredentialNotFound),
 		errors.Is(err, entityError.ErrGoodNotFound),
 		errors.Is(err, entityError.ErrOrderNotFound),
 		errors.Is(err, entityError.ErrUserNotFound),
@@ -42,7 +56,13 @@ func handleError(c *gin.Context, err error) {
 		errors.Is(err, entityError.ErrParcelAutomatNotFound),
 		errors.Is(err, entityError.ErrDeliveryNotFound),
 		errors.Is(err, entityError.ErrDeviceNotFound),
-		errors.Is(err, entityError.ErrQRNoOrdersForPickup):
+		errors.Is(err, entityError.ErrQRNoOrdersForPickup),
+		errors.Is(err, entityError.ErrPickupGrantNotFound),
+		errors.Is(err, entityError.ErrNotificationDeliveryNotFound),
+		errors.Is(err, entityError.ErrWebhookSubscriptionNotFound),
+		errors.Is(err, entityError.ErrWebhookDeliveryNotFound),
+		errors.Is(err, entityError.ErrDeliveryRecordingNotFound),
+		errors.Is(err, entityError.ErrDeliveryRecordingEmpty):
 		c.JSON(http.StatusNotFound, response.Error{Error: err.Error()})
 
 	case errors.Is(err, entityError.ErrDroneCannotDelete),
@@ -52,13 +72,24 @@ func handleError(c *gin.Context, err error) {
 		errors.Is(err, entityError.ErrUserAlreadyExists),
 		errors.Is(err, entityError.ErrUserEmailAlreadyExists),
 		errors.Is(err, entityError.ErrUserPhoneAlreadyExists),
-		errors.Is(err, entityError.ErrLockerCellAlreadyExists):
+		errors.Is(err, entityError.ErrLockerCellAlreadyExists),
+		errors.Is(err, entityError.ErrPickupGrantAlreadyUsed),
+		errors.Is(err, entityError.ErrPickupGrantWrongAutomat),
+		errors.Is(err, entityError.ErrDeliveryRecordingNotAvailable),
+		errors.Is(err, entityError.ErrDeliveryRecordingFailed):
 		c.JSON(http.StatusConflict, response.Error{Error: err.Error()})
 
+	case errors.Is(err, entityError.ErrPickupGrantExpired),
+		errors.Is(err, entityError.ErrPickupGrantRevoked):
+		c.JSON(http.StatusGone, response.Error{Error: err.Error()})
+
 	case errors.Is(err, entityError.ErrInvalidCredentials),
 		errors.Is(err, entityError.ErrPhoneNotVerified),
 		errors.Is(err, entityError.ErrQRValidationFailed),
 		errors.Is(err, entityError.ErrQRUserMismatch),
+		errors.Is(err, entityError.ErrQRReplayed),
+		errors.Is(err, entityError.ErrQRRevoked),
+		errors.Is(err, entityError.ErrPickupPINInvalid),
 		errors.Is(err, jwtError.ErrTokenInvalid),
 		errors.Is(err, jwtError.ErrTokenExpired),
 		errors.Is(err, jwtError.ErrTokenInvalidType),
@@ -71,9 +102,14 @@ func handleError(c *gin.Context, err error) {
 	case errors.Is(err, jwtError.ErrTokenGenerationFailed):
 		c.JSON(http.StatusInternalServerError, response.Error{Error: "Failed to generate tokens"})
 
-	case errors.Is(err, entityError.ErrOrderNotBelongsToUser):
+	case errors.Is(err, entityError.ErrOrderNotBelongsToUser),
+		errors.Is(err, entityError.ErrPickupGrantNotForUser),
+		errors.Is(err, entityError.ErrQRStaticNotAllowed):
 		c.JSON(http.StatusForbidden, response.Error{Error: err.Error()})
 
+	case errors.Is(err, entityError.ErrPickupPINThrottled):
+		c.JSON(http.StatusTooManyRequests, response.Error{Error: err.Error()})
+
 	default:
 		c.JSON(http.StatusInternalServerError, response.Error{Error: "Internal server error"})
 	}
@@ -11,14 +11,16 @@ import (
 
 type monitoringRoutes struct {
 	droneUC         *usecase.DroneUseCase
+	fleetUC         *usecase.FleetStateUseCase
 	parcelAutomatUC *usecase.ParcelAutomatUseCase
 	deliveryUC      *usecase.DeliveryUseCase
 	orderUC         *usecase.OrderUseCase
 }
 
-func newMonitoringRoutes(g *gin.RouterGroup, droneUC *usecase.DroneUseCase, parcelAutomatUC *usecase.ParcelAutomatUseCase, deliveryUC *usecase.DeliveryUseCase, orderUC *usecase.OrderUseCase) {
+func newMonitoringRoutes(g *gin.RouterGroup, droneUC *usecase.DroneUseCase, fleetUC *usecase.FleetStateUseCase, parcelAutomatUC *usecase.ParcelAutomatUseCase, deliveryUC *usecase.DeliveryUseCase, orderUC *usecase.OrderUseCase) {
 	r := &monitoringRoutes{
 		droneUC:         droneUC,
+		fleetUC:         fleetUC,
 		parcelAutomatUC: parcelAutomatUC,
 		deliveryUC:      deliveryUC,
 		orderUC:         orderUC,
@@ -31,7 +33,7 @@ func newMonitoringRoutes(g *gin.RouterGroup, droneUC *usecase.DroneUseCase, parc
 }
 
 // @Summary      System status
-// @Description  Returns current system state: list of drones, parcel automats and active deliveries
+// @Description  Returns current system state: list of drones with their latest telemetry, parcel automats and active deliveries
 // @Tags         monitoring
 // @Accept       json
 // @Produce      json
@@ -80,6 +82,7 @@ func (r *monitoringRoutes) getSystemStatus(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response.SystemStatus{
 		Drones:           drones,
+		Telemetry:        r.fleetUC.List(),
 		Automats:         automatsWithCells,
 		ActiveDeliveries: allActiveDeliveries,
 	})
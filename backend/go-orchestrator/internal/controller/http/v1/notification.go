@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/middleware"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/request"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/response"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
+)
+
+type notificationRoutes struct {
+	uc *usecase.NotificationUseCase
+}
+
+func newNotificationRoutes(protected *gin.RouterGroup, uc *usecase.NotificationUseCase, adminOnly gin.HandlerFunc) {
+	r := &notificationRoutes{uc: uc}
+
+	protected.GET("/users/me/notification-preferences", r.getPreferences)
+	protected.PUT("/users/me/notification-preferences", r.updatePreferences)
+	protected.POST("/notifications/email/bounces", adminOnly, r.recordEmailBounce)
+}
+
+// @Summary      Get notification preferences
+// @Description  Returns the current user's notification channels per event, quiet hours and language. Defaults are returned when nothing was saved yet
+// @Tags         notifications
+// @Produce      json
+// @Success      200 {object} response.NotificationPreferences
+// @Failure      401 {object} response.Error
+// @Security     Bearer
+// @Router       /users/me/notification-preferences [get]
+func (r *notificationRoutes) getPreferences(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+	if c.IsAborted() {
+		return
+	}
+
+	prefs, err := r.uc.GetPreferences(c.Request.Context(), userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toNotificationPreferencesResponse(prefs))
+}
+
+// @Summary      Update notification preferences
+// @Description  Replaces the current user's notification preferences. Events missing from channels stay enabled on every channel. Non-urgent notifications raised during quiet hours are delivered when they end; pickup-critical ones bypass quiet hours unless critical_override is false
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        request body request.UpdateNotificationPreferences true "Notification preferences"
+// @Success      200 {object} response.NotificationPreferences
+// @Failure      400 {object} response.Error
+// @Failure      401 {object} response.Error
+// @Security     Bearer
+// @Router       /users/me/notification-preferences [put]
+func (r *notificationRoutes) updatePreferences(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+	if c.IsAborted() {
+		return
+	}
+
+	var req request.UpdateNotificationPreferences
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
+		return
+	}
+
+	channels := make(map[entity.NotificationEvent][]string, len(req.Channels))
+	for event, eventChannels := range req.Channels {
+		channels[entity.NotificationEvent(event)] = eventChannels
+	}
+
+	criticalOverride := true
+	if req.CriticalOverride != nil {
+		criticalOverride = *req.CriticalOverride
+	}
+
+	prefs, err := r.uc.UpdatePreferences(c.Request.Context(), &entity.NotificationPreferences{
+		UserID:           userID,
+		Channels:         channels,
+		QuietHoursStart:  req.QuietHoursStart,
+		QuietHoursEnd:    req.QuietHoursEnd,
+		Timezone:         req.Timezone,
+		Language:         req.Language,
+		CriticalOverride: criticalOverride,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toNotificationPreferencesResponse(prefs))
+}
+
+func toNotificationPreferencesResponse(prefs *entity.NotificationPreferences) response.NotificationPreferences {
+	channels := make(map[string][]string, len(prefs.Channels))
+	for event, eventChannels := range prefs.Channels {
+		channels[string(event)] = eventChannels
+	}
+
+	resp := response.NotificationPreferences{
+		Channels:         channels,
+		QuietHoursStart:  prefs.QuietHoursStart,
+		QuietHoursEnd:    prefs.QuietHoursEnd,
+		Timezone:         prefs.Timezone,
+		Language:         prefs.Language,
+		CriticalOverride: prefs.CriticalOverride,
+	}
+	if !prefs.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &prefs.UpdatedAt
+	}
+	return resp
+}
+
+// @Summary      Record email bounce
+// @Description  Marks an email notification as bounced by its Message-ID. Used by the mail relay bounce hook
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        request body request.EmailBounce true "Bounce details"
+// @Success      200 {object} response.NotificationDelivery
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /notifications/email/bounces [post]
+func (r *notificationRoutes) recordEmailBounce(c *gin.Context) {
+	var req request.EmailBounce
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
+		return
+	}
+
+	delivery, err := r.uc.RecordEmailBounce(c.Request.Context(), req.MessageID, req.Reason)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NotificationDelivery{
+		ID:             delivery.ID,
+		NotificationID: delivery.NotificationID,
+		UserID:         delivery.UserID,
+		Event:          string(delivery.Event),
+		Channel:        delivery.Channel,
+		Status:         delivery.Status,
+		MessageID:      delivery.MessageID,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt,
+	})
+}
@@ -1,22 +1,28 @@
 package v1
 
 import (
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/middleware"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/request"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/response"
 	_ "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
 )
 
+const orderTrackingKeepAlive = 15 * time.Second
+
 type orderRoutes struct {
-	uc *usecase.OrderUseCase
+	uc       *usecase.OrderUseCase
+	tracking *usecase.OrderTrackingUseCase
 }
 
-func newOrderRoutes(g *gin.RouterGroup, uc *usecase.OrderUseCase, orderRateLimiter gin.HandlerFunc) {
-	r := &orderRoutes{uc: uc}
+func newOrderRoutes(g *gin.RouterGroup, uc *usecase.OrderUseCase, tracking *usecase.OrderTrackingUseCase, orderRateLimiter gin.HandlerFunc) {
+	r := &orderRoutes{uc: uc, tracking: tracking}
 
 	group := g.Group("/orders")
 	{
@@ -24,6 +30,7 @@ func newOrderRoutes(g *gin.RouterGroup, uc *usecase.OrderUseCase, orderRateLimit
 		group.POST("/batch", orderRateLimiter, r.createMultiple)
 		group.POST("/:id/return", r.returnOrder)
 		group.GET("/:id", r.get)
+		group.GET("/:id/track", r.track)
 		group.GET("/user/:userId", r.getUserOrders)
 	}
 }
@@ -135,6 +142,60 @@ func (r *orderRoutes) get(c *gin.Context) {
 	c.JSON(http.StatusOK, order)
 }
 
+// @Summary      Track order
+// @Description  Server-Sent Events stream for the current user's order. A "status" event is sent on connect and on every order or delivery status change; while the drone is in flight "position" events carry its position, speed and ETA, at most once every 2 seconds. The stream ends after a final status (completed, cancelled, failed)
+// @Tags         orders
+// @Produce      text/event-stream
+// @Param        id path string true "Order ID (UUID)"
+// @Success      200 {object} entity.OrderTrackingState
+// @Failure      400 {object} response.Error
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /orders/{id}/track [get]
+func (r *orderRoutes) track(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+	if c.IsAborted() {
+		return
+	}
+
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid order ID"})
+		return
+	}
+
+	updates, err := r.tracking.Track(c.Request.Context(), userID, orderID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	keepAlive := time.NewTicker(orderTrackingKeepAlive)
+	defer keepAlive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return false
+			}
+			if update.Status != nil {
+				c.SSEvent(string(update.Event), update.Status)
+			} else {
+				c.SSEvent(string(update.Event), update.Position)
+			}
+		case <-keepAlive.C:
+			_, _ = io.WriteString(w, ": keep-alive\n\n")
+		}
+		return true
+	})
+}
+
 // @Summary      Cancel order
 // @Description  Cancels order, frees cell and returns drone to base (mark 131)
 // @Tags         orders
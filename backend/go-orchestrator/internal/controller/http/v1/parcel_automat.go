@@ -15,12 +15,13 @@ type parcelAutomatRoutes struct {
 	uc *usecase.ParcelAutomatUseCase
 }
 
-func newParcelAutomatRoutes(public *gin.RouterGroup, protected *gin.RouterGroup, uc *usecase.ParcelAutomatUseCase) {
+func newParcelAutomatRoutes(public *gin.RouterGroup, protected *gin.RouterGroup, uc *usecase.ParcelAutomatUseCase, pinRateLimiter gin.HandlerFunc, adminOnly gin.HandlerFunc) {
 	r := &parcelAutomatRoutes{uc: uc}
 
 	publicGroup := public.Group("/automats")
 	{
 		publicGroup.POST("/qr-scan", r.processQRScan)
+		publicGroup.POST("/pin-entry", pinRateLimiter, r.processPINEntry)
 		publicGroup.POST("/confirm-pickup", r.confirmPickup)
 	}
 
@@ -34,6 +35,7 @@ func newParcelAutomatRoutes(public *gin.RouterGroup, protected *gin.RouterGroup,
 		protectedGroup.GET("/:id/cells", r.getCells)
 		protectedGroup.PATCH("/:id/cells/:cellId", r.updateCell)
 		protectedGroup.PATCH("/:id/status", r.updateStatus)
+		protectedGroup.PATCH("/:id/static-qr", adminOnly, r.updateStaticQR)
 		protectedGroup.DELETE("/:id", r.delete)
 	}
 }
@@ -293,6 +295,47 @@ func (r *parcelAutomatRoutes) updateStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, updatedAutomat)
 }
 
+// @Summary      Toggle static QR fallback
+// @Description  Allows or forbids long-lived static QR codes at the parcel automat (admin only). Static codes are allowed by default until the mobile app issues dynamic ones
+// @Tags         automats
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Parcel automat ID"
+// @Param        request body request.UpdateParcelAutomatStaticQRRequest true "Static QR fallback flag"
+// @Success      200 {object} entity.ParcelAutomat
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      500 {object} response.Error
+// @Security     Bearer
+// @Router       /automats/{id}/static-qr [patch]
+func (r *parcelAutomatRoutes) updateStaticQR(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid automat ID"})
+		return
+	}
+
+	var req request.UpdateParcelAutomatStaticQRRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
+		return
+	}
+
+	automat := &entity.ParcelAutomat{
+		ID:            id,
+		AllowStaticQR: req.AllowStaticQR,
+	}
+
+	updatedAutomat, err := r.uc.UpdateStaticQR(c.Request.Context(), automat)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, updatedAutomat)
+}
+
 // @Summary      Delete parcel automat
 // @Description  Deletes parcel automat by ID
 // @Tags         automats
@@ -360,6 +403,49 @@ func (r *parcelAutomatRoutes) processQRScan(c *gin.Context) {
 	})
 }
 
+// @Summary      Pickup PIN entry
+// @Description  Processes 6-digit pickup PIN entered on the parcel automat keypad and returns cell IDs to open. Repeated wrong PINs lock the automat keypad for a growing backoff (429)
+// @Tags         automats
+// @Accept       json
+// @Produce      json
+// @Param        request body request.PINEntryRequest true "PIN data"
+// @Success      200 {object} map[string]any
+// @Failure      400 {object} response.Error
+// @Failure      401 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      429 {object} response.Error
+// @Router       /automats/pin-entry [post]
+func (r *parcelAutomatRoutes) processPINEntry(c *gin.Context) {
+	var req request.PINEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
+		return
+	}
+
+	automatID, err := uuid.Parse(req.ParcelAutomatID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid parcel automat ID"})
+		return
+	}
+
+	cellIDs, err := r.uc.ProcessPINEntry(c.Request.Context(), req.PIN, automatID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	cellIDStrings := make([]string, 0, len(cellIDs))
+	for _, id := range cellIDs {
+		cellIDStrings = append(cellIDStrings, id.String())
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"message":  "PIN processed successfully",
+		"cell_ids": cellIDStrings,
+	})
+}
+
 // @Summary      Confirm goods pickup
 // @Description  Confirms that user picked up goods from cell
 // @Tags         automats
@@ -0,0 +1,261 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/middleware"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/request"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/response"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
+)
+
+type pickupGrantRoutes struct {
+	uc *usecase.PickupGrantUseCase
+}
+
+func newPickupGrantRoutes(public *gin.RouterGroup, protected *gin.RouterGroup, uc *usecase.PickupGrantUseCase, linkRateLimiter gin.HandlerFunc) {
+	r := &pickupGrantRoutes{uc: uc}
+
+	public.GET("/pickup-grants/link/:token", linkRateLimiter, r.getLinkQR)
+
+	ordersGroup := protected.Group("/orders/:id/pickup-grants")
+	{
+		ordersGroup.POST("", r.create)
+		ordersGroup.GET("", r.list)
+		ordersGroup.DELETE("/:grantId", r.revoke)
+	}
+
+	receivedGroup := protected.Group("/pickup-grants")
+	{
+		receivedGroup.GET("/received", r.listReceived)
+		receivedGroup.GET("/:grantId/qr", r.getReceivedQR)
+	}
+}
+
+// @Summary      Create pickup grant
+// @Description  Allows another person to collect the order. Grant is bound to a phone number or, without phone, issued as a one-time link
+// @Tags         pickup-grants
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Order ID (UUID)"
+// @Param        request body request.CreatePickupGrant true "Grant parameters"
+// @Success      201 {object} response.PickupGrant
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      500 {object} response.Error
+// @Security     Bearer
+// @Router       /orders/{id}/pickup-grants [post]
+func (r *pickupGrantRoutes) create(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+	if c.IsAborted() {
+		return
+	}
+
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid order ID"})
+		return
+	}
+
+	var req request.CreatePickupGrant
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
+		return
+	}
+
+	ttl := time.Duration(req.ExpiresInHours) * time.Hour
+
+	grant, err := r.uc.CreateGrant(c.Request.Context(), userID, orderID, req.Phone, ttl)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toPickupGrantResponse(grant))
+}
+
+// @Summary      List pickup grants
+// @Description  Returns all pickup grants issued for the order
+// @Tags         pickup-grants
+// @Produce      json
+// @Param        id path string true "Order ID (UUID)"
+// @Success      200 {array} response.PickupGrant
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /orders/{id}/pickup-grants [get]
+func (r *pickupGrantRoutes) list(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+	if c.IsAborted() {
+		return
+	}
+
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid order ID"})
+		return
+	}
+
+	grants, err := r.uc.ListGrants(c.Request.Context(), userID, orderID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	result := make([]response.PickupGrant, 0, len(grants))
+	for _, grant := range grants {
+		result = append(result, toPickupGrantResponse(grant))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary      Revoke pickup grant
+// @Description  Revokes an unused pickup grant
+// @Tags         pickup-grants
+// @Produce      json
+// @Param        id path string true "Order ID (UUID)"
+// @Param        grantId path string true "Grant ID (UUID)"
+// @Success      200 {object} response.Success
+// @Failure      400 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      409 {object} response.Error
+// @Security     Bearer
+// @Router       /orders/{id}/pickup-grants/{grantId} [delete]
+func (r *pickupGrantRoutes) revoke(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+	if c.IsAborted() {
+		return
+	}
+
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid order ID"})
+		return
+	}
+
+	grantID, err := uuid.Parse(c.Param("grantId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid grant ID"})
+		return
+	}
+
+	if err := r.uc.RevokeGrant(c.Request.Context(), userID, orderID, grantID); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Success{Success: true})
+}
+
+// @Summary      List received pickup grants
+// @Description  Returns active pickup grants issued to the current user's phone number
+// @Tags         pickup-grants
+// @Produce      json
+// @Success      200 {array} response.PickupGrant
+// @Failure      401 {object} response.Error
+// @Security     Bearer
+// @Router       /pickup-grants/received [get]
+func (r *pickupGrantRoutes) listReceived(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+	if c.IsAborted() {
+		return
+	}
+
+	grants, err := r.uc.ListReceivedGrants(c.Request.Context(), userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	result := make([]response.PickupGrant, 0, len(grants))
+	for _, grant := range grants {
+		result = append(result, toPickupGrantResponse(grant))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary      Get received pickup grant QR
+// @Description  Returns single-use QR code for a pickup grant issued to the current user's phone number
+// @Tags         pickup-grants
+// @Produce      json
+// @Param        grantId path string true "Grant ID (UUID)"
+// @Success      200 {object} response.PickupGrantQR
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      410 {object} response.Error
+// @Security     Bearer
+// @Router       /pickup-grants/{grantId}/qr [get]
+func (r *pickupGrantRoutes) getReceivedQR(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+	if c.IsAborted() {
+		return
+	}
+
+	grantID, err := uuid.Parse(c.Param("grantId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid grant ID"})
+		return
+	}
+
+	grantData, qrCodeBase64, err := r.uc.GetGrantQRForUser(c.Request.Context(), userID, grantID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.PickupGrantQR{
+		GrantID:   grantData.GrantID,
+		OrderID:   grantData.OrderID,
+		QRCode:    qrCodeBase64,
+		ExpiresAt: grantData.ExpiresAt.Unix(),
+	})
+}
+
+// @Summary      Get pickup grant QR by link
+// @Description  Returns single-use QR code for a pickup grant shared as a one-time link
+// @Tags         pickup-grants
+// @Produce      json
+// @Param        token path string true "Link token"
+// @Success      200 {object} response.PickupGrantQR
+// @Failure      404 {object} response.Error
+// @Failure      410 {object} response.Error
+// @Router       /pickup-grants/link/{token} [get]
+func (r *pickupGrantRoutes) getLinkQR(c *gin.Context) {
+	grantData, qrCodeBase64, err := r.uc.GetGrantQRByToken(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.PickupGrantQR{
+		GrantID:   grantData.GrantID,
+		OrderID:   grantData.OrderID,
+		QRCode:    qrCodeBase64,
+		ExpiresAt: grantData.ExpiresAt.Unix(),
+	})
+}
+
+func toPickupGrantResponse(grant *entity.PickupGrant) response.PickupGrant {
+	resp := response.PickupGrant{
+		ID:           grant.ID,
+		OrderID:      grant.OrderID,
+		GranteePhone: grant.GranteePhone,
+		ExpiresAt:    grant.ExpiresAt,
+		UsedAt:       grant.UsedAt,
+		RevokedAt:    grant.RevokedAt,
+		CreatedAt:    grant.CreatedAt,
+	}
+	if grant.IsLink() {
+		resp.LinkToken = grant.Token
+	}
+	return resp
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/middleware"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/response"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
+)
+
+type pickupPINRoutes struct {
+	uc *usecase.PickupPINUseCase
+}
+
+func newPickupPINRoutes(protected *gin.RouterGroup, uc *usecase.PickupPINUseCase) {
+	r := &pickupPINRoutes{uc: uc}
+
+	protected.POST("/orders/:id/pickup-pin", r.reissue)
+}
+
+// @Summary      Reissue pickup PIN
+// @Description  Generates a new 6-digit pickup PIN for a delivered order. The previous PIN stops working
+// @Tags         orders
+// @Produce      json
+// @Param        id path string true "Order ID (UUID)"
+// @Success      201 {object} response.PickupPIN
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /orders/{id}/pickup-pin [post]
+func (r *pickupPINRoutes) reissue(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+	if c.IsAborted() {
+		return
+	}
+
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid order ID"})
+		return
+	}
+
+	pin, record, err := r.uc.ReissuePIN(c.Request.Context(), userID, orderID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.PickupPIN{
+		OrderID:   record.OrderID,
+		PIN:       pin,
+		ExpiresAt: record.ExpiresAt,
+	})
+}
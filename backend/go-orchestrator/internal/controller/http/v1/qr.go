@@ -2,6 +2,7 @@ package v1
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -9,6 +10,7 @@ import (
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/request"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/response"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
+	qrpkg "github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
 )
 
 type qrRoutes struct {
@@ -31,6 +33,8 @@ func newQRRoutes(g *gin.RouterGroup, uc *usecase.QRUseCase, jwtMiddleware *middl
 		{
 			protected.GET("/me", r.getMyQR)
 			protected.POST("/refresh", r.refresh)
+			protected.GET("/secret", r.getSecret)
+			protected.POST("/revoke", r.revoke)
 		}
 	}
 }
@@ -138,3 +142,73 @@ func (qr *qrRoutes) getMyQR(c *gin.Context) {
 		ExpiresAt: qrInfo.ExpiresAt,
 	})
 }
+
+// @Summary      Get dynamic QR secret
+// @Description  Returns the per-user secret the app uses to derive rotating QR codes
+// @Tags         qr
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} response.QRSecretResponse
+// @Failure      401 {object} response.Error
+// @Failure      500 {object} response.Error
+// @Router       /qr/secret [get]
+func (qr *qrRoutes) getSecret(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+
+	secret, err := qr.uc.IssueQRSecret(c.Request.Context(), userID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.QRSecretResponse{
+		Secret:        secret,
+		PeriodSeconds: int64(qrpkg.DynamicPeriod / time.Second),
+	})
+}
+
+// @Summary      Revoke QR codes
+// @Description  Invalidates every issued QR code of the user and rotates the dynamic QR secret. Admins may revoke QR codes of any user
+// @Tags         qr
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body request.RevokeQRRequest false "Target user (admin only, defaults to current user)"
+// @Success      200 {object} response.RevokeQRResponse
+// @Failure      400 {object} response.Error
+// @Failure      401 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Failure      500 {object} response.Error
+// @Router       /qr/revoke [post]
+func (qr *qrRoutes) revoke(c *gin.Context) {
+	userID := middleware.MustGetUserID(c)
+
+	var req request.RevokeQRRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
+			return
+		}
+	}
+
+	targetID := userID
+	if req.UserID != nil && *req.UserID != userID {
+		if !middleware.IsAdmin(c) {
+			c.JSON(http.StatusForbidden, response.Error{Error: "Only admins can revoke QR codes of other users"})
+			return
+		}
+		targetID = *req.UserID
+	}
+
+	generation, err := qr.uc.RevokeQR(c.Request.Context(), targetID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.RevokeQRResponse{
+		UserID:     targetID,
+		Generation: generation,
+	})
+}
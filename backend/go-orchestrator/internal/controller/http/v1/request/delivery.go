@@ -9,3 +9,7 @@ type ConfirmGoodsLoadedRequest struct {
 	LockerCellID string `json:"locker_cell_id" binding:"required"`
 	Timestamp    string `json:"timestamp"`
 }
+
+type ReportDeliveryETARequest struct {
+	ETASeconds int `json:"eta_seconds" binding:"min=0"`
+}
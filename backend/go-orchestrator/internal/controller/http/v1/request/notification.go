@@ -0,0 +1,15 @@
+package request
+
+type EmailBounce struct {
+	MessageID string `json:"message_id" binding:"required"`
+	Reason    string `json:"reason"`
+}
+
+type UpdateNotificationPreferences struct {
+	Channels         map[string][]string `json:"channels"`
+	QuietHoursStart  *string             `json:"quiet_hours_start" binding:"omitempty,datetime=15:04"`
+	QuietHoursEnd    *string             `json:"quiet_hours_end" binding:"omitempty,datetime=15:04"`
+	Timezone         string              `json:"timezone" binding:"required"`
+	Language         string              `json:"language" binding:"required,oneof=ru en"`
+	CriticalOverride *bool               `json:"critical_override"`
+}
@@ -27,6 +27,10 @@ type UpdateParcelAutomatStatusRequest struct {
 	IsWorking bool `json:"is_working"`
 }
 
+type UpdateParcelAutomatStaticQRRequest struct {
+	AllowStaticQR bool `json:"allow_static_qr"`
+}
+
 type UpdateCellRequest struct {
 	Height float64 `json:"height" binding:"required"`
 	Length float64 `json:"length" binding:"required"`
@@ -38,6 +42,11 @@ type QRScanRequest struct {
 	ParcelAutomatID string `json:"parcel_automat_id" binding:"required"`
 }
 
+type PINEntryRequest struct {
+	PIN             string `json:"pin" binding:"required,len=6,numeric"`
+	ParcelAutomatID string `json:"parcel_automat_id" binding:"required"`
+}
+
 type ConfirmPickupRequest struct {
 	CellIDs []string `json:"cell_ids" binding:"required"`
 }
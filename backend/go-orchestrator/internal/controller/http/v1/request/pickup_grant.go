@@ -0,0 +1,6 @@
+package request
+
+type CreatePickupGrant struct {
+	Phone          *string `json:"phone" binding:"omitempty,russian_phone"`
+	ExpiresInHours int     `json:"expires_in_hours" binding:"omitempty,min=1,max=168"`
+}
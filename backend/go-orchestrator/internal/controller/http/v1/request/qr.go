@@ -1,5 +1,11 @@
 package request
 
+import "github.com/google/uuid"
+
 type ValidateRequest struct {
 	QRData string `json:"qr_data" binding:"required"`
 }
+
+type RevokeQRRequest struct {
+	UserID *uuid.UUID `json:"user_id"`
+}
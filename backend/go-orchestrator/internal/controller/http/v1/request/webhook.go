@@ -0,0 +1,12 @@
+package request
+
+type CreateWebhookSubscription struct {
+	URL    string   `json:"url" binding:"required,url"`
+	Events []string `json:"events" binding:"required,min=1"`
+}
+
+type UpdateWebhookSubscription struct {
+	URL      *string  `json:"url" binding:"omitempty,url"`
+	Events   []string `json:"events" binding:"omitempty,min=1"`
+	IsActive *bool    `json:"is_active"`
+}
@@ -1,5 +1,22 @@
 package response
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type Success struct {
 	Success bool `json:"success"`
 }
+
+type DeliveryVideo struct {
+	DeliveryID  uuid.UUID  `json:"delivery_id"`
+	Status      string     `json:"status"`
+	VideoURL    string     `json:"video_url,omitempty"`
+	ManifestURL string     `json:"manifest_url,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	FrameCount  int        `json:"frame_count"`
+	DurationMs  int64      `json:"duration_ms"`
+	SizeBytes   int64      `json:"size_bytes"`
+}
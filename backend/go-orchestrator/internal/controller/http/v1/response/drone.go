@@ -0,0 +1,26 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+)
+
+type DroneCredential struct {
+	DroneID         uuid.UUID  `json:"drone_id"`
+	KeyVersion      int        `json:"key_version"`
+	LastConnectedAt *time.Time `json:"last_connected_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	RotatedAt       time.Time  `json:"rotated_at"`
+}
+
+type IssuedDroneCredential struct {
+	DroneCredential
+	Secret string `json:"secret"`
+}
+
+type CreatedDrone struct {
+	entity.Drone
+	Credential IssuedDroneCredential `json:"credential"`
+}
@@ -10,9 +10,10 @@ type AutomatWithCells struct {
 }
 
 type SystemStatus struct {
-	Drones           []*entity.Drone    `json:"drones"`
-	Automats         []AutomatWithCells `json:"automats"`
-	ActiveDeliveries []*entity.Delivery `json:"active_deliveries"`
+	Drones           []*entity.Drone       `json:"drones"`
+	Telemetry        []*entity.DroneStatus `json:"telemetry"`
+	Automats         []AutomatWithCells    `json:"automats"`
+	ActiveDeliveries []*entity.Delivery    `json:"active_deliveries"`
 }
 
 type DroneDetails struct {
@@ -0,0 +1,29 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationDelivery struct {
+	ID             uuid.UUID `json:"id"`
+	NotificationID uuid.UUID `json:"notification_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Event          string    `json:"event"`
+	Channel        string    `json:"channel"`
+	Status         string    `json:"status"`
+	MessageID      *string   `json:"message_id,omitempty"`
+	Error          *string   `json:"error,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type NotificationPreferences struct {
+	Channels         map[string][]string `json:"channels"`
+	QuietHoursStart  *string             `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd    *string             `json:"quiet_hours_end,omitempty"`
+	Timezone         string              `json:"timezone"`
+	Language         string              `json:"language"`
+	CriticalOverride bool                `json:"critical_override"`
+	UpdatedAt        *time.Time          `json:"updated_at,omitempty"`
+}
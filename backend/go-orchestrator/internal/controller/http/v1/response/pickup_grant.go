@@ -0,0 +1,25 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PickupGrant struct {
+	ID           uuid.UUID  `json:"id"`
+	OrderID      uuid.UUID  `json:"order_id"`
+	GranteePhone *string    `json:"grantee_phone,omitempty"`
+	LinkToken    string     `json:"link_token,omitempty"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	UsedAt       *time.Time `json:"used_at,omitempty"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type PickupGrantQR struct {
+	GrantID   uuid.UUID `json:"grant_id"`
+	OrderID   uuid.UUID `json:"order_id"`
+	QRCode    string    `json:"qr_code"`
+	ExpiresAt int64     `json:"expires_at"`
+}
@@ -0,0 +1,13 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PickupPIN struct {
+	OrderID   uuid.UUID `json:"order_id"`
+	PIN       string    `json:"pin"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
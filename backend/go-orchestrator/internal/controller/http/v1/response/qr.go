@@ -1,5 +1,7 @@
 package response
 
+import "github.com/google/uuid"
+
 type ValidateResponse struct {
 	Valid            bool   `json:"valid"`
 	UserID           string `json:"user_id"`
@@ -21,3 +23,13 @@ type QRResponse struct {
 	IssuedAt  int64  `json:"issued_at"`
 	ExpiresAt int64  `json:"expires_at"`
 }
+
+type QRSecretResponse struct {
+	Secret        string `json:"secret"`
+	PeriodSeconds int64  `json:"period_seconds"`
+}
+
+type RevokeQRResponse struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Generation int       `json:"generation"`
+}
@@ -20,6 +20,7 @@ type Login struct {
 	AccessExpiresAt  int64        `json:"access_expires_at"`
 	RefreshExpiresAt int64        `json:"refresh_expires_at"`
 	QRCode           string       `json:"qr_code"`
+	QRSecret         string       `json:"qr_secret,omitempty"`
 }
 
 type RefreshToken struct {
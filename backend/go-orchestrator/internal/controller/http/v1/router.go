@@ -14,12 +14,19 @@ func NewRouter(
 	userUC *usecase.UserUseCase,
 	goodUC *usecase.GoodUseCase,
 	orderUC *usecase.OrderUseCase,
+	orderTrackingUC *usecase.OrderTrackingUseCase,
 	droneUC *usecase.DroneUseCase,
+	fleetUC *usecase.FleetStateUseCase,
 	deliveryUC *usecase.DeliveryUseCase,
 	lockerUC *usecase.LockerUseCase,
 	parcelAutomatUC *usecase.ParcelAutomatUseCase,
 	qrUC *usecase.QRUseCase,
 	notificationUC *usecase.NotificationUseCase,
+	pickupGrantUC *usecase.PickupGrantUseCase,
+	pickupPINUC *usecase.PickupPINUseCase,
+	webhookUC *usecase.WebhookUseCase,
+	telemetryUC *usecase.TelemetryUseCase,
+	recordingUC *usecase.DeliveryRecordingUseCase,
 	jwtMiddleware *middleware.JWTMiddleware,
 	limiter *middleware.Limiter,
 ) {
@@ -30,14 +37,20 @@ func NewRouter(
 		protected := v1.Group("")
 		protected.Use(jwtMiddleware.RequireAuth())
 
-		newUserRoutes(v1, userUC, jwtMiddleware.JWTService, notificationUC, protected, limiter.MiddleWare(middleware.UserPeriod, middleware.UserRateLimit))
+		newUserRoutes(v1, userUC, qrUC, jwtMiddleware.JWTService, notificationUC, protected, limiter.MiddleWare(middleware.UserPeriod, middleware.UserRateLimit))
 		newQRRoutes(v1, qrUC, jwtMiddleware, limiter.MiddleWare(middleware.QrPeriod, middleware.QrRateLimit))
 		newLockerRoutes(v1, lockerUC)
 		newGoodRoutes(protected, goodUC)
-		newOrderRoutes(protected, orderUC, limiter.MiddleWare(middleware.OrderPeriod, middleware.OrderRateLimit))
-		newDeliveryRoutes(protected, deliveryUC)
-		newDroneRoutes(protected, droneUC)
-		newParcelAutomatRoutes(v1, protected, parcelAutomatUC)
-		newMonitoringRoutes(protected, droneUC, parcelAutomatUC, deliveryUC, orderUC)
+		newOrderRoutes(protected, orderUC, orderTrackingUC, limiter.MiddleWare(middleware.OrderPeriod, middleware.OrderRateLimit))
+		newDeliveryRoutes(protected, deliveryUC, jwtMiddleware.AdminOnly())
+		newDroneRoutes(protected, droneUC, jwtMiddleware.AdminOnly())
+		newParcelAutomatRoutes(v1, protected, parcelAutomatUC, limiter.MiddleWare(middleware.PinPeriod, middleware.PinRateLimit), jwtMiddleware.AdminOnly())
+		newPickupGrantRoutes(v1, protected, pickupGrantUC, limiter.MiddleWare(middleware.QrPeriod, middleware.QrRateLimit))
+		newPickupPINRoutes(protected, pickupPINUC)
+		newNotificationRoutes(protected, notificationUC, jwtMiddleware.AdminOnly())
+		newWebhookRoutes(protected, webhookUC, jwtMiddleware.AdminOnly())
+		newTelemetryRoutes(protected, telemetryUC, jwtMiddleware.AdminOnly())
+		newDeliveryRecordingRoutes(protected, recordingUC, jwtMiddleware.AdminOnly())
+		newMonitoringRoutes(protected, droneUC, fleetUC, parcelAutomatUC, deliveryUC, orderUC)
 	}
 }
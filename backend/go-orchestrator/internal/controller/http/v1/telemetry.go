@@ -0,0 +1,205 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/response"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/geoexport"
+)
+
+const defaultTrackWindow = 24 * time.Hour
+
+type telemetryRoutes struct {
+	uc *usecase.TelemetryUseCase
+}
+
+func newTelemetryRoutes(protected *gin.RouterGroup, uc *usecase.TelemetryUseCase, adminOnly gin.HandlerFunc) {
+	r := &telemetryRoutes{uc: uc}
+
+	protected.GET("/drones/:id/tracks", adminOnly, r.getDroneTrack)
+	protected.GET("/deliveries/:id/track", adminOnly, r.getDeliveryTrack)
+}
+
+// @Summary      Drone flight history
+// @Description  Returns the telemetry recorded for the drone in [from, to) with a flight summary. The range defaults to the last 24 hours and may span at most 7 days. The track is exported as GPX 1.1, KML or GeoJSON when requested with the format parameter or the Accept header
+// @Tags         telemetry
+// @Produce      json
+// @Produce      application/gpx+xml
+// @Produce      application/vnd.google-earth.kml+xml
+// @Produce      application/geo+json
+// @Param        id path string true "Drone ID"
+// @Param        from query string false "Start of the range, RFC 3339"
+// @Param        to query string false "End of the range, RFC 3339"
+// @Param        format query string false "json, gpx, kml or geojson; overrides the Accept header"
+// @Param        max_points query int false "Thin the returned points to at most this many (JSON default 2000, exports default 0 which returns all)"
+// @Success      200 {object} entity.FlightTrack
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /drones/{id}/tracks [get]
+func (r *telemetryRoutes) getDroneTrack(c *gin.Context) {
+	droneID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid drone ID"})
+		return
+	}
+
+	to := time.Now()
+	if raw := c.Query("to"); raw != "" {
+		if to, err = time.Parse(time.RFC3339, raw); err != nil {
+			c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid to, expected RFC 3339"})
+			return
+		}
+	}
+
+	from := to.Add(-defaultTrackWindow)
+	if raw := c.Query("from"); raw != "" {
+		if from, err = time.Parse(time.RFC3339, raw); err != nil {
+			c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid from, expected RFC 3339"})
+			return
+		}
+	}
+
+	format, export, ok := negotiateTrackFormat(c)
+	if !ok {
+		return
+	}
+
+	maxPoints, ok := parseMaxPoints(c, export)
+	if !ok {
+		return
+	}
+
+	track, err := r.uc.GetDroneTrack(c.Request.Context(), droneID, from, to, maxPoints)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if !export {
+		c.JSON(http.StatusOK, track)
+		return
+	}
+
+	name := fmt.Sprintf("Drone %s, %s - %s", droneID, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+	writeTrackExport(c, format, track, name, fmt.Sprintf("drone-%s-%s", droneID, from.UTC().Format("20060102T150405Z")))
+}
+
+// @Summary      Delivery flight track
+// @Description  Returns the telemetry recorded while the drone was flying the delivery, with battery use and distance in the summary. The track is exported as GPX 1.1, KML or GeoJSON when requested with the format parameter or the Accept header
+// @Tags         telemetry
+// @Produce      json
+// @Produce      application/gpx+xml
+// @Produce      application/vnd.google-earth.kml+xml
+// @Produce      application/geo+json
+// @Param        id path string true "Delivery ID"
+// @Param        format query string false "json, gpx, kml or geojson; overrides the Accept header"
+// @Param        max_points query int false "Thin the returned points to at most this many (JSON default 2000, exports default 0 which returns all)"
+// @Success      200 {object} entity.FlightTrack
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /deliveries/{id}/track [get]
+func (r *telemetryRoutes) getDeliveryTrack(c *gin.Context) {
+	deliveryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid delivery ID"})
+		return
+	}
+
+	format, export, ok := negotiateTrackFormat(c)
+	if !ok {
+		return
+	}
+
+	maxPoints, ok := parseMaxPoints(c, export)
+	if !ok {
+		return
+	}
+
+	track, err := r.uc.GetDeliveryTrack(c.Request.Context(), deliveryID, maxPoints)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if !export {
+		c.JSON(http.StatusOK, track)
+		return
+	}
+
+	writeTrackExport(c, format, track, fmt.Sprintf("Delivery %s", deliveryID), fmt.Sprintf("delivery-%s", deliveryID))
+}
+
+// negotiateTrackFormat reports whether the client asked for a file export instead of JSON.
+// The format query parameter takes precedence over the Accept header.
+func negotiateTrackFormat(c *gin.Context) (geoexport.Format, bool, bool) {
+	if raw := c.Query("format"); raw != "" {
+		if raw == "json" {
+			return "", false, true
+		}
+		format, ok := geoexport.ParseFormat(raw)
+		if !ok {
+			c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid format, expected json, gpx, kml or geojson"})
+			return "", false, false
+		}
+		return format, true, true
+	}
+
+	format, ok := geoexport.FromAccept(c.GetHeader("Accept"))
+	return format, ok, true
+}
+
+func writeTrackExport(c *gin.Context, format geoexport.Format, track *entity.FlightTrack, name, filename string) {
+	export := geoexport.Track{
+		Name:   name,
+		Points: make([]geoexport.Point, 0, len(track.Points)),
+	}
+	if track.Summary.Points > 0 {
+		export.Description = fmt.Sprintf("Distance %.0f m, battery used %.2f%%", track.Summary.DistanceMeters, track.Summary.BatteryUsed)
+	}
+	for _, point := range track.Points {
+		export.Points = append(export.Points, geoexport.Point{
+			Latitude:  point.Position.Latitude,
+			Longitude: point.Position.Longitude,
+			Altitude:  point.Position.Altitude,
+			Time:      point.RecordedAt,
+			Battery:   point.BatteryLevel,
+			Status:    point.Status,
+			Speed:     point.Speed,
+		})
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, filename, format.Extension()))
+	c.Header("Content-Type", format.ContentType())
+	c.Status(http.StatusOK)
+	if err := geoexport.Encode(c.Writer, format, export); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+func parseMaxPoints(c *gin.Context, export bool) (int, bool) {
+	raw := c.Query("max_points")
+	if raw == "" {
+		if export {
+			return 0, true
+		}
+		return usecase.TelemetryDefaultPoints, true
+	}
+
+	maxPoints, err := strconv.Atoi(raw)
+	if err != nil || maxPoints < 0 {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid max_points"})
+		return 0, false
+	}
+	return maxPoints, true
+}
@@ -14,12 +14,13 @@ import (
 
 type authRoutes struct {
 	userUC         *usecase.UserUseCase
+	qrUC           *usecase.QRUseCase
 	jwtService     *jwt.JWTService
 	notificationUC *usecase.NotificationUseCase
 }
 
-func newUserRoutes(g *gin.RouterGroup, userUC *usecase.UserUseCase, jwtService *jwt.JWTService, notificationUC *usecase.NotificationUseCase, protectedGroup *gin.RouterGroup, authRateLimiter gin.HandlerFunc) {
-	r := &authRoutes{userUC: userUC, jwtService: jwtService, notificationUC: notificationUC}
+func newUserRoutes(g *gin.RouterGroup, userUC *usecase.UserUseCase, qrUC *usecase.QRUseCase, jwtService *jwt.JWTService, notificationUC *usecase.NotificationUseCase, protectedGroup *gin.RouterGroup, authRateLimiter gin.HandlerFunc) {
+	r := &authRoutes{userUC: userUC, qrUC: qrUC, jwtService: jwtService, notificationUC: notificationUC}
 
 	auth := g.Group("/auth")
 	{
@@ -108,6 +109,12 @@ func (r *authRoutes) verifyPhone(c *gin.Context) {
 		return
 	}
 
+	qrSecret, err := r.qrUC.IssueQRSecret(c.Request.Context(), user.ID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
 	c.JSON(http.StatusOK, response.Login{
 		User:             user,
 		AccessToken:      tokenPair.AccessToken,
@@ -115,6 +122,7 @@ func (r *authRoutes) verifyPhone(c *gin.Context) {
 		AccessExpiresAt:  tokenPair.AccessExpiresAt,
 		RefreshExpiresAt: tokenPair.RefreshExpiresAt,
 		QRCode:           qrCode,
+		QRSecret:         qrSecret,
 	})
 }
 
@@ -148,6 +156,12 @@ func (r *authRoutes) login(c *gin.Context) {
 		return
 	}
 
+	qrSecret, err := r.qrUC.IssueQRSecret(c.Request.Context(), user.ID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
 	c.JSON(http.StatusOK, response.Login{
 		User:             user,
 		AccessToken:      tokenPair.AccessToken,
@@ -155,6 +169,7 @@ func (r *authRoutes) login(c *gin.Context) {
 		AccessExpiresAt:  tokenPair.AccessExpiresAt,
 		RefreshExpiresAt: tokenPair.RefreshExpiresAt,
 		QRCode:           qrCode,
+		QRSecret:         qrSecret,
 	})
 }
 
@@ -0,0 +1,283 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/request"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/response"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase"
+)
+
+type webhookRoutes struct {
+	uc *usecase.WebhookUseCase
+}
+
+func newWebhookRoutes(protected *gin.RouterGroup, uc *usecase.WebhookUseCase, adminOnly gin.HandlerFunc) {
+	r := &webhookRoutes{uc: uc}
+
+	webhooks := protected.Group("/webhooks", adminOnly)
+	{
+		webhooks.POST("", r.createSubscription)
+		webhooks.GET("", r.listSubscriptions)
+		webhooks.GET("/:id", r.getSubscription)
+		webhooks.PATCH("/:id", r.updateSubscription)
+		webhooks.DELETE("/:id", r.deleteSubscription)
+		webhooks.GET("/:id/deliveries", r.listDeliveries)
+		webhooks.POST("/deliveries/:id/redeliver", r.redeliver)
+	}
+}
+
+// @Summary      Create webhook subscription
+// @Description  Registers an endpoint for outbound events. The signing secret is returned only once. Each request carries X-SkyPost-Signature: sha256=HMAC(secret, "<X-SkyPost-Timestamp>.<body>")
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CreateWebhookSubscription true "Subscription"
+// @Success      201 {object} response.CreatedWebhookSubscription
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Security     Bearer
+// @Router       /webhooks [post]
+func (r *webhookRoutes) createSubscription(c *gin.Context) {
+	var req request.CreateWebhookSubscription
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
+		return
+	}
+
+	subscription, err := r.uc.CreateSubscription(c.Request.Context(), req.URL, toWebhookEvents(req.Events))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.CreatedWebhookSubscription{
+		WebhookSubscription: toWebhookSubscriptionResponse(subscription),
+		Secret:              subscription.Secret,
+	})
+}
+
+// @Summary      List webhook subscriptions
+// @Tags         webhooks
+// @Produce      json
+// @Success      200 {array} response.WebhookSubscription
+// @Failure      403 {object} response.Error
+// @Security     Bearer
+// @Router       /webhooks [get]
+func (r *webhookRoutes) listSubscriptions(c *gin.Context) {
+	subscriptions, err := r.uc.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp := make([]response.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		resp = append(resp, toWebhookSubscriptionResponse(subscription))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary      Get webhook subscription
+// @Tags         webhooks
+// @Produce      json
+// @Param        id path string true "Subscription ID"
+// @Success      200 {object} response.WebhookSubscription
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /webhooks/{id} [get]
+func (r *webhookRoutes) getSubscription(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid subscription ID"})
+		return
+	}
+
+	subscription, err := r.uc.GetSubscription(c.Request.Context(), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toWebhookSubscriptionResponse(subscription))
+}
+
+// @Summary      Update webhook subscription
+// @Description  Changes the URL, the subscribed events or pauses the subscription. Omitted fields are left unchanged
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Subscription ID"
+// @Param        request body request.UpdateWebhookSubscription true "Fields to update"
+// @Success      200 {object} response.WebhookSubscription
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /webhooks/{id} [patch]
+func (r *webhookRoutes) updateSubscription(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid subscription ID"})
+		return
+	}
+
+	var req request.UpdateWebhookSubscription
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: err.Error()})
+		return
+	}
+
+	var events []entity.WebhookEvent
+	if req.Events != nil {
+		events = toWebhookEvents(req.Events)
+	}
+
+	subscription, err := r.uc.UpdateSubscription(c.Request.Context(), id, req.URL, events, req.IsActive)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toWebhookSubscriptionResponse(subscription))
+}
+
+// @Summary      Delete webhook subscription
+// @Description  Removes the subscription together with its delivery log
+// @Tags         webhooks
+// @Param        id path string true "Subscription ID"
+// @Success      204
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /webhooks/{id} [delete]
+func (r *webhookRoutes) deleteSubscription(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid subscription ID"})
+		return
+	}
+
+	if err := r.uc.DeleteSubscription(c.Request.Context(), id); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary      List webhook deliveries
+// @Description  Returns the most recent delivery attempts for the subscription, newest first
+// @Tags         webhooks
+// @Produce      json
+// @Param        id path string true "Subscription ID"
+// @Param        limit query int false "Maximum number of deliveries (default and max 50)"
+// @Success      200 {array} response.WebhookDelivery
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /webhooks/{id}/deliveries [get]
+func (r *webhookRoutes) listDeliveries(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid subscription ID"})
+		return
+	}
+
+	limit := usecase.WebhookDeliveryLimit
+	if raw := c.Query("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid limit"})
+			return
+		}
+	}
+
+	deliveries, err := r.uc.ListDeliveries(c.Request.Context(), id, limit)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp := make([]response.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		resp = append(resp, toWebhookDeliveryResponse(delivery))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary      Redeliver webhook
+// @Description  Sends a copy of the delivery immediately. The payload and event id are unchanged so the receiver can deduplicate
+// @Tags         webhooks
+// @Produce      json
+// @Param        id path string true "Delivery ID"
+// @Success      200 {object} response.WebhookDelivery
+// @Failure      400 {object} response.Error
+// @Failure      403 {object} response.Error
+// @Failure      404 {object} response.Error
+// @Security     Bearer
+// @Router       /webhooks/deliveries/{id}/redeliver [post]
+func (r *webhookRoutes) redeliver(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, response.Error{Error: "Invalid delivery ID"})
+		return
+	}
+
+	delivery, err := r.uc.Redeliver(c.Request.Context(), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toWebhookDeliveryResponse(delivery))
+}
+
+func toWebhookEvents(events []string) []entity.WebhookEvent {
+	result := make([]entity.WebhookEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, entity.WebhookEvent(event))
+	}
+	return result
+}
+
+func toWebhookSubscriptionResponse(subscription *entity.WebhookSubscription) response.WebhookSubscription {
+	events := make([]string, 0, len(subscription.Events))
+	for _, event := range subscription.Events {
+		events = append(events, string(event))
+	}
+
+	return response.WebhookSubscription{
+		ID:        subscription.ID,
+		URL:       subscription.URL,
+		Events:    events,
+		IsActive:  subscription.IsActive,
+		CreatedAt: subscription.CreatedAt,
+		UpdatedAt: subscription.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery *entity.WebhookDelivery) response.WebhookDelivery {
+	return response.WebhookDelivery{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		Event:          string(delivery.Event),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
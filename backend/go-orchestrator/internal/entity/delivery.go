@@ -1,6 +1,10 @@
 package entity
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Delivery struct {
 	ID                   uuid.UUID
@@ -9,4 +13,5 @@ type Delivery struct {
 	ParcelAutomatID      uuid.UUID
 	InternalLockerCellID *uuid.UUID
 	Status               string
+	DeliveredAt          *time.Time
 }
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DeliveryRecordingPending    = "pending"
+	DeliveryRecordingProcessing = "processing"
+	DeliveryRecordingReady      = "ready"
+	DeliveryRecordingEmpty      = "empty"
+	DeliveryRecordingFailed     = "failed"
+)
+
+// DeliveryRecording tracks assembly of the video frames a drone streamed during a delivery into one file.
+type DeliveryRecording struct {
+	DeliveryID    uuid.UUID  `json:"delivery_id"`
+	Status        string     `json:"status"`
+	VideoKey      *string    `json:"-"`
+	ManifestKey   *string    `json:"-"`
+	FrameCount    int        `json:"frame_count"`
+	DurationMs    int64      `json:"duration_ms"`
+	SizeBytes     int64      `json:"size_bytes"`
+	Attempts      int        `json:"attempts"`
+	LastError     *string    `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// DeliveryVideo is a ready recording with presigned download links.
+type DeliveryVideo struct {
+	Recording   *DeliveryRecording
+	VideoURL    string
+	ManifestURL string
+	ExpiresAt   time.Time
+}
+
+// RecordingManifest lists the frames of a recording in playback order.
+type RecordingManifest struct {
+	DeliveryID uuid.UUID                `json:"delivery_id"`
+	Width      int                      `json:"width"`
+	Height     int                      `json:"height"`
+	FPS        float64                  `json:"fps"`
+	StartedAt  time.Time                `json:"started_at"`
+	EndedAt    time.Time                `json:"ended_at"`
+	Frames     []RecordingManifestFrame `json:"frames"`
+}
+
+type RecordingManifestFrame struct {
+	Index      int       `json:"index"`
+	ObjectKey  string    `json:"object_key"`
+	CapturedAt time.Time `json:"captured_at"`
+	OffsetMs   int64     `json:"offset_ms"`
+	SizeBytes  int       `json:"size_bytes"`
+}
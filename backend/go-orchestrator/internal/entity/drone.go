@@ -1,6 +1,10 @@
 package entity
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Drone struct {
 	ID        uuid.UUID `json:"id"`
@@ -10,10 +14,13 @@ type Drone struct {
 }
 
 type DroneStatus struct {
-	DroneID      uuid.UUID `json:"drone_id"`
-	Status       string    `json:"status"`
-	BatteryLevel float64   `json:"battery_level"`
-	Position     Position  `json:"position"`
+	DroneID           uuid.UUID  `json:"drone_id"`
+	Status            string     `json:"status"`
+	BatteryLevel      float64    `json:"battery_level"`
+	Position          Position   `json:"position"`
+	Speed             float64    `json:"speed"`
+	CurrentDeliveryID *uuid.UUID `json:"current_delivery_id,omitempty"`
+	UpdatedAt         time.Time  `json:"updated_at"`
 }
 
 type Position struct {
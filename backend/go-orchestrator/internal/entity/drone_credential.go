@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DroneCredential is the per-drone key the drone-service challenges a drone with before registering it.
+// The secret is only ever returned when it is issued or rotated.
+type DroneCredential struct {
+	DroneID         uuid.UUID  `json:"drone_id"`
+	Secret          string     `json:"-"`
+	KeyVersion      int        `json:"key_version"`
+	LastConnectedAt *time.Time `json:"last_connected_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	RotatedAt       time.Time  `json:"rotated_at"`
+}
@@ -0,0 +1,10 @@
+package error
+
+import "errors"
+
+var (
+	ErrDeliveryRecordingNotFound     = errors.New("delivery recording not found")
+	ErrDeliveryRecordingNotAvailable = errors.New("delivery has no video yet: recordings are assembled after the delivery is finished")
+	ErrDeliveryRecordingEmpty        = errors.New("no video frames were recorded for this delivery")
+	ErrDeliveryRecordingFailed       = errors.New("delivery video could not be assembled")
+)
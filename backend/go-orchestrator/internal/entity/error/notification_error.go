@@ -3,6 +3,13 @@ package error
 import "errors"
 
 var (
-	ErrNotificationInvalidToken = errors.New("invalid notification token")
-	ErrNotificationSendFailed   = errors.New("failed to send notification")
+	ErrNotificationInvalidToken        = errors.New("invalid notification token")
+	ErrNotificationSendFailed          = errors.New("failed to send notification")
+	ErrNotificationUnknownEvent        = errors.New("unknown notification event")
+	ErrNotificationDeliveryNotFound    = errors.New("notification delivery not found")
+	ErrNotificationNoEmail             = errors.New("user has no email address")
+	ErrNotificationPreferencesNotFound = errors.New("notification preferences not found")
+	ErrNotificationInvalidQuietHours   = errors.New("quiet hours must be set together in HH:MM format")
+	ErrNotificationInvalidChannel      = errors.New("invalid notification channel")
+	ErrNotificationInvalidTimezone     = errors.New("invalid timezone")
 )
@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrPickupGrantNotFound         = errors.New("pickup grant not found")
+	ErrPickupGrantExpired          = errors.New("pickup grant expired")
+	ErrPickupGrantAlreadyUsed      = errors.New("pickup grant already used")
+	ErrPickupGrantRevoked          = errors.New("pickup grant revoked")
+	ErrPickupGrantInvalidExpiry    = errors.New("invalid pickup grant expiry")
+	ErrPickupGrantInvalidPhone     = errors.New("invalid grantee phone number")
+	ErrPickupGrantOrderNotEligible = errors.New("order is not eligible for pickup grant")
+	ErrPickupGrantWrongAutomat     = errors.New("pickup grant is not valid for this parcel automat")
+	ErrPickupGrantNotForUser       = errors.New("pickup grant is not issued to this user")
+)
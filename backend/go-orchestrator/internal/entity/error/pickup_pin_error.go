@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+var (
+	ErrPickupPINNotFound         = errors.New("pickup pin not found")
+	ErrPickupPINInvalid          = errors.New("invalid or expired pickup pin")
+	ErrPickupPINAlreadyExists    = errors.New("pickup pin already exists for this parcel automat")
+	ErrPickupPINOrderNotEligible = errors.New("order is not eligible for pickup pin")
+	ErrPickupPINThrottled        = errors.New("too many wrong pickup pins at this parcel automat, try again later")
+)
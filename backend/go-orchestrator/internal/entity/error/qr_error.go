@@ -7,4 +7,8 @@ var (
 	ErrQRValidationFailed   = errors.New("QR code validation failed")
 	ErrQRUserMismatch       = errors.New("QR code user mismatch")
 	ErrQRStorageUnavailable = errors.New("QR storage is temporarily unavailable")
+	ErrQRSecretNotFound     = errors.New("QR secret not found")
+	ErrQRReplayed           = errors.New("QR code has already been used")
+	ErrQRStaticNotAllowed   = errors.New("static QR codes are not accepted by this parcel automat")
+	ErrQRRevoked            = errors.New("QR code has been revoked")
 )
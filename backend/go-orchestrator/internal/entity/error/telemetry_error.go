@@ -0,0 +1,8 @@
+package error
+
+import "errors"
+
+var (
+	ErrTelemetryInvalidRange  = errors.New("telemetry time range is invalid: to must be after from and span at most 7 days")
+	ErrTelemetryTooManyPoints = errors.New("telemetry track exceeds the maximum number of points, narrow the time range")
+)
@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+var (
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrWebhookInvalidURL           = errors.New("webhook url must be an absolute http or https url")
+	ErrWebhookInvalidEvent         = errors.New("unknown webhook event")
+	ErrWebhookNoEvents             = errors.New("webhook subscription must include at least one event")
+)
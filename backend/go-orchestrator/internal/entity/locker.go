@@ -11,6 +11,7 @@ type ParcelAutomat struct {
 	Coordinates   string    `json:"coordinates"`
 	ArucoID       int       `json:"aruco_id"`
 	IsWorking     bool      `json:"is_working"`
+	AllowStaticQR bool      `json:"allow_static_qr"`
 }
 
 type LockerCell struct {
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationEvent string
+
+const (
+	NotificationOrderAccepted    NotificationEvent = "order_accepted"
+	NotificationDroneAssigned    NotificationEvent = "drone_assigned"
+	NotificationDroneDeparted    NotificationEvent = "drone_departed"
+	NotificationArrivingSoon     NotificationEvent = "arriving_soon"
+	NotificationOrderDelivered   NotificationEvent = "order_delivered"
+	NotificationDeliveryFailed   NotificationEvent = "delivery_failed"
+	NotificationPickupReminder   NotificationEvent = "pickup_reminder"
+	NotificationOrderCollected   NotificationEvent = "order_collected"
+	NotificationCollectedByGrant NotificationEvent = "collected_by_grant"
+	NotificationReturnCompleted  NotificationEvent = "return_completed"
+	NotificationPasswordReset    NotificationEvent = "password_reset"
+)
+
+type Notification struct {
+	Event NotificationEvent
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+const (
+	NotificationChannelPush  = "push"
+	NotificationChannelSMS   = "sms"
+	NotificationChannelEmail = "email"
+)
+
+const (
+	NotificationStatusSent        = "sent"
+	NotificationStatusFailed      = "failed"
+	NotificationStatusNoRecipient = "no_recipient"
+	NotificationStatusRateLimited = "rate_limited"
+	NotificationStatusBounced     = "bounced"
+)
+
+type NotificationDelivery struct {
+	ID             uuid.UUID
+	NotificationID uuid.UUID
+	UserID         uuid.UUID
+	Event          NotificationEvent
+	Channel        string
+	Status         string
+	MessageID      *string
+	Error          *string
+	CreatedAt      time.Time
+}
+
+type EmailTemplate string
+
+const (
+	EmailOrderConfirmation EmailTemplate = "order_confirmation"
+	EmailOrderDelivered    EmailTemplate = "order_delivered"
+	EmailReceipt           EmailTemplate = "receipt"
+	EmailPasswordReset     EmailTemplate = "password_reset"
+)
@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationLanguageRU = "ru"
+	NotificationLanguageEN = "en"
+
+	DefaultNotificationTimezone = "Europe/Moscow"
+	quietHoursLayout            = "15:04"
+)
+
+type NotificationPreferences struct {
+	UserID           uuid.UUID                      `json:"user_id"`
+	Channels         map[NotificationEvent][]string `json:"channels"`
+	QuietHoursStart  *string                        `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd    *string                        `json:"quiet_hours_end,omitempty"`
+	Timezone         string                         `json:"timezone"`
+	Language         string                         `json:"language"`
+	CriticalOverride bool                           `json:"critical_override"`
+	UpdatedAt        time.Time                      `json:"updated_at"`
+}
+
+type ScheduledNotification struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	Event        NotificationEvent
+	Data         map[string]string
+	DeliverAfter time.Time
+	Attempts     int
+	CreatedAt    time.Time
+}
+
+func DefaultNotificationPreferences(userID uuid.UUID) *NotificationPreferences {
+	return &NotificationPreferences{
+		UserID:           userID,
+		Channels:         map[NotificationEvent][]string{},
+		Timezone:         DefaultNotificationTimezone,
+		Language:         NotificationLanguageRU,
+		CriticalOverride: true,
+	}
+}
+
+// IsPickupCritical reports whether the event carries information the user needs to collect the parcel
+// or secure it, so it may bypass quiet hours.
+func (e NotificationEvent) IsPickupCritical() bool {
+	switch e {
+	case NotificationOrderDelivered, NotificationCollectedByGrant:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChannelEnabled treats events without an explicit entry as enabled on every channel.
+func (p *NotificationPreferences) ChannelEnabled(event NotificationEvent, channel string) bool {
+	channels, ok := p.Channels[event]
+	if !ok {
+		return true
+	}
+	return slices.Contains(channels, channel)
+}
+
+// Location is the user's timezone, or UTC when it cannot be loaded.
+func (p *NotificationPreferences) Location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// QuietHoursUntil returns the moment quiet hours end when now falls inside them.
+// Windows that cross midnight, e.g. 22:00-08:00, are supported.
+func (p *NotificationPreferences) QuietHoursUntil(now time.Time) (time.Time, bool) {
+	if p.QuietHoursStart == nil || p.QuietHoursEnd == nil {
+		return time.Time{}, false
+	}
+
+	start, err := time.Parse(quietHoursLayout, *p.QuietHoursStart)
+	if err != nil {
+		return time.Time{}, false
+	}
+	end, err := time.Parse(quietHoursLayout, *p.QuietHoursEnd)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	loc := p.Location()
+	local := now.In(loc)
+	current := local.Hour()*60 + local.Minute()
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+
+	endToday := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+
+	switch {
+	case startMinutes == endMinutes:
+		return time.Time{}, false
+	case startMinutes < endMinutes:
+		if current >= startMinutes && current < endMinutes {
+			return endToday, true
+		}
+	default:
+		if current >= startMinutes {
+			return endToday.AddDate(0, 0, 1), true
+		}
+		if current < endMinutes {
+			return endToday, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func IsValidQuietHoursTime(value string) bool {
+	_, err := time.Parse(quietHoursLayout, value)
+	return err == nil
+}
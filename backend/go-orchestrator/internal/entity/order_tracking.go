@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderTrackingEvent string
+
+const (
+	OrderTrackingStatus   OrderTrackingEvent = "status"
+	OrderTrackingPosition OrderTrackingEvent = "position"
+)
+
+type OrderTrackingUpdate struct {
+	Event    OrderTrackingEvent
+	Status   *OrderTrackingState
+	Position *OrderTrackingDrone
+}
+
+type OrderTrackingState struct {
+	OrderID        uuid.UUID  `json:"order_id"`
+	OrderStatus    string     `json:"order_status"`
+	DeliveryID     *uuid.UUID `json:"delivery_id,omitempty"`
+	DeliveryStatus string     `json:"delivery_status,omitempty"`
+	DroneID        *uuid.UUID `json:"drone_id,omitempty"`
+	Final          bool       `json:"final"`
+}
+
+type OrderTrackingDrone struct {
+	DroneID    uuid.UUID `json:"drone_id"`
+	Position   Position  `json:"position"`
+	Speed      float64   `json:"speed"`
+	ETASeconds *int      `json:"eta_seconds,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TelemetryPoint struct {
+	DroneID      uuid.UUID  `json:"drone_id"`
+	DeliveryID   *uuid.UUID `json:"delivery_id,omitempty"`
+	Status       string     `json:"status"`
+	BatteryLevel float64    `json:"battery_level"`
+	Position     Position   `json:"position"`
+	Speed        float64    `json:"speed"`
+	RecordedAt   time.Time  `json:"recorded_at"`
+}
+
+// FlightSummary is computed from every stored point of the track, even when Points is thinned out.
+type FlightSummary struct {
+	Points          int        `json:"points"`
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	DurationSeconds int        `json:"duration_seconds"`
+	DistanceMeters  float64    `json:"distance_meters"`
+	MaxAltitude     float64    `json:"max_altitude"`
+	MaxSpeed        float64    `json:"max_speed"`
+	BatteryStart    float64    `json:"battery_start"`
+	BatteryEnd      float64    `json:"battery_end"`
+	BatteryUsed     float64    `json:"battery_used"`
+}
+
+type FlightTrack struct {
+	DroneID    *uuid.UUID       `json:"drone_id,omitempty"`
+	DeliveryID *uuid.UUID       `json:"delivery_id,omitempty"`
+	From       *time.Time       `json:"from,omitempty"`
+	To         *time.Time       `json:"to,omitempty"`
+	Summary    FlightSummary    `json:"summary"`
+	Points     []TelemetryPoint `json:"points"`
+}
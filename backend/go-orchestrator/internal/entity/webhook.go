@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookOrderCreated       WebhookEvent = "order.created"
+	WebhookDeliveryDispatched WebhookEvent = "delivery.dispatched"
+	WebhookDeliveryDelivered  WebhookEvent = "delivery.delivered"
+	WebhookOrderCompleted     WebhookEvent = "order.completed"
+	WebhookOrderCancelled     WebhookEvent = "order.cancelled"
+)
+
+var WebhookEvents = []WebhookEvent{
+	WebhookOrderCreated,
+	WebhookDeliveryDispatched,
+	WebhookDeliveryDelivered,
+	WebhookOrderCompleted,
+	WebhookOrderCancelled,
+}
+
+func (e WebhookEvent) IsValid() bool {
+	for _, event := range WebhookEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+type WebhookSubscription struct {
+	ID        uuid.UUID      `json:"id"`
+	URL       string         `json:"url"`
+	Secret    string         `json:"-"`
+	Events    []WebhookEvent `json:"events"`
+	IsActive  bool           `json:"is_active"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+func (s *WebhookSubscription) Subscribed(event WebhookEvent) bool {
+	for _, e := range s.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID    `json:"id"`
+	SubscriptionID uuid.UUID    `json:"subscription_id"`
+	Event          WebhookEvent `json:"event"`
+	Payload        []byte       `json:"-"`
+	Status         string       `json:"status"`
+	Attempts       int          `json:"attempts"`
+	ResponseStatus *int         `json:"response_status,omitempty"`
+	LastError      *string      `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time   `json:"next_attempt_at,omitempty"`
+	DeliveredAt    *time.Time   `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+}
+
+// WebhookEnvelope is the JSON body posted to subscribers.
+type WebhookEnvelope struct {
+	ID        uuid.UUID    `json:"id"`
+	Event     WebhookEvent `json:"event"`
+	CreatedAt time.Time    `json:"created_at"`
+	Data      any          `json:"data"`
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const (
+	pickupPINFailuresKeyPrefix = "pickup_pin:failures:"
+	pickupPINLockKeyPrefix     = "pickup_pin:lock:"
+)
+
+type PickupPINThrottle struct {
+	rdb *redis.Client
+}
+
+func NewPickupPINThrottle(rdb *redis.Client) *PickupPINThrottle {
+	return &PickupPINThrottle{rdb: rdb}
+}
+
+// RecordFailure counts one wrong PIN against the automat's fixed window and returns the count so far.
+func (t *PickupPINThrottle) RecordFailure(ctx context.Context, automatID uuid.UUID, window time.Duration) (int, error) {
+	key := pickupPINFailuresKeyPrefix + automatID.String()
+
+	count, err := t.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("PickupPINThrottle - RecordFailure - Incr: %w", err)
+	}
+
+	if count == 1 {
+		if err := t.rdb.Expire(ctx, key, window).Err(); err != nil {
+			return 0, fmt.Errorf("PickupPINThrottle - RecordFailure - Expire: %w", err)
+		}
+	}
+
+	return int(count), nil
+}
+
+func (t *PickupPINThrottle) Lock(ctx context.Context, automatID uuid.UUID, duration time.Duration) error {
+	if err := t.rdb.Set(ctx, pickupPINLockKeyPrefix+automatID.String(), 1, duration).Err(); err != nil {
+		return fmt.Errorf("PickupPINThrottle - Lock: %w", err)
+	}
+	return nil
+}
+
+// LockedFor returns how long the automat's keypad stays locked, or 0 when it is not locked.
+func (t *PickupPINThrottle) LockedFor(ctx context.Context, automatID uuid.UUID) (time.Duration, error) {
+	ttl, err := t.rdb.PTTL(ctx, pickupPINLockKeyPrefix+automatID.String()).Result()
+	if err != nil {
+		return 0, fmt.Errorf("PickupPINThrottle - LockedFor: %w", err)
+	}
+	if ttl <= 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
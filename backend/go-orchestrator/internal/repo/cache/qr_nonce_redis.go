@@ -0,0 +1,30 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const qrNonceKeyPrefix = "qr:nonce:"
+
+type QRNonceCache struct {
+	rdb *redis.Client
+}
+
+func NewQRNonceCache(rdb *redis.Client) *QRNonceCache {
+	return &QRNonceCache{rdb: rdb}
+}
+
+func (c *QRNonceCache) Reserve(ctx context.Context, userID uuid.UUID, nonce string, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s%s:%s", qrNonceKeyPrefix, userID.String(), nonce)
+
+	ok, err := c.rdb.SetNX(ctx, key, 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("QRNonceCache - Reserve: %w", err)
+	}
+	return ok, nil
+}
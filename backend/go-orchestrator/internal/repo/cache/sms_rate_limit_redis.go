@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const smsRateLimitKeyPrefix = "notify:sms:"
+
+type SMSRateLimiter struct {
+	rdb *redis.Client
+}
+
+func NewSMSRateLimiter(rdb *redis.Client) *SMSRateLimiter {
+	return &SMSRateLimiter{rdb: rdb}
+}
+
+// Allow counts one SMS against the user's fixed window and reports whether it fits within limit.
+func (l *SMSRateLimiter) Allow(ctx context.Context, userID uuid.UUID, limit int, window time.Duration) (bool, error) {
+	key := smsRateLimitKeyPrefix + userID.String()
+
+	count, err := l.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return false, fmt.Errorf("SMSRateLimiter - Allow - Incr: %w", err)
+	}
+
+	if count == 1 {
+		if err := l.rdb.Expire(ctx, key, window).Err(); err != nil {
+			return false, fmt.Errorf("SMSRateLimiter - Allow - Expire: %w", err)
+		}
+	}
+
+	return count <= int64(limit), nil
+}
@@ -52,8 +52,9 @@ type (
 	}
 
 	DroneRepo interface {
-		Create(ctx context.Context, drone *entity.Drone) (*entity.Drone, error)
+		Create(ctx context.Context, drone *entity.Drone, secret string) (*entity.Drone, *entity.DroneCredential, error)
 		GetByID(ctx context.Context, id uuid.UUID) (*entity.Drone, error)
+		GetState(ctx context.Context, id uuid.UUID) (*entity.DroneStatus, error)
 		GetAvailable(ctx context.Context) (*entity.Drone, error)
 		List(ctx context.Context) ([]*entity.Drone, error)
 		Update(ctx context.Context, drone *entity.Drone) (*entity.Drone, error)
@@ -61,6 +62,11 @@ type (
 		Delete(ctx context.Context, id uuid.UUID) error
 	}
 
+	DroneCredentialRepo interface {
+		GetByDroneID(ctx context.Context, droneID uuid.UUID) (*entity.DroneCredential, error)
+		Rotate(ctx context.Context, droneID uuid.UUID, secret string) (*entity.DroneCredential, error)
+	}
+
 	ParcelAutomatRepo interface {
 		Create(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error)
 		GetByID(ctx context.Context, id uuid.UUID) (*entity.ParcelAutomat, error)
@@ -68,6 +74,7 @@ type (
 		ListWorking(ctx context.Context) ([]*entity.ParcelAutomat, error)
 		Update(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error)
 		UpdateStatus(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error)
+		UpdateStaticQR(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error)
 		Delete(ctx context.Context, id uuid.UUID) error
 	}
 
@@ -78,6 +85,91 @@ type (
 		UpdateStatus(ctx context.Context, delivery *entity.Delivery) (*entity.Delivery, error)
 		UpdateDrone(ctx context.Context, delivery *entity.Delivery) error
 		ListByStatus(ctx context.Context, status string) ([]*entity.Delivery, error)
+		ClaimDuePickupReminders(ctx context.Context, now, dueBefore time.Time, limit int) ([]*entity.Delivery, error)
+		MarkArrivalNotified(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)
+	}
+
+	PickupGrantRepo interface {
+		Create(ctx context.Context, grant *entity.PickupGrant) (*entity.PickupGrant, error)
+		GetByID(ctx context.Context, id uuid.UUID) (*entity.PickupGrant, error)
+		GetByToken(ctx context.Context, token string) (*entity.PickupGrant, error)
+		GetUsedByOrderID(ctx context.Context, orderID uuid.UUID) (*entity.PickupGrant, error)
+		ListByOrderID(ctx context.Context, orderID uuid.UUID) ([]*entity.PickupGrant, error)
+		ListActiveByPhone(ctx context.Context, phone string) ([]*entity.PickupGrant, error)
+		MarkUsed(ctx context.Context, id uuid.UUID) (*entity.PickupGrant, error)
+		Revoke(ctx context.Context, id uuid.UUID) (*entity.PickupGrant, error)
+	}
+
+	PickupPINRepo interface {
+		Upsert(ctx context.Context, pin *entity.PickupPIN) (*entity.PickupPIN, error)
+		GetActiveByHash(ctx context.Context, automatID uuid.UUID, pinHash string) (*entity.PickupPIN, error)
+		MarkUsed(ctx context.Context, id uuid.UUID) (*entity.PickupPIN, error)
+	}
+
+	UserQRSecretRepo interface {
+		GetByUserID(ctx context.Context, userID uuid.UUID) (string, error)
+		Ensure(ctx context.Context, userID uuid.UUID, secret string) (string, error)
+		GetGeneration(ctx context.Context, userID uuid.UUID) (int, error)
+		Revoke(ctx context.Context, userID uuid.UUID, newSecret string) (int, error)
+	}
+
+	NotificationDeliveryRepo interface {
+		Create(ctx context.Context, delivery *entity.NotificationDelivery) (*entity.NotificationDelivery, error)
+		MarkBounced(ctx context.Context, messageID, reason string) (*entity.NotificationDelivery, error)
+	}
+
+	NotificationPreferencesRepo interface {
+		GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.NotificationPreferences, error)
+		Upsert(ctx context.Context, prefs *entity.NotificationPreferences) (*entity.NotificationPreferences, error)
+	}
+
+	ScheduledNotificationRepo interface {
+		Create(ctx context.Context, notification *entity.ScheduledNotification) (*entity.ScheduledNotification, error)
+		ClaimDue(ctx context.Context, now time.Time, limit int) ([]*entity.ScheduledNotification, error)
+	}
+
+	WebhookSubscriptionRepo interface {
+		Create(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		GetByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error)
+		List(ctx context.Context) ([]*entity.WebhookSubscription, error)
+		ListActiveByEvent(ctx context.Context, event entity.WebhookEvent) ([]*entity.WebhookSubscription, error)
+		Update(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		Delete(ctx context.Context, id uuid.UUID) error
+	}
+
+	TelemetryRepo interface {
+		ListByDelivery(ctx context.Context, deliveryID uuid.UUID, limit int) ([]*entity.TelemetryPoint, error)
+		ListByDrone(ctx context.Context, droneID uuid.UUID, from, to time.Time, limit int) ([]*entity.TelemetryPoint, error)
+	}
+
+	WebhookDeliveryRepo interface {
+		Create(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error)
+		GetByID(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+		ListBySubscription(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]*entity.WebhookDelivery, error)
+		ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error)
+		UpdateAttempt(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error)
+	}
+
+	DeliveryRecordingRepo interface {
+		Schedule(ctx context.Context, deliveryID uuid.UUID, notBefore time.Time) error
+		GetByDeliveryID(ctx context.Context, deliveryID uuid.UUID) (*entity.DeliveryRecording, error)
+		ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.DeliveryRecording, error)
+		Update(ctx context.Context, recording *entity.DeliveryRecording) (*entity.DeliveryRecording, error)
+	}
+
+	SMSRateLimiter interface {
+		Allow(ctx context.Context, userID uuid.UUID, limit int, window time.Duration) (bool, error)
+	}
+
+	// PickupPINThrottle counts wrong PINs per parcel automat keypad and locks the keypad for a while.
+	PickupPINThrottle interface {
+		RecordFailure(ctx context.Context, automatID uuid.UUID, window time.Duration) (int, error)
+		Lock(ctx context.Context, automatID uuid.UUID, duration time.Duration) error
+		LockedFor(ctx context.Context, automatID uuid.UUID) (time.Duration, error)
+	}
+
+	QRNonceCache interface {
+		Reserve(ctx context.Context, userID uuid.UUID, nonce string, ttl time.Duration) (bool, error)
 	}
 
 	LockerRepo interface {
@@ -104,6 +196,7 @@ type (
 
 	SMSAeroWebAPI interface {
 		SendSMS(ctx context.Context, phone, message string) error
+		SendMessage(ctx context.Context, phone, text string) error
 		CheckBalance(ctx context.Context) (float64, error)
 	}
 
@@ -117,6 +210,14 @@ type (
 	}
 
 	Sender interface {
-		SendDeliveryNotification(ctx context.Context, tokens []string, orderID string, lockerCellID *string) ([]string, error)
+		Send(ctx context.Context, tokens []string, notification entity.Notification) ([]string, error)
+	}
+
+	EmailSender interface {
+		SendEmail(ctx context.Context, to string, template entity.EmailTemplate, data map[string]string) (string, error)
+	}
+
+	WebhookSender interface {
+		Deliver(ctx context.Context, url, secret string, delivery *entity.WebhookDelivery) (int, error)
 	}
 )
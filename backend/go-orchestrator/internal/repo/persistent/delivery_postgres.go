@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -37,7 +38,7 @@ func ptrUUIDToPgUUID(pu *uuid.UUID) pgtype.UUID {
 }
 
 func toEntityDelivery(d sqlc.Delivery) *entity.Delivery {
-	return &entity.Delivery{
+	delivery := &entity.Delivery{
 		ID:                   d.ID,
 		OrderID:              d.OrderID,
 		DroneID:              pgUUIDToPtrUUID(d.DroneID),
@@ -45,6 +46,18 @@ func toEntityDelivery(d sqlc.Delivery) *entity.Delivery {
 		InternalLockerCellID: pgUUIDToPtrUUID(d.InternalLockerCellID),
 		Status:               d.Status,
 	}
+	if d.DeliveredAt.Valid {
+		delivery.DeliveredAt = &d.DeliveredAt.Time
+	}
+	return delivery
+}
+
+func toEntityDeliveries(rows []sqlc.Delivery) []*entity.Delivery {
+	deliveries := make([]*entity.Delivery, 0, len(rows))
+	for _, d := range rows {
+		deliveries = append(deliveries, toEntityDelivery(d))
+	}
+	return deliveries
 }
 
 func (r *DeliveryRepo) Create(ctx context.Context, delivery *entity.Delivery) (*entity.Delivery, error) {
@@ -88,8 +101,9 @@ func (r *DeliveryRepo) GetByOrderID(ctx context.Context, orderID uuid.UUID) (*en
 
 func (r *DeliveryRepo) UpdateStatus(ctx context.Context, delivery *entity.Delivery) (*entity.Delivery, error) {
 	d, err := r.q.UpdateDeliveryStatus(ctx, sqlc.UpdateDeliveryStatusParams{
-		ID:     delivery.ID,
-		Status: delivery.Status,
+		ID:          delivery.ID,
+		Status:      delivery.Status,
+		DeliveredAt: toPgTimestamp(delivery.DeliveredAt),
 	})
 	if err != nil {
 		if isNoRows(err) {
@@ -105,11 +119,34 @@ func (r *DeliveryRepo) ListByStatus(ctx context.Context, status string) ([]*enti
 	if err != nil {
 		return nil, fmt.Errorf("DeliveryRepo - ListByStatus: %w", err)
 	}
-	deliveries := make([]*entity.Delivery, 0, len(rows))
-	for _, d := range rows {
-		deliveries = append(deliveries, toEntityDelivery(d))
+	return toEntityDeliveries(rows), nil
+}
+
+// ClaimDuePickupReminders marks the delivered deliveries last reminded, or delivered, before dueBefore as
+// reminded at now and returns them, so concurrent workers never remind about the same delivery twice.
+func (r *DeliveryRepo) ClaimDuePickupReminders(ctx context.Context, now, dueBefore time.Time, limit int) ([]*entity.Delivery, error) {
+	rows, err := r.q.ClaimDuePickupReminders(ctx, sqlc.ClaimDuePickupRemindersParams{
+		Now:       pgtype.Timestamp{Time: now, Valid: true},
+		DueBefore: pgtype.Timestamp{Time: dueBefore, Valid: true},
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DeliveryRepo - ClaimDuePickupReminders: %w", err)
+	}
+	return toEntityDeliveries(rows), nil
+}
+
+// MarkArrivalNotified reports whether this call was the first to record the arriving-soon notification
+// for the in-transit delivery.
+func (r *DeliveryRepo) MarkArrivalNotified(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	updated, err := r.q.MarkDeliveryArrivalNotified(ctx, sqlc.MarkDeliveryArrivalNotifiedParams{
+		ID:                id,
+		ArrivalNotifiedAt: pgtype.Timestamp{Time: now, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("DeliveryRepo - MarkArrivalNotified: %w", err)
 	}
-	return deliveries, nil
+	return updated > 0, nil
 }
 
 func (r *DeliveryRepo) UpdateDrone(ctx context.Context, delivery *entity.Delivery) error {
@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type DeliveryRecordingRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewDeliveryRecordingRepo(db *pgxpool.Pool) *DeliveryRecordingRepo {
+	return &DeliveryRecordingRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityDeliveryRecording(r sqlc.DeliveryRecording) *entity.DeliveryRecording {
+	recording := &entity.DeliveryRecording{
+		DeliveryID:  r.DeliveryID,
+		Status:      r.Status,
+		VideoKey:    r.VideoKey,
+		ManifestKey: r.ManifestKey,
+		FrameCount:  int(r.FrameCount),
+		DurationMs:  r.DurationMs,
+		SizeBytes:   r.SizeBytes,
+		Attempts:    int(r.Attempts),
+		LastError:   r.LastError,
+		CreatedAt:   r.CreatedAt.Time,
+		UpdatedAt:   r.UpdatedAt.Time,
+	}
+	if r.NextAttemptAt.Valid {
+		recording.NextAttemptAt = &r.NextAttemptAt.Time
+	}
+	return recording
+}
+
+// Schedule queues assembly at notBefore. A recording that already exists is reset to pending unless a worker holds it.
+func (r *DeliveryRecordingRepo) Schedule(ctx context.Context, deliveryID uuid.UUID, notBefore time.Time) error {
+	if err := r.q.ScheduleDeliveryRecording(ctx, sqlc.ScheduleDeliveryRecordingParams{
+		DeliveryID:    deliveryID,
+		NextAttemptAt: pgtype.Timestamp{Time: notBefore, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("DeliveryRecordingRepo - Schedule: %w", err)
+	}
+	return nil
+}
+
+func (r *DeliveryRecordingRepo) GetByDeliveryID(ctx context.Context, deliveryID uuid.UUID) (*entity.DeliveryRecording, error) {
+	row, err := r.q.GetDeliveryRecording(ctx, deliveryID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrDeliveryRecordingNotFound
+		}
+		return nil, fmt.Errorf("DeliveryRecordingRepo - GetByDeliveryID: %w", err)
+	}
+	return toEntityDeliveryRecording(row), nil
+}
+
+// ClaimDue marks due recordings as processing until leaseUntil. Recordings whose lease ran out are claimed again.
+func (r *DeliveryRecordingRepo) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.DeliveryRecording, error) {
+	rows, err := r.q.ClaimDueDeliveryRecordings(ctx, sqlc.ClaimDueDeliveryRecordingsParams{
+		LeaseUntil: pgtype.Timestamp{Time: leaseUntil, Valid: true},
+		Now:        pgtype.Timestamp{Time: now, Valid: true},
+		BatchSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DeliveryRecordingRepo - ClaimDue: %w", err)
+	}
+
+	result := make([]*entity.DeliveryRecording, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toEntityDeliveryRecording(row))
+	}
+	return result, nil
+}
+
+func (r *DeliveryRecordingRepo) Update(ctx context.Context, recording *entity.DeliveryRecording) (*entity.DeliveryRecording, error) {
+	row, err := r.q.UpdateDeliveryRecording(ctx, sqlc.UpdateDeliveryRecordingParams{
+		DeliveryID:    recording.DeliveryID,
+		Status:        recording.Status,
+		VideoKey:      recording.VideoKey,
+		ManifestKey:   recording.ManifestKey,
+		FrameCount:    int32(recording.FrameCount),
+		DurationMs:    recording.DurationMs,
+		SizeBytes:     recording.SizeBytes,
+		Attempts:      int32(recording.Attempts),
+		LastError:     recording.LastError,
+		NextAttemptAt: toPgTimestamp(recording.NextAttemptAt),
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrDeliveryRecordingNotFound
+		}
+		return nil, fmt.Errorf("DeliveryRecordingRepo - Update: %w", err)
+	}
+	return toEntityDeliveryRecording(row), nil
+}
@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type DroneCredentialRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewDroneCredentialRepo(db *pgxpool.Pool) *DroneCredentialRepo {
+	return &DroneCredentialRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityDroneCredential(c sqlc.DroneCredential) *entity.DroneCredential {
+	credential := &entity.DroneCredential{
+		DroneID:    c.DroneID,
+		Secret:     c.Secret,
+		KeyVersion: int(c.KeyVersion),
+		CreatedAt:  c.CreatedAt.Time,
+		RotatedAt:  c.RotatedAt.Time,
+	}
+	if c.LastConnectedAt.Valid {
+		credential.LastConnectedAt = &c.LastConnectedAt.Time
+	}
+	return credential
+}
+
+func (r *DroneCredentialRepo) GetByDroneID(ctx context.Context, droneID uuid.UUID) (*entity.DroneCredential, error) {
+	row, err := r.q.GetDroneCredential(ctx, droneID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrDroneCredentialNotFound
+		}
+		return nil, fmt.Errorf("DroneCredentialRepo - GetByDroneID: %w", err)
+	}
+	return toEntityDroneCredential(row), nil
+}
+
+// Rotate stores secret as the drone's key. The first call issues version 1, later calls bump the version.
+func (r *DroneCredentialRepo) Rotate(ctx context.Context, droneID uuid.UUID, secret string) (*entity.DroneCredential, error) {
+	row, err := r.q.RotateDroneCredential(ctx, sqlc.RotateDroneCredentialParams{
+		DroneID: droneID,
+		Secret:  secret,
+	})
+	if err != nil {
+		if isPgForeignKeyViolation(err) {
+			return nil, entityError.ErrDroneNotFound
+		}
+		return nil, fmt.Errorf("DroneCredentialRepo - Rotate: %w", err)
+	}
+	return toEntityDroneCredential(row), nil
+}
@@ -29,19 +29,40 @@ func toEntityDrone(d sqlc.Drone) *entity.Drone {
 	}
 }
 
-func (r *DroneRepo) Create(ctx context.Context, drone *entity.Drone) (*entity.Drone, error) {
-	d, err := r.q.CreateDrone(ctx, sqlc.CreateDroneParams{
+// Create stores the drone together with its first credential in one transaction, so a drone is never
+// left without a key.
+func (r *DroneRepo) Create(ctx context.Context, drone *entity.Drone, secret string) (*entity.Drone, *entity.DroneCredential, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("DroneRepo - Create - Begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.q.WithTx(tx)
+	d, err := q.CreateDrone(ctx, sqlc.CreateDroneParams{
 		Model:     drone.Model,
 		Status:    drone.Status,
 		IpAddress: drone.IPAddress,
 	})
 	if err != nil {
 		if isPgUniqueViolation(err) {
-			return nil, entityError.ErrDroneCreateFailed
+			return nil, nil, entityError.ErrDroneCreateFailed
 		}
-		return nil, fmt.Errorf("DroneRepo - Create: %w", err)
+		return nil, nil, fmt.Errorf("DroneRepo - Create: %w", err)
 	}
-	return toEntityDrone(d), nil
+
+	c, err := q.RotateDroneCredential(ctx, sqlc.RotateDroneCredentialParams{
+		DroneID: d.ID,
+		Secret:  secret,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("DroneRepo - Create - RotateDroneCredential: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("DroneRepo - Create - Commit: %w", err)
+	}
+	return toEntityDrone(d), toEntityDroneCredential(c), nil
 }
 
 func (r *DroneRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.Drone, error) {
@@ -55,6 +76,36 @@ func (r *DroneRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.Drone, e
 	return toEntityDrone(d), nil
 }
 
+func (r *DroneRepo) GetState(ctx context.Context, id uuid.UUID) (*entity.DroneStatus, error) {
+	d, err := r.q.GetDroneByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrDroneNotFound
+		}
+		return nil, fmt.Errorf("DroneRepo - GetState: %w", err)
+	}
+
+	state := &entity.DroneStatus{
+		DroneID:      d.ID,
+		Status:       d.Status,
+		BatteryLevel: parseNumeric(d.BatteryLevel),
+		Position: entity.Position{
+			Latitude:  parseNumeric(d.Latitude),
+			Longitude: parseNumeric(d.Longitude),
+			Altitude:  parseNumeric(d.Altitude),
+		},
+		Speed: parseNumeric(d.Speed),
+	}
+	if d.CurrentDeliveryID.Valid {
+		deliveryID := uuid.UUID(d.CurrentDeliveryID.Bytes)
+		state.CurrentDeliveryID = &deliveryID
+	}
+	if d.UpdatedAt.Valid {
+		state.UpdatedAt = d.UpdatedAt.Time
+	}
+	return state, nil
+}
+
 func (r *DroneRepo) GetAvailable(ctx context.Context) (*entity.Drone, error) {
 	d, err := r.q.GetAvailableDrone(ctx)
 	if err != nil {
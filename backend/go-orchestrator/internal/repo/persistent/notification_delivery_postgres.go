@@ -0,0 +1,69 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type NotificationDeliveryRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewNotificationDeliveryRepo(db *pgxpool.Pool) *NotificationDeliveryRepo {
+	return &NotificationDeliveryRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityNotificationDelivery(d sqlc.NotificationDelivery) *entity.NotificationDelivery {
+	return &entity.NotificationDelivery{
+		ID:             d.ID,
+		NotificationID: d.NotificationID,
+		UserID:         d.UserID,
+		Event:          entity.NotificationEvent(d.Event),
+		Channel:        d.Channel,
+		Status:         d.Status,
+		MessageID:      d.MessageID,
+		Error:          d.Error,
+		CreatedAt:      d.CreatedAt.Time,
+	}
+}
+
+func (r *NotificationDeliveryRepo) Create(ctx context.Context, delivery *entity.NotificationDelivery) (*entity.NotificationDelivery, error) {
+	row, err := r.q.CreateNotificationDelivery(ctx, sqlc.CreateNotificationDeliveryParams{
+		NotificationID: delivery.NotificationID,
+		UserID:         delivery.UserID,
+		Event:          string(delivery.Event),
+		Channel:        delivery.Channel,
+		Status:         delivery.Status,
+		MessageID:      delivery.MessageID,
+		Error:          delivery.Error,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("NotificationDeliveryRepo - Create: %w", err)
+	}
+	return toEntityNotificationDelivery(row), nil
+}
+
+func (r *NotificationDeliveryRepo) MarkBounced(ctx context.Context, messageID, reason string) (*entity.NotificationDelivery, error) {
+	var reasonPtr *string
+	if reason != "" {
+		reasonPtr = &reason
+	}
+
+	row, err := r.q.MarkNotificationDeliveryBounced(ctx, sqlc.MarkNotificationDeliveryBouncedParams{
+		MessageID: &messageID,
+		Error:     reasonPtr,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrNotificationDeliveryNotFound
+		}
+		return nil, fmt.Errorf("NotificationDeliveryRepo - MarkBounced: %w", err)
+	}
+	return toEntityNotificationDelivery(row), nil
+}
@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type NotificationPreferencesRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewNotificationPreferencesRepo(db *pgxpool.Pool) *NotificationPreferencesRepo {
+	return &NotificationPreferencesRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityNotificationPreferences(p sqlc.NotificationPreference) (*entity.NotificationPreferences, error) {
+	channels := map[entity.NotificationEvent][]string{}
+	if len(p.Channels) > 0 {
+		if err := json.Unmarshal(p.Channels, &channels); err != nil {
+			return nil, err
+		}
+	}
+
+	return &entity.NotificationPreferences{
+		UserID:           p.UserID,
+		Channels:         channels,
+		QuietHoursStart:  p.QuietHoursStart,
+		QuietHoursEnd:    p.QuietHoursEnd,
+		Timezone:         p.Timezone,
+		Language:         p.Language,
+		CriticalOverride: p.CriticalOverride,
+		UpdatedAt:        p.UpdatedAt.Time,
+	}, nil
+}
+
+func (r *NotificationPreferencesRepo) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.NotificationPreferences, error) {
+	row, err := r.q.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrNotificationPreferencesNotFound
+		}
+		return nil, fmt.Errorf("NotificationPreferencesRepo - GetByUserID: %w", err)
+	}
+
+	prefs, err := toEntityNotificationPreferences(row)
+	if err != nil {
+		return nil, fmt.Errorf("NotificationPreferencesRepo - GetByUserID - Unmarshal: %w", err)
+	}
+	return prefs, nil
+}
+
+func (r *NotificationPreferencesRepo) Upsert(ctx context.Context, prefs *entity.NotificationPreferences) (*entity.NotificationPreferences, error) {
+	channels, err := json.Marshal(prefs.Channels)
+	if err != nil {
+		return nil, fmt.Errorf("NotificationPreferencesRepo - Upsert - Marshal: %w", err)
+	}
+
+	row, err := r.q.UpsertNotificationPreferences(ctx, sqlc.UpsertNotificationPreferencesParams{
+		UserID:           prefs.UserID,
+		Channels:         channels,
+		QuietHoursStart:  prefs.QuietHoursStart,
+		QuietHoursEnd:    prefs.QuietHoursEnd,
+		Timezone:         prefs.Timezone,
+		Language:         prefs.Language,
+		CriticalOverride: prefs.CriticalOverride,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("NotificationPreferencesRepo - Upsert: %w", err)
+	}
+
+	saved, err := toEntityNotificationPreferences(row)
+	if err != nil {
+		return nil, fmt.Errorf("NotificationPreferencesRepo - Upsert - Unmarshal: %w", err)
+	}
+	return saved, nil
+}
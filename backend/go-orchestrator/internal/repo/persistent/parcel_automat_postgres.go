@@ -30,6 +30,7 @@ func toEntityParcelAutomat(p sqlc.ParcelAutomat) *entity.ParcelAutomat {
 		Coordinates:   p.Coordinates,
 		ArucoID:       int(p.ArucoID),
 		IsWorking:     p.IsWorking,
+		AllowStaticQR: p.AllowStaticQr,
 	}
 }
 
@@ -101,6 +102,20 @@ func (r *ParcelAutomatRepo) UpdateStatus(ctx context.Context, automat *entity.Pa
 	return toEntityParcelAutomat(p), nil
 }
 
+func (r *ParcelAutomatRepo) UpdateStaticQR(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error) {
+	p, err := r.q.UpdateParcelAutomatStaticQR(ctx, sqlc.UpdateParcelAutomatStaticQRParams{
+		ID:            automat.ID,
+		AllowStaticQr: automat.AllowStaticQR,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrParcelAutomatNotFound
+		}
+		return nil, fmt.Errorf("ParcelAutomatRepo - UpdateStaticQR: %w", err)
+	}
+	return toEntityParcelAutomat(p), nil
+}
+
 func (r *ParcelAutomatRepo) Update(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error) {
 	p, err := r.q.UpdateParcelAutomat(ctx, sqlc.UpdateParcelAutomatParams{
 		ID:          automat.ID,
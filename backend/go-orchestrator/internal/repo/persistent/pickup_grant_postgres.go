@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type PickupGrantRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewPickupGrantRepo(db *pgxpool.Pool) *PickupGrantRepo {
+	return &PickupGrantRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityPickupGrant(g sqlc.PickupGrant) *entity.PickupGrant {
+	var usedAt, revokedAt *time.Time
+
+	if g.UsedAt.Valid {
+		t := g.UsedAt.Time
+		usedAt = &t
+	}
+	if g.RevokedAt.Valid {
+		t := g.RevokedAt.Time
+		revokedAt = &t
+	}
+
+	return &entity.PickupGrant{
+		ID:           g.ID,
+		OrderID:      g.OrderID,
+		OwnerID:      g.OwnerID,
+		GranteePhone: g.GranteePhone,
+		Token:        g.Token,
+		ExpiresAt:    g.ExpiresAt.Time,
+		UsedAt:       usedAt,
+		RevokedAt:    revokedAt,
+		CreatedAt:    g.CreatedAt.Time,
+	}
+}
+
+func toEntityPickupGrants(rows []sqlc.PickupGrant) []*entity.PickupGrant {
+	grants := make([]*entity.PickupGrant, 0, len(rows))
+	for _, g := range rows {
+		grants = append(grants, toEntityPickupGrant(g))
+	}
+	return grants
+}
+
+func (r *PickupGrantRepo) Create(ctx context.Context, grant *entity.PickupGrant) (*entity.PickupGrant, error) {
+	row, err := r.q.CreatePickupGrant(ctx, sqlc.CreatePickupGrantParams{
+		OrderID:      grant.OrderID,
+		OwnerID:      grant.OwnerID,
+		GranteePhone: grant.GranteePhone,
+		Token:        grant.Token,
+		ExpiresAt:    pgtype.Timestamp{Time: grant.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		if isPgForeignKeyViolation(err) {
+			return nil, entityError.ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("PickupGrantRepo - Create: %w", err)
+	}
+	return toEntityPickupGrant(row), nil
+}
+
+func (r *PickupGrantRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.PickupGrant, error) {
+	row, err := r.q.GetPickupGrantByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrPickupGrantNotFound
+		}
+		return nil, fmt.Errorf("PickupGrantRepo - GetByID: %w", err)
+	}
+	return toEntityPickupGrant(row), nil
+}
+
+func (r *PickupGrantRepo) GetByToken(ctx context.Context, token string) (*entity.PickupGrant, error) {
+	row, err := r.q.GetPickupGrantByToken(ctx, token)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrPickupGrantNotFound
+		}
+		return nil, fmt.Errorf("PickupGrantRepo - GetByToken: %w", err)
+	}
+	return toEntityPickupGrant(row), nil
+}
+
+func (r *PickupGrantRepo) GetUsedByOrderID(ctx context.Context, orderID uuid.UUID) (*entity.PickupGrant, error) {
+	row, err := r.q.GetUsedPickupGrantByOrderID(ctx, orderID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrPickupGrantNotFound
+		}
+		return nil, fmt.Errorf("PickupGrantRepo - GetUsedByOrderID: %w", err)
+	}
+	return toEntityPickupGrant(row), nil
+}
+
+func (r *PickupGrantRepo) ListByOrderID(ctx context.Context, orderID uuid.UUID) ([]*entity.PickupGrant, error) {
+	rows, err := r.q.ListPickupGrantsByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("PickupGrantRepo - ListByOrderID: %w", err)
+	}
+	return toEntityPickupGrants(rows), nil
+}
+
+func (r *PickupGrantRepo) ListActiveByPhone(ctx context.Context, phone string) ([]*entity.PickupGrant, error) {
+	rows, err := r.q.ListActivePickupGrantsByPhone(ctx, &phone)
+	if err != nil {
+		return nil, fmt.Errorf("PickupGrantRepo - ListActiveByPhone: %w", err)
+	}
+	return toEntityPickupGrants(rows), nil
+}
+
+func (r *PickupGrantRepo) MarkUsed(ctx context.Context, id uuid.UUID) (*entity.PickupGrant, error) {
+	row, err := r.q.MarkPickupGrantUsed(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrPickupGrantAlreadyUsed
+		}
+		return nil, fmt.Errorf("PickupGrantRepo - MarkUsed: %w", err)
+	}
+	return toEntityPickupGrant(row), nil
+}
+
+func (r *PickupGrantRepo) Revoke(ctx context.Context, id uuid.UUID) (*entity.PickupGrant, error) {
+	row, err := r.q.RevokePickupGrant(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrPickupGrantAlreadyUsed
+		}
+		return nil, fmt.Errorf("PickupGrantRepo - Revoke: %w", err)
+	}
+	return toEntityPickupGrant(row), nil
+}
@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type PickupPINRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewPickupPINRepo(db *pgxpool.Pool) *PickupPINRepo {
+	return &PickupPINRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityPickupPIN(p sqlc.PickupPin) *entity.PickupPIN {
+	pin := &entity.PickupPIN{
+		ID:              p.ID,
+		OrderID:         p.OrderID,
+		ParcelAutomatID: p.ParcelAutomatID,
+		PINHash:         p.PinHash,
+		ExpiresAt:       p.ExpiresAt.Time,
+		CreatedAt:       p.CreatedAt.Time,
+	}
+	if p.UsedAt.Valid {
+		t := p.UsedAt.Time
+		pin.UsedAt = &t
+	}
+	return pin
+}
+
+func (r *PickupPINRepo) Upsert(ctx context.Context, pin *entity.PickupPIN) (*entity.PickupPIN, error) {
+	row, err := r.q.UpsertPickupPIN(ctx, sqlc.UpsertPickupPINParams{
+		OrderID:         pin.OrderID,
+		ParcelAutomatID: pin.ParcelAutomatID,
+		PinHash:         pin.PINHash,
+		ExpiresAt:       pgtype.Timestamp{Time: pin.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			return nil, entityError.ErrPickupPINAlreadyExists
+		}
+		if isPgForeignKeyViolation(err) {
+			return nil, entityError.ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("PickupPINRepo - Upsert: %w", err)
+	}
+	return toEntityPickupPIN(row), nil
+}
+
+func (r *PickupPINRepo) GetActiveByHash(ctx context.Context, automatID uuid.UUID, pinHash string) (*entity.PickupPIN, error) {
+	row, err := r.q.GetActivePickupPINByHash(ctx, sqlc.GetActivePickupPINByHashParams{
+		ParcelAutomatID: automatID,
+		PinHash:         pinHash,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrPickupPINNotFound
+		}
+		return nil, fmt.Errorf("PickupPINRepo - GetActiveByHash: %w", err)
+	}
+	return toEntityPickupPIN(row), nil
+}
+
+func (r *PickupPINRepo) MarkUsed(ctx context.Context, id uuid.UUID) (*entity.PickupPIN, error) {
+	row, err := r.q.MarkPickupPINUsed(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrPickupPINNotFound
+		}
+		return nil, fmt.Errorf("PickupPINRepo - MarkUsed: %w", err)
+	}
+	return toEntityPickupPIN(row), nil
+}
@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type ScheduledNotificationRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewScheduledNotificationRepo(db *pgxpool.Pool) *ScheduledNotificationRepo {
+	return &ScheduledNotificationRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityScheduledNotification(n sqlc.ScheduledNotification) (*entity.ScheduledNotification, error) {
+	data := map[string]string{}
+	if len(n.Data) > 0 {
+		if err := json.Unmarshal(n.Data, &data); err != nil {
+			return nil, err
+		}
+	}
+
+	return &entity.ScheduledNotification{
+		ID:           n.ID,
+		UserID:       n.UserID,
+		Event:        entity.NotificationEvent(n.Event),
+		Data:         data,
+		DeliverAfter: n.DeliverAfter.Time,
+		Attempts:     int(n.Attempts),
+		CreatedAt:    n.CreatedAt.Time,
+	}, nil
+}
+
+func (r *ScheduledNotificationRepo) Create(ctx context.Context, notification *entity.ScheduledNotification) (*entity.ScheduledNotification, error) {
+	data, err := json.Marshal(notification.Data)
+	if err != nil {
+		return nil, fmt.Errorf("ScheduledNotificationRepo - Create - Marshal: %w", err)
+	}
+
+	row, err := r.q.CreateScheduledNotification(ctx, sqlc.CreateScheduledNotificationParams{
+		UserID:       notification.UserID,
+		Event:        string(notification.Event),
+		Data:         data,
+		DeliverAfter: pgtype.Timestamp{Time: notification.DeliverAfter, Valid: true},
+		Attempts:     int32(notification.Attempts),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ScheduledNotificationRepo - Create: %w", err)
+	}
+
+	created, err := toEntityScheduledNotification(row)
+	if err != nil {
+		return nil, fmt.Errorf("ScheduledNotificationRepo - Create - Unmarshal: %w", err)
+	}
+	return created, nil
+}
+
+// ClaimDue removes and returns notifications whose delivery time has come, so concurrent workers never deliver the same row twice.
+func (r *ScheduledNotificationRepo) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*entity.ScheduledNotification, error) {
+	rows, err := r.q.ClaimDueScheduledNotifications(ctx, sqlc.ClaimDueScheduledNotificationsParams{
+		DeliverAfter: pgtype.Timestamp{Time: now, Valid: true},
+		Limit:        int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ScheduledNotificationRepo - ClaimDue: %w", err)
+	}
+
+	result := make([]*entity.ScheduledNotification, 0, len(rows))
+	for _, row := range rows {
+		notification, err := toEntityScheduledNotification(row)
+		if err != nil {
+			return nil, fmt.Errorf("ScheduledNotificationRepo - ClaimDue - Unmarshal: %w", err)
+		}
+		result = append(result, notification)
+	}
+	return result, nil
+}
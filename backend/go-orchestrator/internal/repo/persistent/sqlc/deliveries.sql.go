@@ -12,10 +12,63 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const claimDuePickupReminders = `-- name: ClaimDuePickupReminders :many
+UPDATE deliveries
+SET pickup_reminded_at = $1
+WHERE id IN (
+        SELECT id
+        FROM deliveries
+        WHERE status = 'delivered'
+            AND COALESCE(pickup_reminded_at, delivered_at) <= $2
+        ORDER BY delivered_at
+        LIMIT $3 FOR
+        UPDATE SKIP LOCKED
+    )
+RETURNING id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at, delivered_at, pickup_reminded_at, arrival_notified_at
+`
+
+type ClaimDuePickupRemindersParams struct {
+	Now       pgtype.Timestamp `json:"now"`
+	DueBefore pgtype.Timestamp `json:"due_before"`
+	BatchSize int32            `json:"batch_size"`
+}
+
+func (q *Queries) ClaimDuePickupReminders(ctx context.Context, arg ClaimDuePickupRemindersParams) ([]Delivery, error) {
+	rows, err := q.db.Query(ctx, claimDuePickupReminders, arg.Now, arg.DueBefore, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Delivery
+	for rows.Next() {
+		var i Delivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.DroneID,
+			&i.ParcelAutomatID,
+			&i.InternalLockerCellID,
+			&i.Status,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.DeliveredAt,
+			&i.PickupRemindedAt,
+			&i.ArrivalNotifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createDelivery = `-- name: CreateDelivery :one
 INSERT INTO deliveries (order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at
+RETURNING id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at, delivered_at, pickup_reminded_at, arrival_notified_at
 `
 
 type CreateDeliveryParams struct {
@@ -44,6 +97,9 @@ func (q *Queries) CreateDelivery(ctx context.Context, arg CreateDeliveryParams)
 		&i.Status,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.DeliveredAt,
+		&i.PickupRemindedAt,
+		&i.ArrivalNotifiedAt,
 	)
 	return i, err
 }
@@ -59,7 +115,7 @@ func (q *Queries) DeleteDelivery(ctx context.Context, id uuid.UUID) error {
 }
 
 const getDeliveryByID = `-- name: GetDeliveryByID :one
-SELECT id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at FROM deliveries
+SELECT id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at, delivered_at, pickup_reminded_at, arrival_notified_at FROM deliveries
 WHERE id = $1
 `
 
@@ -75,12 +131,15 @@ func (q *Queries) GetDeliveryByID(ctx context.Context, id uuid.UUID) (Delivery,
 		&i.Status,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.DeliveredAt,
+		&i.PickupRemindedAt,
+		&i.ArrivalNotifiedAt,
 	)
 	return i, err
 }
 
 const getDeliveryByOrderID = `-- name: GetDeliveryByOrderID :one
-SELECT id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at FROM deliveries
+SELECT id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at, delivered_at, pickup_reminded_at, arrival_notified_at FROM deliveries
 WHERE order_id = $1
 `
 
@@ -96,12 +155,15 @@ func (q *Queries) GetDeliveryByOrderID(ctx context.Context, orderID uuid.UUID) (
 		&i.Status,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.DeliveredAt,
+		&i.PickupRemindedAt,
+		&i.ArrivalNotifiedAt,
 	)
 	return i, err
 }
 
 const listDeliveries = `-- name: ListDeliveries :many
-SELECT id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at FROM deliveries
+SELECT id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at, delivered_at, pickup_reminded_at, arrival_notified_at FROM deliveries
 ORDER BY id DESC
 `
 
@@ -123,6 +185,9 @@ func (q *Queries) ListDeliveries(ctx context.Context) ([]Delivery, error) {
 			&i.Status,
 			&i.StartedAt,
 			&i.CompletedAt,
+			&i.DeliveredAt,
+			&i.PickupRemindedAt,
+			&i.ArrivalNotifiedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -135,7 +200,7 @@ func (q *Queries) ListDeliveries(ctx context.Context) ([]Delivery, error) {
 }
 
 const listDeliveriesByStatus = `-- name: ListDeliveriesByStatus :many
-SELECT id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at FROM deliveries
+SELECT id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at, delivered_at, pickup_reminded_at, arrival_notified_at FROM deliveries
 WHERE status = $1
 ORDER BY id DESC
 `
@@ -158,6 +223,9 @@ func (q *Queries) ListDeliveriesByStatus(ctx context.Context, status string) ([]
 			&i.Status,
 			&i.StartedAt,
 			&i.CompletedAt,
+			&i.DeliveredAt,
+			&i.PickupRemindedAt,
+			&i.ArrivalNotifiedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -169,11 +237,32 @@ func (q *Queries) ListDeliveriesByStatus(ctx context.Context, status string) ([]
 	return items, nil
 }
 
+const markDeliveryArrivalNotified = `-- name: MarkDeliveryArrivalNotified :execrows
+UPDATE deliveries
+SET arrival_notified_at = $2
+WHERE id = $1
+    AND status = 'in_transit'
+    AND arrival_notified_at IS NULL
+`
+
+type MarkDeliveryArrivalNotifiedParams struct {
+	ID                uuid.UUID        `json:"id"`
+	ArrivalNotifiedAt pgtype.Timestamp `json:"arrival_notified_at"`
+}
+
+func (q *Queries) MarkDeliveryArrivalNotified(ctx context.Context, arg MarkDeliveryArrivalNotifiedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markDeliveryArrivalNotified, arg.ID, arg.ArrivalNotifiedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateDeliveryDrone = `-- name: UpdateDeliveryDrone :one
 UPDATE deliveries
 SET drone_id = $2
 WHERE id = $1
-RETURNING id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at
+RETURNING id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at, delivered_at, pickup_reminded_at, arrival_notified_at
 `
 
 type UpdateDeliveryDroneParams struct {
@@ -193,24 +282,40 @@ func (q *Queries) UpdateDeliveryDrone(ctx context.Context, arg UpdateDeliveryDro
 		&i.Status,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.DeliveredAt,
+		&i.PickupRemindedAt,
+		&i.ArrivalNotifiedAt,
 	)
 	return i, err
 }
 
 const updateDeliveryStatus = `-- name: UpdateDeliveryStatus :one
 UPDATE deliveries
-SET status = $2
+SET status = $2,
+    delivered_at = CASE
+        WHEN $2 = 'delivered' THEN COALESCE(delivered_at, $3)
+        ELSE NULL
+    END,
+    pickup_reminded_at = CASE
+        WHEN $2 = 'delivered' THEN pickup_reminded_at
+        ELSE NULL
+    END,
+    arrival_notified_at = CASE
+        WHEN $2 = 'in_transit' THEN arrival_notified_at
+        ELSE NULL
+    END
 WHERE id = $1
-RETURNING id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at
+RETURNING id, order_id, drone_id, parcel_automat_id, internal_locker_cell_id, status, started_at, completed_at, delivered_at, pickup_reminded_at, arrival_notified_at
 `
 
 type UpdateDeliveryStatusParams struct {
-	ID     uuid.UUID `json:"id"`
-	Status string    `json:"status"`
+	ID          uuid.UUID        `json:"id"`
+	Status      string           `json:"status"`
+	DeliveredAt pgtype.Timestamp `json:"delivered_at"`
 }
 
 func (q *Queries) UpdateDeliveryStatus(ctx context.Context, arg UpdateDeliveryStatusParams) (Delivery, error) {
-	row := q.db.QueryRow(ctx, updateDeliveryStatus, arg.ID, arg.Status)
+	row := q.db.QueryRow(ctx, updateDeliveryStatus, arg.ID, arg.Status, arg.DeliveredAt)
 	var i Delivery
 	err := row.Scan(
 		&i.ID,
@@ -221,6 +326,9 @@ func (q *Queries) UpdateDeliveryStatus(ctx context.Context, arg UpdateDeliverySt
 		&i.Status,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.DeliveredAt,
+		&i.PickupRemindedAt,
+		&i.ArrivalNotifiedAt,
 	)
 	return i, err
 }
@@ -18,6 +18,24 @@ type Delivery struct {
 	Status               string           `json:"status"`
 	StartedAt            pgtype.Timestamp `json:"started_at"`
 	CompletedAt          pgtype.Timestamp `json:"completed_at"`
+	DeliveredAt          pgtype.Timestamp `json:"delivered_at"`
+	PickupRemindedAt     pgtype.Timestamp `json:"pickup_reminded_at"`
+	ArrivalNotifiedAt    pgtype.Timestamp `json:"arrival_notified_at"`
+}
+
+type DeliveryRecording struct {
+	DeliveryID    uuid.UUID        `json:"delivery_id"`
+	Status        string           `json:"status"`
+	VideoKey      *string          `json:"video_key"`
+	ManifestKey   *string          `json:"manifest_key"`
+	FrameCount    int32            `json:"frame_count"`
+	DurationMs    int64            `json:"duration_ms"`
+	SizeBytes     int64            `json:"size_bytes"`
+	Attempts      int32            `json:"attempts"`
+	LastError     *string          `json:"last_error"`
+	NextAttemptAt pgtype.Timestamp `json:"next_attempt_at"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
 }
 
 type Drone struct {
@@ -36,6 +54,60 @@ type Drone struct {
 	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
 }
 
+type DroneCommand struct {
+	ID            uuid.UUID        `json:"id"`
+	DroneID       uuid.UUID        `json:"drone_id"`
+	Command       string           `json:"command"`
+	Payload       []byte           `json:"payload"`
+	Status        string           `json:"status"`
+	Attempts      int32            `json:"attempts"`
+	MaxAttempts   int32            `json:"max_attempts"`
+	LastError     *string          `json:"last_error"`
+	NextAttemptAt pgtype.Timestamp `json:"next_attempt_at"`
+	ExpiresAt     pgtype.Timestamp `json:"expires_at"`
+	SentAt        pgtype.Timestamp `json:"sent_at"`
+	AckedAt       pgtype.Timestamp `json:"acked_at"`
+	CompletedAt   pgtype.Timestamp `json:"completed_at"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+}
+
+type DroneCommandAudit struct {
+	ID            uuid.UUID        `json:"id"`
+	DroneID       uuid.UUID        `json:"drone_id"`
+	CommandID     pgtype.UUID      `json:"command_id"`
+	Command       string           `json:"command"`
+	Payload       []byte           `json:"payload"`
+	OperatorID    uuid.UUID        `json:"operator_id"`
+	OperatorEmail string           `json:"operator_email"`
+	OperatorRole  string           `json:"operator_role"`
+	Result        string           `json:"result"`
+	Reason        *string          `json:"reason"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+}
+
+type DroneCredential struct {
+	DroneID         uuid.UUID        `json:"drone_id"`
+	Secret          string           `json:"secret"`
+	KeyVersion      int32            `json:"key_version"`
+	LastConnectedAt pgtype.Timestamp `json:"last_connected_at"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	RotatedAt       pgtype.Timestamp `json:"rotated_at"`
+}
+
+type DroneTelemetry struct {
+	ID           int64            `json:"id"`
+	DroneID      uuid.UUID        `json:"drone_id"`
+	DeliveryID   pgtype.UUID      `json:"delivery_id"`
+	Status       string           `json:"status"`
+	BatteryLevel float64          `json:"battery_level"`
+	Latitude     float64          `json:"latitude"`
+	Longitude    float64          `json:"longitude"`
+	Altitude     float64          `json:"altitude"`
+	Speed        float64          `json:"speed"`
+	RecordedAt   pgtype.Timestamp `json:"recorded_at"`
+}
+
 type Good struct {
 	ID                uuid.UUID `json:"id"`
 	Name              string    `json:"name"`
@@ -66,6 +138,29 @@ type LockerCellsOut struct {
 	CellNumber *int32    `json:"cell_number"`
 }
 
+type NotificationDelivery struct {
+	ID             uuid.UUID        `json:"id"`
+	NotificationID uuid.UUID        `json:"notification_id"`
+	UserID         uuid.UUID        `json:"user_id"`
+	Event          string           `json:"event"`
+	Channel        string           `json:"channel"`
+	Status         string           `json:"status"`
+	Error          *string          `json:"error"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	MessageID      *string          `json:"message_id"`
+}
+
+type NotificationPreference struct {
+	UserID           uuid.UUID        `json:"user_id"`
+	Channels         []byte           `json:"channels"`
+	QuietHoursStart  *string          `json:"quiet_hours_start"`
+	QuietHoursEnd    *string          `json:"quiet_hours_end"`
+	Timezone         string           `json:"timezone"`
+	Language         string           `json:"language"`
+	CriticalOverride bool             `json:"critical_override"`
+	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
+}
+
 type Order struct {
 	ID              uuid.UUID        `json:"id"`
 	UserID          uuid.UUID        `json:"user_id"`
@@ -85,6 +180,39 @@ type ParcelAutomat struct {
 	Coordinates   string    `json:"coordinates"`
 	ArucoID       int32     `json:"aruco_id"`
 	IsWorking     bool      `json:"is_working"`
+	AllowStaticQr bool      `json:"allow_static_qr"`
+}
+
+type PickupGrant struct {
+	ID           uuid.UUID        `json:"id"`
+	OrderID      uuid.UUID        `json:"order_id"`
+	OwnerID      uuid.UUID        `json:"owner_id"`
+	GranteePhone *string          `json:"grantee_phone"`
+	Token        string           `json:"token"`
+	ExpiresAt    pgtype.Timestamp `json:"expires_at"`
+	UsedAt       pgtype.Timestamp `json:"used_at"`
+	RevokedAt    pgtype.Timestamp `json:"revoked_at"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
+
+type PickupPin struct {
+	ID              uuid.UUID        `json:"id"`
+	OrderID         uuid.UUID        `json:"order_id"`
+	ParcelAutomatID uuid.UUID        `json:"parcel_automat_id"`
+	PinHash         string           `json:"pin_hash"`
+	ExpiresAt       pgtype.Timestamp `json:"expires_at"`
+	UsedAt          pgtype.Timestamp `json:"used_at"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+}
+
+type ScheduledNotification struct {
+	ID           uuid.UUID        `json:"id"`
+	UserID       uuid.UUID        `json:"user_id"`
+	Event        string           `json:"event"`
+	Data         []byte           `json:"data"`
+	DeliverAfter pgtype.Timestamp `json:"deliver_after"`
+	Attempts     int32            `json:"attempts"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
 }
 
 type User struct {
@@ -110,3 +238,35 @@ type UserDevice struct {
 	CreatedAt pgtype.Timestamp `json:"created_at"`
 	UpdatedAt pgtype.Timestamp `json:"updated_at"`
 }
+
+type UserQrSecret struct {
+	UserID     uuid.UUID        `json:"user_id"`
+	Secret     string           `json:"secret"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	Generation int32            `json:"generation"`
+	RevokedAt  pgtype.Timestamp `json:"revoked_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID        `json:"id"`
+	SubscriptionID uuid.UUID        `json:"subscription_id"`
+	Event          string           `json:"event"`
+	Payload        []byte           `json:"payload"`
+	Status         string           `json:"status"`
+	Attempts       int32            `json:"attempts"`
+	ResponseStatus *int32           `json:"response_status"`
+	LastError      *string          `json:"last_error"`
+	NextAttemptAt  pgtype.Timestamp `json:"next_attempt_at"`
+	DeliveredAt    pgtype.Timestamp `json:"delivered_at"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+}
+
+type WebhookSubscription struct {
+	ID        uuid.UUID        `json:"id"`
+	Url       string           `json:"url"`
+	Secret    string           `json:"secret"`
+	Events    []string         `json:"events"`
+	IsActive  bool             `json:"is_active"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
@@ -14,7 +14,7 @@ import (
 const createParcelAutomat = `-- name: CreateParcelAutomat :one
 INSERT INTO parcel_automats (city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working
+RETURNING id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working, allow_static_qr
 `
 
 type CreateParcelAutomatParams struct {
@@ -47,6 +47,7 @@ func (q *Queries) CreateParcelAutomat(ctx context.Context, arg CreateParcelAutom
 		&i.Coordinates,
 		&i.ArucoID,
 		&i.IsWorking,
+		&i.AllowStaticQr,
 	)
 	return i, err
 }
@@ -62,7 +63,7 @@ func (q *Queries) DeleteParcelAutomat(ctx context.Context, id uuid.UUID) error {
 }
 
 const getParcelAutomatByID = `-- name: GetParcelAutomatByID :one
-SELECT id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working FROM parcel_automats
+SELECT id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working, allow_static_qr FROM parcel_automats
 WHERE id = $1
 `
 
@@ -78,12 +79,13 @@ func (q *Queries) GetParcelAutomatByID(ctx context.Context, id uuid.UUID) (Parce
 		&i.Coordinates,
 		&i.ArucoID,
 		&i.IsWorking,
+		&i.AllowStaticQr,
 	)
 	return i, err
 }
 
 const listParcelAutomats = `-- name: ListParcelAutomats :many
-SELECT id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working FROM parcel_automats
+SELECT id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working, allow_static_qr FROM parcel_automats
 ORDER BY id
 `
 
@@ -105,6 +107,7 @@ func (q *Queries) ListParcelAutomats(ctx context.Context) ([]ParcelAutomat, erro
 			&i.Coordinates,
 			&i.ArucoID,
 			&i.IsWorking,
+			&i.AllowStaticQr,
 		); err != nil {
 			return nil, err
 		}
@@ -117,7 +120,7 @@ func (q *Queries) ListParcelAutomats(ctx context.Context) ([]ParcelAutomat, erro
 }
 
 const listWorkingParcelAutomats = `-- name: ListWorkingParcelAutomats :many
-SELECT id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working FROM parcel_automats
+SELECT id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working, allow_static_qr FROM parcel_automats
 WHERE is_working = true
 ORDER BY city, address
 `
@@ -140,6 +143,7 @@ func (q *Queries) ListWorkingParcelAutomats(ctx context.Context) ([]ParcelAutoma
 			&i.Coordinates,
 			&i.ArucoID,
 			&i.IsWorking,
+			&i.AllowStaticQr,
 		); err != nil {
 			return nil, err
 		}
@@ -155,7 +159,7 @@ const updateParcelAutomat = `-- name: UpdateParcelAutomat :one
 UPDATE parcel_automats
 SET city = $2, address = $3, ip_address = $4, coordinates = $5
 WHERE id = $1
-RETURNING id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working
+RETURNING id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working, allow_static_qr
 `
 
 type UpdateParcelAutomatParams struct {
@@ -184,6 +188,7 @@ func (q *Queries) UpdateParcelAutomat(ctx context.Context, arg UpdateParcelAutom
 		&i.Coordinates,
 		&i.ArucoID,
 		&i.IsWorking,
+		&i.AllowStaticQr,
 	)
 	return i, err
 }
@@ -192,7 +197,7 @@ const updateParcelAutomatStatus = `-- name: UpdateParcelAutomatStatus :one
 UPDATE parcel_automats
 SET is_working = $2
 WHERE id = $1
-RETURNING id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working
+RETURNING id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working, allow_static_qr
 `
 
 type UpdateParcelAutomatStatusParams struct {
@@ -212,6 +217,36 @@ func (q *Queries) UpdateParcelAutomatStatus(ctx context.Context, arg UpdateParce
 		&i.Coordinates,
 		&i.ArucoID,
 		&i.IsWorking,
+		&i.AllowStaticQr,
+	)
+	return i, err
+}
+
+const updateParcelAutomatStaticQR = `-- name: UpdateParcelAutomatStaticQR :one
+UPDATE parcel_automats
+SET allow_static_qr = $2
+WHERE id = $1
+RETURNING id, city, address, number_of_cells, ip_address, coordinates, aruco_id, is_working, allow_static_qr
+`
+
+type UpdateParcelAutomatStaticQRParams struct {
+	ID            uuid.UUID `json:"id"`
+	AllowStaticQr bool      `json:"allow_static_qr"`
+}
+
+func (q *Queries) UpdateParcelAutomatStaticQR(ctx context.Context, arg UpdateParcelAutomatStaticQRParams) (ParcelAutomat, error) {
+	row := q.db.QueryRow(ctx, updateParcelAutomatStaticQR, arg.ID, arg.AllowStaticQr)
+	var i ParcelAutomat
+	err := row.Scan(
+		&i.ID,
+		&i.City,
+		&i.Address,
+		&i.NumberOfCells,
+		&i.IpAddress,
+		&i.Coordinates,
+		&i.ArucoID,
+		&i.IsWorking,
+		&i.AllowStaticQr,
 	)
 	return i, err
 }
@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type TelemetryRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewTelemetryRepo(db *pgxpool.Pool) *TelemetryRepo {
+	return &TelemetryRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityTelemetryPoints(rows []sqlc.DroneTelemetry) []*entity.TelemetryPoint {
+	points := make([]*entity.TelemetryPoint, 0, len(rows))
+	for _, row := range rows {
+		point := &entity.TelemetryPoint{
+			DroneID:      row.DroneID,
+			Status:       row.Status,
+			BatteryLevel: row.BatteryLevel,
+			Position: entity.Position{
+				Latitude:  row.Latitude,
+				Longitude: row.Longitude,
+				Altitude:  row.Altitude,
+			},
+			Speed:      row.Speed,
+			RecordedAt: row.RecordedAt.Time,
+		}
+		if row.DeliveryID.Valid {
+			deliveryID := uuid.UUID(row.DeliveryID.Bytes)
+			point.DeliveryID = &deliveryID
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+func (r *TelemetryRepo) ListByDelivery(ctx context.Context, deliveryID uuid.UUID, limit int) ([]*entity.TelemetryPoint, error) {
+	rows, err := r.q.ListDroneTelemetryByDelivery(ctx, sqlc.ListDroneTelemetryByDeliveryParams{
+		DeliveryID: pgtype.UUID{Bytes: deliveryID, Valid: true},
+		Limit:      int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("TelemetryRepo - ListByDelivery: %w", err)
+	}
+	return toEntityTelemetryPoints(rows), nil
+}
+
+func (r *TelemetryRepo) ListByDrone(ctx context.Context, droneID uuid.UUID, from, to time.Time, limit int) ([]*entity.TelemetryPoint, error) {
+	rows, err := r.q.ListDroneTelemetryByDrone(ctx, sqlc.ListDroneTelemetryByDroneParams{
+		DroneID:  droneID,
+		FromTime: pgtype.Timestamp{Time: from, Valid: true},
+		ToTime:   pgtype.Timestamp{Time: to, Valid: true},
+		MaxRows:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("TelemetryRepo - ListByDrone: %w", err)
+	}
+	return toEntityTelemetryPoints(rows), nil
+}
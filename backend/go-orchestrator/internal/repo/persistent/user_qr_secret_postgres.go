@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type UserQRSecretRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewUserQRSecretRepo(db *pgxpool.Pool) *UserQRSecretRepo {
+	return &UserQRSecretRepo{db: db, q: sqlc.New(db)}
+}
+
+func (r *UserQRSecretRepo) GetByUserID(ctx context.Context, userID uuid.UUID) (string, error) {
+	row, err := r.q.GetUserQRSecret(ctx, userID)
+	if err != nil {
+		if isNoRows(err) {
+			return "", entityError.ErrQRSecretNotFound
+		}
+		return "", fmt.Errorf("UserQRSecretRepo - GetByUserID: %w", err)
+	}
+	return row.Secret, nil
+}
+
+func (r *UserQRSecretRepo) Ensure(ctx context.Context, userID uuid.UUID, secret string) (string, error) {
+	row, err := r.q.EnsureUserQRSecret(ctx, sqlc.EnsureUserQRSecretParams{
+		UserID: userID,
+		Secret: secret,
+	})
+	if err != nil {
+		if isPgForeignKeyViolation(err) {
+			return "", entityError.ErrUserNotFound
+		}
+		return "", fmt.Errorf("UserQRSecretRepo - Ensure: %w", err)
+	}
+	return row.Secret, nil
+}
+
+func (r *UserQRSecretRepo) GetGeneration(ctx context.Context, userID uuid.UUID) (int, error) {
+	row, err := r.q.GetUserQRSecret(ctx, userID)
+	if err != nil {
+		if isNoRows(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("UserQRSecretRepo - GetGeneration: %w", err)
+	}
+	return int(row.Generation), nil
+}
+
+func (r *UserQRSecretRepo) Revoke(ctx context.Context, userID uuid.UUID, newSecret string) (int, error) {
+	row, err := r.q.RevokeUserQR(ctx, sqlc.RevokeUserQRParams{
+		UserID: userID,
+		Secret: newSecret,
+	})
+	if err != nil {
+		if isPgForeignKeyViolation(err) {
+			return 0, entityError.ErrUserNotFound
+		}
+		return 0, fmt.Errorf("UserQRSecretRepo - Revoke: %w", err)
+	}
+	return int(row.Generation), nil
+}
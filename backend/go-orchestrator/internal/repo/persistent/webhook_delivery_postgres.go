@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type WebhookDeliveryRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewWebhookDeliveryRepo(db *pgxpool.Pool) *WebhookDeliveryRepo {
+	return &WebhookDeliveryRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityWebhookDelivery(d sqlc.WebhookDelivery) *entity.WebhookDelivery {
+	delivery := &entity.WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		Event:          entity.WebhookEvent(d.Event),
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       int(d.Attempts),
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.Time,
+	}
+	if d.ResponseStatus != nil {
+		status := int(*d.ResponseStatus)
+		delivery.ResponseStatus = &status
+	}
+	if d.NextAttemptAt.Valid {
+		delivery.NextAttemptAt = &d.NextAttemptAt.Time
+	}
+	if d.DeliveredAt.Valid {
+		delivery.DeliveredAt = &d.DeliveredAt.Time
+	}
+	return delivery
+}
+
+func toPgTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: *t, Valid: true}
+}
+
+func (r *WebhookDeliveryRepo) Create(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	row, err := r.q.CreateWebhookDelivery(ctx, sqlc.CreateWebhookDeliveryParams{
+		SubscriptionID: delivery.SubscriptionID,
+		Event:          string(delivery.Event),
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		NextAttemptAt:  toPgTimestamp(delivery.NextAttemptAt),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WebhookDeliveryRepo - Create: %w", err)
+	}
+	return toEntityWebhookDelivery(row), nil
+}
+
+func (r *WebhookDeliveryRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	row, err := r.q.GetWebhookDeliveryByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("WebhookDeliveryRepo - GetByID: %w", err)
+	}
+	return toEntityWebhookDelivery(row), nil
+}
+
+func (r *WebhookDeliveryRepo) ListBySubscription(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]*entity.WebhookDelivery, error) {
+	rows, err := r.q.ListWebhookDeliveriesBySubscription(ctx, sqlc.ListWebhookDeliveriesBySubscriptionParams{
+		SubscriptionID: subscriptionID,
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WebhookDeliveryRepo - ListBySubscription: %w", err)
+	}
+
+	result := make([]*entity.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toEntityWebhookDelivery(row))
+	}
+	return result, nil
+}
+
+// ClaimDue pushes next_attempt_at of due deliveries to leaseUntil so parallel workers skip them while they are in flight.
+func (r *WebhookDeliveryRepo) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	rows, err := r.q.ClaimDueWebhookDeliveries(ctx, sqlc.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: pgtype.Timestamp{Time: leaseUntil, Valid: true},
+		Now:        pgtype.Timestamp{Time: now, Valid: true},
+		BatchSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WebhookDeliveryRepo - ClaimDue: %w", err)
+	}
+
+	result := make([]*entity.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toEntityWebhookDelivery(row))
+	}
+	return result, nil
+}
+
+func (r *WebhookDeliveryRepo) UpdateAttempt(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	var responseStatus *int32
+	if delivery.ResponseStatus != nil {
+		status := int32(*delivery.ResponseStatus)
+		responseStatus = &status
+	}
+
+	row, err := r.q.UpdateWebhookDeliveryAttempt(ctx, sqlc.UpdateWebhookDeliveryAttemptParams{
+		ID:             delivery.ID,
+		Status:         delivery.Status,
+		Attempts:       int32(delivery.Attempts),
+		ResponseStatus: responseStatus,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  toPgTimestamp(delivery.NextAttemptAt),
+		DeliveredAt:    toPgTimestamp(delivery.DeliveredAt),
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("WebhookDeliveryRepo - UpdateAttempt: %w", err)
+	}
+	return toEntityWebhookDelivery(row), nil
+}
@@ -0,0 +1,119 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/persistent/sqlc"
+)
+
+type WebhookSubscriptionRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewWebhookSubscriptionRepo(db *pgxpool.Pool) *WebhookSubscriptionRepo {
+	return &WebhookSubscriptionRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityWebhookSubscription(s sqlc.WebhookSubscription) *entity.WebhookSubscription {
+	events := make([]entity.WebhookEvent, 0, len(s.Events))
+	for _, event := range s.Events {
+		events = append(events, entity.WebhookEvent(event))
+	}
+
+	return &entity.WebhookSubscription{
+		ID:        s.ID,
+		URL:       s.Url,
+		Secret:    s.Secret,
+		Events:    events,
+		IsActive:  s.IsActive,
+		CreatedAt: s.CreatedAt.Time,
+		UpdatedAt: s.UpdatedAt.Time,
+	}
+}
+
+func webhookEventsToStrings(events []entity.WebhookEvent) []string {
+	result := make([]string, 0, len(events))
+	for _, event := range events {
+		result = append(result, string(event))
+	}
+	return result
+}
+
+func (r *WebhookSubscriptionRepo) Create(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	row, err := r.q.CreateWebhookSubscription(ctx, sqlc.CreateWebhookSubscriptionParams{
+		Url:      subscription.URL,
+		Secret:   subscription.Secret,
+		Events:   webhookEventsToStrings(subscription.Events),
+		IsActive: subscription.IsActive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WebhookSubscriptionRepo - Create: %w", err)
+	}
+	return toEntityWebhookSubscription(row), nil
+}
+
+func (r *WebhookSubscriptionRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	row, err := r.q.GetWebhookSubscriptionByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("WebhookSubscriptionRepo - GetByID: %w", err)
+	}
+	return toEntityWebhookSubscription(row), nil
+}
+
+func (r *WebhookSubscriptionRepo) List(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	rows, err := r.q.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookSubscriptionRepo - List: %w", err)
+	}
+
+	result := make([]*entity.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toEntityWebhookSubscription(row))
+	}
+	return result, nil
+}
+
+func (r *WebhookSubscriptionRepo) ListActiveByEvent(ctx context.Context, event entity.WebhookEvent) ([]*entity.WebhookSubscription, error) {
+	rows, err := r.q.ListActiveWebhookSubscriptionsByEvent(ctx, string(event))
+	if err != nil {
+		return nil, fmt.Errorf("WebhookSubscriptionRepo - ListActiveByEvent: %w", err)
+	}
+
+	result := make([]*entity.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toEntityWebhookSubscription(row))
+	}
+	return result, nil
+}
+
+func (r *WebhookSubscriptionRepo) Update(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	row, err := r.q.UpdateWebhookSubscription(ctx, sqlc.UpdateWebhookSubscriptionParams{
+		ID:       subscription.ID,
+		Url:      subscription.URL,
+		Events:   webhookEventsToStrings(subscription.Events),
+		IsActive: subscription.IsActive,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("WebhookSubscriptionRepo - Update: %w", err)
+	}
+	return toEntityWebhookSubscription(row), nil
+}
+
+func (r *WebhookSubscriptionRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.q.DeleteWebhookSubscription(ctx, id); err != nil {
+		return fmt.Errorf("WebhookSubscriptionRepo - Delete: %w", err)
+	}
+	return nil
+}
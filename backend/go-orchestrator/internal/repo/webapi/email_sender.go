@@ -0,0 +1,257 @@
+package webapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	webapierror "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/webapi/error"
+)
+
+//go:embed templates/email/*
+var emailTemplateFS embed.FS
+
+var emailSubjects = map[entity.EmailTemplate]string{
+	entity.EmailOrderConfirmation: "Заказ принят",
+	entity.EmailOrderDelivered:    "Заказ ждёт вас в постамате",
+	entity.EmailReceipt:           "Чек о получении заказа",
+	entity.EmailPasswordReset:     "Сброс пароля",
+}
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	StartTLS bool
+}
+
+type emailTemplateSet struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+type smtpEmailSender struct {
+	cfg       SMTPConfig
+	from      *mail.Address
+	templates map[entity.EmailTemplate]emailTemplateSet
+}
+
+type noopEmailSender struct{}
+
+type EmailSender interface {
+	SendEmail(ctx context.Context, to string, template entity.EmailTemplate, data map[string]string) (string, error)
+}
+
+type emailView struct {
+	Subject string
+	Data    map[string]string
+}
+
+func NewSMTPEmailSender(cfg SMTPConfig) (*smtpEmailSender, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("smtpEmailSender - NewSMTPEmailSender - ParseAddress: %w", err)
+	}
+
+	templates, err := loadEmailTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("smtpEmailSender - NewSMTPEmailSender - loadEmailTemplates: %w", err)
+	}
+
+	return &smtpEmailSender{cfg: cfg, from: from, templates: templates}, nil
+}
+
+func NewNoopEmailSender() *noopEmailSender {
+	return &noopEmailSender{}
+}
+
+func loadEmailTemplates() (map[entity.EmailTemplate]emailTemplateSet, error) {
+	templates := make(map[entity.EmailTemplate]emailTemplateSet, len(emailSubjects))
+	for name := range emailSubjects {
+		html, err := htmltemplate.ParseFS(emailTemplateFS, "templates/email/layout.html", fmt.Sprintf("templates/email/%s.html", name))
+		if err != nil {
+			return nil, err
+		}
+		text, err := texttemplate.ParseFS(emailTemplateFS, fmt.Sprintf("templates/email/%s.txt", name))
+		if err != nil {
+			return nil, err
+		}
+		templates[name] = emailTemplateSet{html: html, text: text}
+	}
+	return templates, nil
+}
+
+func (s *smtpEmailSender) SendEmail(ctx context.Context, to string, template entity.EmailTemplate, data map[string]string) (string, error) {
+	recipient, err := mail.ParseAddress(to)
+	if err != nil {
+		return "", fmt.Errorf("smtpEmailSender - SendEmail - ParseAddress: %w", webapierror.ErrEmailRejected)
+	}
+
+	messageID := s.newMessageID()
+	message, err := s.buildMessage(recipient, messageID, template, data)
+	if err != nil {
+		return "", fmt.Errorf("smtpEmailSender - SendEmail - buildMessage: %w", err)
+	}
+
+	if err := s.deliver(ctx, recipient.Address, message); err != nil {
+		return messageID, fmt.Errorf("smtpEmailSender - SendEmail - deliver: %w", err)
+	}
+
+	return messageID, nil
+}
+
+func (s *smtpEmailSender) newMessageID() string {
+	domain := "localhost"
+	if at := strings.LastIndex(s.from.Address, "@"); at >= 0 {
+		domain = s.from.Address[at+1:]
+	}
+	return fmt.Sprintf("<%s@%s>", uuid.NewString(), domain)
+}
+
+func (s *smtpEmailSender) buildMessage(to *mail.Address, messageID string, template entity.EmailTemplate, data map[string]string) ([]byte, error) {
+	set, ok := s.templates[template]
+	if !ok {
+		return nil, webapierror.ErrEmailUnknownTemplate
+	}
+
+	view := emailView{Subject: emailSubjects[template], Data: data}
+
+	var htmlBody, textBody bytes.Buffer
+	if err := set.html.ExecuteTemplate(&htmlBody, "layout", view); err != nil {
+		return nil, err
+	}
+	if err := set.text.Execute(&textBody, view); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", s.from.String())
+	fmt.Fprintf(&message, "To: %s\r\n", to.String())
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", view.Subject))
+	fmt.Fprintf(&message, "Message-ID: %s\r\n", messageID)
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&message, "Content-Type: multipart/alternative; boundary=%s\r\n", writer.Boundary())
+	message.WriteString("\r\n")
+
+	for _, part := range []struct {
+		contentType string
+		body        []byte
+	}{
+		{"text/plain; charset=utf-8", textBody.Bytes()},
+		{"text/html; charset=utf-8", htmlBody.Bytes()},
+	} {
+		partHeader := make(textproto.MIMEHeader)
+		partHeader.Set("Content-Type", part.contentType)
+		partHeader.Set("Content-Transfer-Encoding", "quoted-printable")
+		partWriter, err := writer.CreatePart(partHeader)
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(partWriter)
+		if _, err := qp.Write(part.body); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	message.Write(buf.Bytes())
+	return message.Bytes(), nil
+}
+
+func (s *smtpEmailSender) deliver(ctx context.Context, to string, message []byte) error {
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("%w: %v", webapierror.ErrEmailServiceUnavailable, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return classifySMTPError(err)
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	if s.cfg.StartTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: s.cfg.Host}); err != nil {
+				return classifySMTPError(err)
+			}
+		}
+	}
+
+	if s.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return classifySMTPError(err)
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return classifySMTPError(err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return classifySMTPError(err)
+	}
+
+	wc, err := client.Data()
+	if err != nil {
+		return classifySMTPError(err)
+	}
+	if _, err := wc.Write(message); err != nil {
+		_ = wc.Close()
+		return classifySMTPError(err)
+	}
+	if err := wc.Close(); err != nil {
+		return classifySMTPError(err)
+	}
+
+	return client.Quit()
+}
+
+// classifySMTPError maps permanent 5xx replies to ErrEmailRejected so callers can record them as bounces.
+func classifySMTPError(err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return fmt.Errorf("%w: %v", webapierror.ErrEmailRejected, err)
+	}
+	return fmt.Errorf("%w: %v", webapierror.ErrEmailSendFailed, err)
+}
+
+func (s *noopEmailSender) SendEmail(ctx context.Context, to string, template entity.EmailTemplate, data map[string]string) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,143 @@
+package webapi
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	webapierror "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/webapi/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startFakeSMTPServer(t *testing.T, rcptReply string) (string, int, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		tp := textproto.NewConn(conn)
+		_ = tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			switch command := strings.ToUpper(strings.Fields(line)[0]); command {
+			case "EHLO", "HELO", "MAIL":
+				_ = tp.PrintfLine("250 OK")
+			case "RCPT":
+				_ = tp.PrintfLine("%s", rcptReply)
+			case "DATA":
+				_ = tp.PrintfLine("354 Go ahead")
+				body, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				received <- string(body)
+				_ = tp.PrintfLine("250 Queued")
+			case "QUIT":
+				_ = tp.PrintfLine("221 Bye")
+				return
+			default:
+				_ = tp.PrintfLine("502 Not implemented")
+			}
+		}
+	}()
+
+	host, portStr, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+	return host, port, received
+}
+
+func TestLoadEmailTemplates(t *testing.T) {
+	templates, err := loadEmailTemplates()
+
+	require.NoError(t, err)
+	for _, name := range []entity.EmailTemplate{
+		entity.EmailOrderConfirmation,
+		entity.EmailOrderDelivered,
+		entity.EmailReceipt,
+		entity.EmailPasswordReset,
+	} {
+		assert.Contains(t, templates, name)
+	}
+}
+
+func TestSMTPEmailSender_SendEmail_Success(t *testing.T) {
+	host, port, received := startFakeSMTPServer(t, "250 OK")
+
+	sender, err := NewSMTPEmailSender(SMTPConfig{Host: host, Port: port, From: "SkyPost <noreply@skypost.test>"})
+	require.NoError(t, err)
+
+	messageID, err := sender.SendEmail(context.Background(), "user@example.com", entity.EmailOrderDelivered, map[string]string{
+		"order_id":   "order-42",
+		"pickup_pin": "123456",
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(messageID, "@skypost.test>"))
+
+	raw := <-received
+	msg, err := mail.ReadMessage(strings.NewReader(raw))
+	require.NoError(t, err)
+	assert.Equal(t, messageID, msg.Header.Get("Message-ID"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, emailSubjects[entity.EmailOrderDelivered], subject)
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	var contentTypes []string
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+		contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "123456")
+	}
+	assert.Equal(t, []string{"text/plain; charset=utf-8", "text/html; charset=utf-8"}, contentTypes)
+}
+
+func TestSMTPEmailSender_SendEmail_RecipientRejected(t *testing.T) {
+	host, port, _ := startFakeSMTPServer(t, "550 No such user")
+
+	sender, err := NewSMTPEmailSender(SMTPConfig{Host: host, Port: port, From: "noreply@skypost.test"})
+	require.NoError(t, err)
+
+	messageID, err := sender.SendEmail(context.Background(), "missing@example.com", entity.EmailReceipt, map[string]string{"order_id": "order-42"})
+
+	assert.ErrorIs(t, err, webapierror.ErrEmailRejected)
+	assert.NotEmpty(t, messageID)
+}
+
+func TestSMTPEmailSender_SendEmail_UnknownTemplate(t *testing.T) {
+	sender, err := NewSMTPEmailSender(SMTPConfig{Host: "127.0.0.1", Port: 1, From: "noreply@skypost.test"})
+	require.NoError(t, err)
+
+	_, err = sender.SendEmail(context.Background(), "user@example.com", entity.EmailTemplate("unknown"), nil)
+
+	assert.ErrorIs(t, err, webapierror.ErrEmailUnknownTemplate)
+}
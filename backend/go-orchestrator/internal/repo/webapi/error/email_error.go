@@ -0,0 +1,10 @@
+package error
+
+import "errors"
+
+var (
+	ErrEmailUnknownTemplate    = errors.New("unknown email template")
+	ErrEmailRejected           = errors.New("email rejected by recipient server")
+	ErrEmailSendFailed         = errors.New("failed to send email")
+	ErrEmailServiceUnavailable = errors.New("email service is temporarily unavailable")
+)
@@ -0,0 +1,8 @@
+package error
+
+import "errors"
+
+var (
+	ErrWebhookRequestFailed    = errors.New("webhook request failed")
+	ErrWebhookUnexpectedStatus = errors.New("webhook endpoint returned non-2xx status")
+)
@@ -5,21 +5,29 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
 )
 
 type QRAdapter struct {
-	qrGenerator *qr.QRGenerator
+	qrGenerator  *qr.QRGenerator
+	qrSecretRepo repo.UserQRSecretRepo
 }
 
-func NewQRAdapter(qrGenerator *qr.QRGenerator) *QRAdapter {
+func NewQRAdapter(qrGenerator *qr.QRGenerator, qrSecretRepo repo.UserQRSecretRepo) *QRAdapter {
 	return &QRAdapter{
-		qrGenerator: qrGenerator,
+		qrGenerator:  qrGenerator,
+		qrSecretRepo: qrSecretRepo,
 	}
 }
 
 func (a *QRAdapter) GenerateQR(ctx context.Context, userID uuid.UUID, email, name string) (string, error) {
-	_, qrImageBase64, err := a.qrGenerator.GenerateQRCode(userID, email, name)
+	generation, err := a.qrSecretRepo.GetGeneration(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("QRAdapter - GenerateQR - GetGeneration: %w", err)
+	}
+
+	_, qrImageBase64, err := a.qrGenerator.GenerateQRCode(userID, email, name, generation)
 	if err != nil {
 		return "", fmt.Errorf("QRAdapter - GenerateQR - GenerateQRCode: %w", err)
 	}
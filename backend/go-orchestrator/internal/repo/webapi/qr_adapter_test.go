@@ -2,20 +2,24 @@ package webapi
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/config"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestQRAdapter_GenerateQR_Success(t *testing.T) {
 	qrGen := qr.NewQRGenerator(&config.QR{HMACSecret: "test-secret-key"})
-	adapter := NewQRAdapter(qrGen)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+	adapter := NewQRAdapter(qrGen, mockQRSecretRepo)
 
 	ctx := context.Background()
 	userID := uuid.New()
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, nil)
 	email := "test@example.com"
 	name := "Test User"
 
@@ -27,10 +31,12 @@ func TestQRAdapter_GenerateQR_Success(t *testing.T) {
 
 func TestQRAdapter_GenerateQR_ValidatesOutput(t *testing.T) {
 	qrGen := qr.NewQRGenerator(&config.QR{HMACSecret: "test-secret-key"})
-	adapter := NewQRAdapter(qrGen)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+	adapter := NewQRAdapter(qrGen, mockQRSecretRepo)
 
 	ctx := context.Background()
 	userID := uuid.New()
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, nil)
 	email := "test@example.com"
 	name := "Test User"
 
@@ -43,8 +49,23 @@ func TestQRAdapter_GenerateQR_ValidatesOutput(t *testing.T) {
 
 func TestNewQRAdapter(t *testing.T) {
 	qrGen := qr.NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
-	adapter := NewQRAdapter(qrGen)
+	adapter := NewQRAdapter(qrGen, new(mocks.MockUserQRSecretRepo))
 
 	assert.NotNil(t, adapter)
 	assert.NotNil(t, adapter.qrGenerator)
 }
+
+func TestQRAdapter_GenerateQR_GenerationError(t *testing.T) {
+	qrGen := qr.NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+	adapter := NewQRAdapter(qrGen, mockQRSecretRepo)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, errors.New("db down"))
+
+	result, err := adapter.GenerateQR(ctx, userID, "test@example.com", "Test User")
+
+	assert.Error(t, err)
+	assert.Empty(t, result)
+}
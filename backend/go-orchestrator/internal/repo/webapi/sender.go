@@ -6,6 +6,7 @@ import (
 
 	firebase "firebase.google.com/go/v4"
 	"firebase.google.com/go/v4/messaging"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
 	"google.golang.org/api/option"
 )
 
@@ -16,7 +17,7 @@ type fcmSender struct {
 type noopSender struct{}
 
 type PushSender interface {
-	SendDeliveryNotification(ctx context.Context, tokens []string, orderID string, lockerCellID *string) ([]string, error)
+	Send(ctx context.Context, tokens []string, notification entity.Notification) ([]string, error)
 }
 
 func NewFCMSender(ctx context.Context, credentialsFile, projectID string) (*fcmSender, error) {
@@ -47,30 +48,37 @@ func NewNoopSender() *noopSender {
 	return &noopSender{}
 }
 
-func (s *fcmSender) SendDeliveryNotification(ctx context.Context, tokens []string, orderID string, lockerCellID *string) ([]string, error) {
+func (s *fcmSender) Send(ctx context.Context, tokens []string, notification entity.Notification) ([]string, error) {
 	if len(tokens) == 0 {
 		return nil, nil
 	}
 
-	data := map[string]string{
-		"order_id": orderID,
-	}
-	if lockerCellID != nil && *lockerCellID != "" {
-		data["locker_cell_id"] = *lockerCellID
+	data := make(map[string]string, len(notification.Data)+1)
+	for key, value := range notification.Data {
+		data[key] = value
 	}
+	data["event"] = string(notification.Event)
 
 	message := &messaging.MulticastMessage{
 		Tokens: tokens,
 		Notification: &messaging.Notification{
-			Title: "Посылка доставлена",
-			Body:  "Заказ готов к выдаче",
+			Title: notification.Title,
+			Body:  notification.Body,
 		},
 		Data: data,
 	}
 
+	invalid, err := s.sendMulticast(ctx, message)
+	if err != nil {
+		return nil, fmt.Errorf("fcmSender - Send - sendMulticast: %w", err)
+	}
+	return invalid, nil
+}
+
+func (s *fcmSender) sendMulticast(ctx context.Context, message *messaging.MulticastMessage) ([]string, error) {
 	sendResponses, err := s.client.SendEachForMulticast(ctx, message)
 	if err != nil {
-		return nil, fmt.Errorf("fcmSender - SendDeliveryNotification - SendEachForMulticast: %w", err)
+		return nil, err
 	}
 
 	invalid := make([]string, 0)
@@ -81,12 +89,12 @@ func (s *fcmSender) SendDeliveryNotification(ctx context.Context, tokens []strin
 
 		if messaging.IsUnregistered(resp.Error) ||
 			messaging.IsInvalidArgument(resp.Error) {
-			invalid = append(invalid, tokens[index])
+			invalid = append(invalid, message.Tokens[index])
 		}
 	}
 	return invalid, nil
 }
 
-func (s *noopSender) SendDeliveryNotification(ctx context.Context, tokens []string, orderID string, lockerCellID *string) ([]string, error) {
+func (s *noopSender) Send(ctx context.Context, tokens []string, notification entity.Notification) ([]string, error) {
 	return nil, nil
 }
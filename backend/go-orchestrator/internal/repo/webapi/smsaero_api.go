@@ -36,16 +36,24 @@ func NewSMSAeroAPI(email, apiKey, baseURL string) *SMSAeroAPI {
 }
 
 func (s *SMSAeroAPI) SendSMS(ctx context.Context, phone, code string) error {
+	return s.send(ctx, "SendSMS", phone, fmt.Sprintf("Ваш код для входа в SkyPost Delivery: %s", code))
+}
+
+func (s *SMSAeroAPI) SendMessage(ctx context.Context, phone, text string) error {
+	return s.send(ctx, "SendMessage", phone, text)
+}
+
+func (s *SMSAeroAPI) send(ctx context.Context, operation, phone, text string) error {
 	endpoint := fmt.Sprintf("%s/sms/send", s.baseURL)
 
 	data := url.Values{}
 	data.Set("number", phone)
-	data.Set("text", fmt.Sprintf("Ваш код для входа в SkyPost Delivery: %s", code))
+	data.Set("text", text)
 	data.Set("sign", "SMS Aero")
 
 	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBufferString(data.Encode()))
 	if err != nil {
-		return fmt.Errorf("SMSAeroAPI - SendSMS - NewRequest: %w", err)
+		return fmt.Errorf("SMSAeroAPI - %s - NewRequest: %w", operation, err)
 	}
 
 	req.SetBasicAuth(s.email, s.apiKey)
@@ -53,7 +61,7 @@ func (s *SMSAeroAPI) SendSMS(ctx context.Context, phone, code string) error {
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return fmt.Errorf("SMSAeroAPI - SendSMS - Do: %w", err)
+		return fmt.Errorf("SMSAeroAPI - %s - Do: %w", operation, err)
 	}
 	defer func() {
 		_ = resp.Body.Close()
@@ -61,37 +69,37 @@ func (s *SMSAeroAPI) SendSMS(ctx context.Context, phone, code string) error {
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("SMSAeroAPI - SendSMS - ReadAll: %w", err)
+		return fmt.Errorf("SMSAeroAPI - %s - ReadAll: %w", operation, err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusOK:
 	case http.StatusBadRequest:
-		return fmt.Errorf("SMSAeroAPI - SendSMS - HandleResponse[status=%d]: %w", resp.StatusCode, webapierror.ErrSMSInvalidPhone)
+		return fmt.Errorf("SMSAeroAPI - %s - HandleResponse[status=%d]: %w", operation, resp.StatusCode, webapierror.ErrSMSInvalidPhone)
 	case http.StatusPaymentRequired:
 		return webapierror.ErrSMSInsufficientFunds
 	case http.StatusTooManyRequests:
 		return webapierror.ErrSMSRateLimitExceeded
 	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
-		return fmt.Errorf("SMSAeroAPI - SendSMS - HandleResponse[status=%d]: %w", resp.StatusCode, webapierror.ErrSMSServiceUnavailable)
+		return fmt.Errorf("SMSAeroAPI - %s - HandleResponse[status=%d]: %w", operation, resp.StatusCode, webapierror.ErrSMSServiceUnavailable)
 	default:
-		return fmt.Errorf("SMSAeroAPI - SendSMS - HandleResponse[status=%d]: %w", resp.StatusCode, webapierror.ErrSMSSendFailed)
+		return fmt.Errorf("SMSAeroAPI - %s - HandleResponse[status=%d]: %w", operation, resp.StatusCode, webapierror.ErrSMSSendFailed)
 	}
 
 	var smsResp SMSAeroResponse
 	if err := json.Unmarshal(body, &smsResp); err != nil {
-		return fmt.Errorf("SMSAeroAPI - SendSMS - Unmarshal: %w", err)
+		return fmt.Errorf("SMSAeroAPI - %s - Unmarshal: %w", operation, err)
 	}
 
 	if !smsResp.Success {
 		message := strings.ToLower(smsResp.Message)
 		switch {
 		case strings.Contains(message, "balance") || strings.Contains(message, "funds"):
-			return fmt.Errorf("SMSAeroAPI - SendSMS - HandleResponse[message=%s]: %w", smsResp.Message, webapierror.ErrSMSInsufficientFunds)
+			return fmt.Errorf("SMSAeroAPI - %s - HandleResponse[message=%s]: %w", operation, smsResp.Message, webapierror.ErrSMSInsufficientFunds)
 		case strings.Contains(message, "phone") || strings.Contains(message, "number"):
-			return fmt.Errorf("SMSAeroAPI - SendSMS - HandleResponse[message=%s]: %w", smsResp.Message, webapierror.ErrSMSInvalidPhone)
+			return fmt.Errorf("SMSAeroAPI - %s - HandleResponse[message=%s]: %w", operation, smsResp.Message, webapierror.ErrSMSInvalidPhone)
 		default:
-			return fmt.Errorf("SMSAeroAPI - SendSMS - HandleResponse[message=%s]: %w", smsResp.Message, webapierror.ErrSMSSendFailed)
+			return fmt.Errorf("SMSAeroAPI - %s - HandleResponse[message=%s]: %w", operation, smsResp.Message, webapierror.ErrSMSSendFailed)
 		}
 	}
 
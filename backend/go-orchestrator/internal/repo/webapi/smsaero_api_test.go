@@ -168,3 +168,20 @@ func TestSMSAeroAPI_CheckBalance_InvalidJSON(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, 0.0, balance)
 }
+
+func TestSMSAeroAPI_SendMessage_SendsTextAsIs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		assert.NoError(t, err)
+		assert.Equal(t, "Посылка доставлена. Заказ готов к выдаче", r.FormValue("text"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"success": true}`))
+	}))
+	defer server.Close()
+
+	api := NewSMSAeroAPI("test@example.com", "test-api-key", server.URL)
+	err := api.SendMessage(context.Background(), "79991234567", "Посылка доставлена. Заказ готов к выдаче")
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,78 @@
+package webapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	webapierror "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/webapi/error"
+)
+
+const (
+	WebhookEventHeader     = "X-SkyPost-Event"
+	WebhookDeliveryHeader  = "X-SkyPost-Delivery"
+	WebhookTimestampHeader = "X-SkyPost-Timestamp"
+	WebhookSignatureHeader = "X-SkyPost-Signature"
+
+	webhookResponseSnippetLimit = 512
+)
+
+type WebhookClient struct {
+	client *http.Client
+}
+
+func NewWebhookClient(timeout time.Duration) *WebhookClient {
+	return &WebhookClient{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// SignWebhookPayload returns the value of the signature header: HMAC-SHA256 over "<timestamp>.<payload>".
+// Receivers recompute it with the subscription secret and reject stale timestamps to prevent replays.
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *WebhookClient) Deliver(ctx context.Context, url, secret string, delivery *entity.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("WebhookClient - Deliver - NewRequest: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "SkyPost-Webhooks/1.0")
+	req.Header.Set(WebhookEventHeader, string(delivery.Event))
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(secret, timestamp, delivery.Payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("WebhookClient - Deliver - Do: %w: %v", webapierror.ErrWebhookRequestFailed, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, webhookResponseSnippetLimit))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("WebhookClient - Deliver - HandleResponse[status=%d, body=%q]: %w",
+			resp.StatusCode, snippet, webapierror.ErrWebhookUnexpectedStatus)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,92 @@
+package webapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	webapierror "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/webapi/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookClient_Deliver_SignsPayload(t *testing.T) {
+	secret := "whsec_test"
+	delivery := &entity.WebhookDelivery{
+		ID:      uuid.New(),
+		Event:   entity.WebhookOrderCreated,
+		Payload: []byte(`{"event":"order.created"}`),
+	}
+
+	received := make(chan *http.Request, 1)
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		received <- r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewWebhookClient(5 * time.Second)
+	status, err := client.Deliver(context.Background(), server.URL, secret, delivery)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+
+	r := <-received
+	assert.Equal(t, http.MethodPost, r.Method)
+	assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+	assert.Equal(t, string(entity.WebhookOrderCreated), r.Header.Get(WebhookEventHeader))
+	assert.Equal(t, delivery.ID.String(), r.Header.Get(WebhookDeliveryHeader))
+	assert.Equal(t, delivery.Payload, body)
+
+	timestamp, err := strconv.ParseInt(r.Header.Get(WebhookTimestampHeader), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, SignWebhookPayload(secret, timestamp, body), r.Header.Get(WebhookSignatureHeader))
+	assert.NotEqual(t, SignWebhookPayload("other", timestamp, body), r.Header.Get(WebhookSignatureHeader))
+}
+
+func TestWebhookClient_Deliver_Non2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("maintenance"))
+	}))
+	defer server.Close()
+
+	client := NewWebhookClient(5 * time.Second)
+	status, err := client.Deliver(context.Background(), server.URL, "secret", &entity.WebhookDelivery{
+		ID:      uuid.New(),
+		Event:   entity.WebhookOrderCancelled,
+		Payload: []byte(`{}`),
+	})
+
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.ErrorIs(t, err, webapierror.ErrWebhookUnexpectedStatus)
+	assert.Contains(t, err.Error(), "maintenance")
+}
+
+func TestWebhookClient_Deliver_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	client := NewWebhookClient(time.Second)
+	status, err := client.Deliver(context.Background(), url, "secret", &entity.WebhookDelivery{ID: uuid.New(), Payload: []byte(`{}`)})
+
+	assert.Zero(t, status)
+	assert.ErrorIs(t, err, webapierror.ErrWebhookRequestFailed)
+}
+
+func TestSignWebhookPayload_KnownVector(t *testing.T) {
+	signature := SignWebhookPayload("secret", 1700000000, []byte(`{"a":1}`))
+
+	assert.Equal(t, "sha256=", signature[:7])
+	assert.Len(t, signature, 7+64)
+	assert.Equal(t, signature, SignWebhookPayload("secret", 1700000000, []byte(`{"a":1}`)))
+}
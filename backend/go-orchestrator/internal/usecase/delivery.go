@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,8 +20,12 @@ type DeliveryUseCase struct {
 	orderRepo          repo.OrderRepo
 	lockerRepo         repo.LockerRepo
 	internalLockerRepo repo.InternalLockerRepo
+	parcelAutomatRepo  repo.ParcelAutomatRepo
 	rabbitmqClient     rabbitmq.RabbitMQClient
-	notifier           DeliveryNotifier
+	notifier           OrderEventNotifier
+	webhooks           WebhookPublisher
+	pinIssuer          PickupPINIssuer
+	recordings         RecordingScheduler
 	logger             logger.Interface
 }
 
@@ -29,8 +34,12 @@ func NewDeliveryUseCase(
 	orderRepo repo.OrderRepo,
 	lockerRepo repo.LockerRepo,
 	internalLockerRepo repo.InternalLockerRepo,
+	parcelAutomatRepo repo.ParcelAutomatRepo,
 	rabbitmqClient rabbitmq.RabbitMQClient,
-	notifier DeliveryNotifier,
+	notifier OrderEventNotifier,
+	webhooks WebhookPublisher,
+	pinIssuer PickupPINIssuer,
+	recordings RecordingScheduler,
 	logger logger.Interface,
 ) *DeliveryUseCase {
 	return &DeliveryUseCase{
@@ -38,12 +47,32 @@ func NewDeliveryUseCase(
 		orderRepo:          orderRepo,
 		lockerRepo:         lockerRepo,
 		internalLockerRepo: internalLockerRepo,
+		parcelAutomatRepo:  parcelAutomatRepo,
 		rabbitmqClient:     rabbitmqClient,
 		notifier:           notifier,
+		webhooks:           webhooks,
+		pinIssuer:          pinIssuer,
+		recordings:         recordings,
 		logger:             logger,
 	}
 }
 
+const (
+	arrivingSoonThreshold   = 5 * time.Minute
+	pickupReminderDelay     = 24 * time.Hour
+	pickupReminderBatchSize = 100
+)
+
+var deliveryStatusEvents = map[string]entity.NotificationEvent{
+	"in_transit": entity.NotificationDroneDeparted,
+	"failed":     entity.NotificationDeliveryFailed,
+}
+
+var deliveryWebhookEvents = map[string]entity.WebhookEvent{
+	"in_transit": entity.WebhookDeliveryDispatched,
+	"delivered":  entity.WebhookDeliveryDelivered,
+}
+
 var validDeliveryStatuses = map[string]bool{
 	"pending":        true,
 	"awaiting_drone": true,
@@ -118,7 +147,7 @@ func (uc *DeliveryUseCase) UpdateStatus(ctx context.Context, deliveryID uuid.UUI
 		return fmt.Errorf("DeliveryUseCase - UpdateStatus - GetByID: %w", err)
 	}
 
-	delivery.Status = status
+	markDeliveryStatus(delivery, status, time.Now())
 	updatedDelivery, err := uc.deliveryRepo.UpdateStatus(ctx, delivery)
 	if err != nil {
 		return fmt.Errorf("DeliveryUseCase - UpdateStatus: %w", err)
@@ -150,12 +179,152 @@ func (uc *DeliveryUseCase) UpdateStatus(ctx context.Context, deliveryID uuid.UUI
 	})
 
 	if status == "delivered" {
-		uc.notifyOrderDelivered(ctx, order.UserID, order.ID, order.LockerCellID)
+		uc.notifyOrderDelivered(ctx, order, delivery.ParcelAutomatID)
+	} else if event, ok := deliveryStatusEvents[status]; ok {
+		uc.notifyOrder(ctx, order, event, nil)
+	}
+	if event, ok := deliveryWebhookEvents[status]; ok {
+		uc.publishWebhook(ctx, event, order, delivery)
+	}
+	if finalDeliveryStatuses[status] {
+		uc.scheduleRecording(ctx, delivery.ID)
+	}
+
+	return nil
+}
+
+// ReportPosition estimates when the drone flying the delivery reaches its parcel automat from the drone's
+// position and speed, and passes the estimate on to ReportETA.
+func (uc *DeliveryUseCase) ReportPosition(ctx context.Context, deliveryID uuid.UUID, position entity.Position, speed float64) error {
+	if speed < orderTrackingMinSpeed || (position.Latitude == 0 && position.Longitude == 0) {
+		return nil
+	}
+
+	delivery, err := uc.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		return fmt.Errorf("DeliveryUseCase - ReportPosition - GetByID: %w", err)
+	}
+
+	if delivery.Status != "in_transit" {
+		return nil
+	}
+
+	automat, err := uc.parcelAutomatRepo.GetByID(ctx, delivery.ParcelAutomatID)
+	if err != nil {
+		return fmt.Errorf("DeliveryUseCase - ReportPosition - GetParcelAutomat: %w", err)
+	}
+
+	destination, ok := parseCoordinates(automat.Coordinates)
+	if !ok {
+		return nil
+	}
+
+	eta := time.Duration(distanceMeters(position, destination) / speed * float64(time.Second))
+	return uc.reportETA(ctx, delivery, eta)
+}
+
+func (uc *DeliveryUseCase) ReportETA(ctx context.Context, deliveryID uuid.UUID, eta time.Duration) error {
+	if eta > arrivingSoonThreshold {
+		return nil
+	}
+
+	delivery, err := uc.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		return fmt.Errorf("DeliveryUseCase - ReportETA - GetByID: %w", err)
+	}
+
+	return uc.reportETA(ctx, delivery, eta)
+}
+
+// reportETA sends arriving_soon once per flight: the repo records it on the delivery, so a restart or
+// another replica does not send it again.
+func (uc *DeliveryUseCase) reportETA(ctx context.Context, delivery *entity.Delivery, eta time.Duration) error {
+	if eta > arrivingSoonThreshold || delivery.Status != "in_transit" {
+		return nil
+	}
+
+	order, err := uc.orderRepo.GetByID(ctx, delivery.OrderID)
+	if err != nil {
+		return fmt.Errorf("DeliveryUseCase - ReportETA - GetOrder: %w", err)
 	}
 
+	marked, err := uc.deliveryRepo.MarkArrivalNotified(ctx, delivery.ID, time.Now())
+	if err != nil {
+		return fmt.Errorf("DeliveryUseCase - ReportETA - MarkArrivalNotified: %w", err)
+	}
+	if !marked {
+		return nil
+	}
+
+	minutes := int((eta + time.Minute - 1) / time.Minute)
+	uc.notifyOrder(ctx, order, entity.NotificationArrivingSoon, map[string]string{
+		"eta_minutes": strconv.Itoa(minutes),
+	})
+
 	return nil
 }
 
+func (uc *DeliveryUseCase) StartPickupReminderWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	uc.logger.Info("Pickup reminder worker started", nil, map[string]any{
+		"interval": interval.String(),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			uc.logger.Info("Pickup reminder worker stopped", nil)
+			return
+		case <-ticker.C:
+			uc.sendPickupReminders(ctx, time.Now())
+		}
+	}
+}
+
+// sendPickupReminders reminds about orders left in the parcel automat for pickupReminderDelay, and again
+// every pickupReminderDelay after that. Claiming the deliveries records the reminder, so each one is sent
+// by a single replica.
+func (uc *DeliveryUseCase) sendPickupReminders(ctx context.Context, now time.Time) {
+	for {
+		deliveries, err := uc.deliveryRepo.ClaimDuePickupReminders(ctx, now, now.Add(-pickupReminderDelay), pickupReminderBatchSize)
+		if err != nil {
+			uc.logger.Error("DeliveryUseCase - sendPickupReminders - ClaimDuePickupReminders", err)
+			return
+		}
+
+		for _, delivery := range deliveries {
+			order, err := uc.orderRepo.GetByID(ctx, delivery.OrderID)
+			if err != nil {
+				uc.logger.Warn("DeliveryUseCase - sendPickupReminders - GetOrder", err, map[string]any{
+					"orderID": delivery.OrderID,
+				})
+				continue
+			}
+			if order.Status != "delivered" {
+				continue
+			}
+
+			uc.notifyOrder(ctx, order, entity.NotificationPickupReminder, nil)
+		}
+
+		if len(deliveries) < pickupReminderBatchSize {
+			return
+		}
+	}
+}
+
+// markDeliveryStatus sets the status and, when the delivery reaches the parcel automat, the time it did.
+// The repo keeps the first delivered time if the status is repeated.
+func markDeliveryStatus(delivery *entity.Delivery, status string, now time.Time) {
+	delivery.Status = status
+	delivery.DeliveredAt = nil
+	if status == "delivered" {
+		delivery.DeliveredAt = &now
+	}
+}
+
 func (uc *DeliveryUseCase) ListByStatus(ctx context.Context, status string) ([]*entity.Delivery, error) {
 	deliveries, err := uc.deliveryRepo.ListByStatus(ctx, status)
 	if err != nil {
@@ -175,7 +344,7 @@ func (uc *DeliveryUseCase) ConfirmGoodsLoaded(ctx context.Context, orderID, lock
 		return fmt.Errorf("DeliveryUseCase - ConfirmGoodsLoaded - GetByID: %w", err)
 	}
 
-	delivery.Status = "delivered"
+	markDeliveryStatus(delivery, "delivered", time.Now())
 	if _, err := uc.deliveryRepo.UpdateStatus(ctx, delivery); err != nil {
 		return fmt.Errorf("DeliveryUseCase - ConfirmGoodsLoaded - UpdateStatus: %w", err)
 	}
@@ -219,20 +388,68 @@ func (uc *DeliveryUseCase) ConfirmGoodsLoaded(ctx context.Context, orderID, lock
 		"lockerCellID": lockerCellID,
 	})
 
-	uc.notifyOrderDelivered(ctx, updatedOrder.UserID, updatedOrder.ID, updatedOrder.LockerCellID)
+	uc.notifyOrderDelivered(ctx, updatedOrder, delivery.ParcelAutomatID)
+	uc.publishWebhook(ctx, entity.WebhookDeliveryDelivered, updatedOrder, delivery)
+	uc.scheduleRecording(ctx, delivery.ID)
 
 	return nil
 }
 
-func (uc *DeliveryUseCase) notifyOrderDelivered(ctx context.Context, userID, orderID uuid.UUID, lockerCellID *uuid.UUID) {
+func (uc *DeliveryUseCase) notifyOrderDelivered(ctx context.Context, order *entity.Order, parcelAutomatID uuid.UUID) {
+	var pickupPIN *string
+	if uc.pinIssuer != nil {
+		pin, _, err := uc.pinIssuer.IssuePIN(ctx, order.ID, parcelAutomatID)
+		if err != nil {
+			uc.logger.Warn("DeliveryUseCase - notifyOrderDelivered - IssuePIN", err, map[string]any{
+				"orderID": order.ID,
+			})
+		} else {
+			pickupPIN = &pin
+		}
+	}
+
+	data := map[string]string{}
+	if pickupPIN != nil {
+		data[pickupPINDataKey] = *pickupPIN
+	}
+	uc.notifyOrder(ctx, order, entity.NotificationOrderDelivered, data)
+}
+
+func (uc *DeliveryUseCase) publishWebhook(ctx context.Context, event entity.WebhookEvent, order *entity.Order, delivery *entity.Delivery) {
+	if uc.webhooks == nil {
+		return
+	}
+
+	if err := uc.webhooks.Publish(ctx, event, orderWebhookData(order, delivery)); err != nil {
+		uc.logger.Warn("DeliveryUseCase - publishWebhook", err, map[string]any{
+			"orderID": order.ID,
+			"event":   event,
+		})
+	}
+}
+
+func (uc *DeliveryUseCase) scheduleRecording(ctx context.Context, deliveryID uuid.UUID) {
+	if uc.recordings == nil {
+		return
+	}
+	uc.recordings.ScheduleAssembly(ctx, deliveryID)
+}
+
+func (uc *DeliveryUseCase) notifyOrder(ctx context.Context, order *entity.Order, event entity.NotificationEvent, extra map[string]string) {
 	if uc.notifier == nil {
 		return
 	}
 
-	if err := uc.notifier.NotifyOrderDelivered(ctx, userID, orderID, lockerCellID); err != nil {
-		uc.logger.Warn("DeliveryUseCase - notifyOrderDelivered", err, map[string]any{
-			"userID":  userID,
-			"orderID": orderID,
+	data := orderNotificationData(order)
+	for key, value := range extra {
+		data[key] = value
+	}
+
+	if err := uc.notifier.Notify(ctx, order.UserID, event, data); err != nil {
+		uc.logger.Warn("DeliveryUseCase - notifyOrder", err, map[string]any{
+			"userID":  order.UserID,
+			"orderID": order.ID,
+			"event":   event,
 		})
 	}
 }
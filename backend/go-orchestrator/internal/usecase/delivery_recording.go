@@ -0,0 +1,420 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/jpeg"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/avi"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/minio"
+)
+
+const (
+	// recordingAssemblyDelay gives frames that were still in flight when the delivery finished time to land.
+	recordingAssemblyDelay = time.Minute
+	recordingMaxAttempts   = 5
+	recordingRetryDelay    = 5 * time.Minute
+	recordingLease         = 30 * time.Minute
+	recordingBatchSize     = 2
+	recordingMaxFrameBytes = 8 << 20
+	recordingMinFPS        = 1
+	recordingMaxFPS        = 30
+	RecordingURLTTL        = 15 * time.Minute
+)
+
+var finalDeliveryStatuses = map[string]bool{
+	"delivered": true,
+	"failed":    true,
+	"cancelled": true,
+}
+
+type RecordingScheduler interface {
+	ScheduleAssembly(ctx context.Context, deliveryID uuid.UUID)
+}
+
+type DeliveryRecordingUseCase struct {
+	recordingRepo repo.DeliveryRecordingRepo
+	deliveryRepo  repo.DeliveryRepo
+	storage       minio.RecordingStorage
+	logger        logger.Interface
+}
+
+func NewDeliveryRecordingUseCase(
+	recordingRepo repo.DeliveryRecordingRepo,
+	deliveryRepo repo.DeliveryRepo,
+	storage minio.RecordingStorage,
+	logger logger.Interface,
+) *DeliveryRecordingUseCase {
+	return &DeliveryRecordingUseCase{
+		recordingRepo: recordingRepo,
+		deliveryRepo:  deliveryRepo,
+		storage:       storage,
+		logger:        logger,
+	}
+}
+
+func recordingFramesPrefix(deliveryID uuid.UUID) string {
+	return fmt.Sprintf("deliveries/%s/frames/", deliveryID)
+}
+
+func recordingVideoKey(deliveryID uuid.UUID) string {
+	return fmt.Sprintf("deliveries/%s/recording.avi", deliveryID)
+}
+
+func recordingManifestKey(deliveryID uuid.UUID) string {
+	return fmt.Sprintf("deliveries/%s/manifest.json", deliveryID)
+}
+
+// ScheduleAssembly queues the recording of a finished delivery. Failures are only logged: a missing
+// recording is queued again the first time somebody asks for the video.
+func (uc *DeliveryRecordingUseCase) ScheduleAssembly(ctx context.Context, deliveryID uuid.UUID) {
+	if err := uc.recordingRepo.Schedule(ctx, deliveryID, time.Now().Add(recordingAssemblyDelay)); err != nil {
+		uc.logger.Warn("DeliveryRecordingUseCase - ScheduleAssembly", err, map[string]any{
+			"deliveryID": deliveryID,
+		})
+	}
+}
+
+func (uc *DeliveryRecordingUseCase) GetVideo(ctx context.Context, deliveryID uuid.UUID) (*entity.DeliveryVideo, error) {
+	recording, err := uc.recordingRepo.GetByDeliveryID(ctx, deliveryID)
+	if errors.Is(err, entityError.ErrDeliveryRecordingNotFound) {
+		return uc.scheduleMissing(ctx, deliveryID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("DeliveryRecordingUseCase - GetVideo - GetByDeliveryID: %w", err)
+	}
+
+	switch recording.Status {
+	case entity.DeliveryRecordingEmpty:
+		return nil, entityError.ErrDeliveryRecordingEmpty
+	case entity.DeliveryRecordingFailed:
+		return nil, entityError.ErrDeliveryRecordingFailed
+	case entity.DeliveryRecordingReady:
+	default:
+		return &entity.DeliveryVideo{Recording: recording}, nil
+	}
+
+	if recording.VideoKey == nil || recording.ManifestKey == nil {
+		return nil, fmt.Errorf("DeliveryRecordingUseCase - GetVideo: ready recording %s has no object keys", deliveryID)
+	}
+
+	expiresAt := time.Now().Add(RecordingURLTTL)
+	videoURL, err := uc.storage.PresignedGetURL(ctx, *recording.VideoKey, RecordingURLTTL)
+	if err != nil {
+		return nil, fmt.Errorf("DeliveryRecordingUseCase - GetVideo - PresignVideo: %w", err)
+	}
+	manifestURL, err := uc.storage.PresignedGetURL(ctx, *recording.ManifestKey, RecordingURLTTL)
+	if err != nil {
+		return nil, fmt.Errorf("DeliveryRecordingUseCase - GetVideo - PresignManifest: %w", err)
+	}
+
+	return &entity.DeliveryVideo{
+		Recording:   recording,
+		VideoURL:    videoURL,
+		ManifestURL: manifestURL,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// scheduleMissing covers deliveries that finished before recordings existed or whose scheduling failed.
+func (uc *DeliveryRecordingUseCase) scheduleMissing(ctx context.Context, deliveryID uuid.UUID) (*entity.DeliveryVideo, error) {
+	delivery, err := uc.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("DeliveryRecordingUseCase - GetVideo - GetDelivery: %w", err)
+	}
+	if !finalDeliveryStatuses[delivery.Status] {
+		return nil, entityError.ErrDeliveryRecordingNotAvailable
+	}
+
+	now := time.Now()
+	if err := uc.recordingRepo.Schedule(ctx, deliveryID, now); err != nil {
+		return nil, fmt.Errorf("DeliveryRecordingUseCase - GetVideo - Schedule: %w", err)
+	}
+
+	return &entity.DeliveryVideo{Recording: &entity.DeliveryRecording{
+		DeliveryID:    deliveryID,
+		Status:        entity.DeliveryRecordingPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}}, nil
+}
+
+func (uc *DeliveryRecordingUseCase) StartAssemblyWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	uc.logger.Info("Delivery recording worker started", nil, map[string]any{
+		"interval": interval.String(),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			uc.logger.Info("Delivery recording worker stopped", nil)
+			return
+		case <-ticker.C:
+			uc.processDue(ctx, time.Now())
+		}
+	}
+}
+
+func (uc *DeliveryRecordingUseCase) processDue(ctx context.Context, now time.Time) {
+	recordings, err := uc.recordingRepo.ClaimDue(ctx, now, now.Add(recordingLease), recordingBatchSize)
+	if err != nil {
+		uc.logger.Error("DeliveryRecordingUseCase - processDue - ClaimDue", err)
+		return
+	}
+
+	for _, recording := range recordings {
+		if _, err := uc.process(ctx, recording); err != nil {
+			uc.logger.Error("DeliveryRecordingUseCase - processDue - process", err, map[string]any{
+				"deliveryID": recording.DeliveryID,
+			})
+		}
+	}
+}
+
+func (uc *DeliveryRecordingUseCase) process(ctx context.Context, recording *entity.DeliveryRecording) (*entity.DeliveryRecording, error) {
+	result, err := uc.assemble(ctx, recording.DeliveryID)
+	recording.NextAttemptAt = nil
+	recording.LastError = nil
+
+	switch {
+	case err != nil:
+		recording.Attempts++
+		message := err.Error()
+		recording.LastError = &message
+		if recording.Attempts >= recordingMaxAttempts {
+			recording.Status = entity.DeliveryRecordingFailed
+		} else {
+			next := time.Now().Add(time.Duration(recording.Attempts) * recordingRetryDelay)
+			recording.Status = entity.DeliveryRecordingPending
+			recording.NextAttemptAt = &next
+		}
+		uc.logger.Warn("Delivery recording assembly failed", err, map[string]any{
+			"deliveryID": recording.DeliveryID,
+			"attempts":   recording.Attempts,
+			"status":     recording.Status,
+		})
+	case result == nil:
+		recording.Status = entity.DeliveryRecordingEmpty
+	default:
+		recording.Status = entity.DeliveryRecordingReady
+		recording.VideoKey = &result.videoKey
+		recording.ManifestKey = &result.manifestKey
+		recording.FrameCount = result.frames
+		recording.DurationMs = result.durationMs
+		recording.SizeBytes = result.sizeBytes
+		uc.logger.Info("Delivery recording assembled", nil, map[string]any{
+			"deliveryID": recording.DeliveryID,
+			"frames":     result.frames,
+			"sizeBytes":  result.sizeBytes,
+		})
+	}
+
+	updated, updateErr := uc.recordingRepo.Update(ctx, recording)
+	if updateErr != nil {
+		return nil, fmt.Errorf("DeliveryRecordingUseCase - process - Update: %w", updateErr)
+	}
+	return updated, nil
+}
+
+type recordingFrame struct {
+	key        string
+	capturedAt time.Time
+}
+
+type assembledRecording struct {
+	videoKey    string
+	manifestKey string
+	frames      int
+	durationMs  int64
+	sizeBytes   int64
+}
+
+// assemble writes the frames of a delivery into an MJPEG AVI and a JSON manifest. A nil result without
+// an error means the delivery has no usable frames.
+func (uc *DeliveryRecordingUseCase) assemble(ctx context.Context, deliveryID uuid.UUID) (*assembledRecording, error) {
+	keys, err := uc.storage.ListObjects(ctx, recordingFramesPrefix(deliveryID))
+	if err != nil {
+		return nil, fmt.Errorf("ListObjects: %w", err)
+	}
+
+	frames := parseRecordingFrames(keys)
+	if len(frames) == 0 {
+		return nil, nil
+	}
+
+	fps := recordingFPS(frames)
+	file, err := os.CreateTemp("", "delivery-recording-*.avi")
+	if err != nil {
+		return nil, fmt.Errorf("CreateTemp: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	manifest := entity.RecordingManifest{DeliveryID: deliveryID, FPS: fps}
+	var writer *avi.Writer
+	for _, frame := range frames {
+		data, err := uc.readFrame(ctx, frame.key)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := jpeg.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			uc.logger.Warn("Skipping undecodable recording frame", err, map[string]any{
+				"deliveryID": deliveryID,
+				"object":     frame.key,
+			})
+			continue
+		}
+
+		if writer == nil {
+			writer, err = avi.NewWriter(file, config.Width, config.Height, fps)
+			if err != nil {
+				return nil, fmt.Errorf("NewWriter: %w", err)
+			}
+			manifest.Width, manifest.Height = config.Width, config.Height
+			manifest.StartedAt = frame.capturedAt
+		}
+
+		if err := writer.WriteFrame(data); err != nil {
+			if errors.Is(err, avi.ErrFileTooLarge) {
+				uc.logger.Warn("Delivery recording truncated at the AVI size limit", nil, map[string]any{
+					"deliveryID": deliveryID,
+					"frames":     writer.Frames(),
+				})
+				break
+			}
+			return nil, fmt.Errorf("WriteFrame: %w", err)
+		}
+
+		manifest.EndedAt = frame.capturedAt
+		manifest.Frames = append(manifest.Frames, entity.RecordingManifestFrame{
+			Index:      len(manifest.Frames),
+			ObjectKey:  frame.key,
+			CapturedAt: frame.capturedAt,
+			OffsetMs:   frame.capturedAt.Sub(manifest.StartedAt).Milliseconds(),
+			SizeBytes:  len(data),
+		})
+	}
+
+	if writer == nil {
+		return nil, nil
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("CloseWriter: %w", err)
+	}
+
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("Seek: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("Seek: %w", err)
+	}
+
+	videoKey := recordingVideoKey(deliveryID)
+	if err := uc.storage.PutObject(ctx, videoKey, file, size, "video/x-msvideo"); err != nil {
+		return nil, fmt.Errorf("PutVideo: %w", err)
+	}
+
+	manifestData, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("MarshalManifest: %w", err)
+	}
+	manifestKey := recordingManifestKey(deliveryID)
+	if err := uc.storage.PutObject(ctx, manifestKey, bytes.NewReader(manifestData), int64(len(manifestData)), "application/json"); err != nil {
+		return nil, fmt.Errorf("PutManifest: %w", err)
+	}
+
+	return &assembledRecording{
+		videoKey:    videoKey,
+		manifestKey: manifestKey,
+		frames:      len(manifest.Frames),
+		durationMs:  manifest.EndedAt.Sub(manifest.StartedAt).Milliseconds(),
+		sizeBytes:   size,
+	}, nil
+}
+
+func (uc *DeliveryRecordingUseCase) readFrame(ctx context.Context, key string) ([]byte, error) {
+	object, err := uc.storage.GetObject(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("GetObject: %w", err)
+	}
+	defer func() { _ = object.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(object, recordingMaxFrameBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("ReadFrame %s: %w", key, err)
+	}
+	if len(data) > recordingMaxFrameBytes {
+		return nil, fmt.Errorf("ReadFrame %s: frame exceeds %d bytes", key, recordingMaxFrameBytes)
+	}
+	return data, nil
+}
+
+// parseRecordingFrames takes the capture time from object names of the form <unix nanos>-<sequence>.jpg
+// and returns the frames in capture order. Objects that do not follow the scheme are ignored.
+func parseRecordingFrames(keys []string) []recordingFrame {
+	frames := make([]recordingFrame, 0, len(keys))
+	for _, key := range keys {
+		name := strings.TrimSuffix(path.Base(key), ".jpg")
+		if name == path.Base(key) {
+			continue
+		}
+		nanosPart, _, found := strings.Cut(name, "-")
+		if !found {
+			continue
+		}
+		nanos, err := strconv.ParseInt(nanosPart, 10, 64)
+		if err != nil {
+			continue
+		}
+		frames = append(frames, recordingFrame{key: key, capturedAt: time.Unix(0, nanos).UTC()})
+	}
+
+	sort.SliceStable(frames, func(i, j int) bool {
+		return frames[i].key < frames[j].key
+	})
+	return frames
+}
+
+// recordingFPS spreads the frames evenly over the time they were captured in. Frames arrive at an uneven
+// rate over the air, so the AVI plays in roughly real time rather than frame-accurate.
+func recordingFPS(frames []recordingFrame) float64 {
+	if len(frames) < 2 {
+		return recordingMinFPS
+	}
+	elapsed := frames[len(frames)-1].capturedAt.Sub(frames[0].capturedAt).Seconds()
+	if elapsed <= 0 {
+		return recordingMaxFPS
+	}
+	fps := float64(len(frames)-1) / elapsed
+	if fps < recordingMinFPS {
+		return recordingMinFPS
+	}
+	if fps > recordingMaxFPS {
+		return recordingMaxFPS
+	}
+	return fps
+}
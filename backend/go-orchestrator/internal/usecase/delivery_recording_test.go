@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRecordingScheduler struct {
+	mock.Mock
+}
+
+func (m *mockRecordingScheduler) ScheduleAssembly(ctx context.Context, deliveryID uuid.UUID) {
+	m.Called(ctx, deliveryID)
+}
+
+func testJPEG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+func frameKey(deliveryID uuid.UUID, capturedAt time.Time, seq int) string {
+	return fmt.Sprintf("deliveries/%s/frames/%020d-%06d.jpg", deliveryID, capturedAt.UnixNano(), seq)
+}
+
+func returnDeliveryRecording(_ context.Context, recording *entity.DeliveryRecording) (*entity.DeliveryRecording, error) {
+	return recording, nil
+}
+
+func TestDeliveryRecordingUseCase_Process_AssemblesVideoAndManifest(t *testing.T) {
+	mockRecordingRepo := new(mocks.MockDeliveryRecordingRepo)
+	mockStorage := new(mocks.MockRecordingStorage)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewDeliveryRecordingUseCase(mockRecordingRepo, nil, mockStorage, mockLogger)
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	frame := testJPEG(t, 32, 24)
+
+	first := frameKey(deliveryID, start, 0)
+	broken := frameKey(deliveryID, start.Add(500*time.Millisecond), 1)
+	last := frameKey(deliveryID, start.Add(2*time.Second), 2)
+
+	mockStorage.On("ListObjects", ctx, fmt.Sprintf("deliveries/%s/frames/", deliveryID)).
+		Return([]string{last, first, broken, fmt.Sprintf("deliveries/%s/frames/readme.txt", deliveryID)}, nil)
+	mockStorage.On("GetObject", ctx, first).Return(io.NopCloser(bytes.NewReader(frame)), nil)
+	mockStorage.On("GetObject", ctx, broken).Return(io.NopCloser(bytes.NewReader([]byte("not a jpeg"))), nil)
+	mockStorage.On("GetObject", ctx, last).Return(io.NopCloser(bytes.NewReader(frame)), nil)
+
+	var video []byte
+	mockStorage.On("PutObject", ctx, fmt.Sprintf("deliveries/%s/recording.avi", deliveryID), mock.Anything, mock.Anything, "video/x-msvideo").
+		Run(func(args mock.Arguments) {
+			data, err := io.ReadAll(args.Get(2).(io.Reader))
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(data)), args.Get(3).(int64))
+			video = data
+		}).Return(nil)
+
+	var manifest entity.RecordingManifest
+	mockStorage.On("PutObject", ctx, fmt.Sprintf("deliveries/%s/manifest.json", deliveryID), mock.Anything, mock.Anything, "application/json").
+		Run(func(args mock.Arguments) {
+			require.NoError(t, json.NewDecoder(args.Get(2).(io.Reader)).Decode(&manifest))
+		}).Return(nil)
+	mockRecordingRepo.On("Update", ctx, mock.Anything).Return(returnDeliveryRecording)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	recording, err := uc.process(ctx, &entity.DeliveryRecording{DeliveryID: deliveryID, Status: entity.DeliveryRecordingProcessing})
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryRecordingReady, recording.Status)
+	assert.Equal(t, 2, recording.FrameCount)
+	assert.Equal(t, int64(2000), recording.DurationMs)
+	assert.Equal(t, int64(len(video)), recording.SizeBytes)
+	require.NotNil(t, recording.VideoKey)
+	require.NotNil(t, recording.ManifestKey)
+
+	require.Greater(t, len(video), 224)
+	assert.Equal(t, "RIFF", string(video[0:4]))
+	assert.Equal(t, "AVI ", string(video[8:12]))
+
+	assert.Equal(t, 32, manifest.Width)
+	assert.Equal(t, 24, manifest.Height)
+	require.Len(t, manifest.Frames, 2)
+	assert.Equal(t, first, manifest.Frames[0].ObjectKey)
+	assert.Equal(t, last, manifest.Frames[1].ObjectKey)
+	assert.Equal(t, int64(2000), manifest.Frames[1].OffsetMs)
+	assert.Equal(t, start, manifest.Frames[0].CapturedAt)
+}
+
+func TestDeliveryRecordingUseCase_Process_NoFramesMarksEmpty(t *testing.T) {
+	mockRecordingRepo := new(mocks.MockDeliveryRecordingRepo)
+	mockStorage := new(mocks.MockRecordingStorage)
+	uc := NewDeliveryRecordingUseCase(mockRecordingRepo, nil, mockStorage, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+
+	mockStorage.On("ListObjects", ctx, mock.Anything).Return([]string{}, nil)
+	mockRecordingRepo.On("Update", ctx, mock.Anything).Return(returnDeliveryRecording)
+
+	recording, err := uc.process(ctx, &entity.DeliveryRecording{DeliveryID: deliveryID, Status: entity.DeliveryRecordingProcessing})
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryRecordingEmpty, recording.Status)
+	mockStorage.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeliveryRecordingUseCase_Process_RetriesThenFails(t *testing.T) {
+	mockRecordingRepo := new(mocks.MockDeliveryRecordingRepo)
+	mockStorage := new(mocks.MockRecordingStorage)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewDeliveryRecordingUseCase(mockRecordingRepo, nil, mockStorage, mockLogger)
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+
+	mockStorage.On("ListObjects", ctx, mock.Anything).Return(nil, errors.New("minio unavailable"))
+	mockRecordingRepo.On("Update", ctx, mock.Anything).Return(returnDeliveryRecording)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	recording, err := uc.process(ctx, &entity.DeliveryRecording{DeliveryID: deliveryID, Status: entity.DeliveryRecordingProcessing})
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryRecordingPending, recording.Status)
+	assert.Equal(t, 1, recording.Attempts)
+	require.NotNil(t, recording.NextAttemptAt)
+	require.NotNil(t, recording.LastError)
+	assert.Contains(t, *recording.LastError, "minio unavailable")
+
+	recording, err = uc.process(ctx, &entity.DeliveryRecording{DeliveryID: deliveryID, Attempts: recordingMaxAttempts - 1})
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryRecordingFailed, recording.Status)
+	assert.Nil(t, recording.NextAttemptAt)
+}
+
+func TestDeliveryRecordingUseCase_GetVideo_Ready(t *testing.T) {
+	mockRecordingRepo := new(mocks.MockDeliveryRecordingRepo)
+	mockStorage := new(mocks.MockRecordingStorage)
+	uc := NewDeliveryRecordingUseCase(mockRecordingRepo, nil, mockStorage, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	videoKey := recordingVideoKey(deliveryID)
+	manifestKey := recordingManifestKey(deliveryID)
+
+	mockRecordingRepo.On("GetByDeliveryID", ctx, deliveryID).Return(&entity.DeliveryRecording{
+		DeliveryID:  deliveryID,
+		Status:      entity.DeliveryRecordingReady,
+		VideoKey:    &videoKey,
+		ManifestKey: &manifestKey,
+	}, nil)
+	mockStorage.On("PresignedGetURL", ctx, videoKey, RecordingURLTTL).Return("https://minio/video?sig", nil)
+	mockStorage.On("PresignedGetURL", ctx, manifestKey, RecordingURLTTL).Return("https://minio/manifest?sig", nil)
+
+	video, err := uc.GetVideo(ctx, deliveryID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://minio/video?sig", video.VideoURL)
+	assert.Equal(t, "https://minio/manifest?sig", video.ManifestURL)
+	assert.WithinDuration(t, time.Now().Add(RecordingURLTTL), video.ExpiresAt, time.Minute)
+}
+
+func TestDeliveryRecordingUseCase_GetVideo_SchedulesFinishedDelivery(t *testing.T) {
+	mockRecordingRepo := new(mocks.MockDeliveryRecordingRepo)
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	uc := NewDeliveryRecordingUseCase(mockRecordingRepo, mockDeliveryRepo, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+
+	mockRecordingRepo.On("GetByDeliveryID", ctx, deliveryID).Return(nil, entityError.ErrDeliveryRecordingNotFound)
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(&entity.Delivery{ID: deliveryID, Status: "delivered"}, nil)
+	mockRecordingRepo.On("Schedule", ctx, deliveryID, mock.Anything).Return(nil)
+
+	video, err := uc.GetVideo(ctx, deliveryID)
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryRecordingPending, video.Recording.Status)
+	assert.Empty(t, video.VideoURL)
+	mockRecordingRepo.AssertExpectations(t)
+}
+
+func TestDeliveryRecordingUseCase_GetVideo_ActiveDelivery(t *testing.T) {
+	mockRecordingRepo := new(mocks.MockDeliveryRecordingRepo)
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	uc := NewDeliveryRecordingUseCase(mockRecordingRepo, mockDeliveryRepo, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+
+	mockRecordingRepo.On("GetByDeliveryID", ctx, deliveryID).Return(nil, entityError.ErrDeliveryRecordingNotFound)
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(&entity.Delivery{ID: deliveryID, Status: "in_transit"}, nil)
+
+	_, err := uc.GetVideo(ctx, deliveryID)
+
+	assert.ErrorIs(t, err, entityError.ErrDeliveryRecordingNotAvailable)
+	mockRecordingRepo.AssertNotCalled(t, "Schedule", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeliveryRecordingUseCase_GetVideo_Empty(t *testing.T) {
+	mockRecordingRepo := new(mocks.MockDeliveryRecordingRepo)
+	uc := NewDeliveryRecordingUseCase(mockRecordingRepo, nil, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+
+	mockRecordingRepo.On("GetByDeliveryID", ctx, deliveryID).Return(&entity.DeliveryRecording{
+		DeliveryID: deliveryID,
+		Status:     entity.DeliveryRecordingEmpty,
+	}, nil)
+
+	_, err := uc.GetVideo(ctx, deliveryID)
+
+	assert.ErrorIs(t, err, entityError.ErrDeliveryRecordingEmpty)
+}
+
+func TestRecordingFPS(t *testing.T) {
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	frames := func(n int, step time.Duration) []recordingFrame {
+		result := make([]recordingFrame, 0, n)
+		for i := 0; i < n; i++ {
+			result = append(result, recordingFrame{capturedAt: start.Add(time.Duration(i) * step)})
+		}
+		return result
+	}
+
+	assert.Equal(t, float64(recordingMinFPS), recordingFPS(frames(1, time.Second)))
+	assert.InDelta(t, 10.0, recordingFPS(frames(11, 100*time.Millisecond)), 0.001)
+	assert.Equal(t, float64(recordingMaxFPS), recordingFPS(frames(11, time.Millisecond)))
+	assert.Equal(t, float64(recordingMinFPS), recordingFPS(frames(3, 10*time.Second)))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
@@ -19,7 +20,7 @@ func TestDeliveryUseCase_GetByID_Success(t *testing.T) {
 	mockRabbitMQClient := new(mocks.MockRabbitMQClient)
 	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
 	mockLogger := new(mocks.MockLogger)
-	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, mockRabbitMQClient, nil, mockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, nil, mockRabbitMQClient, nil, nil, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	deliveryID := uuid.New()
@@ -46,7 +47,7 @@ func TestDeliveryUseCase_GetByID_NotFound(t *testing.T) {
 	mockRabbitMQClient := new(mocks.MockRabbitMQClient)
 	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
 	mockLogger := new(mocks.MockLogger)
-	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, mockRabbitMQClient, nil, mockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, nil, mockRabbitMQClient, nil, nil, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	deliveryID := uuid.New()
@@ -68,7 +69,7 @@ func TestDeliveryUseCase_UpdateStatus_Success(t *testing.T) {
 	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
 	mockRabbitMQClient := new(mocks.MockRabbitMQClient)
 	mockLogger := new(mocks.MockLogger)
-	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, mockRabbitMQClient, nil, mockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, nil, mockRabbitMQClient, nil, nil, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	deliveryID := uuid.New()
@@ -120,7 +121,7 @@ func TestDeliveryUseCase_UpdateStatus_Delivered(t *testing.T) {
 	mockRabbitMQClient := new(mocks.MockRabbitMQClient)
 	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
 	mockLogger := new(mocks.MockLogger)
-	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, mockRabbitMQClient, nil, mockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, nil, mockRabbitMQClient, nil, nil, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	deliveryID := uuid.New()
@@ -174,7 +175,7 @@ func TestDeliveryUseCase_ListByStatus_Success(t *testing.T) {
 	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
 	mockRabbitMQClient := new(mocks.MockRabbitMQClient)
 	mockLogger := new(mocks.MockLogger)
-	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, mockRabbitMQClient, nil, mockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, nil, mockRabbitMQClient, nil, nil, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	status := "pending"
@@ -201,7 +202,7 @@ func TestDeliveryUseCase_ConfirmGoodsLoaded_Success(t *testing.T) {
 	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
 	mockRabbitMQClient := new(mocks.MockRabbitMQClient)
 	mockLogger := new(mocks.MockLogger)
-	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, mockRabbitMQClient, nil, mockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, nil, mockRabbitMQClient, nil, nil, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -272,7 +273,7 @@ func TestDeliveryUseCase_ConfirmGoodsLoaded_DeliveryNotFound(t *testing.T) {
 	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
 	mockRabbitMQClient := new(mocks.MockRabbitMQClient)
 	mockLogger := new(mocks.MockLogger)
-	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, mockRabbitMQClient, nil, mockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, mockLockerRepo, mockInternalLockerRepo, nil, mockRabbitMQClient, nil, nil, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -286,3 +287,198 @@ func TestDeliveryUseCase_ConfirmGoodsLoaded_DeliveryNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "delivery not found")
 	mockDeliveryRepo.AssertExpectations(t)
 }
+
+func TestDeliveryUseCase_UpdateStatus_FailedNotifiesCustomer(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockNotifier := new(mockOrderEventNotifier)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, nil, nil, nil, nil, mockNotifier, nil, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	orderID := uuid.New()
+	userID := uuid.New()
+
+	delivery := &entity.Delivery{ID: deliveryID, OrderID: orderID, Status: "in_transit"}
+	order := &entity.Order{ID: orderID, UserID: userID, Status: "in_progress"}
+
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(delivery, nil)
+	mockDeliveryRepo.On("UpdateStatus", ctx, mock.Anything).Return(&entity.Delivery{ID: deliveryID, OrderID: orderID, Status: "failed"}, nil)
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(order, nil)
+	mockOrderRepo.On("UpdateStatus", ctx, mock.Anything).Return(&entity.Order{ID: orderID, UserID: userID, Status: "failed"}, nil)
+	mockNotifier.On("Notify", ctx, userID, entity.NotificationDeliveryFailed, mock.MatchedBy(func(data map[string]string) bool {
+		return data["order_id"] == orderID.String()
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.UpdateStatus(ctx, deliveryID, "failed")
+
+	assert.NoError(t, err)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestDeliveryUseCase_UpdateStatus_InTransitPublishesWebhook(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockWebhooks := new(mockWebhookPublisher)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, nil, nil, nil, nil, nil, mockWebhooks, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	orderID := uuid.New()
+	droneID := uuid.New()
+
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(&entity.Delivery{ID: deliveryID, OrderID: orderID, Status: "pending"}, nil)
+	mockDeliveryRepo.On("UpdateStatus", ctx, mock.Anything).Return(&entity.Delivery{ID: deliveryID, OrderID: orderID, DroneID: &droneID, Status: "in_transit"}, nil)
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, Status: "pending"}, nil)
+	mockOrderRepo.On("UpdateStatus", ctx, mock.Anything).Return(&entity.Order{ID: orderID, Status: "in_progress"}, nil)
+	mockWebhooks.On("Publish", ctx, entity.WebhookDeliveryDispatched, mock.MatchedBy(func(data map[string]any) bool {
+		return data["order_id"] == orderID && data["delivery_id"] == deliveryID && data["drone_id"] == droneID
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.UpdateStatus(ctx, deliveryID, "in_transit")
+
+	assert.NoError(t, err)
+	mockWebhooks.AssertExpectations(t)
+}
+
+func TestDeliveryUseCase_ReportETA_NotifiesOnce(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockNotifier := new(mockOrderEventNotifier)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, nil, nil, nil, nil, mockNotifier, nil, nil, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	orderID := uuid.New()
+	userID := uuid.New()
+
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(&entity.Delivery{ID: deliveryID, OrderID: orderID, Status: "in_transit"}, nil)
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: userID}, nil)
+	mockDeliveryRepo.On("MarkArrivalNotified", ctx, deliveryID, mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+	mockDeliveryRepo.On("MarkArrivalNotified", ctx, deliveryID, mock.AnythingOfType("time.Time")).Return(false, nil)
+	mockNotifier.On("Notify", ctx, userID, entity.NotificationArrivingSoon, mock.MatchedBy(func(data map[string]string) bool {
+		return data["eta_minutes"] == "4"
+	})).Return(nil).Once()
+
+	assert.NoError(t, uc.ReportETA(ctx, deliveryID, 10*time.Minute))
+	assert.NoError(t, uc.ReportETA(ctx, deliveryID, 3*time.Minute+30*time.Second))
+	assert.NoError(t, uc.ReportETA(ctx, deliveryID, 2*time.Minute))
+
+	mockNotifier.AssertNumberOfCalls(t, "Notify", 1)
+}
+
+func TestDeliveryUseCase_ReportPosition_NotifiesWhenClose(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockParcelAutomatRepo := new(mocks.MockParcelAutomatRepo)
+	mockNotifier := new(mockOrderEventNotifier)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, nil, nil, mockParcelAutomatRepo, nil, mockNotifier, nil, nil, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	orderID := uuid.New()
+	automatID := uuid.New()
+	userID := uuid.New()
+
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(&entity.Delivery{ID: deliveryID, OrderID: orderID, ParcelAutomatID: automatID, Status: "in_transit"}, nil)
+	mockParcelAutomatRepo.On("GetByID", ctx, automatID).Return(&entity.ParcelAutomat{ID: automatID, Coordinates: "55.7558,37.6173"}, nil)
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: userID}, nil)
+	mockDeliveryRepo.On("MarkArrivalNotified", ctx, deliveryID, mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockNotifier.On("Notify", ctx, userID, entity.NotificationArrivingSoon, mock.MatchedBy(func(data map[string]string) bool {
+		return data["eta_minutes"] == "2"
+	})).Return(nil).Once()
+
+	assert.NoError(t, uc.ReportPosition(ctx, deliveryID, entity.Position{Latitude: 55.70, Longitude: 37.6173}, 10))
+	mockNotifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	assert.NoError(t, uc.ReportPosition(ctx, deliveryID, entity.Position{Latitude: 55.7468, Longitude: 37.6173}, 10))
+	mockNotifier.AssertNumberOfCalls(t, "Notify", 1)
+}
+
+func TestDeliveryUseCase_ReportPosition_Hovering(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, new(mocks.MockLogger))
+
+	assert.NoError(t, uc.ReportPosition(context.Background(), uuid.New(), entity.Position{Latitude: 55.7468, Longitude: 37.6173}, 0))
+
+	mockDeliveryRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestDeliveryUseCase_sendPickupReminders_ClaimsDueDeliveries(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockNotifier := new(mockOrderEventNotifier)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, nil, nil, nil, nil, mockNotifier, nil, nil, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	collectedOrderID := uuid.New()
+	userID := uuid.New()
+	now := time.Now()
+
+	mockDeliveryRepo.On("ClaimDuePickupReminders", ctx, now, now.Add(-pickupReminderDelay), pickupReminderBatchSize).Return([]*entity.Delivery{
+		{ID: uuid.New(), OrderID: orderID, Status: "delivered"},
+		{ID: uuid.New(), OrderID: collectedOrderID, Status: "delivered"},
+	}, nil).Once()
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: userID, Status: "delivered"}, nil)
+	mockOrderRepo.On("GetByID", ctx, collectedOrderID).Return(&entity.Order{ID: collectedOrderID, UserID: userID, Status: "completed"}, nil)
+	mockNotifier.On("Notify", ctx, userID, entity.NotificationPickupReminder, mock.Anything).Return(nil)
+
+	uc.sendPickupReminders(ctx, now)
+
+	mockNotifier.AssertNumberOfCalls(t, "Notify", 1)
+	mockDeliveryRepo.AssertExpectations(t)
+}
+
+func TestDeliveryUseCase_UpdateStatus_DeliveredRecordsTime(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, nil, nil, nil, nil, nil, nil, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	orderID := uuid.New()
+	delivery := &entity.Delivery{ID: deliveryID, OrderID: orderID, Status: "in_transit"}
+
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(delivery, nil)
+	mockDeliveryRepo.On("UpdateStatus", ctx, mock.MatchedBy(func(d *entity.Delivery) bool {
+		return d.Status == "delivered" && d.DeliveredAt != nil
+	})).Return(delivery, nil)
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID}, nil)
+	mockOrderRepo.On("UpdateStatus", ctx, mock.Anything).Return(&entity.Order{ID: orderID, Status: "delivered"}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.UpdateStatus(ctx, deliveryID, "delivered")
+
+	assert.NoError(t, err)
+	mockDeliveryRepo.AssertExpectations(t)
+}
+
+func TestDeliveryUseCase_UpdateStatus_FinalStatusSchedulesRecording(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockRecordings := new(mockRecordingScheduler)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewDeliveryUseCase(mockDeliveryRepo, mockOrderRepo, nil, nil, nil, nil, nil, nil, nil, mockRecordings, mockLogger)
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	orderID := uuid.New()
+
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(&entity.Delivery{ID: deliveryID, OrderID: orderID, Status: "in_transit"}, nil)
+	mockDeliveryRepo.On("UpdateStatus", ctx, mock.Anything).Return(&entity.Delivery{ID: deliveryID, OrderID: orderID, Status: "cancelled"}, nil)
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, Status: "in_progress"}, nil)
+	mockOrderRepo.On("UpdateStatus", ctx, mock.Anything).Return(&entity.Order{ID: orderID, Status: "cancelled"}, nil)
+	mockRecordings.On("ScheduleAssembly", ctx, deliveryID).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.UpdateStatus(ctx, deliveryID, "cancelled")
+
+	assert.NoError(t, err)
+	mockRecordings.AssertExpectations(t)
+}
@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"net"
 
@@ -12,18 +14,26 @@ import (
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
 )
 
+const droneSecretBytes = 32
+
 type DroneUseCase struct {
-	droneRepo repo.DroneRepo
-	logger    logger.Interface
+	droneRepo      repo.DroneRepo
+	credentialRepo repo.DroneCredentialRepo
+	fleet          DroneStateProvider
+	logger         logger.Interface
 }
 
 func NewDroneUseCase(
 	droneRepo repo.DroneRepo,
+	credentialRepo repo.DroneCredentialRepo,
+	fleet DroneStateProvider,
 	logger logger.Interface,
 ) *DroneUseCase {
 	return &DroneUseCase{
-		droneRepo: droneRepo,
-		logger:    logger,
+		droneRepo:      droneRepo,
+		credentialRepo: credentialRepo,
+		fleet:          fleet,
+		logger:         logger,
 	}
 }
 
@@ -48,6 +58,14 @@ func (uc *DroneUseCase) GetByID(ctx context.Context, droneID uuid.UUID) (*entity
 }
 
 func (uc *DroneUseCase) GetStatus(ctx context.Context, droneID uuid.UUID) (*entity.DroneStatus, error) {
+	if uc.fleet != nil {
+		status, err := uc.fleet.GetState(ctx, droneID)
+		if err != nil {
+			return nil, fmt.Errorf("DroneUseCase - GetStatus: %w", err)
+		}
+		return status, nil
+	}
+
 	drone, err := uc.droneRepo.GetByID(ctx, droneID)
 	if err != nil {
 		return nil, fmt.Errorf("DroneUseCase - GetStatus: %w", err)
@@ -67,17 +85,19 @@ func (uc *DroneUseCase) List(ctx context.Context) ([]*entity.Drone, error) {
 	return drones, nil
 }
 
-func (uc *DroneUseCase) Create(ctx context.Context, model, ipAddress string) (*entity.Drone, error) {
+// Create provisions a drone together with its first credential. The returned secret is not stored anywhere
+// the API can read it back, so it has to be loaded onto the drone now or rotated later.
+func (uc *DroneUseCase) Create(ctx context.Context, model, ipAddress string) (*entity.Drone, *entity.DroneCredential, error) {
 	if model == "" {
-		return nil, entityError.ErrDroneInvalidModel
+		return nil, nil, entityError.ErrDroneInvalidModel
 	}
 
 	if ipAddress == "" {
-		return nil, entityError.ErrDroneInvalidIP
+		return nil, nil, entityError.ErrDroneInvalidIP
 	}
 
 	if !isValidIP(ipAddress) {
-		return nil, entityError.ErrDroneInvalidIP
+		return nil, nil, entityError.ErrDroneInvalidIP
 	}
 
 	drone := &entity.Drone{
@@ -86,18 +106,68 @@ func (uc *DroneUseCase) Create(ctx context.Context, model, ipAddress string) (*e
 		IPAddress: ipAddress,
 	}
 
-	createdDrone, err := uc.droneRepo.Create(ctx, drone)
+	secret, err := generateDroneSecret()
+	if err != nil {
+		return nil, nil, fmt.Errorf("DroneUseCase - Create - generateDroneSecret: %w", err)
+	}
+
+	createdDrone, credential, err := uc.droneRepo.Create(ctx, drone, secret)
 	if err != nil {
-		return nil, fmt.Errorf("DroneUseCase - Create: %w", err)
+		return nil, nil, fmt.Errorf("DroneUseCase - Create: %w", err)
 	}
 
 	uc.logger.Info("Drone created", nil, map[string]any{
-		"droneID":   createdDrone.ID,
-		"model":     createdDrone.Model,
-		"ipAddress": createdDrone.IPAddress,
+		"droneID":    createdDrone.ID,
+		"model":      createdDrone.Model,
+		"ipAddress":  createdDrone.IPAddress,
+		"keyVersion": credential.KeyVersion,
+	})
+
+	return createdDrone, credential, nil
+}
+
+func (uc *DroneUseCase) GetCredential(ctx context.Context, droneID uuid.UUID) (*entity.DroneCredential, error) {
+	credential, err := uc.credentialRepo.GetByDroneID(ctx, droneID)
+	if err != nil {
+		return nil, fmt.Errorf("DroneUseCase - GetCredential: %w", err)
+	}
+	return credential, nil
+}
+
+// RotateCredential replaces the drone's key. The old key stops working immediately, including for a
+// drone that is connected: its next registration has to use the new one.
+func (uc *DroneUseCase) RotateCredential(ctx context.Context, droneID uuid.UUID) (*entity.DroneCredential, error) {
+	if _, err := uc.droneRepo.GetByID(ctx, droneID); err != nil {
+		return nil, fmt.Errorf("DroneUseCase - RotateCredential: %w", err)
+	}
+
+	credential, err := uc.issueCredential(ctx, droneID)
+	if err != nil {
+		return nil, fmt.Errorf("DroneUseCase - RotateCredential: %w", err)
+	}
+
+	uc.logger.Info("Drone credential rotated", nil, map[string]any{
+		"droneID":    droneID,
+		"keyVersion": credential.KeyVersion,
 	})
 
-	return createdDrone, nil
+	return credential, nil
+}
+
+func (uc *DroneUseCase) issueCredential(ctx context.Context, droneID uuid.UUID) (*entity.DroneCredential, error) {
+	secret, err := generateDroneSecret()
+	if err != nil {
+		return nil, fmt.Errorf("generateSecret: %w", err)
+	}
+	return uc.credentialRepo.Rotate(ctx, droneID, secret)
+}
+
+func generateDroneSecret() (string, error) {
+	buf := make([]byte, droneSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "dsk_" + hex.EncodeToString(buf), nil
 }
 
 func (uc *DroneUseCase) Update(ctx context.Context, droneID uuid.UUID, model, ipAddress, status string) (*entity.Drone, error) {
@@ -3,10 +3,12 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -16,7 +18,7 @@ func TestDroneUseCase_GetStatus_Success(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := uuid.New()
@@ -41,7 +43,7 @@ func TestDroneUseCase_GetStatus_Error(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := uuid.New()
@@ -60,7 +62,7 @@ func TestDroneUseCase_List_Success(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 
@@ -83,7 +85,7 @@ func TestDroneUseCase_UpdateStatus_Success(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := uuid.New()
@@ -106,7 +108,7 @@ func TestDroneUseCase_UpdateStatus_Error(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := uuid.New()
@@ -129,30 +131,34 @@ func TestDroneUseCase_Create_Success(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	model := "DJI-Phantom-5"
 	ipAddress := "192.168.10.1"
-	expectedDrone := &entity.Drone{
-		ID:        uuid.New(),
-		Model:     model,
-		IPAddress: ipAddress,
-		Status:    "idle",
-	}
+	droneID := uuid.New()
 
 	mockDroneRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.Drone) bool {
 		return d.Model == model && d.IPAddress == ipAddress && d.Status == "idle"
-	})).Return(expectedDrone, nil)
+	}), mock.MatchedBy(func(secret string) bool {
+		return strings.HasPrefix(secret, "dsk_") && len(secret) == len("dsk_")+2*droneSecretBytes
+	})).Return(func(_ context.Context, d *entity.Drone, secret string) (*entity.Drone, *entity.DroneCredential, error) {
+		created := *d
+		created.ID = droneID
+		return &created, &entity.DroneCredential{DroneID: droneID, Secret: secret, KeyVersion: 1}, nil
+	})
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
 
-	drone, err := uc.Create(ctx, model, ipAddress)
+	drone, credential, err := uc.Create(ctx, model, ipAddress)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, drone)
 	assert.Equal(t, model, drone.Model)
 	assert.Equal(t, ipAddress, drone.IPAddress)
 	assert.Equal(t, "idle", drone.Status)
+	assert.Equal(t, drone.ID, credential.DroneID)
+	assert.Equal(t, 1, credential.KeyVersion)
+	assert.NotEmpty(t, credential.Secret)
 	mockDroneRepo.AssertExpectations(t)
 }
 
@@ -160,7 +166,7 @@ func TestDroneUseCase_Create_Error(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	model := "DJI-Phantom-5"
@@ -168,21 +174,65 @@ func TestDroneUseCase_Create_Error(t *testing.T) {
 
 	mockDroneRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.Drone) bool {
 		return d.Model == model && d.IPAddress == ipAddress && d.Status == "idle"
-	})).Return(nil, errors.New("database error"))
+	}), mock.AnythingOfType("string")).Return(nil, nil, errors.New("database error"))
 
-	drone, err := uc.Create(ctx, model, ipAddress)
+	drone, credential, err := uc.Create(ctx, model, ipAddress)
 
 	assert.Error(t, err)
 	assert.Nil(t, drone)
+	assert.Nil(t, credential)
 	assert.Contains(t, err.Error(), "database error")
 	mockDroneRepo.AssertExpectations(t)
 }
 
+func TestDroneUseCase_RotateCredential_Success(t *testing.T) {
+	mockDroneRepo := new(mocks.MockDroneRepo)
+	mockCredentialRepo := new(mocks.MockDroneCredentialRepo)
+	mockLogger := new(mocks.MockLogger)
+
+	uc := NewDroneUseCase(mockDroneRepo, mockCredentialRepo, nil, mockLogger)
+
+	ctx := context.Background()
+	droneID := uuid.New()
+
+	mockDroneRepo.On("GetByID", ctx, droneID).Return(&entity.Drone{ID: droneID}, nil)
+	mockCredentialRepo.On("Rotate", ctx, droneID, mock.AnythingOfType("string")).
+		Return(&entity.DroneCredential{DroneID: droneID, Secret: "dsk_new", KeyVersion: 3}, nil)
+	mockLogger.On("Info", "Drone credential rotated", mock.Anything, mock.Anything).Return()
+
+	credential, err := uc.RotateCredential(ctx, droneID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, credential.KeyVersion)
+	assert.Equal(t, "dsk_new", credential.Secret)
+	mockCredentialRepo.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestDroneUseCase_RotateCredential_DroneNotFound(t *testing.T) {
+	mockDroneRepo := new(mocks.MockDroneRepo)
+	mockCredentialRepo := new(mocks.MockDroneCredentialRepo)
+	mockLogger := new(mocks.MockLogger)
+
+	uc := NewDroneUseCase(mockDroneRepo, mockCredentialRepo, nil, mockLogger)
+
+	ctx := context.Background()
+	droneID := uuid.New()
+
+	mockDroneRepo.On("GetByID", ctx, droneID).Return(nil, entityError.ErrDroneNotFound)
+
+	credential, err := uc.RotateCredential(ctx, droneID)
+
+	assert.ErrorIs(t, err, entityError.ErrDroneNotFound)
+	assert.Nil(t, credential)
+	mockCredentialRepo.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestDroneUseCase_Update_Success(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := uuid.New()
@@ -218,7 +268,7 @@ func TestDroneUseCase_Update_UpdateError(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := uuid.New()
@@ -247,7 +297,7 @@ func TestDroneUseCase_Delete_Success(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := uuid.New()
@@ -266,7 +316,7 @@ func TestDroneUseCase_Delete_Error(t *testing.T) {
 	mockDroneRepo := new(mocks.MockDroneRepo)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewDroneUseCase(mockDroneRepo, mockLogger)
+	uc := NewDroneUseCase(mockDroneRepo, nil, nil, mockLogger)
 
 	ctx := context.Background()
 	droneID := uuid.New()
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/rabbitmq"
+)
+
+const fleetStateMaxAge = 30 * time.Second
+
+// DeliveryPositionReporter is told where the drone flying a delivery is, so the customer can be warned
+// before it lands.
+type DeliveryPositionReporter interface {
+	ReportPosition(ctx context.Context, deliveryID uuid.UUID, position entity.Position, speed float64) error
+}
+
+// FleetStateUseCase keeps the latest telemetry sample of every drone in memory. It is fed by the drone-service
+// telemetry exchange and falls back to the drones table for drones that have not reported recently.
+type FleetStateUseCase struct {
+	droneRepo      repo.DroneRepo
+	rabbitmqClient rabbitmq.RabbitMQClient
+	positions      DeliveryPositionReporter
+	logger         logger.Interface
+	maxAge         time.Duration
+	now            func() time.Time
+
+	mu     sync.RWMutex
+	states map[uuid.UUID]*fleetSample
+}
+
+type fleetSample struct {
+	state      entity.DroneStatus
+	receivedAt time.Time
+}
+
+func NewFleetStateUseCase(
+	droneRepo repo.DroneRepo,
+	rabbitmqClient rabbitmq.RabbitMQClient,
+	positions DeliveryPositionReporter,
+	logger logger.Interface,
+) *FleetStateUseCase {
+	return &FleetStateUseCase{
+		droneRepo:      droneRepo,
+		rabbitmqClient: rabbitmqClient,
+		positions:      positions,
+		logger:         logger,
+		maxAge:         fleetStateMaxAge,
+		now:            time.Now,
+		states:         make(map[uuid.UUID]*fleetSample),
+	}
+}
+
+func (uc *FleetStateUseCase) StartTelemetryConsumer() error {
+	if err := uc.rabbitmqClient.Subscribe(rabbitmq.ExchangeDroneTelemetry, uc.handleStatusUpdate); err != nil {
+		return fmt.Errorf("FleetStateUseCase - StartTelemetryConsumer - Subscribe: %w", err)
+	}
+	return nil
+}
+
+func (uc *FleetStateUseCase) handleStatusUpdate(body []byte) error {
+	var update rabbitmq.DroneStatusUpdate
+	if err := json.Unmarshal(body, &update); err != nil {
+		return fmt.Errorf("FleetStateUseCase - handleStatusUpdate - Unmarshal: %w", err)
+	}
+	if update.DroneID == uuid.Nil {
+		return fmt.Errorf("FleetStateUseCase - handleStatusUpdate: missing drone_id")
+	}
+
+	updatedAt := time.Unix(update.UpdatedAt, 0)
+	if update.UpdatedAt == 0 {
+		updatedAt = uc.now()
+	}
+
+	state := entity.DroneStatus{
+		DroneID:      update.DroneID,
+		Status:       update.Status,
+		BatteryLevel: update.BatteryLevel,
+		Position: entity.Position{
+			Latitude:  update.Latitude,
+			Longitude: update.Longitude,
+			Altitude:  update.Altitude,
+		},
+		Speed:             update.Speed,
+		CurrentDeliveryID: update.CurrentDeliveryID,
+		UpdatedAt:         updatedAt,
+	}
+
+	if !uc.store(state) {
+		return nil
+	}
+
+	if uc.positions != nil && state.CurrentDeliveryID != nil {
+		if err := uc.positions.ReportPosition(context.Background(), *state.CurrentDeliveryID, state.Position, state.Speed); err != nil {
+			uc.logger.Warn("FleetStateUseCase - handleStatusUpdate - ReportPosition", err, map[string]any{
+				"droneID":    state.DroneID,
+				"deliveryID": *state.CurrentDeliveryID,
+			})
+		}
+	}
+	return nil
+}
+
+// store reports whether the sample replaced the cached one; samples older than it are dropped.
+func (uc *FleetStateUseCase) store(state entity.DroneStatus) bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if current, ok := uc.states[state.DroneID]; ok && current.state.UpdatedAt.After(state.UpdatedAt) {
+		return false
+	}
+	uc.states[state.DroneID] = &fleetSample{state: state, receivedAt: uc.now()}
+	return true
+}
+
+// GetState returns the cached sample when it is fresh, otherwise the last state persisted by the drone-service.
+func (uc *FleetStateUseCase) GetState(ctx context.Context, droneID uuid.UUID) (*entity.DroneStatus, error) {
+	uc.mu.RLock()
+	sample, ok := uc.states[droneID]
+	uc.mu.RUnlock()
+
+	if ok && uc.now().Sub(sample.receivedAt) <= uc.maxAge {
+		state := sample.state
+		return &state, nil
+	}
+
+	state, err := uc.droneRepo.GetState(ctx, droneID)
+	if err != nil {
+		return nil, fmt.Errorf("FleetStateUseCase - GetState: %w", err)
+	}
+	return state, nil
+}
+
+// List returns the fresh cached samples ordered by drone ID.
+func (uc *FleetStateUseCase) List() []*entity.DroneStatus {
+	now := uc.now()
+
+	uc.mu.RLock()
+	states := make([]*entity.DroneStatus, 0, len(uc.states))
+	for _, sample := range uc.states {
+		if now.Sub(sample.receivedAt) > uc.maxAge {
+			continue
+		}
+		state := sample.state
+		states = append(states, &state)
+	}
+	uc.mu.RUnlock()
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].DroneID.String() < states[j].DroneID.String()
+	})
+	return states
+}
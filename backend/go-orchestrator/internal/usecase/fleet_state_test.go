@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/rabbitmq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDeliveryPositionReporter struct {
+	mock.Mock
+}
+
+func (m *mockDeliveryPositionReporter) ReportPosition(ctx context.Context, deliveryID uuid.UUID, position entity.Position, speed float64) error {
+	args := m.Called(ctx, deliveryID, position, speed)
+	return args.Error(0)
+}
+
+func telemetryMessage(t *testing.T, update rabbitmq.DroneStatusUpdate) []byte {
+	body, err := json.Marshal(update)
+	require.NoError(t, err)
+	return body
+}
+
+func TestFleetStateUseCase_HandleStatusUpdate_CachesLatestSample(t *testing.T) {
+	mockDroneRepo := new(mocks.MockDroneRepo)
+	uc := NewFleetStateUseCase(mockDroneRepo, nil, nil, new(mocks.MockLogger))
+
+	droneID := uuid.New()
+	deliveryID := uuid.New()
+	now := time.Now()
+
+	require.NoError(t, uc.handleStatusUpdate(telemetryMessage(t, rabbitmq.DroneStatusUpdate{
+		DroneID:           droneID,
+		Status:            "in_transit",
+		BatteryLevel:      81,
+		Latitude:          55.75,
+		Longitude:         37.61,
+		Altitude:          60,
+		Speed:             12,
+		CurrentDeliveryID: &deliveryID,
+		UpdatedAt:         now.Unix(),
+	})))
+	require.NoError(t, uc.handleStatusUpdate(telemetryMessage(t, rabbitmq.DroneStatusUpdate{
+		DroneID:   droneID,
+		Status:    "idle",
+		UpdatedAt: now.Add(-time.Minute).Unix(),
+	})))
+
+	state, err := uc.GetState(context.Background(), droneID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "in_transit", state.Status)
+	assert.Equal(t, 12.0, state.Speed)
+	assert.Equal(t, deliveryID, *state.CurrentDeliveryID)
+	assert.Equal(t, entity.Position{Latitude: 55.75, Longitude: 37.61, Altitude: 60}, state.Position)
+	mockDroneRepo.AssertNotCalled(t, "GetState")
+}
+
+func TestFleetStateUseCase_HandleStatusUpdate_Malformed(t *testing.T) {
+	uc := NewFleetStateUseCase(nil, nil, nil, new(mocks.MockLogger))
+
+	assert.Error(t, uc.handleStatusUpdate([]byte("not json")))
+	assert.Error(t, uc.handleStatusUpdate([]byte(`{"status":"idle"}`)))
+	assert.Empty(t, uc.List())
+}
+
+func TestFleetStateUseCase_GetState_FallsBackWhenStale(t *testing.T) {
+	mockDroneRepo := new(mocks.MockDroneRepo)
+	uc := NewFleetStateUseCase(mockDroneRepo, nil, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	droneID := uuid.New()
+	current := time.Now()
+	uc.now = func() time.Time { return current }
+
+	require.NoError(t, uc.handleStatusUpdate(telemetryMessage(t, rabbitmq.DroneStatusUpdate{
+		DroneID:   droneID,
+		Status:    "in_transit",
+		UpdatedAt: current.Unix(),
+	})))
+	assert.Len(t, uc.List(), 1)
+
+	current = current.Add(fleetStateMaxAge + time.Second)
+	mockDroneRepo.On("GetState", ctx, droneID).Return(&entity.DroneStatus{DroneID: droneID, Status: "offline"}, nil)
+
+	state, err := uc.GetState(ctx, droneID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "offline", state.Status)
+	assert.Empty(t, uc.List())
+	mockDroneRepo.AssertExpectations(t)
+}
+
+func TestFleetStateUseCase_HandleStatusUpdate_ReportsDeliveryPosition(t *testing.T) {
+	mockPositions := new(mockDeliveryPositionReporter)
+	uc := NewFleetStateUseCase(nil, nil, mockPositions, new(mocks.MockLogger))
+
+	droneID := uuid.New()
+	deliveryID := uuid.New()
+	now := time.Now()
+	position := entity.Position{Latitude: 55.75, Longitude: 37.61, Altitude: 60}
+
+	mockPositions.On("ReportPosition", mock.Anything, deliveryID, position, 12.0).Return(nil).Once()
+
+	require.NoError(t, uc.handleStatusUpdate(telemetryMessage(t, rabbitmq.DroneStatusUpdate{
+		DroneID:           droneID,
+		Status:            "in_transit",
+		Latitude:          position.Latitude,
+		Longitude:         position.Longitude,
+		Altitude:          position.Altitude,
+		Speed:             12,
+		CurrentDeliveryID: &deliveryID,
+		UpdatedAt:         now.Unix(),
+	})))
+	require.NoError(t, uc.handleStatusUpdate(telemetryMessage(t, rabbitmq.DroneStatusUpdate{
+		DroneID:           droneID,
+		Status:            "in_transit",
+		Speed:             12,
+		CurrentDeliveryID: &deliveryID,
+		UpdatedAt:         now.Add(-time.Minute).Unix(),
+	})))
+	require.NoError(t, uc.handleStatusUpdate(telemetryMessage(t, rabbitmq.DroneStatusUpdate{
+		DroneID:   uuid.New(),
+		Status:    "idle",
+		UpdatedAt: now.Unix(),
+	})))
+
+	mockPositions.AssertExpectations(t)
+}
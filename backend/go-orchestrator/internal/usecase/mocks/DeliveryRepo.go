@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
@@ -39,6 +40,86 @@ func (_m *MockDeliveryRepo) EXPECT() *MockDeliveryRepo_Expecter {
 	return &MockDeliveryRepo_Expecter{mock: &_m.Mock}
 }
 
+// ClaimDuePickupReminders provides a mock function for the type MockDeliveryRepo
+func (_mock *MockDeliveryRepo) ClaimDuePickupReminders(ctx context.Context, now time.Time, dueBefore time.Time, limit int) ([]*entity.Delivery, error) {
+	ret := _mock.Called(ctx, now, dueBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDuePickupReminders")
+	}
+
+	var r0 []*entity.Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]*entity.Delivery, error)); ok {
+		return returnFunc(ctx, now, dueBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []*entity.Delivery); ok {
+		r0 = returnFunc(ctx, now, dueBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, dueBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveryRepo_ClaimDuePickupReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDuePickupReminders'
+type MockDeliveryRepo_ClaimDuePickupReminders_Call struct {
+	*mock.Call
+}
+
+// ClaimDuePickupReminders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - dueBefore time.Time
+//   - limit int
+func (_e *MockDeliveryRepo_Expecter) ClaimDuePickupReminders(ctx interface{}, now interface{}, dueBefore interface{}, limit interface{}) *MockDeliveryRepo_ClaimDuePickupReminders_Call {
+	return &MockDeliveryRepo_ClaimDuePickupReminders_Call{Call: _e.mock.On("ClaimDuePickupReminders", ctx, now, dueBefore, limit)}
+}
+
+func (_c *MockDeliveryRepo_ClaimDuePickupReminders_Call) Run(run func(ctx context.Context, now time.Time, dueBefore time.Time, limit int)) *MockDeliveryRepo_ClaimDuePickupReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveryRepo_ClaimDuePickupReminders_Call) Return(deliverys []*entity.Delivery, err error) *MockDeliveryRepo_ClaimDuePickupReminders_Call {
+	_c.Call.Return(deliverys, err)
+	return _c
+}
+
+func (_c *MockDeliveryRepo_ClaimDuePickupReminders_Call) RunAndReturn(run func(ctx context.Context, now time.Time, dueBefore time.Time, limit int) ([]*entity.Delivery, error)) *MockDeliveryRepo_ClaimDuePickupReminders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockDeliveryRepo
 func (_mock *MockDeliveryRepo) Create(ctx context.Context, delivery *entity.Delivery) (*entity.Delivery, error) {
 	ret := _mock.Called(ctx, delivery)
@@ -311,6 +392,78 @@ func (_c *MockDeliveryRepo_ListByStatus_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// MarkArrivalNotified provides a mock function for the type MockDeliveryRepo
+func (_mock *MockDeliveryRepo) MarkArrivalNotified(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	ret := _mock.Called(ctx, id, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkArrivalNotified")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, id, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) bool); ok {
+		r0 = returnFunc(ctx, id, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveryRepo_MarkArrivalNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkArrivalNotified'
+type MockDeliveryRepo_MarkArrivalNotified_Call struct {
+	*mock.Call
+}
+
+// MarkArrivalNotified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockDeliveryRepo_Expecter) MarkArrivalNotified(ctx interface{}, id interface{}, now interface{}) *MockDeliveryRepo_MarkArrivalNotified_Call {
+	return &MockDeliveryRepo_MarkArrivalNotified_Call{Call: _e.mock.On("MarkArrivalNotified", ctx, id, now)}
+}
+
+func (_c *MockDeliveryRepo_MarkArrivalNotified_Call) Run(run func(ctx context.Context, id uuid.UUID, now time.Time)) *MockDeliveryRepo_MarkArrivalNotified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveryRepo_MarkArrivalNotified_Call) Return(b bool, err error) *MockDeliveryRepo_MarkArrivalNotified_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockDeliveryRepo_MarkArrivalNotified_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)) *MockDeliveryRepo_MarkArrivalNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDrone provides a mock function for the type MockDeliveryRepo
 func (_mock *MockDeliveryRepo) UpdateDrone(ctx context.Context, delivery *entity.Delivery) error {
 	ret := _mock.Called(ctx, delivery)
@@ -40,31 +40,39 @@ func (_m *MockDroneRepo) EXPECT() *MockDroneRepo_Expecter {
 }
 
 // Create provides a mock function for the type MockDroneRepo
-func (_mock *MockDroneRepo) Create(ctx context.Context, drone *entity.Drone) (*entity.Drone, error) {
-	ret := _mock.Called(ctx, drone)
+func (_mock *MockDroneRepo) Create(ctx context.Context, drone *entity.Drone, secret string) (*entity.Drone, *entity.DroneCredential, error) {
+	ret := _mock.Called(ctx, drone, secret)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 *entity.Drone
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Drone) (*entity.Drone, error)); ok {
-		return returnFunc(ctx, drone)
+	var r1 *entity.DroneCredential
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Drone, string) (*entity.Drone, *entity.DroneCredential, error)); ok {
+		return returnFunc(ctx, drone, secret)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Drone) *entity.Drone); ok {
-		r0 = returnFunc(ctx, drone)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Drone, string) *entity.Drone); ok {
+		r0 = returnFunc(ctx, drone, secret)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Drone)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *entity.Drone) error); ok {
-		r1 = returnFunc(ctx, drone)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entity.Drone, string) *entity.DroneCredential); ok {
+		r1 = returnFunc(ctx, drone, secret)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entity.DroneCredential)
+		}
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *entity.Drone, string) error); ok {
+		r2 = returnFunc(ctx, drone, secret)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockDroneRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
@@ -75,11 +83,12 @@ type MockDroneRepo_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
 //   - drone *entity.Drone
-func (_e *MockDroneRepo_Expecter) Create(ctx interface{}, drone interface{}) *MockDroneRepo_Create_Call {
-	return &MockDroneRepo_Create_Call{Call: _e.mock.On("Create", ctx, drone)}
+//   - secret string
+func (_e *MockDroneRepo_Expecter) Create(ctx interface{}, drone interface{}, secret interface{}) *MockDroneRepo_Create_Call {
+	return &MockDroneRepo_Create_Call{Call: _e.mock.On("Create", ctx, drone, secret)}
 }
 
-func (_c *MockDroneRepo_Create_Call) Run(run func(ctx context.Context, drone *entity.Drone)) *MockDroneRepo_Create_Call {
+func (_c *MockDroneRepo_Create_Call) Run(run func(ctx context.Context, drone *entity.Drone, secret string)) *MockDroneRepo_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,20 +98,25 @@ func (_c *MockDroneRepo_Create_Call) Run(run func(ctx context.Context, drone *en
 		if args[1] != nil {
 			arg1 = args[1].(*entity.Drone)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDroneRepo_Create_Call) Return(drone1 *entity.Drone, err error) *MockDroneRepo_Create_Call {
-	_c.Call.Return(drone1, err)
+func (_c *MockDroneRepo_Create_Call) Return(drone1 *entity.Drone, droneCredential *entity.DroneCredential, err error) *MockDroneRepo_Create_Call {
+	_c.Call.Return(drone1, droneCredential, err)
 	return _c
 }
 
-func (_c *MockDroneRepo_Create_Call) RunAndReturn(run func(ctx context.Context, drone *entity.Drone) (*entity.Drone, error)) *MockDroneRepo_Create_Call {
+func (_c *MockDroneRepo_Create_Call) RunAndReturn(run func(ctx context.Context, drone *entity.Drone, secret string) (*entity.Drone, *entity.DroneCredential, error)) *MockDroneRepo_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -294,6 +308,74 @@ func (_c *MockDroneRepo_GetByID_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// GetState provides a mock function for the type MockDroneRepo
+func (_mock *MockDroneRepo) GetState(ctx context.Context, id uuid.UUID) (*entity.DroneStatus, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetState")
+	}
+
+	var r0 *entity.DroneStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.DroneStatus, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.DroneStatus); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.DroneStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDroneRepo_GetState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetState'
+type MockDroneRepo_GetState_Call struct {
+	*mock.Call
+}
+
+// GetState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockDroneRepo_Expecter) GetState(ctx interface{}, id interface{}) *MockDroneRepo_GetState_Call {
+	return &MockDroneRepo_GetState_Call{Call: _e.mock.On("GetState", ctx, id)}
+}
+
+func (_c *MockDroneRepo_GetState_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockDroneRepo_GetState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDroneRepo_GetState_Call) Return(droneStatus *entity.DroneStatus, err error) *MockDroneRepo_GetState_Call {
+	_c.Call.Return(droneStatus, err)
+	return _c
+}
+
+func (_c *MockDroneRepo_GetState_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*entity.DroneStatus, error)) *MockDroneRepo_GetState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function for the type MockDroneRepo
 func (_mock *MockDroneRepo) List(ctx context.Context) ([]*entity.Drone, error) {
 	ret := _mock.Called(ctx)
@@ -424,6 +424,74 @@ func (_c *MockParcelAutomatRepo_Update_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// UpdateStaticQR provides a mock function for the type MockParcelAutomatRepo
+func (_mock *MockParcelAutomatRepo) UpdateStaticQR(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error) {
+	ret := _mock.Called(ctx, automat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStaticQR")
+	}
+
+	var r0 *entity.ParcelAutomat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.ParcelAutomat) (*entity.ParcelAutomat, error)); ok {
+		return returnFunc(ctx, automat)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.ParcelAutomat) *entity.ParcelAutomat); ok {
+		r0 = returnFunc(ctx, automat)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ParcelAutomat)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entity.ParcelAutomat) error); ok {
+		r1 = returnFunc(ctx, automat)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockParcelAutomatRepo_UpdateStaticQR_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStaticQR'
+type MockParcelAutomatRepo_UpdateStaticQR_Call struct {
+	*mock.Call
+}
+
+// UpdateStaticQR is a helper method to define mock.On call
+//   - ctx context.Context
+//   - automat *entity.ParcelAutomat
+func (_e *MockParcelAutomatRepo_Expecter) UpdateStaticQR(ctx interface{}, automat interface{}) *MockParcelAutomatRepo_UpdateStaticQR_Call {
+	return &MockParcelAutomatRepo_UpdateStaticQR_Call{Call: _e.mock.On("UpdateStaticQR", ctx, automat)}
+}
+
+func (_c *MockParcelAutomatRepo_UpdateStaticQR_Call) Run(run func(ctx context.Context, automat *entity.ParcelAutomat)) *MockParcelAutomatRepo_UpdateStaticQR_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entity.ParcelAutomat
+		if args[1] != nil {
+			arg1 = args[1].(*entity.ParcelAutomat)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockParcelAutomatRepo_UpdateStaticQR_Call) Return(parcelAutomat *entity.ParcelAutomat, err error) *MockParcelAutomatRepo_UpdateStaticQR_Call {
+	_c.Call.Return(parcelAutomat, err)
+	return _c
+}
+
+func (_c *MockParcelAutomatRepo_UpdateStaticQR_Call) RunAndReturn(run func(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error)) *MockParcelAutomatRepo_UpdateStaticQR_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStatus provides a mock function for the type MockParcelAutomatRepo
 func (_mock *MockParcelAutomatRepo) UpdateStatus(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error) {
 	ret := _mock.Called(ctx, automat)
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
 	mock "github.com/stretchr/testify/mock"
@@ -37,9 +39,154 @@ func (_m *MockQRGenerator) EXPECT() *MockQRGenerator_Expecter {
 	return &MockQRGenerator_Expecter{mock: &_m.Mock}
 }
 
+// GenerateGrantQRCode provides a mock function for the type MockQRGenerator
+func (_mock *MockQRGenerator) GenerateGrantQRCode(grantID uuid.UUID, userID uuid.UUID, orderID uuid.UUID, expiresAt time.Time) (*qr.GrantQRData, string, error) {
+	ret := _mock.Called(grantID, userID, orderID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateGrantQRCode")
+	}
+
+	var r0 *qr.GrantQRData
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*qr.GrantQRData, string, error)); ok {
+		return returnFunc(grantID, userID, orderID, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, uuid.UUID, time.Time) *qr.GrantQRData); ok {
+		r0 = returnFunc(grantID, userID, orderID, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*qr.GrantQRData)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, uuid.UUID, time.Time) string); ok {
+		r1 = returnFunc(grantID, userID, orderID, expiresAt)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r2 = returnFunc(grantID, userID, orderID, expiresAt)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockQRGenerator_GenerateGrantQRCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateGrantQRCode'
+type MockQRGenerator_GenerateGrantQRCode_Call struct {
+	*mock.Call
+}
+
+// GenerateGrantQRCode is a helper method to define mock.On call
+//   - grantID uuid.UUID
+//   - userID uuid.UUID
+//   - orderID uuid.UUID
+//   - expiresAt time.Time
+func (_e *MockQRGenerator_Expecter) GenerateGrantQRCode(grantID interface{}, userID interface{}, orderID interface{}, expiresAt interface{}) *MockQRGenerator_GenerateGrantQRCode_Call {
+	return &MockQRGenerator_GenerateGrantQRCode_Call{Call: _e.mock.On("GenerateGrantQRCode", grantID, userID, orderID, expiresAt)}
+}
+
+func (_c *MockQRGenerator_GenerateGrantQRCode_Call) Run(run func(grantID uuid.UUID, userID uuid.UUID, orderID uuid.UUID, expiresAt time.Time)) *MockQRGenerator_GenerateGrantQRCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uuid.UUID
+		if args[0] != nil {
+			arg0 = args[0].(uuid.UUID)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQRGenerator_GenerateGrantQRCode_Call) Return(grantData *qr.GrantQRData, qrImageBase64 string, err error) *MockQRGenerator_GenerateGrantQRCode_Call {
+	_c.Call.Return(grantData, qrImageBase64, err)
+	return _c
+}
+
+func (_c *MockQRGenerator_GenerateGrantQRCode_Call) RunAndReturn(run func(grantID uuid.UUID, userID uuid.UUID, orderID uuid.UUID, expiresAt time.Time) (*qr.GrantQRData, string, error)) *MockQRGenerator_GenerateGrantQRCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GeneratePickupPIN provides a mock function for the type MockQRGenerator
+func (_mock *MockQRGenerator) GeneratePickupPIN() (string, string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GeneratePickupPIN")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func() (string, string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() string); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func() error); ok {
+		r2 = returnFunc()
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockQRGenerator_GeneratePickupPIN_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GeneratePickupPIN'
+type MockQRGenerator_GeneratePickupPIN_Call struct {
+	*mock.Call
+}
+
+// GeneratePickupPIN is a helper method to define mock.On call
+func (_e *MockQRGenerator_Expecter) GeneratePickupPIN() *MockQRGenerator_GeneratePickupPIN_Call {
+	return &MockQRGenerator_GeneratePickupPIN_Call{Call: _e.mock.On("GeneratePickupPIN")}
+}
+
+func (_c *MockQRGenerator_GeneratePickupPIN_Call) Run(run func()) *MockQRGenerator_GeneratePickupPIN_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockQRGenerator_GeneratePickupPIN_Call) Return(pin string, pinHash string, err error) *MockQRGenerator_GeneratePickupPIN_Call {
+	_c.Call.Return(pin, pinHash, err)
+	return _c
+}
+
+func (_c *MockQRGenerator_GeneratePickupPIN_Call) RunAndReturn(run func() (string, string, error)) *MockQRGenerator_GeneratePickupPIN_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateQRCode provides a mock function for the type MockQRGenerator
-func (_mock *MockQRGenerator) GenerateQRCode(userID uuid.UUID, email string, name string) (*qr.QRData, string, error) {
-	ret := _mock.Called(userID, email, name)
+func (_mock *MockQRGenerator) GenerateQRCode(userID uuid.UUID, email string, name string, generation int) (*qr.QRData, string, error) {
+	ret := _mock.Called(userID, email, name, generation)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateQRCode")
@@ -48,23 +195,23 @@ func (_mock *MockQRGenerator) GenerateQRCode(userID uuid.UUID, email string, nam
 	var r0 *qr.QRData
 	var r1 string
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string) (*qr.QRData, string, error)); ok {
-		return returnFunc(userID, email, name)
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string, int) (*qr.QRData, string, error)); ok {
+		return returnFunc(userID, email, name, generation)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string) *qr.QRData); ok {
-		r0 = returnFunc(userID, email, name)
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string, int) *qr.QRData); ok {
+		r0 = returnFunc(userID, email, name, generation)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*qr.QRData)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID, string, string) string); ok {
-		r1 = returnFunc(userID, email, name)
+	if returnFunc, ok := ret.Get(1).(func(uuid.UUID, string, string, int) string); ok {
+		r1 = returnFunc(userID, email, name, generation)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
-	if returnFunc, ok := ret.Get(2).(func(uuid.UUID, string, string) error); ok {
-		r2 = returnFunc(userID, email, name)
+	if returnFunc, ok := ret.Get(2).(func(uuid.UUID, string, string, int) error); ok {
+		r2 = returnFunc(userID, email, name, generation)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -80,11 +227,12 @@ type MockQRGenerator_GenerateQRCode_Call struct {
 //   - userID uuid.UUID
 //   - email string
 //   - name string
-func (_e *MockQRGenerator_Expecter) GenerateQRCode(userID interface{}, email interface{}, name interface{}) *MockQRGenerator_GenerateQRCode_Call {
-	return &MockQRGenerator_GenerateQRCode_Call{Call: _e.mock.On("GenerateQRCode", userID, email, name)}
+//   - generation int
+func (_e *MockQRGenerator_Expecter) GenerateQRCode(userID interface{}, email interface{}, name interface{}, generation interface{}) *MockQRGenerator_GenerateQRCode_Call {
+	return &MockQRGenerator_GenerateQRCode_Call{Call: _e.mock.On("GenerateQRCode", userID, email, name, generation)}
 }
 
-func (_c *MockQRGenerator_GenerateQRCode_Call) Run(run func(userID uuid.UUID, email string, name string)) *MockQRGenerator_GenerateQRCode_Call {
+func (_c *MockQRGenerator_GenerateQRCode_Call) Run(run func(userID uuid.UUID, email string, name string, generation int)) *MockQRGenerator_GenerateQRCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 uuid.UUID
 		if args[0] != nil {
@@ -98,10 +246,15 @@ func (_c *MockQRGenerator_GenerateQRCode_Call) Run(run func(userID uuid.UUID, em
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -112,14 +265,65 @@ func (_c *MockQRGenerator_GenerateQRCode_Call) Return(qrData *qr.QRData, qrImage
 	return _c
 }
 
-func (_c *MockQRGenerator_GenerateQRCode_Call) RunAndReturn(run func(userID uuid.UUID, email string, name string) (*qr.QRData, string, error)) *MockQRGenerator_GenerateQRCode_Call {
+func (_c *MockQRGenerator_GenerateQRCode_Call) RunAndReturn(run func(userID uuid.UUID, email string, name string, generation int) (*qr.QRData, string, error)) *MockQRGenerator_GenerateQRCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HashPickupPIN provides a mock function for the type MockQRGenerator
+func (_mock *MockQRGenerator) HashPickupPIN(pin string) string {
+	ret := _mock.Called(pin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HashPickupPIN")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(pin)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockQRGenerator_HashPickupPIN_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HashPickupPIN'
+type MockQRGenerator_HashPickupPIN_Call struct {
+	*mock.Call
+}
+
+// HashPickupPIN is a helper method to define mock.On call
+//   - pin string
+func (_e *MockQRGenerator_Expecter) HashPickupPIN(pin interface{}) *MockQRGenerator_HashPickupPIN_Call {
+	return &MockQRGenerator_HashPickupPIN_Call{Call: _e.mock.On("HashPickupPIN", pin)}
+}
+
+func (_c *MockQRGenerator_HashPickupPIN_Call) Run(run func(pin string)) *MockQRGenerator_HashPickupPIN_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQRGenerator_HashPickupPIN_Call) Return(s string) *MockQRGenerator_HashPickupPIN_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockQRGenerator_HashPickupPIN_Call) RunAndReturn(run func(pin string) string) *MockQRGenerator_HashPickupPIN_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // RefreshQRCode provides a mock function for the type MockQRGenerator
-func (_mock *MockQRGenerator) RefreshQRCode(userID uuid.UUID, email string, name string) (*qr.QRData, string, error) {
-	ret := _mock.Called(userID, email, name)
+func (_mock *MockQRGenerator) RefreshQRCode(userID uuid.UUID, email string, name string, generation int) (*qr.QRData, string, error) {
+	ret := _mock.Called(userID, email, name, generation)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RefreshQRCode")
@@ -128,23 +332,23 @@ func (_mock *MockQRGenerator) RefreshQRCode(userID uuid.UUID, email string, name
 	var r0 *qr.QRData
 	var r1 string
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string) (*qr.QRData, string, error)); ok {
-		return returnFunc(userID, email, name)
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string, int) (*qr.QRData, string, error)); ok {
+		return returnFunc(userID, email, name, generation)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string) *qr.QRData); ok {
-		r0 = returnFunc(userID, email, name)
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string, int) *qr.QRData); ok {
+		r0 = returnFunc(userID, email, name, generation)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*qr.QRData)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID, string, string) string); ok {
-		r1 = returnFunc(userID, email, name)
+	if returnFunc, ok := ret.Get(1).(func(uuid.UUID, string, string, int) string); ok {
+		r1 = returnFunc(userID, email, name, generation)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
-	if returnFunc, ok := ret.Get(2).(func(uuid.UUID, string, string) error); ok {
-		r2 = returnFunc(userID, email, name)
+	if returnFunc, ok := ret.Get(2).(func(uuid.UUID, string, string, int) error); ok {
+		r2 = returnFunc(userID, email, name, generation)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -160,11 +364,12 @@ type MockQRGenerator_RefreshQRCode_Call struct {
 //   - userID uuid.UUID
 //   - email string
 //   - name string
-func (_e *MockQRGenerator_Expecter) RefreshQRCode(userID interface{}, email interface{}, name interface{}) *MockQRGenerator_RefreshQRCode_Call {
-	return &MockQRGenerator_RefreshQRCode_Call{Call: _e.mock.On("RefreshQRCode", userID, email, name)}
+//   - generation int
+func (_e *MockQRGenerator_Expecter) RefreshQRCode(userID interface{}, email interface{}, name interface{}, generation interface{}) *MockQRGenerator_RefreshQRCode_Call {
+	return &MockQRGenerator_RefreshQRCode_Call{Call: _e.mock.On("RefreshQRCode", userID, email, name, generation)}
 }
 
-func (_c *MockQRGenerator_RefreshQRCode_Call) Run(run func(userID uuid.UUID, email string, name string)) *MockQRGenerator_RefreshQRCode_Call {
+func (_c *MockQRGenerator_RefreshQRCode_Call) Run(run func(userID uuid.UUID, email string, name string, generation int)) *MockQRGenerator_RefreshQRCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 uuid.UUID
 		if args[0] != nil {
@@ -178,10 +383,15 @@ func (_c *MockQRGenerator_RefreshQRCode_Call) Run(run func(userID uuid.UUID, ema
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -192,15 +402,77 @@ func (_c *MockQRGenerator_RefreshQRCode_Call) Return(qrData *qr.QRData, qrImageB
 	return _c
 }
 
-func (_c *MockQRGenerator_RefreshQRCode_Call) RunAndReturn(run func(userID uuid.UUID, email string, name string) (*qr.QRData, string, error)) *MockQRGenerator_RefreshQRCode_Call {
+func (_c *MockQRGenerator_RefreshQRCode_Call) RunAndReturn(run func(userID uuid.UUID, email string, name string, generation int) (*qr.QRData, string, error)) *MockQRGenerator_RefreshQRCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ValidateQRCode provides a mock function for the type MockQRGenerator
-func (_mock *MockQRGenerator) ValidateQRCode(qrDataJSON string) (*qr.QRData, error) {
+// ValidateGrantQRCode provides a mock function for the type MockQRGenerator
+func (_mock *MockQRGenerator) ValidateGrantQRCode(qrDataJSON string) (*qr.GrantQRData, error) {
 	ret := _mock.Called(qrDataJSON)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateGrantQRCode")
+	}
+
+	var r0 *qr.GrantQRData
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*qr.GrantQRData, error)); ok {
+		return returnFunc(qrDataJSON)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *qr.GrantQRData); ok {
+		r0 = returnFunc(qrDataJSON)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*qr.GrantQRData)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(qrDataJSON)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQRGenerator_ValidateGrantQRCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateGrantQRCode'
+type MockQRGenerator_ValidateGrantQRCode_Call struct {
+	*mock.Call
+}
+
+// ValidateGrantQRCode is a helper method to define mock.On call
+//   - qrDataJSON string
+func (_e *MockQRGenerator_Expecter) ValidateGrantQRCode(qrDataJSON interface{}) *MockQRGenerator_ValidateGrantQRCode_Call {
+	return &MockQRGenerator_ValidateGrantQRCode_Call{Call: _e.mock.On("ValidateGrantQRCode", qrDataJSON)}
+}
+
+func (_c *MockQRGenerator_ValidateGrantQRCode_Call) Run(run func(qrDataJSON string)) *MockQRGenerator_ValidateGrantQRCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQRGenerator_ValidateGrantQRCode_Call) Return(grantData *qr.GrantQRData, err error) *MockQRGenerator_ValidateGrantQRCode_Call {
+	_c.Call.Return(grantData, err)
+	return _c
+}
+
+func (_c *MockQRGenerator_ValidateGrantQRCode_Call) RunAndReturn(run func(qrDataJSON string) (*qr.GrantQRData, error)) *MockQRGenerator_ValidateGrantQRCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateQRCode provides a mock function for the type MockQRGenerator
+func (_mock *MockQRGenerator) ValidateQRCode(payload string) (*qr.QRData, error) {
+	ret := _mock.Called(payload)
+
 	if len(ret) == 0 {
 		panic("no return value specified for ValidateQRCode")
 	}
@@ -208,17 +480,17 @@ func (_mock *MockQRGenerator) ValidateQRCode(qrDataJSON string) (*qr.QRData, err
 	var r0 *qr.QRData
 	var r1 error
 	if returnFunc, ok := ret.Get(0).(func(string) (*qr.QRData, error)); ok {
-		return returnFunc(qrDataJSON)
+		return returnFunc(payload)
 	}
 	if returnFunc, ok := ret.Get(0).(func(string) *qr.QRData); ok {
-		r0 = returnFunc(qrDataJSON)
+		r0 = returnFunc(payload)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*qr.QRData)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(qrDataJSON)
+		r1 = returnFunc(payload)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -231,12 +503,12 @@ type MockQRGenerator_ValidateQRCode_Call struct {
 }
 
 // ValidateQRCode is a helper method to define mock.On call
-//   - qrDataJSON string
-func (_e *MockQRGenerator_Expecter) ValidateQRCode(qrDataJSON interface{}) *MockQRGenerator_ValidateQRCode_Call {
-	return &MockQRGenerator_ValidateQRCode_Call{Call: _e.mock.On("ValidateQRCode", qrDataJSON)}
+//   - payload string
+func (_e *MockQRGenerator_Expecter) ValidateQRCode(payload interface{}) *MockQRGenerator_ValidateQRCode_Call {
+	return &MockQRGenerator_ValidateQRCode_Call{Call: _e.mock.On("ValidateQRCode", payload)}
 }
 
-func (_c *MockQRGenerator_ValidateQRCode_Call) Run(run func(qrDataJSON string)) *MockQRGenerator_ValidateQRCode_Call {
+func (_c *MockQRGenerator_ValidateQRCode_Call) Run(run func(payload string)) *MockQRGenerator_ValidateQRCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -254,7 +526,7 @@ func (_c *MockQRGenerator_ValidateQRCode_Call) Return(qrData *qr.QRData, err err
 	return _c
 }
 
-func (_c *MockQRGenerator_ValidateQRCode_Call) RunAndReturn(run func(qrDataJSON string) (*qr.QRData, error)) *MockQRGenerator_ValidateQRCode_Call {
+func (_c *MockQRGenerator_ValidateQRCode_Call) RunAndReturn(run func(payload string) (*qr.QRData, error)) *MockQRGenerator_ValidateQRCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
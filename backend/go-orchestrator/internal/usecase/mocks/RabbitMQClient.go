@@ -200,3 +200,60 @@ func (_c *MockRabbitMQClient_Publish_Call) RunAndReturn(run func(ctx context.Con
 	_c.Call.Return(run)
 	return _c
 }
+
+// Subscribe provides a mock function for the type MockRabbitMQClient
+func (_mock *MockRabbitMQClient) Subscribe(exchange string, handler func([]byte) error) error {
+	ret := _mock.Called(exchange, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, func([]byte) error) error); ok {
+		r0 = returnFunc(exchange, handler)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRabbitMQClient_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockRabbitMQClient_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - exchange string
+//   - handler func([]byte) error
+func (_e *MockRabbitMQClient_Expecter) Subscribe(exchange interface{}, handler interface{}) *MockRabbitMQClient_Subscribe_Call {
+	return &MockRabbitMQClient_Subscribe_Call{Call: _e.mock.On("Subscribe", exchange, handler)}
+}
+
+func (_c *MockRabbitMQClient_Subscribe_Call) Run(run func(exchange string, handler func([]byte) error)) *MockRabbitMQClient_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 func([]byte) error
+		if args[1] != nil {
+			arg1 = args[1].(func([]byte) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRabbitMQClient_Subscribe_Call) Return(err error) *MockRabbitMQClient_Subscribe_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRabbitMQClient_Subscribe_Call) RunAndReturn(run func(exchange string, handler func([]byte) error) error) *MockRabbitMQClient_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -97,6 +97,69 @@ func (_c *MockSMSAeroWebAPI_CheckBalance_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// SendMessage provides a mock function for the type MockSMSAeroWebAPI
+func (_mock *MockSMSAeroWebAPI) SendMessage(ctx context.Context, phone string, text string) error {
+	ret := _mock.Called(ctx, phone, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, phone, text)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSMSAeroWebAPI_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type MockSMSAeroWebAPI_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - phone string
+//   - text string
+func (_e *MockSMSAeroWebAPI_Expecter) SendMessage(ctx interface{}, phone interface{}, text interface{}) *MockSMSAeroWebAPI_SendMessage_Call {
+	return &MockSMSAeroWebAPI_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, phone, text)}
+}
+
+func (_c *MockSMSAeroWebAPI_SendMessage_Call) Run(run func(ctx context.Context, phone string, text string)) *MockSMSAeroWebAPI_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSMSAeroWebAPI_SendMessage_Call) Return(err error) *MockSMSAeroWebAPI_SendMessage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSMSAeroWebAPI_SendMessage_Call) RunAndReturn(run func(ctx context.Context, phone string, text string) error) *MockSMSAeroWebAPI_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendSMS provides a mock function for the type MockSMSAeroWebAPI
 func (_mock *MockSMSAeroWebAPI) SendSMS(ctx context.Context, phone string, message string) error {
 	ret := _mock.Called(ctx, phone, message)
@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -37,49 +38,48 @@ func (_m *MockSender) EXPECT() *MockSender_Expecter {
 	return &MockSender_Expecter{mock: &_m.Mock}
 }
 
-// SendDeliveryNotification provides a mock function for the type MockSender
-func (_mock *MockSender) SendDeliveryNotification(ctx context.Context, tokens []string, orderID string, lockerCellID *string) ([]string, error) {
-	ret := _mock.Called(ctx, tokens, orderID, lockerCellID)
+// Send provides a mock function for the type MockSender
+func (_mock *MockSender) Send(ctx context.Context, tokens []string, notification entity.Notification) ([]string, error) {
+	ret := _mock.Called(ctx, tokens, notification)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendDeliveryNotification")
+		panic("no return value specified for Send")
 	}
 
 	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, string, *string) ([]string, error)); ok {
-		return returnFunc(ctx, tokens, orderID, lockerCellID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, entity.Notification) ([]string, error)); ok {
+		return returnFunc(ctx, tokens, notification)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, string, *string) []string); ok {
-		r0 = returnFunc(ctx, tokens, orderID, lockerCellID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, entity.Notification) []string); ok {
+		r0 = returnFunc(ctx, tokens, notification)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, []string, string, *string) error); ok {
-		r1 = returnFunc(ctx, tokens, orderID, lockerCellID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string, entity.Notification) error); ok {
+		r1 = returnFunc(ctx, tokens, notification)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSender_SendDeliveryNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDeliveryNotification'
-type MockSender_SendDeliveryNotification_Call struct {
+// MockSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockSender_Send_Call struct {
 	*mock.Call
 }
 
-// SendDeliveryNotification is a helper method to define mock.On call
+// Send is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tokens []string
-//   - orderID string
-//   - lockerCellID *string
-func (_e *MockSender_Expecter) SendDeliveryNotification(ctx interface{}, tokens interface{}, orderID interface{}, lockerCellID interface{}) *MockSender_SendDeliveryNotification_Call {
-	return &MockSender_SendDeliveryNotification_Call{Call: _e.mock.On("SendDeliveryNotification", ctx, tokens, orderID, lockerCellID)}
+//   - notification entity.Notification
+func (_e *MockSender_Expecter) Send(ctx interface{}, tokens interface{}, notification interface{}) *MockSender_Send_Call {
+	return &MockSender_Send_Call{Call: _e.mock.On("Send", ctx, tokens, notification)}
 }
 
-func (_c *MockSender_SendDeliveryNotification_Call) Run(run func(ctx context.Context, tokens []string, orderID string, lockerCellID *string)) *MockSender_SendDeliveryNotification_Call {
+func (_c *MockSender_Send_Call) Run(run func(ctx context.Context, tokens []string, notification entity.Notification)) *MockSender_Send_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,30 +89,25 @@ func (_c *MockSender_SendDeliveryNotification_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].([]string)
 		}
-		var arg2 string
+		var arg2 entity.Notification
 		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 *string
-		if args[3] != nil {
-			arg3 = args[3].(*string)
+			arg2 = args[2].(entity.Notification)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSender_SendDeliveryNotification_Call) Return(strings []string, err error) *MockSender_SendDeliveryNotification_Call {
+func (_c *MockSender_Send_Call) Return(strings []string, err error) *MockSender_Send_Call {
 	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *MockSender_SendDeliveryNotification_Call) RunAndReturn(run func(ctx context.Context, tokens []string, orderID string, lockerCellID *string) ([]string, error)) *MockSender_SendDeliveryNotification_Call {
+func (_c *MockSender_Send_Call) RunAndReturn(run func(ctx context.Context, tokens []string, notification entity.Notification) ([]string, error)) *MockSender_Send_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,30 +2,92 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
 	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
+	webapiError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/webapi/error"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
 )
 
+const (
+	scheduledNotificationsBatchSize = 100
+
+	// A scheduled notification that cannot be dispatched is queued again, waiting twice as long each time.
+	scheduledNotificationMaxAttempts = 5
+	scheduledNotificationRetryDelay  = time.Minute
+)
+
 type NotificationUseCase struct {
-	deviceRepo repo.DeviceRepo
-	sender     repo.Sender
-	logger     logger.Interface
+	deviceRepo      repo.DeviceRepo
+	sender          repo.Sender
+	userRepo        repo.UserRepo
+	smsWebAPI       repo.SMSAeroWebAPI
+	emailSender     repo.EmailSender
+	deliveryLogRepo repo.NotificationDeliveryRepo
+	preferencesRepo repo.NotificationPreferencesRepo
+	scheduledRepo   repo.ScheduledNotificationRepo
+	pinReissuer     PickupPINReissuer
+	smsLimiter      repo.SMSRateLimiter
+	smsPolicy       SMSFallbackPolicy
+	logger          logger.Interface
+}
+
+type SMSFallbackPolicy struct {
+	Limit  int
+	Window time.Duration
+}
+
+// PickupPINReissuer issues a fresh pickup PIN when a queued notification is finally sent, so plaintext
+// PINs never sit in the scheduled notifications table.
+type PickupPINReissuer interface {
+	ReissuePIN(ctx context.Context, userID, orderID uuid.UUID) (string, *entity.PickupPIN, error)
 }
 
-type DeliveryNotifier interface {
-	NotifyOrderDelivered(ctx context.Context, userID uuid.UUID, orderID uuid.UUID, lockerCellID *uuid.UUID) error
+type OrderEventNotifier interface {
+	Notify(ctx context.Context, userID uuid.UUID, event entity.NotificationEvent, data map[string]string) error
 }
 
-func NewNotificationUseCase(deviceRepo repo.DeviceRepo, sender repo.Sender, logger logger.Interface) *NotificationUseCase {
+type PickupNotifier interface {
+	NotifyOrderCollected(ctx context.Context, userID uuid.UUID, orderID uuid.UUID) error
+}
+
+type AccountMailer interface {
+	SendPasswordResetEmail(ctx context.Context, userID uuid.UUID, code string, ttl time.Duration) error
+}
+
+func NewNotificationUseCase(
+	deviceRepo repo.DeviceRepo,
+	sender repo.Sender,
+	userRepo repo.UserRepo,
+	smsWebAPI repo.SMSAeroWebAPI,
+	emailSender repo.EmailSender,
+	deliveryLogRepo repo.NotificationDeliveryRepo,
+	preferencesRepo repo.NotificationPreferencesRepo,
+	scheduledRepo repo.ScheduledNotificationRepo,
+	pinReissuer PickupPINReissuer,
+	smsLimiter repo.SMSRateLimiter,
+	smsPolicy SMSFallbackPolicy,
+	logger logger.Interface,
+) *NotificationUseCase {
 	return &NotificationUseCase{
-		deviceRepo: deviceRepo,
-		sender:     sender,
-		logger:     logger,
+		deviceRepo:      deviceRepo,
+		sender:          sender,
+		userRepo:        userRepo,
+		smsWebAPI:       smsWebAPI,
+		emailSender:     emailSender,
+		deliveryLogRepo: deliveryLogRepo,
+		preferencesRepo: preferencesRepo,
+		scheduledRepo:   scheduledRepo,
+		pinReissuer:     pinReissuer,
+		smsLimiter:      smsLimiter,
+		smsPolicy:       smsPolicy,
+		logger:          logger,
 	}
 }
 
@@ -45,20 +107,288 @@ func (uc *NotificationUseCase) RegisterDevice(ctx context.Context, device *entit
 	return nil
 }
 
-func (uc *NotificationUseCase) NotifyOrderDelivered(ctx context.Context, userID uuid.UUID, orderID uuid.UUID, lockerCellID *uuid.UUID) error {
-	devices, err := uc.deviceRepo.ListByUserID(ctx, userID)
+// Notify delivers the event on the channels the user enabled: push falls back to SMS when it cannot
+// reach the user and events with an email template are also emailed. Events raised during the user's
+// quiet hours are queued until they end, unless the event is pickup-critical and the user allows overrides.
+func (uc *NotificationUseCase) Notify(ctx context.Context, userID uuid.UUID, event entity.NotificationEvent, data map[string]string) error {
+	prefs := uc.loadPreferences(ctx, userID)
+
+	notification, err := buildNotification(event, prefs.Language, localizeTimes(data, prefs.Location()))
 	if err != nil {
-		return fmt.Errorf("NotificationUseCase - NotifyOrderDelivered - ListDevices: %w", err)
+		return fmt.Errorf("NotificationUseCase - Notify - buildNotification: %w", err)
 	}
 
-	if len(devices) == 0 {
-		uc.logger.Debug("No devices registered for user", nil, map[string]any{
-			"userID":  userID,
-			"orderID": orderID,
-		})
+	if uc.scheduledRepo != nil && (!event.IsPickupCritical() || !prefs.CriticalOverride) {
+		if until, quiet := prefs.QuietHoursUntil(time.Now()); quiet {
+			return uc.schedule(ctx, userID, notification, until)
+		}
+	}
+
+	return uc.dispatch(ctx, userID, notification, prefs)
+}
+
+func (uc *NotificationUseCase) dispatch(ctx context.Context, userID uuid.UUID, notification entity.Notification, prefs *entity.NotificationPreferences) error {
+	notificationID := uuid.New()
+	event := notification.Event
+
+	if template, ok := emailTemplatesByEvent[event]; ok && prefs.ChannelEnabled(event, entity.NotificationChannelEmail) {
+		if err := uc.sendEmail(ctx, notificationID, userID, event, template, notification.Data); err != nil {
+			uc.logger.Warn("NotificationUseCase - Notify - sendEmail", err, map[string]any{
+				"userID": userID,
+				"event":  event,
+			})
+		}
+	}
+
+	var pushErr error
+	if prefs.ChannelEnabled(event, entity.NotificationChannelPush) {
+		var delivered bool
+		delivered, pushErr = uc.sendPush(ctx, notificationID, userID, notification)
+		if delivered {
+			return nil
+		}
+	}
+
+	if !prefs.ChannelEnabled(event, entity.NotificationChannelSMS) {
+		if pushErr != nil {
+			return fmt.Errorf("NotificationUseCase - Notify - sendPush: %w", pushErr)
+		}
 		return nil
 	}
 
+	sent, err := uc.sendSMS(ctx, notificationID, userID, notification)
+	if err != nil {
+		return fmt.Errorf("NotificationUseCase - Notify - sendSMS: %w", errors.Join(pushErr, err))
+	}
+	if !sent && pushErr != nil {
+		return fmt.Errorf("NotificationUseCase - Notify - sendPush: %w", pushErr)
+	}
+
+	return nil
+}
+
+func (uc *NotificationUseCase) schedule(ctx context.Context, userID uuid.UUID, notification entity.Notification, deliverAfter time.Time) error {
+	data := make(map[string]string, len(notification.Data))
+	for key, value := range notification.Data {
+		if key != pickupPINDataKey {
+			data[key] = value
+		}
+	}
+
+	// deliverAfter is in the user's timezone; the TIMESTAMP column keeps only the wall clock, and
+	// ClaimDue compares it with the server's.
+	deliverAfter = deliverAfter.Local()
+	_, err := uc.scheduledRepo.Create(ctx, &entity.ScheduledNotification{
+		UserID:       userID,
+		Event:        notification.Event,
+		Data:         data,
+		DeliverAfter: deliverAfter,
+	})
+	if err != nil {
+		return fmt.Errorf("NotificationUseCase - Notify - Schedule: %w", err)
+	}
+
+	uc.logger.Info("Notification queued until quiet hours end", nil, map[string]any{
+		"userID":       userID,
+		"event":        notification.Event,
+		"deliverAfter": deliverAfter,
+	})
+
+	return nil
+}
+
+func (uc *NotificationUseCase) StartScheduledNotificationsWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	uc.logger.Info("Scheduled notifications worker started", nil, map[string]any{
+		"interval": interval.String(),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			uc.logger.Info("Scheduled notifications worker stopped", nil)
+			return
+		case <-ticker.C:
+			uc.deliverScheduled(ctx, time.Now())
+		}
+	}
+}
+
+func (uc *NotificationUseCase) deliverScheduled(ctx context.Context, now time.Time) {
+	due, err := uc.scheduledRepo.ClaimDue(ctx, now, scheduledNotificationsBatchSize)
+	if err != nil {
+		uc.logger.Error("NotificationUseCase - deliverScheduled - ClaimDue", err)
+		return
+	}
+
+	for _, scheduled := range due {
+		prefs := uc.loadPreferences(ctx, scheduled.UserID)
+
+		notification, err := buildNotification(scheduled.Event, prefs.Language, uc.withPickupPIN(ctx, scheduled))
+		if err != nil {
+			uc.logger.Warn("NotificationUseCase - deliverScheduled - buildNotification", err, map[string]any{
+				"scheduledID": scheduled.ID,
+				"event":       scheduled.Event,
+			})
+			continue
+		}
+
+		if err := uc.dispatch(ctx, scheduled.UserID, notification, prefs); err != nil {
+			uc.logger.Warn("NotificationUseCase - deliverScheduled - dispatch", err, map[string]any{
+				"scheduledID": scheduled.ID,
+				"userID":      scheduled.UserID,
+			})
+			uc.reschedule(ctx, scheduled, now)
+		}
+	}
+}
+
+// withPickupPIN returns the data to render a claimed notification with. The PIN of a delivered order
+// was dropped when the notification was queued, so a new one is issued for the message being sent now.
+func (uc *NotificationUseCase) withPickupPIN(ctx context.Context, scheduled *entity.ScheduledNotification) map[string]string {
+	if scheduled.Event != entity.NotificationOrderDelivered || uc.pinReissuer == nil {
+		return scheduled.Data
+	}
+
+	orderID, err := uuid.Parse(scheduled.Data["order_id"])
+	if err != nil {
+		return scheduled.Data
+	}
+
+	pin, _, err := uc.pinReissuer.ReissuePIN(ctx, scheduled.UserID, orderID)
+	if err != nil {
+		uc.logger.Warn("NotificationUseCase - withPickupPIN - ReissuePIN", err, map[string]any{
+			"scheduledID": scheduled.ID,
+			"orderID":     orderID,
+		})
+		return scheduled.Data
+	}
+
+	data := make(map[string]string, len(scheduled.Data)+1)
+	for key, value := range scheduled.Data {
+		data[key] = value
+	}
+	data[pickupPINDataKey] = pin
+	return data
+}
+
+// reschedule puts a notification that could not be dispatched back in the queue with a growing delay.
+func (uc *NotificationUseCase) reschedule(ctx context.Context, scheduled *entity.ScheduledNotification, now time.Time) {
+	attempts := scheduled.Attempts + 1
+	if attempts >= scheduledNotificationMaxAttempts {
+		uc.logger.Error("Scheduled notification dropped after retries", nil, map[string]any{
+			"scheduledID": scheduled.ID,
+			"userID":      scheduled.UserID,
+			"event":       scheduled.Event,
+			"attempts":    attempts,
+		})
+		return
+	}
+
+	deliverAfter := now.Add(scheduledNotificationRetryDelay << (attempts - 1))
+	_, err := uc.scheduledRepo.Create(ctx, &entity.ScheduledNotification{
+		UserID:       scheduled.UserID,
+		Event:        scheduled.Event,
+		Data:         scheduled.Data,
+		DeliverAfter: deliverAfter,
+		Attempts:     attempts,
+	})
+	if err != nil {
+		uc.logger.Error("NotificationUseCase - reschedule - Create", err, map[string]any{
+			"scheduledID": scheduled.ID,
+			"userID":      scheduled.UserID,
+		})
+	}
+}
+
+func (uc *NotificationUseCase) loadPreferences(ctx context.Context, userID uuid.UUID) *entity.NotificationPreferences {
+	if uc.preferencesRepo == nil {
+		return entity.DefaultNotificationPreferences(userID)
+	}
+
+	prefs, err := uc.preferencesRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, entityError.ErrNotificationPreferencesNotFound) {
+			uc.logger.Warn("NotificationUseCase - loadPreferences - GetByUserID", err, map[string]any{
+				"userID": userID,
+			})
+		}
+		return entity.DefaultNotificationPreferences(userID)
+	}
+
+	return prefs
+}
+
+func (uc *NotificationUseCase) GetPreferences(ctx context.Context, userID uuid.UUID) (*entity.NotificationPreferences, error) {
+	prefs, err := uc.preferencesRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrNotificationPreferencesNotFound) {
+			return entity.DefaultNotificationPreferences(userID), nil
+		}
+		return nil, fmt.Errorf("NotificationUseCase - GetPreferences: %w", err)
+	}
+	return prefs, nil
+}
+
+func (uc *NotificationUseCase) UpdatePreferences(ctx context.Context, prefs *entity.NotificationPreferences) (*entity.NotificationPreferences, error) {
+	if err := validatePreferences(prefs); err != nil {
+		return nil, err
+	}
+
+	if prefs.Channels == nil {
+		prefs.Channels = map[entity.NotificationEvent][]string{}
+	}
+
+	saved, err := uc.preferencesRepo.Upsert(ctx, prefs)
+	if err != nil {
+		return nil, fmt.Errorf("NotificationUseCase - UpdatePreferences - Upsert: %w", err)
+	}
+
+	uc.logger.Info("Notification preferences updated", nil, map[string]any{
+		"userID": prefs.UserID,
+	})
+
+	return saved, nil
+}
+
+func validatePreferences(prefs *entity.NotificationPreferences) error {
+	for event, channels := range prefs.Channels {
+		if _, ok := notificationTemplates[entity.NotificationLanguageRU][event]; !ok {
+			return entityError.ErrNotificationUnknownEvent
+		}
+		for _, channel := range channels {
+			switch channel {
+			case entity.NotificationChannelPush, entity.NotificationChannelSMS, entity.NotificationChannelEmail:
+			default:
+				return entityError.ErrNotificationInvalidChannel
+			}
+		}
+	}
+
+	if (prefs.QuietHoursStart == nil) != (prefs.QuietHoursEnd == nil) {
+		return entityError.ErrNotificationInvalidQuietHours
+	}
+	if prefs.QuietHoursStart != nil &&
+		(!entity.IsValidQuietHoursTime(*prefs.QuietHoursStart) || !entity.IsValidQuietHoursTime(*prefs.QuietHoursEnd)) {
+		return entityError.ErrNotificationInvalidQuietHours
+	}
+
+	if _, err := time.LoadLocation(prefs.Timezone); err != nil || prefs.Timezone == "" {
+		return entityError.ErrNotificationInvalidTimezone
+	}
+
+	return nil
+}
+
+func (uc *NotificationUseCase) sendPush(ctx context.Context, notificationID, userID uuid.UUID, notification entity.Notification) (bool, error) {
+	devices, err := uc.deviceRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelPush, entity.NotificationStatusFailed, err)
+		return false, fmt.Errorf("NotificationUseCase - sendPush - ListDevices: %w", err)
+	}
+
 	tokens := make([]string, 0, len(devices))
 	for _, device := range devices {
 		if device.Token != "" {
@@ -67,46 +397,203 @@ func (uc *NotificationUseCase) NotifyOrderDelivered(ctx context.Context, userID
 	}
 
 	if len(tokens) == 0 {
-		uc.logger.Warn("All device tokens are empty for user", nil, map[string]any{
+		uc.logger.Debug("No device tokens for user", nil, map[string]any{
 			"userID":       userID,
-			"orderID":      orderID,
+			"event":        notification.Event,
 			"devicesCount": len(devices),
 		})
-		return nil
-	}
-
-	var lockerCellIDString *string
-	if lockerCellID != nil {
-		str := lockerCellID.String()
-		lockerCellIDString = &str
+		uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelPush, entity.NotificationStatusNoRecipient, nil)
+		return false, nil
 	}
 
-	invalidTokens, err := uc.sender.SendDeliveryNotification(ctx, tokens, orderID.String(), lockerCellIDString)
+	invalidTokens, err := uc.sender.Send(ctx, tokens, notification)
 	if err != nil {
-		uc.logger.Error("NotificationUseCase - NotifyOrderDelivered - SendNotification", err, map[string]any{
+		uc.logger.Error("NotificationUseCase - sendPush - Send", err, map[string]any{
 			"userID":      userID,
-			"orderID":     orderID,
+			"event":       notification.Event,
 			"tokensCount": len(tokens),
 		})
-		return fmt.Errorf("NotificationUseCase - NotifyOrderDelivered - SendNotification: %w", err)
+		uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelPush, entity.NotificationStatusFailed, err)
+		return false, fmt.Errorf("NotificationUseCase - sendPush - Send: %w", err)
+	}
+
+	for _, invalidToken := range invalidTokens {
+		if err := uc.deviceRepo.DeleteByToken(ctx, invalidToken); err != nil {
+			uc.logger.Warn("NotificationUseCase - sendPush - DeleteInvalidToken", err, map[string]any{
+				"token": invalidToken,
+			})
+		}
+	}
+
+	if len(invalidTokens) >= len(tokens) {
+		uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelPush, entity.NotificationStatusNoRecipient, nil)
+		return false, nil
 	}
 
-	uc.logger.Info("Delivery notification sent", nil, map[string]any{
+	uc.logger.Info("Push notification sent", nil, map[string]any{
 		"userID":        userID,
-		"orderID":       orderID,
-		"sentTo":        len(tokens),
+		"event":         notification.Event,
+		"sentTo":        len(tokens) - len(invalidTokens),
 		"invalidTokens": len(invalidTokens),
 	})
+	uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelPush, entity.NotificationStatusSent, nil)
 
-	if len(invalidTokens) > 0 {
-		for _, invalidToken := range invalidTokens {
-			if err := uc.deviceRepo.DeleteByToken(ctx, invalidToken); err != nil {
-				uc.logger.Warn("NotificationUseCase - NotifyOrderDelivered - DeleteInvalidToken", err, map[string]any{
-					"token": invalidToken,
-				})
-			}
+	return true, nil
+}
+
+func (uc *NotificationUseCase) sendSMS(ctx context.Context, notificationID, userID uuid.UUID, notification entity.Notification) (bool, error) {
+	if uc.smsWebAPI == nil || uc.userRepo == nil {
+		return false, nil
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelSMS, entity.NotificationStatusFailed, err)
+		return false, fmt.Errorf("NotificationUseCase - sendSMS - GetUser: %w", err)
+	}
+
+	phone := user.GetPhoneNumber()
+	if phone == "" {
+		uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelSMS, entity.NotificationStatusNoRecipient, nil)
+		return false, nil
+	}
+
+	if uc.smsLimiter != nil && uc.smsPolicy.Limit > 0 {
+		allowed, err := uc.smsLimiter.Allow(ctx, userID, uc.smsPolicy.Limit, uc.smsPolicy.Window)
+		if err != nil {
+			uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelSMS, entity.NotificationStatusFailed, err)
+			return false, fmt.Errorf("NotificationUseCase - sendSMS - Allow: %w", err)
+		}
+		if !allowed {
+			uc.logger.Info("SMS fallback rate limited", nil, map[string]any{
+				"userID": userID,
+				"event":  notification.Event,
+			})
+			uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelSMS, entity.NotificationStatusRateLimited, nil)
+			return false, nil
 		}
 	}
 
+	text := fmt.Sprintf("%s. %s", notification.Title, notification.Body)
+	if err := uc.smsWebAPI.SendMessage(ctx, phone, text); err != nil {
+		uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelSMS, entity.NotificationStatusFailed, err)
+		return false, fmt.Errorf("NotificationUseCase - sendSMS - SendMessage: %w", err)
+	}
+
+	uc.logger.Info("SMS notification sent", nil, map[string]any{
+		"userID": userID,
+		"event":  notification.Event,
+	})
+	uc.recordDelivery(ctx, notificationID, userID, notification.Event, entity.NotificationChannelSMS, entity.NotificationStatusSent, nil)
+
+	return true, nil
+}
+
+func (uc *NotificationUseCase) SendPasswordResetEmail(ctx context.Context, userID uuid.UUID, code string, ttl time.Duration) error {
+	err := uc.sendEmail(ctx, uuid.New(), userID, entity.NotificationPasswordReset, entity.EmailPasswordReset, map[string]string{
+		"code":               code,
+		"expires_in_minutes": strconv.Itoa(int(ttl / time.Minute)),
+	})
+	if err != nil {
+		return fmt.Errorf("NotificationUseCase - SendPasswordResetEmail: %w", err)
+	}
+	return nil
+}
+
+func (uc *NotificationUseCase) RecordEmailBounce(ctx context.Context, messageID, reason string) (*entity.NotificationDelivery, error) {
+	delivery, err := uc.deliveryLogRepo.MarkBounced(ctx, messageID, reason)
+	if err != nil {
+		return nil, fmt.Errorf("NotificationUseCase - RecordEmailBounce: %w", err)
+	}
+
+	uc.logger.Info("Email bounce recorded", nil, map[string]any{
+		"messageID": messageID,
+		"userID":    delivery.UserID,
+	})
+
+	return delivery, nil
+}
+
+func (uc *NotificationUseCase) sendEmail(ctx context.Context, notificationID, userID uuid.UUID, event entity.NotificationEvent, template entity.EmailTemplate, data map[string]string) error {
+	if uc.emailSender == nil || uc.userRepo == nil {
+		return nil
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("NotificationUseCase - sendEmail - GetUser: %w", err)
+	}
+
+	email := user.GetEmail()
+	if email == "" {
+		uc.recordEmailDelivery(ctx, notificationID, userID, event, entity.NotificationStatusNoRecipient, nil, nil)
+		return entityError.ErrNotificationNoEmail
+	}
+
+	payload := make(map[string]string, len(data)+1)
+	for key, value := range data {
+		payload[key] = value
+	}
+	payload["full_name"] = user.FullName
+
+	messageID, err := uc.emailSender.SendEmail(ctx, email, template, payload)
+	if err != nil {
+		status := entity.NotificationStatusFailed
+		if errors.Is(err, webapiError.ErrEmailRejected) {
+			status = entity.NotificationStatusBounced
+		}
+		uc.recordEmailDelivery(ctx, notificationID, userID, event, status, &messageID, err)
+		return fmt.Errorf("NotificationUseCase - sendEmail - SendEmail: %w", err)
+	}
+
+	uc.recordEmailDelivery(ctx, notificationID, userID, event, entity.NotificationStatusSent, &messageID, nil)
 	return nil
 }
+
+func (uc *NotificationUseCase) recordEmailDelivery(ctx context.Context, notificationID, userID uuid.UUID, event entity.NotificationEvent, status string, messageID *string, cause error) {
+	if messageID != nil && *messageID == "" {
+		messageID = nil
+	}
+	uc.saveDelivery(ctx, &entity.NotificationDelivery{
+		NotificationID: notificationID,
+		UserID:         userID,
+		Event:          event,
+		Channel:        entity.NotificationChannelEmail,
+		Status:         status,
+		MessageID:      messageID,
+	}, cause)
+}
+
+func (uc *NotificationUseCase) recordDelivery(ctx context.Context, notificationID, userID uuid.UUID, event entity.NotificationEvent, channel, status string, cause error) {
+	uc.saveDelivery(ctx, &entity.NotificationDelivery{
+		NotificationID: notificationID,
+		UserID:         userID,
+		Event:          event,
+		Channel:        channel,
+		Status:         status,
+	}, cause)
+}
+
+func (uc *NotificationUseCase) saveDelivery(ctx context.Context, record *entity.NotificationDelivery, cause error) {
+	if uc.deliveryLogRepo == nil {
+		return
+	}
+
+	if cause != nil {
+		message := cause.Error()
+		record.Error = &message
+	}
+
+	if _, err := uc.deliveryLogRepo.Create(ctx, record); err != nil {
+		uc.logger.Warn("NotificationUseCase - saveDelivery - Create", err, map[string]any{
+			"notificationID": record.NotificationID,
+			"channel":        record.Channel,
+		})
+	}
+}
+
+func (uc *NotificationUseCase) NotifyOrderCollected(ctx context.Context, userID uuid.UUID, orderID uuid.UUID) error {
+	return uc.Notify(ctx, userID, entity.NotificationCollectedByGrant, map[string]string{
+		"order_id": orderID.String(),
+	})
+}
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+)
+
+// pickupPINDataKey holds the plaintext pickup PIN in notification data. It is only ever set on
+// notifications being sent right away.
+const pickupPINDataKey = "pickup_pin"
+
+// collectedAtDataKey is set as RFC 3339 by the caller and shown to the user in their own timezone.
+const collectedAtDataKey = "collected_at"
+
+const notificationTimeLayout = "02.01.2006 15:04"
+
+type notificationTemplate struct {
+	title string
+	body  func(data map[string]string) string
+}
+
+func staticBody(body string) func(map[string]string) string {
+	return func(map[string]string) string { return body }
+}
+
+var notificationTemplates = map[string]map[entity.NotificationEvent]notificationTemplate{
+	entity.NotificationLanguageRU: {
+		entity.NotificationOrderAccepted: {
+			title: "Заказ принят",
+			body:  staticBody("Мы приняли ваш заказ и готовим его к отправке"),
+		},
+		entity.NotificationDroneAssigned: {
+			title: "Дрон назначен",
+			body:  staticBody("Для вашего заказа назначен дрон"),
+		},
+		entity.NotificationDroneDeparted: {
+			title: "Дрон в пути",
+			body:  staticBody("Дрон вылетел к постамату с вашим заказом"),
+		},
+		entity.NotificationArrivingSoon: {
+			title: "Дрон скоро прибудет",
+			body: func(data map[string]string) string {
+				if minutes := data["eta_minutes"]; minutes != "" {
+					return fmt.Sprintf("Дрон прибудет к постамату примерно через %s мин.", minutes)
+				}
+				return "Дрон скоро прибудет к постамату"
+			},
+		},
+		entity.NotificationOrderDelivered: {
+			title: "Посылка доставлена",
+			body: func(data map[string]string) string {
+				if pin := data[pickupPINDataKey]; pin != "" {
+					return fmt.Sprintf("Заказ готов к выдаче. PIN-код для получения: %s", pin)
+				}
+				return "Заказ готов к выдаче"
+			},
+		},
+		entity.NotificationDeliveryFailed: {
+			title: "Доставка не удалась",
+			body:  staticBody("Не удалось доставить заказ. Мы свяжемся с вами"),
+		},
+		entity.NotificationPickupReminder: {
+			title: "Заказ ждёт вас",
+			body:  staticBody("Не забудьте забрать заказ из постамата"),
+		},
+		entity.NotificationOrderCollected: {
+			title: "Заказ получен",
+			body:  staticBody("Спасибо, что воспользовались SkyPost Delivery"),
+		},
+		entity.NotificationCollectedByGrant: {
+			title: "Посылка получена",
+			body:  staticBody("Заказ забран по вашему разрешению"),
+		},
+		entity.NotificationReturnCompleted: {
+			title: "Возврат оформлен",
+			body:  staticBody("Заказ отменён, возврат выполнен"),
+		},
+	},
+	entity.NotificationLanguageEN: {
+		entity.NotificationOrderAccepted: {
+			title: "Order accepted",
+			body:  staticBody("We have accepted your order and are preparing it for dispatch"),
+		},
+		entity.NotificationDroneAssigned: {
+			title: "Drone assigned",
+			body:  staticBody("A drone has been assigned to your order"),
+		},
+		entity.NotificationDroneDeparted: {
+			title: "Drone on the way",
+			body:  staticBody("The drone has departed to the parcel locker with your order"),
+		},
+		entity.NotificationArrivingSoon: {
+			title: "Drone arriving soon",
+			body: func(data map[string]string) string {
+				if minutes := data["eta_minutes"]; minutes != "" {
+					return fmt.Sprintf("The drone will reach the parcel locker in about %s min.", minutes)
+				}
+				return "The drone will reach the parcel locker soon"
+			},
+		},
+		entity.NotificationOrderDelivered: {
+			title: "Parcel delivered",
+			body: func(data map[string]string) string {
+				if pin := data[pickupPINDataKey]; pin != "" {
+					return fmt.Sprintf("Your order is ready for pickup. Pickup PIN: %s", pin)
+				}
+				return "Your order is ready for pickup"
+			},
+		},
+		entity.NotificationDeliveryFailed: {
+			title: "Delivery failed",
+			body:  staticBody("We could not deliver your order. We will contact you"),
+		},
+		entity.NotificationPickupReminder: {
+			title: "Your order is waiting",
+			body:  staticBody("Don't forget to collect your order from the parcel locker"),
+		},
+		entity.NotificationOrderCollected: {
+			title: "Order collected",
+			body:  staticBody("Thank you for using SkyPost Delivery"),
+		},
+		entity.NotificationCollectedByGrant: {
+			title: "Parcel collected",
+			body:  staticBody("Your order was collected using your pickup permission"),
+		},
+		entity.NotificationReturnCompleted: {
+			title: "Return completed",
+			body:  staticBody("Your order was cancelled and returned"),
+		},
+	},
+}
+
+var emailTemplatesByEvent = map[entity.NotificationEvent]entity.EmailTemplate{
+	entity.NotificationOrderAccepted:  entity.EmailOrderConfirmation,
+	entity.NotificationOrderDelivered: entity.EmailOrderDelivered,
+	entity.NotificationOrderCollected: entity.EmailReceipt,
+}
+
+// buildNotification renders the event in the requested language, falling back to Russian.
+func buildNotification(event entity.NotificationEvent, language string, data map[string]string) (entity.Notification, error) {
+	tmpl, ok := notificationTemplates[language][event]
+	if !ok {
+		tmpl, ok = notificationTemplates[entity.NotificationLanguageRU][event]
+	}
+	if !ok {
+		return entity.Notification{}, entityError.ErrNotificationUnknownEvent
+	}
+
+	if data == nil {
+		data = map[string]string{}
+	}
+
+	return entity.Notification{
+		Event: event,
+		Title: tmpl.title,
+		Body:  tmpl.body(data),
+		Data:  data,
+	}, nil
+}
+
+// localizeTimes formats the timestamps in data in the user's timezone. Values that are not RFC 3339
+// are left as they are.
+func localizeTimes(data map[string]string, loc *time.Location) map[string]string {
+	value, ok := data[collectedAtDataKey]
+	if !ok {
+		return data
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return data
+	}
+
+	localized := make(map[string]string, len(data))
+	for key, v := range data {
+		localized[key] = v
+	}
+	localized[collectedAtDataKey] = at.In(loc).Format(notificationTimeLayout)
+	return localized
+}
+
+func orderNotificationData(order *entity.Order) map[string]string {
+	data := map[string]string{
+		"order_id": order.ID.String(),
+	}
+	if order.LockerCellID != nil {
+		data["locker_cell_id"] = order.LockerCellID.String()
+	}
+	return data
+}
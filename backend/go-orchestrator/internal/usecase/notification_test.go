@@ -0,0 +1,691 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	webapiError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo/webapi/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockOrderEventNotifier struct {
+	mock.Mock
+}
+
+func (m *mockOrderEventNotifier) Notify(ctx context.Context, userID uuid.UUID, event entity.NotificationEvent, data map[string]string) error {
+	args := m.Called(ctx, userID, event, data)
+	return args.Error(0)
+}
+
+func TestNotificationUseCase_Notify_Success(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockLogger := new(mocks.MockLogger)
+	mockDeliveryLogRepo := new(mocks.MockNotificationDeliveryRepo)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, nil, nil, nil, mockDeliveryLogRepo, nil, nil, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	orderID := uuid.New()
+
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{
+		{UserID: userID, Token: "token-1"},
+		{UserID: userID, Token: ""},
+		{UserID: userID, Token: "token-2"},
+	}, nil)
+	mockSender.On("Send", ctx, []string{"token-1", "token-2"}, mock.MatchedBy(func(n entity.Notification) bool {
+		return n.Event == entity.NotificationDroneDeparted &&
+			n.Title != "" &&
+			n.Data["order_id"] == orderID.String()
+	})).Return([]string{"token-2"}, nil)
+	mockDeviceRepo.On("DeleteByToken", ctx, "token-2").Return(nil)
+	mockDeliveryLogRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.NotificationDelivery) bool {
+		return d.Channel == entity.NotificationChannelPush && d.Status == entity.NotificationStatusSent
+	})).Return(&entity.NotificationDelivery{}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationDroneDeparted, map[string]string{"order_id": orderID.String()})
+
+	assert.NoError(t, err)
+	mockSender.AssertExpectations(t)
+	mockDeviceRepo.AssertExpectations(t)
+	mockDeliveryLogRepo.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_Notify_UnknownEvent(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, nil, nil, nil, nil, nil, nil, nil, nil, SMSFallbackPolicy{}, new(mocks.MockLogger))
+
+	err := uc.Notify(context.Background(), uuid.New(), entity.NotificationEvent("unknown"), nil)
+
+	assert.ErrorIs(t, err, entityError.ErrNotificationUnknownEvent)
+	mockDeviceRepo.AssertNotCalled(t, "ListByUserID", mock.Anything, mock.Anything)
+	mockSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNotificationUseCase_Notify_NoDevicesFallsBackToSMS(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockSMS := new(mocks.MockSMSAeroWebAPI)
+	mockLimiter := new(mocks.MockSMSRateLimiter)
+	mockLogger := new(mocks.MockLogger)
+	policy := SMSFallbackPolicy{Limit: 3, Window: time.Hour}
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, mockUserRepo, mockSMS, nil, nil, nil, nil, nil, mockLimiter, policy, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	phone := "+79991234567"
+
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{}, nil)
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID, PhoneNumber: &phone}, nil)
+	mockLimiter.On("Allow", ctx, userID, 3, time.Hour).Return(true, nil)
+	mockSMS.On("SendMessage", ctx, phone, mock.MatchedBy(func(text string) bool {
+		return strings.Contains(text, "123456")
+	})).Return(nil)
+	mockLogger.On("Debug", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationOrderDelivered, map[string]string{"pickup_pin": "123456"})
+
+	assert.NoError(t, err)
+	mockSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+	mockSMS.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_Notify_PushFailureFallsBackToSMS(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockSMS := new(mocks.MockSMSAeroWebAPI)
+	mockDeliveryLogRepo := new(mocks.MockNotificationDeliveryRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, mockUserRepo, mockSMS, nil, mockDeliveryLogRepo, nil, nil, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	phone := "+79991234567"
+
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{{UserID: userID, Token: "token"}}, nil)
+	mockSender.On("Send", ctx, []string{"token"}, mock.Anything).Return(nil, errors.New("fcm unavailable"))
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID, PhoneNumber: &phone}, nil)
+	mockSMS.On("SendMessage", ctx, phone, mock.Anything).Return(nil)
+	mockDeliveryLogRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.NotificationDelivery) bool {
+		return d.Channel == entity.NotificationChannelPush && d.Status == entity.NotificationStatusFailed && d.Error != nil
+	})).Return(&entity.NotificationDelivery{}, nil).Once()
+	mockDeliveryLogRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.NotificationDelivery) bool {
+		return d.Channel == entity.NotificationChannelSMS && d.Status == entity.NotificationStatusSent
+	})).Return(&entity.NotificationDelivery{}, nil).Once()
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationDeliveryFailed, nil)
+
+	assert.NoError(t, err)
+	mockDeliveryLogRepo.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_Notify_SMSRateLimited(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockSMS := new(mocks.MockSMSAeroWebAPI)
+	mockLimiter := new(mocks.MockSMSRateLimiter)
+	mockDeliveryLogRepo := new(mocks.MockNotificationDeliveryRepo)
+	mockLogger := new(mocks.MockLogger)
+	policy := SMSFallbackPolicy{Limit: 1, Window: time.Hour}
+	uc := NewNotificationUseCase(mockDeviceRepo, new(mocks.MockSender), mockUserRepo, mockSMS, nil, mockDeliveryLogRepo, nil, nil, nil, mockLimiter, policy, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	phone := "+79991234567"
+
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{}, nil)
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID, PhoneNumber: &phone}, nil)
+	mockLimiter.On("Allow", ctx, userID, 1, time.Hour).Return(false, nil)
+	mockDeliveryLogRepo.On("Create", ctx, mock.Anything).Return(&entity.NotificationDelivery{}, nil)
+	mockLogger.On("Debug", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationPickupReminder, nil)
+
+	assert.NoError(t, err)
+	mockSMS.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything, mock.Anything)
+	mockDeliveryLogRepo.AssertCalled(t, "Create", ctx, mock.MatchedBy(func(d *entity.NotificationDelivery) bool {
+		return d.Channel == entity.NotificationChannelSMS && d.Status == entity.NotificationStatusRateLimited
+	}))
+}
+
+func TestNotificationUseCase_Notify_SendsEmailForMappedEvent(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockEmail := new(mocks.MockEmailSender)
+	mockDeliveryLogRepo := new(mocks.MockNotificationDeliveryRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, mockUserRepo, nil, mockEmail, mockDeliveryLogRepo, nil, nil, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	email := "user@example.com"
+
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID, FullName: "Иван Иванов", Email: &email}, nil)
+	mockEmail.On("SendEmail", ctx, email, entity.EmailOrderDelivered, mock.MatchedBy(func(data map[string]string) bool {
+		return data["full_name"] == "Иван Иванов" && data["pickup_pin"] == "123456"
+	})).Return("<msg-1@skypost.local>", nil)
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{{UserID: userID, Token: "token"}}, nil)
+	mockSender.On("Send", ctx, []string{"token"}, mock.Anything).Return(nil, nil)
+	mockDeliveryLogRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.NotificationDelivery) bool {
+		return d.Channel == entity.NotificationChannelEmail &&
+			d.Status == entity.NotificationStatusSent &&
+			d.MessageID != nil && *d.MessageID == "<msg-1@skypost.local>"
+	})).Return(&entity.NotificationDelivery{}, nil).Once()
+	mockDeliveryLogRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.NotificationDelivery) bool {
+		return d.Channel == entity.NotificationChannelPush
+	})).Return(&entity.NotificationDelivery{}, nil).Once()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationOrderDelivered, map[string]string{"pickup_pin": "123456"})
+
+	assert.NoError(t, err)
+	mockEmail.AssertExpectations(t)
+	mockDeliveryLogRepo.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_Notify_EmailRejectedRecordedAsBounced(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockEmail := new(mocks.MockEmailSender)
+	mockDeliveryLogRepo := new(mocks.MockNotificationDeliveryRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, mockUserRepo, nil, mockEmail, mockDeliveryLogRepo, nil, nil, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	email := "missing@example.com"
+
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID, Email: &email}, nil)
+	mockEmail.On("SendEmail", ctx, email, entity.EmailOrderConfirmation, mock.Anything).
+		Return("<msg-2@skypost.local>", webapiError.ErrEmailRejected)
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{{UserID: userID, Token: "token"}}, nil)
+	mockSender.On("Send", ctx, []string{"token"}, mock.Anything).Return(nil, nil)
+	mockDeliveryLogRepo.On("Create", ctx, mock.Anything).Return(&entity.NotificationDelivery{}, nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationOrderAccepted, nil)
+
+	assert.NoError(t, err)
+	mockDeliveryLogRepo.AssertCalled(t, "Create", ctx, mock.MatchedBy(func(d *entity.NotificationDelivery) bool {
+		return d.Channel == entity.NotificationChannelEmail &&
+			d.Status == entity.NotificationStatusBounced &&
+			d.Error != nil
+	}))
+}
+
+func TestNotificationUseCase_SendPasswordResetEmail_NoEmail(t *testing.T) {
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockEmail := new(mocks.MockEmailSender)
+	mockDeliveryLogRepo := new(mocks.MockNotificationDeliveryRepo)
+	uc := NewNotificationUseCase(nil, nil, mockUserRepo, nil, mockEmail, mockDeliveryLogRepo, nil, nil, nil, nil, SMSFallbackPolicy{}, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID}, nil)
+	mockDeliveryLogRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.NotificationDelivery) bool {
+		return d.Event == entity.NotificationPasswordReset && d.Status == entity.NotificationStatusNoRecipient
+	})).Return(&entity.NotificationDelivery{}, nil)
+
+	err := uc.SendPasswordResetEmail(ctx, userID, "1234", 10*time.Minute)
+
+	assert.ErrorIs(t, err, entityError.ErrNotificationNoEmail)
+	mockEmail.AssertNotCalled(t, "SendEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockDeliveryLogRepo.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_RecordEmailBounce(t *testing.T) {
+	mockDeliveryLogRepo := new(mocks.MockNotificationDeliveryRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(nil, nil, nil, nil, nil, mockDeliveryLogRepo, nil, nil, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	messageID := "<msg-3@skypost.local>"
+	userID := uuid.New()
+
+	mockDeliveryLogRepo.On("MarkBounced", ctx, messageID, "mailbox full").Return(&entity.NotificationDelivery{
+		UserID:    userID,
+		Channel:   entity.NotificationChannelEmail,
+		Status:    entity.NotificationStatusBounced,
+		MessageID: &messageID,
+	}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	delivery, err := uc.RecordEmailBounce(ctx, messageID, "mailbox full")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.NotificationStatusBounced, delivery.Status)
+}
+
+func TestNotificationUseCase_RecordEmailBounce_NotFound(t *testing.T) {
+	mockDeliveryLogRepo := new(mocks.MockNotificationDeliveryRepo)
+	uc := NewNotificationUseCase(nil, nil, nil, nil, nil, mockDeliveryLogRepo, nil, nil, nil, nil, SMSFallbackPolicy{}, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	mockDeliveryLogRepo.On("MarkBounced", ctx, "<unknown>", "").Return(nil, entityError.ErrNotificationDeliveryNotFound)
+
+	_, err := uc.RecordEmailBounce(ctx, "<unknown>", "")
+
+	assert.ErrorIs(t, err, entityError.ErrNotificationDeliveryNotFound)
+}
+
+// quietAllDay returns a quiet-hours window that always contains the current time.
+func quietAllDay(userID uuid.UUID) *entity.NotificationPreferences {
+	return quietAllDayIn(userID, "UTC")
+}
+
+func quietAllDayIn(userID uuid.UUID, timezone string) *entity.NotificationPreferences {
+	prefs := entity.DefaultNotificationPreferences(userID)
+	prefs.Timezone = timezone
+	now := time.Now().In(prefs.Location())
+	start := now.Add(-time.Hour).Format("15:04")
+	end := now.Add(time.Hour).Format("15:04")
+	prefs.QuietHoursStart = &start
+	prefs.QuietHoursEnd = &end
+	return prefs
+}
+
+func TestNotificationUseCase_Notify_QueuedDuringQuietHours(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockPrefsRepo := new(mocks.MockNotificationPreferencesRepo)
+	mockScheduledRepo := new(mocks.MockScheduledNotificationRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, new(mocks.MockSender), nil, nil, nil, nil, mockPrefsRepo, mockScheduledRepo, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockPrefsRepo.On("GetByUserID", ctx, userID).Return(quietAllDay(userID), nil)
+	mockScheduledRepo.On("Create", ctx, mock.MatchedBy(func(n *entity.ScheduledNotification) bool {
+		return n.UserID == userID &&
+			n.Event == entity.NotificationDroneDeparted &&
+			n.DeliverAfter.After(time.Now())
+	})).Return(&entity.ScheduledNotification{}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationDroneDeparted, nil)
+
+	assert.NoError(t, err)
+	mockScheduledRepo.AssertExpectations(t)
+	mockDeviceRepo.AssertNotCalled(t, "ListByUserID", mock.Anything, mock.Anything)
+}
+
+func TestNotificationUseCase_Notify_QueuedOnServerClockForOtherTimezone(t *testing.T) {
+	serverLocal := time.Local
+	time.Local = time.UTC
+	t.Cleanup(func() { time.Local = serverLocal })
+
+	mockPrefsRepo := new(mocks.MockNotificationPreferencesRepo)
+	mockScheduledRepo := new(mocks.MockScheduledNotificationRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(new(mocks.MockDeviceRepo), new(mocks.MockSender), nil, nil, nil, nil, mockPrefsRepo, mockScheduledRepo, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	prefs := quietAllDayIn(userID, "Europe/Moscow")
+	quietEnd, quiet := prefs.QuietHoursUntil(time.Now())
+	require.True(t, quiet)
+
+	mockPrefsRepo.On("GetByUserID", ctx, userID).Return(prefs, nil)
+	mockScheduledRepo.On("Create", ctx, mock.MatchedBy(func(n *entity.ScheduledNotification) bool {
+		// The wall clock stored in the TIMESTAMP column must be the server's, three hours behind Moscow.
+		return n.DeliverAfter.Location() == time.Local &&
+			n.DeliverAfter.Equal(quietEnd) &&
+			n.DeliverAfter.Hour() == quietEnd.UTC().Hour()
+	})).Return(&entity.ScheduledNotification{}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationDroneDeparted, nil)
+
+	assert.NoError(t, err)
+	mockScheduledRepo.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_Notify_CollectedAtInUserTimezone(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockPrefsRepo := new(mocks.MockNotificationPreferencesRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, nil, nil, nil, nil, mockPrefsRepo, nil, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	prefs := entity.DefaultNotificationPreferences(userID)
+	prefs.Timezone = "Asia/Vladivostok"
+	prefs.Channels[entity.NotificationOrderCollected] = []string{entity.NotificationChannelPush}
+
+	mockPrefsRepo.On("GetByUserID", ctx, userID).Return(prefs, nil)
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{{UserID: userID, Token: "token"}}, nil)
+	mockSender.On("Send", ctx, []string{"token"}, mock.MatchedBy(func(n entity.Notification) bool {
+		return n.Data["collected_at"] == "18.10.2026 19:30"
+	})).Return(nil, nil).Once()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationOrderCollected, map[string]string{
+		"collected_at": "2026-10-18T12:30:00+03:00",
+	})
+
+	assert.NoError(t, err)
+	mockSender.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_Notify_PickupCriticalOverridesQuietHours(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockPrefsRepo := new(mocks.MockNotificationPreferencesRepo)
+	mockScheduledRepo := new(mocks.MockScheduledNotificationRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, nil, nil, nil, nil, mockPrefsRepo, mockScheduledRepo, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockPrefsRepo.On("GetByUserID", ctx, userID).Return(quietAllDay(userID), nil)
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{{UserID: userID, Token: "token"}}, nil)
+	mockSender.On("Send", ctx, []string{"token"}, mock.Anything).Return(nil, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationOrderDelivered, map[string]string{"pickup_pin": "123456"})
+
+	assert.NoError(t, err)
+	mockSender.AssertExpectations(t)
+	mockScheduledRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestNotificationUseCase_Notify_RespectsDisabledChannelsAndLanguage(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockSMS := new(mocks.MockSMSAeroWebAPI)
+	mockPrefsRepo := new(mocks.MockNotificationPreferencesRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, mockUserRepo, mockSMS, nil, nil, mockPrefsRepo, nil, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	phone := "+79991234567"
+
+	prefs := entity.DefaultNotificationPreferences(userID)
+	prefs.Language = entity.NotificationLanguageEN
+	prefs.Channels[entity.NotificationDroneDeparted] = []string{entity.NotificationChannelSMS}
+
+	mockPrefsRepo.On("GetByUserID", ctx, userID).Return(prefs, nil)
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID, PhoneNumber: &phone}, nil)
+	mockSMS.On("SendMessage", ctx, phone, "Drone on the way. The drone has departed to the parcel locker with your order").Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationDroneDeparted, nil)
+
+	assert.NoError(t, err)
+	mockSMS.AssertExpectations(t)
+	mockDeviceRepo.AssertNotCalled(t, "ListByUserID", mock.Anything, mock.Anything)
+	mockSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNotificationUseCase_DeliverScheduled(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockScheduledRepo := new(mocks.MockScheduledNotificationRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, nil, nil, nil, nil, nil, mockScheduledRepo, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	now := time.Now()
+	userID := uuid.New()
+
+	mockScheduledRepo.On("ClaimDue", ctx, now, scheduledNotificationsBatchSize).Return([]*entity.ScheduledNotification{
+		{ID: uuid.New(), UserID: userID, Event: entity.NotificationPickupReminder, Data: map[string]string{}},
+	}, nil)
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{{UserID: userID, Token: "token"}}, nil)
+	mockSender.On("Send", ctx, []string{"token"}, mock.MatchedBy(func(n entity.Notification) bool {
+		return n.Event == entity.NotificationPickupReminder
+	})).Return(nil, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	uc.deliverScheduled(ctx, now)
+
+	mockSender.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_Notify_QueuedWithoutPickupPIN(t *testing.T) {
+	mockPrefsRepo := new(mocks.MockNotificationPreferencesRepo)
+	mockScheduledRepo := new(mocks.MockScheduledNotificationRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(new(mocks.MockDeviceRepo), new(mocks.MockSender), nil, nil, nil, nil, mockPrefsRepo, mockScheduledRepo, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	prefs := quietAllDay(userID)
+	prefs.CriticalOverride = false
+
+	mockPrefsRepo.On("GetByUserID", ctx, userID).Return(prefs, nil)
+	mockScheduledRepo.On("Create", ctx, mock.MatchedBy(func(n *entity.ScheduledNotification) bool {
+		_, hasPIN := n.Data[pickupPINDataKey]
+		return n.Event == entity.NotificationOrderDelivered && n.Data["order_id"] == "order-1" && !hasPIN
+	})).Return(&entity.ScheduledNotification{}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.Notify(ctx, userID, entity.NotificationOrderDelivered, map[string]string{"order_id": "order-1", pickupPINDataKey: "123456"})
+
+	assert.NoError(t, err)
+	mockScheduledRepo.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_DeliverScheduled_ReissuesPickupPIN(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockSender := new(mocks.MockSender)
+	mockScheduledRepo := new(mocks.MockScheduledNotificationRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	pinUC := NewPickupPINUseCase(mockPickupPINRepo, mockOrderRepo, mockDeliveryRepo, mockQRGenerator, nil, mockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, mockSender, nil, nil, nil, nil, nil, mockScheduledRepo, pinUC, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	now := time.Now()
+	userID := uuid.New()
+	cellID := uuid.New()
+	order := &entity.Order{ID: uuid.New(), UserID: userID, Status: "delivered", LockerCellID: &cellID}
+	automatID := uuid.New()
+
+	mockScheduledRepo.On("ClaimDue", ctx, now, scheduledNotificationsBatchSize).Return([]*entity.ScheduledNotification{
+		{ID: uuid.New(), UserID: userID, Event: entity.NotificationOrderDelivered, Data: map[string]string{"order_id": order.ID.String()}},
+	}, nil)
+	mockOrderRepo.On("GetByID", ctx, order.ID).Return(order, nil)
+	mockDeliveryRepo.On("GetByOrderID", ctx, order.ID).Return(&entity.Delivery{OrderID: order.ID, ParcelAutomatID: automatID}, nil)
+	mockQRGenerator.On("GeneratePickupPIN").Return("654321", "hash", nil)
+	mockPickupPINRepo.On("Upsert", ctx, mock.Anything).Return(&entity.PickupPIN{OrderID: order.ID, ParcelAutomatID: automatID}, nil)
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return([]*entity.Device{{UserID: userID, Token: "token"}}, nil)
+	mockSender.On("Send", ctx, []string{"token"}, mock.MatchedBy(func(n entity.Notification) bool {
+		return n.Data[pickupPINDataKey] == "654321" && strings.Contains(n.Body, "654321")
+	})).Return(nil, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	uc.deliverScheduled(ctx, now)
+
+	mockSender.AssertExpectations(t)
+	mockPickupPINRepo.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_DeliverScheduled_ReschedulesFailedDispatch(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockScheduledRepo := new(mocks.MockScheduledNotificationRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, new(mocks.MockSender), nil, nil, nil, nil, nil, mockScheduledRepo, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	now := time.Now()
+	userID := uuid.New()
+	data := map[string]string{"order_id": "order-1"}
+
+	mockScheduledRepo.On("ClaimDue", ctx, now, scheduledNotificationsBatchSize).Return([]*entity.ScheduledNotification{
+		{ID: uuid.New(), UserID: userID, Event: entity.NotificationPickupReminder, Data: data, Attempts: 2},
+	}, nil)
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return(nil, errors.New("db down"))
+	mockScheduledRepo.On("Create", ctx, &entity.ScheduledNotification{
+		UserID:       userID,
+		Event:        entity.NotificationPickupReminder,
+		Data:         data,
+		DeliverAfter: now.Add(4 * scheduledNotificationRetryDelay),
+		Attempts:     3,
+	}).Return(&entity.ScheduledNotification{}, nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	uc.deliverScheduled(ctx, now)
+
+	mockScheduledRepo.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_DeliverScheduled_DropsAfterMaxAttempts(t *testing.T) {
+	mockDeviceRepo := new(mocks.MockDeviceRepo)
+	mockScheduledRepo := new(mocks.MockScheduledNotificationRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewNotificationUseCase(mockDeviceRepo, new(mocks.MockSender), nil, nil, nil, nil, nil, mockScheduledRepo, nil, nil, SMSFallbackPolicy{}, mockLogger)
+
+	ctx := context.Background()
+	now := time.Now()
+	userID := uuid.New()
+
+	mockScheduledRepo.On("ClaimDue", ctx, now, scheduledNotificationsBatchSize).Return([]*entity.ScheduledNotification{
+		{ID: uuid.New(), UserID: userID, Event: entity.NotificationPickupReminder, Attempts: scheduledNotificationMaxAttempts - 1},
+	}, nil)
+	mockDeviceRepo.On("ListByUserID", ctx, userID).Return(nil, errors.New("db down"))
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", "Scheduled notification dropped after retries", nil, mock.Anything).Return()
+
+	uc.deliverScheduled(ctx, now)
+
+	mockScheduledRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestNotificationUseCase_UpdatePreferences_Validation(t *testing.T) {
+	mockPrefsRepo := new(mocks.MockNotificationPreferencesRepo)
+	uc := NewNotificationUseCase(nil, nil, nil, nil, nil, nil, mockPrefsRepo, nil, nil, nil, SMSFallbackPolicy{}, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	start := "22:00"
+	badTime := "25:00"
+
+	tests := []struct {
+		name    string
+		mutate  func(p *entity.NotificationPreferences)
+		wantErr error
+	}{
+		{"unknown event", func(p *entity.NotificationPreferences) {
+			p.Channels["unknown"] = []string{entity.NotificationChannelPush}
+		}, entityError.ErrNotificationUnknownEvent},
+		{"invalid channel", func(p *entity.NotificationPreferences) {
+			p.Channels[entity.NotificationOrderAccepted] = []string{"pigeon"}
+		}, entityError.ErrNotificationInvalidChannel},
+		{"quiet hours without end", func(p *entity.NotificationPreferences) {
+			p.QuietHoursStart = &start
+		}, entityError.ErrNotificationInvalidQuietHours},
+		{"quiet hours bad format", func(p *entity.NotificationPreferences) {
+			p.QuietHoursStart = &start
+			p.QuietHoursEnd = &badTime
+		}, entityError.ErrNotificationInvalidQuietHours},
+		{"invalid timezone", func(p *entity.NotificationPreferences) {
+			p.Timezone = "Mars/Olympus"
+		}, entityError.ErrNotificationInvalidTimezone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefs := entity.DefaultNotificationPreferences(uuid.New())
+			tt.mutate(prefs)
+
+			_, err := uc.UpdatePreferences(ctx, prefs)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+	mockPrefsRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+}
+
+func TestNotificationUseCase_GetPreferences_Defaults(t *testing.T) {
+	mockPrefsRepo := new(mocks.MockNotificationPreferencesRepo)
+	uc := NewNotificationUseCase(nil, nil, nil, nil, nil, nil, mockPrefsRepo, nil, nil, nil, SMSFallbackPolicy{}, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	userID := uuid.New()
+	mockPrefsRepo.On("GetByUserID", ctx, userID).Return(nil, entityError.ErrNotificationPreferencesNotFound)
+
+	prefs, err := uc.GetPreferences(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, userID, prefs.UserID)
+	assert.Equal(t, entity.NotificationLanguageRU, prefs.Language)
+	assert.True(t, prefs.CriticalOverride)
+}
+
+func TestNotificationPreferences_QuietHoursUntil(t *testing.T) {
+	start, end := "22:00", "08:00"
+	prefs := &entity.NotificationPreferences{QuietHoursStart: &start, QuietHoursEnd: &end, Timezone: "Europe/Moscow"}
+	loc, err := time.LoadLocation("Europe/Moscow")
+	assert.NoError(t, err)
+
+	until, quiet := prefs.QuietHoursUntil(time.Date(2026, 3, 10, 23, 30, 0, 0, loc))
+	assert.True(t, quiet)
+	assert.Equal(t, time.Date(2026, 3, 11, 8, 0, 0, 0, loc), until)
+
+	until, quiet = prefs.QuietHoursUntil(time.Date(2026, 3, 11, 6, 15, 0, 0, loc))
+	assert.True(t, quiet)
+	assert.Equal(t, time.Date(2026, 3, 11, 8, 0, 0, 0, loc), until)
+
+	_, quiet = prefs.QuietHoursUntil(time.Date(2026, 3, 11, 12, 0, 0, 0, loc))
+	assert.False(t, quiet)
+}
+
+func TestBuildNotification_DeliveredWithPIN(t *testing.T) {
+	notification, err := buildNotification(entity.NotificationOrderDelivered, entity.NotificationLanguageRU, map[string]string{"pickup_pin": "123456"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Посылка доставлена", notification.Title)
+	assert.Contains(t, notification.Body, "123456")
+}
+
+func TestBuildNotification_AllEventsHaveTemplates(t *testing.T) {
+	events := []entity.NotificationEvent{
+		entity.NotificationOrderAccepted,
+		entity.NotificationDroneAssigned,
+		entity.NotificationDroneDeparted,
+		entity.NotificationArrivingSoon,
+		entity.NotificationOrderDelivered,
+		entity.NotificationDeliveryFailed,
+		entity.NotificationPickupReminder,
+		entity.NotificationOrderCollected,
+		entity.NotificationCollectedByGrant,
+		entity.NotificationReturnCompleted,
+	}
+
+	for _, event := range events {
+		notification, err := buildNotification(event, entity.NotificationLanguageRU, nil)
+		assert.NoError(t, err, event)
+		assert.NotEmpty(t, notification.Title, event)
+		assert.NotEmpty(t, notification.Body, event)
+		assert.NotNil(t, notification.Data, event)
+	}
+}
@@ -23,6 +23,8 @@ type OrderUseCase struct {
 	lockerRepo         repo.LockerRepo
 	internalLockerRepo repo.InternalLockerRepo
 	rabbitmqClient     rabbitmq.RabbitMQClient
+	notifier           OrderEventNotifier
+	webhooks           WebhookPublisher
 	logger             logger.Interface
 }
 
@@ -35,6 +37,8 @@ func NewOrderUseCase(
 	lockerRepo repo.LockerRepo,
 	internalLockerRepo repo.InternalLockerRepo,
 	rabbitmqClient rabbitmq.RabbitMQClient,
+	notifier OrderEventNotifier,
+	webhooks WebhookPublisher,
 	logger logger.Interface,
 ) *OrderUseCase {
 	return &OrderUseCase{
@@ -46,6 +50,8 @@ func NewOrderUseCase(
 		lockerRepo:         lockerRepo,
 		internalLockerRepo: internalLockerRepo,
 		rabbitmqClient:     rabbitmqClient,
+		notifier:           notifier,
+		webhooks:           webhooks,
 		logger:             logger,
 	}
 }
@@ -137,12 +143,14 @@ func (uc *OrderUseCase) CreateOrder(ctx context.Context, userID, goodID uuid.UUI
 		if deliveryErr != nil {
 			uc.logger.Error("OrderUseCase - CreateOrder - CreateDelivery", deliveryErr, map[string]any{"orderID": createdOrder.ID})
 		}
+		uc.orderCreated(ctx, createdOrder, nil)
 		return createdOrder, nil
 	}
 
 	drone.Status = "busy"
 	if err := uc.droneRepo.UpdateStatus(ctx, drone); err != nil {
 		uc.logger.Warn("OrderUseCase - CreateOrder - UpdateDroneStatus", err, map[string]any{"droneID": drone.ID, "orderID": createdOrder.ID})
+		uc.orderCreated(ctx, createdOrder, nil)
 		return createdOrder, nil
 	}
 
@@ -165,6 +173,7 @@ func (uc *OrderUseCase) CreateOrder(ctx context.Context, userID, goodID uuid.UUI
 				_ = uc.internalLockerRepo.UpdateCellStatus(ctx, internalCell)
 			}
 		}
+		uc.orderCreated(ctx, createdOrder, nil)
 		return createdOrder, nil
 	}
 
@@ -211,9 +220,44 @@ func (uc *OrderUseCase) CreateOrder(ctx context.Context, userID, goodID uuid.UUI
 		return nil, fmt.Errorf("OrderUseCase - CreateOrder - Publish: %w", err)
 	}
 
+	uc.orderCreated(ctx, createdOrder, delivery)
+	uc.notifyOrder(ctx, createdOrder, entity.NotificationDroneAssigned)
+
 	return createdOrder, nil
 }
 
+func (uc *OrderUseCase) orderCreated(ctx context.Context, order *entity.Order, delivery *entity.Delivery) {
+	uc.notifyOrder(ctx, order, entity.NotificationOrderAccepted)
+	uc.publishWebhook(ctx, entity.WebhookOrderCreated, order, delivery)
+}
+
+func (uc *OrderUseCase) publishWebhook(ctx context.Context, event entity.WebhookEvent, order *entity.Order, delivery *entity.Delivery) {
+	if uc.webhooks == nil {
+		return
+	}
+
+	if err := uc.webhooks.Publish(ctx, event, orderWebhookData(order, delivery)); err != nil {
+		uc.logger.Warn("OrderUseCase - publishWebhook", err, map[string]any{
+			"orderID": order.ID,
+			"event":   event,
+		})
+	}
+}
+
+func (uc *OrderUseCase) notifyOrder(ctx context.Context, order *entity.Order, event entity.NotificationEvent) {
+	if uc.notifier == nil {
+		return
+	}
+
+	if err := uc.notifier.Notify(ctx, order.UserID, event, orderNotificationData(order)); err != nil {
+		uc.logger.Warn("OrderUseCase - notifyOrder", err, map[string]any{
+			"userID":  order.UserID,
+			"orderID": order.ID,
+			"event":   event,
+		})
+	}
+}
+
 func (uc *OrderUseCase) reserveInternalCell(ctx context.Context, automatID, externalCellID uuid.UUID) (*uuid.UUID, error) {
 	if uc.internalLockerRepo == nil {
 		return nil, nil
@@ -396,5 +440,8 @@ func (uc *OrderUseCase) ReturnOrder(ctx context.Context, orderID, userID uuid.UU
 		return fmt.Errorf("OrderUseCase - ReturnOrder - UpdateStatus: %w", err)
 	}
 
+	uc.notifyOrder(ctx, order, entity.NotificationReturnCompleted)
+	uc.publishWebhook(ctx, entity.WebhookOrderCancelled, order, delivery)
+
 	return nil
 }
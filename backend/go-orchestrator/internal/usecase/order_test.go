@@ -31,6 +31,8 @@ func TestOrderUseCase_CreateOrder_GoodNotFound(t *testing.T) {
 		mockInternalLockerRepo,
 		mockRabbitMQClient,
 		nil,
+		nil,
+		nil,
 	)
 
 	ctx := context.Background()
@@ -66,6 +68,8 @@ func TestOrderUseCase_GetOrder_Success(t *testing.T) {
 		nil,
 		mockRabbitMQClient,
 		nil,
+		nil,
+		nil,
 	)
 
 	ctx := context.Background()
@@ -106,6 +110,8 @@ func TestOrderUseCase_GetUserOrders_Success(t *testing.T) {
 		mockInternalLockerRepo,
 		mockRabbitMQClient,
 		nil,
+		nil,
+		nil,
 	)
 
 	ctx := context.Background()
@@ -146,6 +152,8 @@ func TestOrderUseCase_GetUserOrdersWithGoods_Success(t *testing.T) {
 		mockInternalLockerRepo,
 		mockRabbitMQClient,
 		nil,
+		nil,
+		nil,
 	)
 
 	ctx := context.Background()
@@ -227,6 +235,8 @@ func TestOrderUseCase_GetUserOrdersWithGoods_Error(t *testing.T) {
 		mockInternalLockerRepo,
 		mockRabbitMQClient,
 		nil,
+		nil,
+		nil,
 	)
 
 	ctx := context.Background()
@@ -262,6 +272,8 @@ func TestOrderUseCase_CreateMultipleOrders_Success(t *testing.T) {
 		mockInternalLockerRepo,
 		mockRabbitMQClient,
 		nil,
+		nil,
+		nil,
 	)
 
 	ctx := context.Background()
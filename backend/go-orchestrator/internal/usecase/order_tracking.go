@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
+)
+
+const (
+	orderTrackingInterval = 2 * time.Second
+	orderTrackingMinSpeed = 0.5
+	earthRadiusMeters     = 6371000.0
+)
+
+var finalOrderStatuses = map[string]bool{
+	"completed": true,
+	"cancelled": true,
+	"failed":    true,
+}
+
+type DroneStateProvider interface {
+	GetState(ctx context.Context, droneID uuid.UUID) (*entity.DroneStatus, error)
+}
+
+type OrderTrackingUseCase struct {
+	orderRepo         repo.OrderRepo
+	deliveryRepo      repo.DeliveryRepo
+	parcelAutomatRepo repo.ParcelAutomatRepo
+	drones            DroneStateProvider
+	logger            logger.Interface
+	interval          time.Duration
+}
+
+func NewOrderTrackingUseCase(
+	orderRepo repo.OrderRepo,
+	deliveryRepo repo.DeliveryRepo,
+	parcelAutomatRepo repo.ParcelAutomatRepo,
+	drones DroneStateProvider,
+	logger logger.Interface,
+) *OrderTrackingUseCase {
+	return &OrderTrackingUseCase{
+		orderRepo:         orderRepo,
+		deliveryRepo:      deliveryRepo,
+		parcelAutomatRepo: parcelAutomatRepo,
+		drones:            drones,
+		logger:            logger,
+		interval:          orderTrackingInterval,
+	}
+}
+
+type orderTracker struct {
+	orderID        uuid.UUID
+	last           *entity.OrderTrackingState
+	lastPositionAt time.Time
+	destination    *entity.Position
+}
+
+// Track streams status transitions of the user's order and, while the drone is in flight, its position.
+// Updates are produced at most once per interval and only when something changed. The channel is closed
+// when the order reaches a final status or ctx is cancelled.
+func (uc *OrderTrackingUseCase) Track(ctx context.Context, userID, orderID uuid.UUID) (<-chan entity.OrderTrackingUpdate, error) {
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("OrderTrackingUseCase - Track - GetByID: %w", err)
+	}
+
+	if order.UserID != userID {
+		return nil, entityError.ErrOrderNotBelongsToUser
+	}
+
+	updates := make(chan entity.OrderTrackingUpdate, 2)
+	go uc.run(ctx, &orderTracker{orderID: orderID}, updates)
+
+	return updates, nil
+}
+
+func (uc *OrderTrackingUseCase) run(ctx context.Context, tracker *orderTracker, updates chan<- entity.OrderTrackingUpdate) {
+	defer close(updates)
+
+	ticker := time.NewTicker(uc.interval)
+	defer ticker.Stop()
+
+	for {
+		batch, done := uc.poll(ctx, tracker)
+		for _, update := range batch {
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if done {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (uc *OrderTrackingUseCase) poll(ctx context.Context, tracker *orderTracker) ([]entity.OrderTrackingUpdate, bool) {
+	order, err := uc.orderRepo.GetByID(ctx, tracker.orderID)
+	if err != nil {
+		uc.logger.Warn("OrderTrackingUseCase - poll - GetOrder", err, map[string]any{
+			"orderID": tracker.orderID,
+		})
+		return nil, false
+	}
+
+	state := &entity.OrderTrackingState{
+		OrderID:     order.ID,
+		OrderStatus: order.Status,
+		Final:       finalOrderStatuses[order.Status],
+	}
+
+	delivery, err := uc.deliveryRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		delivery = nil
+		if !errors.Is(err, entityError.ErrDeliveryNotFound) {
+			uc.logger.Warn("OrderTrackingUseCase - poll - GetDelivery", err, map[string]any{
+				"orderID": order.ID,
+			})
+		}
+	}
+	if delivery != nil {
+		state.DeliveryID = &delivery.ID
+		state.DeliveryStatus = delivery.Status
+		state.DroneID = delivery.DroneID
+	}
+
+	var updates []entity.OrderTrackingUpdate
+	if !sameTrackingState(tracker.last, state) {
+		tracker.last = state
+		updates = append(updates, entity.OrderTrackingUpdate{Event: entity.OrderTrackingStatus, Status: state})
+	}
+
+	if state.Final {
+		return updates, true
+	}
+
+	if delivery != nil && delivery.Status == "in_transit" && delivery.DroneID != nil {
+		if position := uc.dronePosition(ctx, tracker, delivery); position != nil {
+			updates = append(updates, entity.OrderTrackingUpdate{Event: entity.OrderTrackingPosition, Position: position})
+		}
+	}
+
+	return updates, false
+}
+
+func (uc *OrderTrackingUseCase) dronePosition(ctx context.Context, tracker *orderTracker, delivery *entity.Delivery) *entity.OrderTrackingDrone {
+	state, err := uc.drones.GetState(ctx, *delivery.DroneID)
+	if err != nil {
+		uc.logger.Warn("OrderTrackingUseCase - dronePosition - GetState", err, map[string]any{
+			"droneID": *delivery.DroneID,
+		})
+		return nil
+	}
+
+	if !state.UpdatedAt.After(tracker.lastPositionAt) {
+		return nil
+	}
+	if state.Position.Latitude == 0 && state.Position.Longitude == 0 {
+		return nil
+	}
+	tracker.lastPositionAt = state.UpdatedAt
+
+	position := &entity.OrderTrackingDrone{
+		DroneID:   state.DroneID,
+		Position:  state.Position,
+		Speed:     state.Speed,
+		UpdatedAt: state.UpdatedAt,
+	}
+
+	if destination := uc.destination(ctx, tracker, delivery.ParcelAutomatID); destination != nil && state.Speed >= orderTrackingMinSpeed {
+		eta := int(math.Ceil(distanceMeters(state.Position, *destination) / state.Speed))
+		position.ETASeconds = &eta
+	}
+
+	return position
+}
+
+func (uc *OrderTrackingUseCase) destination(ctx context.Context, tracker *orderTracker, parcelAutomatID uuid.UUID) *entity.Position {
+	if tracker.destination != nil {
+		return tracker.destination
+	}
+
+	automat, err := uc.parcelAutomatRepo.GetByID(ctx, parcelAutomatID)
+	if err != nil {
+		uc.logger.Warn("OrderTrackingUseCase - destination - GetParcelAutomat", err, map[string]any{
+			"parcelAutomatID": parcelAutomatID,
+		})
+		return nil
+	}
+
+	position, ok := parseCoordinates(automat.Coordinates)
+	if !ok {
+		return nil
+	}
+	tracker.destination = &position
+	return tracker.destination
+}
+
+func sameTrackingState(a, b *entity.OrderTrackingState) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.OrderStatus == b.OrderStatus &&
+		a.DeliveryStatus == b.DeliveryStatus &&
+		equalUUIDPtr(a.DeliveryID, b.DeliveryID) &&
+		equalUUIDPtr(a.DroneID, b.DroneID)
+}
+
+func equalUUIDPtr(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// parseCoordinates reads parcel automat coordinates stored as "lat,lon".
+func parseCoordinates(value string) (entity.Position, bool) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return entity.Position{}, false
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return entity.Position{}, false
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return entity.Position{}, false
+	}
+
+	return entity.Position{Latitude: lat, Longitude: lon}, true
+}
+
+// distanceMeters is the great-circle distance between two points, ignoring altitude.
+func distanceMeters(from, to entity.Position) float64 {
+	lat1 := from.Latitude * math.Pi / 180
+	lat2 := to.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (to.Longitude - from.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
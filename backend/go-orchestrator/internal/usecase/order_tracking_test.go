@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderTrackingUseCase_Track_NotOwner(t *testing.T) {
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	uc := NewOrderTrackingUseCase(mockOrderRepo, nil, nil, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: uuid.New()}, nil)
+
+	_, err := uc.Track(ctx, uuid.New(), orderID)
+
+	assert.ErrorIs(t, err, entityError.ErrOrderNotBelongsToUser)
+}
+
+func TestOrderTrackingUseCase_Poll_StatusSentOnlyOnChange(t *testing.T) {
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	uc := NewOrderTrackingUseCase(mockOrderRepo, mockDeliveryRepo, nil, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	tracker := &orderTracker{orderID: orderID}
+
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, Status: "pending"}, nil).Twice()
+	mockDeliveryRepo.On("GetByOrderID", ctx, orderID).Return(nil, entityError.ErrDeliveryNotFound).Twice()
+
+	updates, done := uc.poll(ctx, tracker)
+	require.Len(t, updates, 1)
+	assert.False(t, done)
+	assert.Equal(t, entity.OrderTrackingStatus, updates[0].Event)
+	assert.Equal(t, "pending", updates[0].Status.OrderStatus)
+
+	updates, done = uc.poll(ctx, tracker)
+	assert.Empty(t, updates)
+	assert.False(t, done)
+
+	droneID := uuid.New()
+	deliveryID := uuid.New()
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, Status: "in_progress"}, nil).Once()
+	mockDeliveryRepo.On("GetByOrderID", ctx, orderID).Return(&entity.Delivery{ID: deliveryID, OrderID: orderID, DroneID: &droneID, Status: "pending"}, nil).Once()
+
+	updates, _ = uc.poll(ctx, tracker)
+	require.Len(t, updates, 1)
+	assert.Equal(t, "in_progress", updates[0].Status.OrderStatus)
+	assert.Equal(t, droneID, *updates[0].Status.DroneID)
+}
+
+func TestOrderTrackingUseCase_Poll_InTransitPositionWithETA(t *testing.T) {
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockParcelAutomatRepo := new(mocks.MockParcelAutomatRepo)
+	mockDroneRepo := new(mocks.MockDroneRepo)
+	uc := NewOrderTrackingUseCase(mockOrderRepo, mockDeliveryRepo, mockParcelAutomatRepo, mockDroneRepo, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	droneID := uuid.New()
+	automatID := uuid.New()
+	updatedAt := time.Now()
+	tracker := &orderTracker{orderID: orderID}
+
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, Status: "in_progress"}, nil)
+	mockDeliveryRepo.On("GetByOrderID", ctx, orderID).Return(&entity.Delivery{
+		ID:              uuid.New(),
+		OrderID:         orderID,
+		DroneID:         &droneID,
+		ParcelAutomatID: automatID,
+		Status:          "in_transit",
+	}, nil)
+	mockParcelAutomatRepo.On("GetByID", ctx, automatID).Return(&entity.ParcelAutomat{ID: automatID, Coordinates: "55.7558,37.6173"}, nil).Once()
+	mockDroneRepo.On("GetState", ctx, droneID).Return(&entity.DroneStatus{
+		DroneID:   droneID,
+		Status:    "in_transit",
+		Position:  entity.Position{Latitude: 55.7468, Longitude: 37.6173, Altitude: 60},
+		Speed:     10,
+		UpdatedAt: updatedAt,
+	}, nil)
+
+	updates, done := uc.poll(ctx, tracker)
+
+	require.Len(t, updates, 2)
+	assert.False(t, done)
+	position := updates[1].Position
+	require.NotNil(t, position)
+	assert.Equal(t, entity.OrderTrackingPosition, updates[1].Event)
+	assert.Equal(t, droneID, position.DroneID)
+	require.NotNil(t, position.ETASeconds)
+	assert.InDelta(t, 101, *position.ETASeconds, 2)
+
+	updates, _ = uc.poll(ctx, tracker)
+	assert.Empty(t, updates)
+	mockParcelAutomatRepo.AssertExpectations(t)
+}
+
+func TestOrderTrackingUseCase_Track_ClosesOnFinalStatus(t *testing.T) {
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	uc := NewOrderTrackingUseCase(mockOrderRepo, mockDeliveryRepo, nil, nil, new(mocks.MockLogger))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	userID := uuid.New()
+	orderID := uuid.New()
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: userID, Status: "completed"}, nil)
+	mockDeliveryRepo.On("GetByOrderID", ctx, orderID).Return(nil, entityError.ErrDeliveryNotFound)
+
+	updates, err := uc.Track(ctx, userID, orderID)
+	require.NoError(t, err)
+
+	var received []entity.OrderTrackingUpdate
+	for update := range updates {
+		received = append(received, update)
+	}
+
+	require.Len(t, received, 1)
+	assert.True(t, received[0].Status.Final)
+}
+
+func TestParseCoordinates(t *testing.T) {
+	position, ok := parseCoordinates("55.7558, 37.6173")
+	assert.True(t, ok)
+	assert.Equal(t, 55.7558, position.Latitude)
+	assert.Equal(t, 37.6173, position.Longitude)
+
+	_, ok = parseCoordinates("")
+	assert.False(t, ok)
+	_, ok = parseCoordinates("north,east")
+	assert.False(t, ok)
+}
@@ -160,5 +160,7 @@ func (uc *OrderUseCase) processSingleDelivery(ctx context.Context, delivery *ent
 		"deliveryID": delivery.ID,
 	})
 
+	uc.notifyOrder(ctx, order, entity.NotificationDroneAssigned)
+
 	return nil
 }
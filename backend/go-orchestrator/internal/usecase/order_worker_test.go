@@ -32,6 +32,8 @@ func TestOrderUseCase_processPendingOrders_Success(t *testing.T) {
 		mockLockerRepo,
 		mockInternalLockerRepo,
 		mockRabbitMQClient,
+		nil,
+		nil,
 		mockLogger,
 	)
 
@@ -120,6 +122,8 @@ func TestOrderUseCase_processPendingOrders_NoAvailableDrone(t *testing.T) {
 		mockLockerRepo,
 		mockInternalLockerRepo,
 		mockRabbitMQClient,
+		nil,
+		nil,
 		mockLogger,
 	)
 
@@ -166,6 +170,8 @@ func TestOrderUseCase_processPendingOrders_NoDeliveries(t *testing.T) {
 		mockInternalLockerRepo,
 		mockRabbitMQClient,
 		nil,
+		nil,
+		nil,
 	)
 
 	ctx := context.Background()
@@ -197,6 +203,8 @@ func TestOrderUseCase_StartPendingOrdersWorker_Cancellation(t *testing.T) {
 		mockLockerRepo,
 		mockInternalLockerRepo,
 		mockRabbitMQClient,
+		nil,
+		nil,
 		mockLogger,
 	)
 
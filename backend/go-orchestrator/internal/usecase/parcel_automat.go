@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/request"
@@ -10,6 +11,7 @@ import (
 	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
 )
 
 type ParcelAutomatUseCase struct {
@@ -19,6 +21,10 @@ type ParcelAutomatUseCase struct {
 	orderRepo          repo.OrderRepo
 	deliveryRepo       repo.DeliveryRepo
 	qrUseCase          *QRUseCase
+	pickupGrantUseCase *PickupGrantUseCase
+	pickupPINUseCase   *PickupPINUseCase
+	notifier           OrderEventNotifier
+	webhooks           WebhookPublisher
 	orangePIWebAPI     repo.OrangePIWebAPI
 	logger             logger.Interface
 }
@@ -30,6 +36,10 @@ func NewParcelAutomatUseCase(
 	orderRepo repo.OrderRepo,
 	deliveryRepo repo.DeliveryRepo,
 	qrUseCase *QRUseCase,
+	pickupGrantUseCase *PickupGrantUseCase,
+	pickupPINUseCase *PickupPINUseCase,
+	notifier OrderEventNotifier,
+	webhooks WebhookPublisher,
 	orangePIWebAPI repo.OrangePIWebAPI,
 	logger logger.Interface,
 ) *ParcelAutomatUseCase {
@@ -40,6 +50,10 @@ func NewParcelAutomatUseCase(
 		orderRepo:          orderRepo,
 		deliveryRepo:       deliveryRepo,
 		qrUseCase:          qrUseCase,
+		pickupGrantUseCase: pickupGrantUseCase,
+		pickupPINUseCase:   pickupPINUseCase,
+		notifier:           notifier,
+		webhooks:           webhooks,
 		orangePIWebAPI:     orangePIWebAPI,
 		logger:             logger,
 	}
@@ -136,6 +150,15 @@ func (uc *ParcelAutomatUseCase) UpdateStatus(ctx context.Context, automat *entit
 	return updatedAutomat, nil
 }
 
+func (uc *ParcelAutomatUseCase) UpdateStaticQR(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error) {
+	updatedAutomat, err := uc.parcelAutomatRepo.UpdateStaticQR(ctx, automat)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedAutomat, nil
+}
+
 func (uc *ParcelAutomatUseCase) Update(ctx context.Context, automat *entity.ParcelAutomat) (*entity.ParcelAutomat, error) {
 	updatedAutomat, err := uc.parcelAutomatRepo.Update(ctx, automat)
 	if err != nil {
@@ -188,6 +211,20 @@ func (uc *ParcelAutomatUseCase) GetAutomatCells(ctx context.Context, automatID u
 }
 
 func (uc *ParcelAutomatUseCase) ProcessQRScan(ctx context.Context, qrDataJSON string, automatID uuid.UUID) ([]uuid.UUID, error) {
+	if qr.IsGrantQR(qrDataJSON) {
+		return uc.processGrantScan(ctx, qrDataJSON, automatID)
+	}
+
+	if !qr.IsDynamicQR(qrDataJSON) {
+		automat, err := uc.parcelAutomatRepo.GetByID(ctx, automatID)
+		if err != nil {
+			return nil, fmt.Errorf("ParcelAutomatUseCase - ProcessQRScan - GetAutomat: %w", err)
+		}
+		if !automat.AllowStaticQR {
+			return nil, entityError.ErrQRStaticNotAllowed
+		}
+	}
+
 	user, err := uc.qrUseCase.ValidateQR(ctx, qrDataJSON)
 	if err != nil {
 		return nil, fmt.Errorf("ParcelAutomatUseCase - ProcessQRScan - ValidateQR: %w", err)
@@ -253,6 +290,95 @@ func (uc *ParcelAutomatUseCase) ProcessQRScan(ctx context.Context, qrDataJSON st
 	return cellIDs, nil
 }
 
+func (uc *ParcelAutomatUseCase) processGrantScan(ctx context.Context, qrDataJSON string, automatID uuid.UUID) ([]uuid.UUID, error) {
+	if uc.pickupGrantUseCase == nil {
+		return nil, entityError.ErrQRValidationFailed
+	}
+
+	grant, err := uc.pickupGrantUseCase.ValidateGrantQR(ctx, qrDataJSON)
+	if err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - processGrantScan - ValidateGrantQR: %w", err)
+	}
+
+	order, err := uc.orderRepo.GetByID(ctx, grant.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - processGrantScan - GetOrder: %w", err)
+	}
+
+	if order.Status != "delivered" || order.LockerCellID == nil {
+		return nil, entityError.ErrQRNoOrdersForPickup
+	}
+
+	delivery, err := uc.deliveryRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - processGrantScan - GetDelivery: %w", err)
+	}
+
+	if delivery.ParcelAutomatID != automatID {
+		return nil, entityError.ErrPickupGrantWrongAutomat
+	}
+
+	cell, err := uc.lockerRepo.GetCellByID(ctx, *order.LockerCellID)
+	if err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - processGrantScan - GetCell: %w", err)
+	}
+
+	if cell.Status != "occupied" {
+		return nil, entityError.ErrQRNoOrdersForPickup
+	}
+
+	if _, err := uc.pickupGrantUseCase.RedeemGrant(ctx, grant.ID); err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - processGrantScan - RedeemGrant: %w", err)
+	}
+
+	cell.Status = "opened"
+	if err := uc.lockerRepo.UpdateCellStatus(ctx, cell); err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - processGrantScan - UpdateCellStatus: %w", err)
+	}
+
+	return []uuid.UUID{cell.ID}, nil
+}
+
+func (uc *ParcelAutomatUseCase) ProcessPINEntry(ctx context.Context, pin string, automatID uuid.UUID) ([]uuid.UUID, error) {
+	if uc.pickupPINUseCase == nil {
+		return nil, entityError.ErrPickupPINInvalid
+	}
+
+	record, err := uc.pickupPINUseCase.ValidatePIN(ctx, pin, automatID)
+	if err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - ProcessPINEntry - ValidatePIN: %w", err)
+	}
+
+	order, err := uc.orderRepo.GetByID(ctx, record.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - ProcessPINEntry - GetOrder: %w", err)
+	}
+
+	if order.Status != "delivered" || order.LockerCellID == nil {
+		return nil, entityError.ErrQRNoOrdersForPickup
+	}
+
+	cell, err := uc.lockerRepo.GetCellByID(ctx, *order.LockerCellID)
+	if err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - ProcessPINEntry - GetCell: %w", err)
+	}
+
+	if cell.Status != "occupied" {
+		return nil, entityError.ErrQRNoOrdersForPickup
+	}
+
+	if _, err := uc.pickupPINUseCase.RedeemPIN(ctx, record.ID); err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - ProcessPINEntry - RedeemPIN: %w", err)
+	}
+
+	cell.Status = "opened"
+	if err := uc.lockerRepo.UpdateCellStatus(ctx, cell); err != nil {
+		return nil, fmt.Errorf("ParcelAutomatUseCase - ProcessPINEntry - UpdateCellStatus: %w", err)
+	}
+
+	return []uuid.UUID{cell.ID}, nil
+}
+
 func (uc *ParcelAutomatUseCase) UpdateCell(ctx context.Context, cellID uuid.UUID, height, length, width float64) (*entity.LockerCell, error) {
 	cell, err := uc.lockerRepo.GetCellByID(ctx, cellID)
 	if err != nil {
@@ -338,7 +464,15 @@ func (uc *ParcelAutomatUseCase) ConfirmPickup(ctx context.Context, cellIDs []uui
 				"orderID": order.ID,
 			})
 			hasErrors = true
+			continue
 		}
+
+		collectedByGrant := false
+		if uc.pickupGrantUseCase != nil {
+			collectedByGrant = uc.pickupGrantUseCase.NotifyOwnerIfCollectedByGrant(ctx, order)
+		}
+
+		uc.notifyOrderCollected(ctx, order, collectedByGrant)
 	}
 
 	if hasErrors {
@@ -348,6 +482,30 @@ func (uc *ParcelAutomatUseCase) ConfirmPickup(ctx context.Context, cellIDs []uui
 	return nil
 }
 
+// A grant pickup already sent the owner collected_by_grant, so order_collected is skipped for it.
+func (uc *ParcelAutomatUseCase) notifyOrderCollected(ctx context.Context, order *entity.Order, collectedByGrant bool) {
+	if uc.webhooks != nil {
+		if err := uc.webhooks.Publish(ctx, entity.WebhookOrderCompleted, orderWebhookData(order, nil)); err != nil {
+			uc.logger.Warn("ParcelAutomatUseCase - ConfirmPickup - PublishWebhook", err, map[string]any{
+				"orderID": order.ID,
+			})
+		}
+	}
+
+	if uc.notifier == nil || collectedByGrant {
+		return
+	}
+
+	data := orderNotificationData(order)
+	data[collectedAtDataKey] = time.Now().Format(time.RFC3339)
+
+	if err := uc.notifier.Notify(ctx, order.UserID, entity.NotificationOrderCollected, data); err != nil {
+		uc.logger.Warn("ParcelAutomatUseCase - ConfirmPickup - Notify", err, map[string]any{
+			"orderID": order.ID,
+		})
+	}
+}
+
 func (uc *ParcelAutomatUseCase) PrepareCell(ctx context.Context, orderID, parcelAutomatID uuid.UUID) (uuid.UUID, *uuid.UUID, error) {
 	order, err := uc.orderRepo.GetByID(ctx, orderID)
 	if err != nil {
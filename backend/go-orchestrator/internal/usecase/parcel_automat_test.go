@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/controller/http/v1/request"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
 	"github.com/stretchr/testify/assert"
@@ -23,7 +25,7 @@ func TestParcelAutomatUseCase_Create_Success(t *testing.T) {
 	mockQRUseCase := &QRUseCase{}
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	city := "Moscow"
@@ -112,7 +114,7 @@ func TestParcelAutomatUseCase_Create_Error(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	city := "Moscow"
@@ -158,7 +160,7 @@ func TestParcelAutomatUseCase_Create_CustomCellDimensions(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	city := "Ekaterinburg"
@@ -237,7 +239,7 @@ func TestParcelAutomatUseCase_Create_ErrorCreatingCell(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	city := "Moscow"
@@ -292,7 +294,7 @@ func TestParcelAutomatUseCase_GetByID_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	automatID := uuid.New()
@@ -325,7 +327,7 @@ func TestParcelAutomatUseCase_List_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 
@@ -354,7 +356,7 @@ func TestParcelAutomatUseCase_ListWorking_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 
@@ -383,7 +385,7 @@ func TestParcelAutomatUseCase_UpdateStatus_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	automatID := uuid.New()
@@ -421,7 +423,7 @@ func TestParcelAutomatUseCase_Delete_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	automatID := uuid.New()
@@ -444,7 +446,7 @@ func TestParcelAutomatUseCase_GetAutomatCells_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	automatID := uuid.New()
@@ -476,8 +478,9 @@ func TestParcelAutomatUseCase_ProcessQRScan_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 
 	mockLogger := new(mocks.MockLogger)
-	qrUseCase := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, qrUseCase, mockOrangePIWebAPI, mockLogger)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+	qrUseCase := NewQRUseCase(mockQRGenerator, mockUserRepo, mockQRSecretRepo, nil, mockMinioClient, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, qrUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -512,8 +515,10 @@ func TestParcelAutomatUseCase_ProcessQRScan_Success(t *testing.T) {
 
 	qrData := `{"user_id":"` + userID.String() + `","email":"test@test.com","full_name":"Test User","exp":9999999999}`
 
+	mockParcelAutomatRepo.On("GetByID", ctx, automatID).Return(&entity.ParcelAutomat{ID: automatID, AllowStaticQR: true}, nil)
 	mockQRGenerator.On("ValidateQRCode", qrData).Return(&qr.QRData{UserID: userID, Email: email, FullName: "Test User"}, nil)
 	mockUserRepo.On("GetByID", ctx, userID).Return(user, nil)
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, nil)
 	mockOrderRepo.On("ListByUserID", ctx, userID).Return(orders, nil)
 	mockDeliveryRepo.On("GetByOrderID", ctx, orderID).Return(delivery, nil)
 	mockLockerRepo.On("GetCellByID", ctx, cellID).Return(cell, nil)
@@ -545,13 +550,14 @@ func TestParcelAutomatUseCase_ProcessQRScan_InvalidQR(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 
 	mockLogger := new(mocks.MockLogger)
-	qrUseCase := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, qrUseCase, mockOrangePIWebAPI, mockLogger)
+	qrUseCase := NewQRUseCase(mockQRGenerator, mockUserRepo, nil, nil, mockMinioClient, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, qrUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	automatID := uuid.New()
 	qrData := `invalid json`
 
+	mockParcelAutomatRepo.On("GetByID", ctx, automatID).Return(&entity.ParcelAutomat{ID: automatID, AllowStaticQR: true}, nil)
 	mockQRGenerator.On("ValidateQRCode", qrData).Return(nil, errors.New("invalid QR code"))
 
 	result, err := uc.ProcessQRScan(ctx, qrData, automatID)
@@ -561,6 +567,26 @@ func TestParcelAutomatUseCase_ProcessQRScan_InvalidQR(t *testing.T) {
 	mockQRGenerator.AssertExpectations(t)
 }
 
+func TestParcelAutomatUseCase_ProcessQRScan_StaticQRNotAllowed(t *testing.T) {
+	mockParcelAutomatRepo := new(mocks.MockParcelAutomatRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	qrUseCase := NewQRUseCase(mockQRGenerator, new(mocks.MockUserRepo), nil, nil, new(mocks.MockMinioClient), mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, new(mocks.MockLockerRepo), new(mocks.MockInternalLockerRepo), new(mocks.MockOrderRepo), new(mocks.MockDeliveryRepo), qrUseCase, nil, nil, nil, nil, new(mocks.MockOrangePIWebAPI), mockLogger)
+
+	ctx := context.Background()
+	automatID := uuid.New()
+	qrData := `{"user_id":"` + uuid.NewString() + `","email":"test@test.com"}`
+
+	mockParcelAutomatRepo.On("GetByID", ctx, automatID).Return(&entity.ParcelAutomat{ID: automatID}, nil)
+
+	result, err := uc.ProcessQRScan(ctx, qrData, automatID)
+
+	assert.ErrorIs(t, err, entityError.ErrQRStaticNotAllowed)
+	assert.Nil(t, result)
+	mockQRGenerator.AssertNotCalled(t, "ValidateQRCode", mock.Anything)
+}
+
 func TestParcelAutomatUseCase_ConfirmPickup_Success(t *testing.T) {
 	mockParcelAutomatRepo := new(mocks.MockParcelAutomatRepo)
 	mockLockerRepo := new(mocks.MockLockerRepo)
@@ -571,7 +597,7 @@ func TestParcelAutomatUseCase_ConfirmPickup_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	cellID := uuid.New()
@@ -604,7 +630,7 @@ func TestParcelAutomatUseCase_ConfirmPickup_CellNotOpened(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	cellID := uuid.New()
@@ -623,6 +649,77 @@ func TestParcelAutomatUseCase_ConfirmPickup_CellNotOpened(t *testing.T) {
 	mockLockerRepo.AssertExpectations(t)
 }
 
+func TestParcelAutomatUseCase_ConfirmPickup_NotifiesCustomer(t *testing.T) {
+	mockParcelAutomatRepo := new(mocks.MockParcelAutomatRepo)
+	mockLockerRepo := new(mocks.MockLockerRepo)
+	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockPickupNotifier := new(mockPickupNotifier)
+	mockNotifier := new(mockOrderEventNotifier)
+	mockQRUseCase := &QRUseCase{}
+	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
+	mockLogger := new(mocks.MockLogger)
+
+	pickupGrantUC := NewPickupGrantUseCase(mockPickupGrantRepo, nil, nil, nil, mockPickupNotifier, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, pickupGrantUC, nil, mockNotifier, nil, mockOrangePIWebAPI, mockLogger)
+
+	ctx := context.Background()
+	cellID := uuid.New()
+	order := &entity.Order{ID: uuid.New(), UserID: uuid.New(), GoodID: uuid.New(), Status: "delivered"}
+
+	mockLockerRepo.On("GetCellByID", ctx, cellID).Return(&entity.LockerCell{ID: cellID, Status: "opened"}, nil)
+	mockLockerRepo.On("UpdateCellStatus", ctx, mock.Anything).Return(nil)
+	mockOrderRepo.On("GetByLockerCellID", ctx, cellID).Return(order, nil)
+	mockDeliveryRepo.On("GetByOrderID", ctx, order.ID).Return(nil, errors.New("delivery not found"))
+	mockOrderRepo.On("UpdateStatus", ctx, order).Return(order, nil)
+	mockPickupGrantRepo.On("GetUsedByOrderID", ctx, order.ID).Return(nil, entityError.ErrPickupGrantNotFound)
+	mockNotifier.On("Notify", ctx, order.UserID, entity.NotificationOrderCollected, mock.Anything).Return(nil)
+
+	err := uc.ConfirmPickup(ctx, []uuid.UUID{cellID})
+
+	assert.NoError(t, err)
+	mockNotifier.AssertNumberOfCalls(t, "Notify", 1)
+	mockPickupNotifier.AssertNotCalled(t, "NotifyOrderCollected", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestParcelAutomatUseCase_ConfirmPickup_ByGrantNotifiesOwnerOnce(t *testing.T) {
+	mockParcelAutomatRepo := new(mocks.MockParcelAutomatRepo)
+	mockLockerRepo := new(mocks.MockLockerRepo)
+	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockPickupNotifier := new(mockPickupNotifier)
+	mockNotifier := new(mockOrderEventNotifier)
+	mockQRUseCase := &QRUseCase{}
+	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
+	mockLogger := new(mocks.MockLogger)
+
+	pickupGrantUC := NewPickupGrantUseCase(mockPickupGrantRepo, nil, nil, nil, mockPickupNotifier, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, pickupGrantUC, nil, mockNotifier, nil, mockOrangePIWebAPI, mockLogger)
+
+	ctx := context.Background()
+	cellID := uuid.New()
+	order := &entity.Order{ID: uuid.New(), UserID: uuid.New(), GoodID: uuid.New(), Status: "delivered"}
+	usedAt := time.Now()
+
+	mockLockerRepo.On("GetCellByID", ctx, cellID).Return(&entity.LockerCell{ID: cellID, Status: "opened"}, nil)
+	mockLockerRepo.On("UpdateCellStatus", ctx, mock.Anything).Return(nil)
+	mockOrderRepo.On("GetByLockerCellID", ctx, cellID).Return(order, nil)
+	mockDeliveryRepo.On("GetByOrderID", ctx, order.ID).Return(nil, errors.New("delivery not found"))
+	mockOrderRepo.On("UpdateStatus", ctx, order).Return(order, nil)
+	mockPickupGrantRepo.On("GetUsedByOrderID", ctx, order.ID).Return(&entity.PickupGrant{ID: uuid.New(), OrderID: order.ID, OwnerID: order.UserID, UsedAt: &usedAt}, nil)
+	mockPickupNotifier.On("NotifyOrderCollected", ctx, order.UserID, order.ID).Return(nil)
+
+	err := uc.ConfirmPickup(ctx, []uuid.UUID{cellID})
+
+	assert.NoError(t, err)
+	mockPickupNotifier.AssertNumberOfCalls(t, "NotifyOrderCollected", 1)
+	mockNotifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestParcelAutomatUseCase_Update_Success(t *testing.T) {
 	mockParcelAutomatRepo := new(mocks.MockParcelAutomatRepo)
 	mockLockerRepo := new(mocks.MockLockerRepo)
@@ -633,7 +730,7 @@ func TestParcelAutomatUseCase_Update_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	automatID := uuid.New()
@@ -682,7 +779,7 @@ func TestParcelAutomatUseCase_UpdateCell_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	cellID := uuid.New()
@@ -728,7 +825,7 @@ func TestParcelAutomatUseCase_PrepareCell_Success(t *testing.T) {
 	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, mockOrangePIWebAPI, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, mockQRUseCase, nil, nil, nil, nil, mockOrangePIWebAPI, mockLogger)
 
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -786,3 +883,68 @@ func TestParcelAutomatUseCase_PrepareCell_Success(t *testing.T) {
 	mockDeliveryRepo.AssertExpectations(t)
 	mockOrangePIWebAPI.AssertExpectations(t)
 }
+
+func TestParcelAutomatUseCase_ProcessPINEntry_Success(t *testing.T) {
+	mockParcelAutomatRepo := new(mocks.MockParcelAutomatRepo)
+	mockLockerRepo := new(mocks.MockLockerRepo)
+	mockInternalLockerRepo := new(mocks.MockInternalLockerRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockOrangePIWebAPI := new(mocks.MockOrangePIWebAPI)
+	mockLogger := new(mocks.MockLogger)
+
+	pickupPINUseCase := NewPickupPINUseCase(mockPickupPINRepo, mockOrderRepo, mockDeliveryRepo, mockQRGenerator, nil, mockLogger)
+	uc := NewParcelAutomatUseCase(mockParcelAutomatRepo, mockLockerRepo, mockInternalLockerRepo, mockOrderRepo, mockDeliveryRepo, nil, nil, pickupPINUseCase, nil, nil, mockOrangePIWebAPI, mockLogger)
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	cellID := uuid.New()
+	automatID := uuid.New()
+	record := &entity.PickupPIN{ID: uuid.New(), OrderID: orderID, ParcelAutomatID: automatID}
+
+	mockQRGenerator.On("HashPickupPIN", "123456").Return("hash")
+	mockPickupPINRepo.On("GetActiveByHash", ctx, automatID, "hash").Return(record, nil)
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, Status: "delivered", LockerCellID: &cellID}, nil)
+	mockLockerRepo.On("GetCellByID", ctx, cellID).Return(&entity.LockerCell{ID: cellID, PostID: automatID, Status: "occupied"}, nil)
+	mockPickupPINRepo.On("MarkUsed", ctx, record.ID).Return(record, nil)
+	mockLockerRepo.On("UpdateCellStatus", ctx, mock.MatchedBy(func(c *entity.LockerCell) bool {
+		return c.ID == cellID && c.Status == "opened"
+	})).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	result, err := uc.ProcessPINEntry(ctx, "123456", automatID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{cellID}, result)
+	mockPickupPINRepo.AssertExpectations(t)
+	mockLockerRepo.AssertExpectations(t)
+}
+
+func TestParcelAutomatUseCase_ProcessPINEntry_AlreadyCollected(t *testing.T) {
+	mockLockerRepo := new(mocks.MockLockerRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+
+	pickupPINUseCase := NewPickupPINUseCase(mockPickupPINRepo, mockOrderRepo, nil, mockQRGenerator, nil, mockLogger)
+	uc := NewParcelAutomatUseCase(nil, mockLockerRepo, nil, mockOrderRepo, nil, nil, nil, pickupPINUseCase, nil, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	cellID := uuid.New()
+	automatID := uuid.New()
+	record := &entity.PickupPIN{ID: uuid.New(), OrderID: orderID, ParcelAutomatID: automatID}
+
+	mockQRGenerator.On("HashPickupPIN", "123456").Return("hash")
+	mockPickupPINRepo.On("GetActiveByHash", ctx, automatID, "hash").Return(record, nil)
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, Status: "completed", LockerCellID: &cellID}, nil)
+
+	result, err := uc.ProcessPINEntry(ctx, "123456", automatID)
+
+	assert.ErrorIs(t, err, entityError.ErrQRNoOrdersForPickup)
+	assert.Nil(t, result)
+	mockPickupPINRepo.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything)
+}
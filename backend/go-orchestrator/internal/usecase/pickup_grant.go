@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/validator"
+)
+
+const (
+	defaultPickupGrantTTL = 24 * time.Hour
+	maxPickupGrantTTL     = qr.TTL
+	pickupGrantTokenBytes = 24
+)
+
+type PickupGrantUseCase struct {
+	pickupGrantRepo repo.PickupGrantRepo
+	orderRepo       repo.OrderRepo
+	userRepo        repo.UserRepo
+	qrGenerator     qr.QRGeneratorContract
+	notifier        PickupNotifier
+	logger          logger.Interface
+}
+
+func NewPickupGrantUseCase(
+	pickupGrantRepo repo.PickupGrantRepo,
+	orderRepo repo.OrderRepo,
+	userRepo repo.UserRepo,
+	qrGenerator qr.QRGeneratorContract,
+	notifier PickupNotifier,
+	logger logger.Interface,
+) *PickupGrantUseCase {
+	return &PickupGrantUseCase{
+		pickupGrantRepo: pickupGrantRepo,
+		orderRepo:       orderRepo,
+		userRepo:        userRepo,
+		qrGenerator:     qrGenerator,
+		notifier:        notifier,
+		logger:          logger,
+	}
+}
+
+func (uc *PickupGrantUseCase) CreateGrant(ctx context.Context, ownerID, orderID uuid.UUID, granteePhone *string, ttl time.Duration) (*entity.PickupGrant, error) {
+	order, err := uc.getOwnedOrder(ctx, ownerID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status != "pending" && order.Status != "in_progress" && order.Status != "delivered" {
+		return nil, entityError.ErrPickupGrantOrderNotEligible
+	}
+
+	if ttl == 0 {
+		ttl = defaultPickupGrantTTL
+	}
+	if ttl < 0 || ttl > maxPickupGrantTTL {
+		return nil, entityError.ErrPickupGrantInvalidExpiry
+	}
+
+	var phone *string
+	if granteePhone != nil && *granteePhone != "" {
+		normalized := validator.NormalizeRussianPhone(*granteePhone)
+		if !validator.ValidateRussianPhone(normalized) {
+			return nil, entityError.ErrPickupGrantInvalidPhone
+		}
+		phone = &normalized
+	}
+
+	token, err := generatePickupGrantToken()
+	if err != nil {
+		return nil, fmt.Errorf("PickupGrantUseCase - CreateGrant - generatePickupGrantToken: %w", err)
+	}
+
+	grant, err := uc.pickupGrantRepo.Create(ctx, &entity.PickupGrant{
+		OrderID:      order.ID,
+		OwnerID:      ownerID,
+		GranteePhone: phone,
+		Token:        token,
+		ExpiresAt:    time.Now().Add(ttl),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("PickupGrantUseCase - CreateGrant - Create: %w", err)
+	}
+
+	uc.logger.Info("Pickup grant created", nil, map[string]any{
+		"grantID": grant.ID,
+		"orderID": order.ID,
+		"ownerID": ownerID,
+		"isLink":  grant.IsLink(),
+	})
+
+	return grant, nil
+}
+
+func (uc *PickupGrantUseCase) ListGrants(ctx context.Context, ownerID, orderID uuid.UUID) ([]*entity.PickupGrant, error) {
+	if _, err := uc.getOwnedOrder(ctx, ownerID, orderID); err != nil {
+		return nil, err
+	}
+
+	grants, err := uc.pickupGrantRepo.ListByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("PickupGrantUseCase - ListGrants - ListByOrderID: %w", err)
+	}
+	return grants, nil
+}
+
+func (uc *PickupGrantUseCase) RevokeGrant(ctx context.Context, ownerID, orderID, grantID uuid.UUID) error {
+	grant, err := uc.pickupGrantRepo.GetByID(ctx, grantID)
+	if err != nil {
+		return err
+	}
+
+	if grant.OrderID != orderID || grant.OwnerID != ownerID {
+		return entityError.ErrPickupGrantNotFound
+	}
+
+	if _, err := uc.pickupGrantRepo.Revoke(ctx, grantID); err != nil {
+		return fmt.Errorf("PickupGrantUseCase - RevokeGrant - Revoke: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *PickupGrantUseCase) ListReceivedGrants(ctx context.Context, userID uuid.UUID) ([]*entity.PickupGrant, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.GetPhoneNumber() == "" {
+		return []*entity.PickupGrant{}, nil
+	}
+
+	grants, err := uc.pickupGrantRepo.ListActiveByPhone(ctx, user.GetPhoneNumber())
+	if err != nil {
+		return nil, fmt.Errorf("PickupGrantUseCase - ListReceivedGrants - ListActiveByPhone: %w", err)
+	}
+	return grants, nil
+}
+
+func (uc *PickupGrantUseCase) GetGrantQRByToken(ctx context.Context, token string) (*qr.GrantQRData, string, error) {
+	grant, err := uc.pickupGrantRepo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !grant.IsLink() {
+		return nil, "", entityError.ErrPickupGrantNotFound
+	}
+
+	return uc.issueGrantQR(grant)
+}
+
+func (uc *PickupGrantUseCase) GetGrantQRForUser(ctx context.Context, userID, grantID uuid.UUID) (*qr.GrantQRData, string, error) {
+	grant, err := uc.pickupGrantRepo.GetByID(ctx, grantID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if grant.IsLink() || *grant.GranteePhone != user.GetPhoneNumber() {
+		return nil, "", entityError.ErrPickupGrantNotForUser
+	}
+
+	return uc.issueGrantQR(grant)
+}
+
+func (uc *PickupGrantUseCase) ValidateGrantQR(ctx context.Context, qrDataJSON string) (*entity.PickupGrant, error) {
+	grantData, err := uc.qrGenerator.ValidateGrantQRCode(qrDataJSON)
+	if err != nil {
+		uc.logger.Warn("PickupGrantUseCase - ValidateGrantQR - ValidateGrantQRCode", err, nil)
+		return nil, fmt.Errorf("PickupGrantUseCase - ValidateGrantQR: %w", entityError.ErrQRValidationFailed)
+	}
+
+	grant, err := uc.pickupGrantRepo.GetByID(ctx, grantData.GrantID)
+	if err != nil {
+		return nil, fmt.Errorf("PickupGrantUseCase - ValidateGrantQR - GetByID: %w", err)
+	}
+
+	if grant.OrderID != grantData.OrderID || grant.OwnerID != grantData.UserID {
+		return nil, entityError.ErrQRUserMismatch
+	}
+
+	if err := checkPickupGrantActive(grant); err != nil {
+		return nil, err
+	}
+
+	return grant, nil
+}
+
+func (uc *PickupGrantUseCase) RedeemGrant(ctx context.Context, grantID uuid.UUID) (*entity.PickupGrant, error) {
+	grant, err := uc.pickupGrantRepo.MarkUsed(ctx, grantID)
+	if err != nil {
+		return nil, fmt.Errorf("PickupGrantUseCase - RedeemGrant - MarkUsed: %w", err)
+	}
+
+	uc.logger.Info("Pickup grant redeemed", nil, map[string]any{
+		"grantID": grant.ID,
+		"orderID": grant.OrderID,
+	})
+
+	return grant, nil
+}
+
+// NotifyOwnerIfCollectedByGrant reports whether the order was collected through a grant.
+func (uc *PickupGrantUseCase) NotifyOwnerIfCollectedByGrant(ctx context.Context, order *entity.Order) bool {
+	grant, err := uc.pickupGrantRepo.GetUsedByOrderID(ctx, order.ID)
+	if err != nil {
+		if !errors.Is(err, entityError.ErrPickupGrantNotFound) {
+			uc.logger.Warn("PickupGrantUseCase - NotifyOwnerIfCollectedByGrant - GetUsedByOrderID", err, map[string]any{
+				"orderID": order.ID,
+			})
+		}
+		return false
+	}
+
+	if uc.notifier == nil {
+		return true
+	}
+
+	if err := uc.notifier.NotifyOrderCollected(ctx, grant.OwnerID, order.ID); err != nil {
+		uc.logger.Warn("PickupGrantUseCase - NotifyOwnerIfCollectedByGrant - NotifyOrderCollected", err, map[string]any{
+			"orderID": order.ID,
+			"grantID": grant.ID,
+		})
+	}
+	return true
+}
+
+func (uc *PickupGrantUseCase) getOwnedOrder(ctx context.Context, ownerID, orderID uuid.UUID) (*entity.Order, error) {
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.UserID != ownerID {
+		return nil, entityError.ErrOrderNotBelongsToUser
+	}
+
+	return order, nil
+}
+
+func (uc *PickupGrantUseCase) issueGrantQR(grant *entity.PickupGrant) (*qr.GrantQRData, string, error) {
+	if err := checkPickupGrantActive(grant); err != nil {
+		return nil, "", err
+	}
+
+	grantData, qrImageBase64, err := uc.qrGenerator.GenerateGrantQRCode(grant.ID, grant.OwnerID, grant.OrderID, grant.ExpiresAt)
+	if err != nil {
+		return nil, "", fmt.Errorf("PickupGrantUseCase - issueGrantQR - GenerateGrantQRCode: %w", err)
+	}
+
+	return grantData, qrImageBase64, nil
+}
+
+func checkPickupGrantActive(grant *entity.PickupGrant) error {
+	switch {
+	case grant.RevokedAt != nil:
+		return entityError.ErrPickupGrantRevoked
+	case grant.UsedAt != nil:
+		return entityError.ErrPickupGrantAlreadyUsed
+	case time.Now().After(grant.ExpiresAt):
+		return entityError.ErrPickupGrantExpired
+	}
+	return nil
+}
+
+func generatePickupGrantToken() (string, error) {
+	buf := make([]byte, pickupGrantTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPickupNotifier struct {
+	mock.Mock
+}
+
+func (m *mockPickupNotifier) NotifyOrderCollected(ctx context.Context, userID uuid.UUID, orderID uuid.UUID) error {
+	args := m.Called(ctx, userID, orderID)
+	return args.Error(0)
+}
+
+func TestPickupGrantUseCase_CreateGrant_Phone(t *testing.T) {
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(mockPickupGrantRepo, mockOrderRepo, mockUserRepo, mockQRGenerator, nil, mockLogger)
+
+	ctx := context.Background()
+	ownerID := uuid.New()
+	orderID := uuid.New()
+	phone := "8 (999) 123-45-67"
+
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: ownerID, Status: "delivered"}, nil)
+	mockPickupGrantRepo.On("Create", ctx, mock.MatchedBy(func(g *entity.PickupGrant) bool {
+		return g.OrderID == orderID && g.OwnerID == ownerID &&
+			g.GranteePhone != nil && *g.GranteePhone == "+79991234567" &&
+			len(g.Token) == pickupGrantTokenBytes*2 &&
+			g.ExpiresAt.After(time.Now().Add(defaultPickupGrantTTL-time.Minute))
+	})).Return(func(_ context.Context, g *entity.PickupGrant) (*entity.PickupGrant, error) {
+		created := *g
+		created.ID = uuid.New()
+		return &created, nil
+	})
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	grant, err := uc.CreateGrant(ctx, ownerID, orderID, &phone, 0)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, grant)
+	assert.False(t, grant.IsLink())
+	mockOrderRepo.AssertExpectations(t)
+	mockPickupGrantRepo.AssertExpectations(t)
+}
+
+func TestPickupGrantUseCase_CreateGrant_NotOwner(t *testing.T) {
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(mockPickupGrantRepo, mockOrderRepo, nil, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: uuid.New(), Status: "delivered"}, nil)
+
+	grant, err := uc.CreateGrant(ctx, uuid.New(), orderID, nil, time.Hour)
+
+	assert.ErrorIs(t, err, entityError.ErrOrderNotBelongsToUser)
+	assert.Nil(t, grant)
+	mockPickupGrantRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPickupGrantUseCase_CreateGrant_InvalidExpiry(t *testing.T) {
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(new(mocks.MockPickupGrantRepo), mockOrderRepo, nil, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	ownerID := uuid.New()
+	orderID := uuid.New()
+
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: ownerID, Status: "pending"}, nil)
+
+	grant, err := uc.CreateGrant(ctx, ownerID, orderID, nil, maxPickupGrantTTL+time.Hour)
+
+	assert.ErrorIs(t, err, entityError.ErrPickupGrantInvalidExpiry)
+	assert.Nil(t, grant)
+}
+
+func TestPickupGrantUseCase_CreateGrant_CompletedOrder(t *testing.T) {
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(new(mocks.MockPickupGrantRepo), mockOrderRepo, nil, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	ownerID := uuid.New()
+	orderID := uuid.New()
+
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: ownerID, Status: "completed"}, nil)
+
+	grant, err := uc.CreateGrant(ctx, ownerID, orderID, nil, 0)
+
+	assert.ErrorIs(t, err, entityError.ErrPickupGrantOrderNotEligible)
+	assert.Nil(t, grant)
+}
+
+func TestPickupGrantUseCase_GetGrantQRByToken_Success(t *testing.T) {
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(mockPickupGrantRepo, nil, nil, mockQRGenerator, nil, mockLogger)
+
+	ctx := context.Background()
+	grant := &entity.PickupGrant{
+		ID:        uuid.New(),
+		OrderID:   uuid.New(),
+		OwnerID:   uuid.New(),
+		Token:     "token",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	grantData := &qr.GrantQRData{GrantID: grant.ID, OrderID: grant.OrderID, ExpiresAt: grant.ExpiresAt}
+
+	mockPickupGrantRepo.On("GetByToken", ctx, "token").Return(grant, nil)
+	mockQRGenerator.On("GenerateGrantQRCode", grant.ID, grant.OwnerID, grant.OrderID, grant.ExpiresAt).Return(grantData, "base64", nil)
+
+	data, image, err := uc.GetGrantQRByToken(ctx, "token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, grantData, data)
+	assert.Equal(t, "base64", image)
+	mockQRGenerator.AssertExpectations(t)
+}
+
+func TestPickupGrantUseCase_GetGrantQRByToken_Used(t *testing.T) {
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(mockPickupGrantRepo, nil, nil, mockQRGenerator, nil, mockLogger)
+
+	ctx := context.Background()
+	usedAt := time.Now()
+	grant := &entity.PickupGrant{
+		ID:        uuid.New(),
+		Token:     "token",
+		ExpiresAt: time.Now().Add(time.Hour),
+		UsedAt:    &usedAt,
+	}
+
+	mockPickupGrantRepo.On("GetByToken", ctx, "token").Return(grant, nil)
+
+	data, _, err := uc.GetGrantQRByToken(ctx, "token")
+
+	assert.ErrorIs(t, err, entityError.ErrPickupGrantAlreadyUsed)
+	assert.Nil(t, data)
+	mockQRGenerator.AssertNotCalled(t, "GenerateGrantQRCode", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPickupGrantUseCase_GetGrantQRForUser_WrongPhone(t *testing.T) {
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(mockPickupGrantRepo, nil, mockUserRepo, new(mocks.MockQRGenerator), nil, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	granteePhone := "+79991234567"
+	userPhone := "+79990000000"
+	grant := &entity.PickupGrant{
+		ID:           uuid.New(),
+		GranteePhone: &granteePhone,
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+
+	mockPickupGrantRepo.On("GetByID", ctx, grant.ID).Return(grant, nil)
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID, PhoneNumber: &userPhone}, nil)
+
+	data, _, err := uc.GetGrantQRForUser(ctx, userID, grant.ID)
+
+	assert.ErrorIs(t, err, entityError.ErrPickupGrantNotForUser)
+	assert.Nil(t, data)
+}
+
+func TestPickupGrantUseCase_ValidateGrantQR_Revoked(t *testing.T) {
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(mockPickupGrantRepo, nil, nil, mockQRGenerator, nil, mockLogger)
+
+	ctx := context.Background()
+	revokedAt := time.Now()
+	grant := &entity.PickupGrant{
+		ID:        uuid.New(),
+		OrderID:   uuid.New(),
+		OwnerID:   uuid.New(),
+		ExpiresAt: time.Now().Add(time.Hour),
+		RevokedAt: &revokedAt,
+	}
+	qrDataJSON := `{"type":"pickup_grant"}`
+
+	mockQRGenerator.On("ValidateGrantQRCode", qrDataJSON).Return(&qr.GrantQRData{GrantID: grant.ID, OrderID: grant.OrderID, UserID: grant.OwnerID}, nil)
+	mockPickupGrantRepo.On("GetByID", ctx, grant.ID).Return(grant, nil)
+
+	result, err := uc.ValidateGrantQR(ctx, qrDataJSON)
+
+	assert.ErrorIs(t, err, entityError.ErrPickupGrantRevoked)
+	assert.Nil(t, result)
+}
+
+func TestPickupGrantUseCase_ValidateGrantQR_InvalidSignature(t *testing.T) {
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(new(mocks.MockPickupGrantRepo), nil, nil, mockQRGenerator, nil, mockLogger)
+
+	ctx := context.Background()
+	qrDataJSON := `{"type":"pickup_grant"}`
+
+	mockQRGenerator.On("ValidateGrantQRCode", qrDataJSON).Return(nil, errors.New("invalid signature"))
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	result, err := uc.ValidateGrantQR(ctx, qrDataJSON)
+
+	assert.ErrorIs(t, err, entityError.ErrQRValidationFailed)
+	assert.Nil(t, result)
+}
+
+func TestPickupGrantUseCase_NotifyOwnerIfCollectedByGrant(t *testing.T) {
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockNotifier := new(mockPickupNotifier)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(mockPickupGrantRepo, nil, nil, nil, mockNotifier, mockLogger)
+
+	ctx := context.Background()
+	ownerID := uuid.New()
+	order := &entity.Order{ID: uuid.New(), UserID: ownerID}
+	usedAt := time.Now()
+
+	mockPickupGrantRepo.On("GetUsedByOrderID", ctx, order.ID).Return(&entity.PickupGrant{ID: uuid.New(), OrderID: order.ID, OwnerID: ownerID, UsedAt: &usedAt}, nil)
+	mockNotifier.On("NotifyOrderCollected", ctx, ownerID, order.ID).Return(nil)
+
+	assert.True(t, uc.NotifyOwnerIfCollectedByGrant(ctx, order))
+
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestPickupGrantUseCase_NotifyOwnerIfCollectedByGrant_NoGrant(t *testing.T) {
+	mockPickupGrantRepo := new(mocks.MockPickupGrantRepo)
+	mockNotifier := new(mockPickupNotifier)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupGrantUseCase(mockPickupGrantRepo, nil, nil, nil, mockNotifier, mockLogger)
+
+	ctx := context.Background()
+	order := &entity.Order{ID: uuid.New()}
+
+	mockPickupGrantRepo.On("GetUsedByOrderID", ctx, order.ID).Return(nil, entityError.ErrPickupGrantNotFound)
+
+	assert.False(t, uc.NotifyOwnerIfCollectedByGrant(ctx, order))
+
+	mockNotifier.AssertNotCalled(t, "NotifyOrderCollected", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
+)
+
+const (
+	pickupPINTTL                = qr.TTL
+	maxPickupPINIssueCollisions = 3
+
+	// A keypad gets a few free mistakes per window; after that every wrong PIN locks it for twice as long.
+	pickupPINFailureWindow    = 15 * time.Minute
+	pickupPINFreeFailures     = 5
+	pickupPINBaseLockDuration = 30 * time.Second
+	pickupPINMaxLockDuration  = 15 * time.Minute
+)
+
+type PickupPINIssuer interface {
+	IssuePIN(ctx context.Context, orderID, parcelAutomatID uuid.UUID) (string, *entity.PickupPIN, error)
+}
+
+type PickupPINUseCase struct {
+	pickupPINRepo repo.PickupPINRepo
+	orderRepo     repo.OrderRepo
+	deliveryRepo  repo.DeliveryRepo
+	qrGenerator   qr.QRGeneratorContract
+	throttle      repo.PickupPINThrottle
+	logger        logger.Interface
+}
+
+func NewPickupPINUseCase(
+	pickupPINRepo repo.PickupPINRepo,
+	orderRepo repo.OrderRepo,
+	deliveryRepo repo.DeliveryRepo,
+	qrGenerator qr.QRGeneratorContract,
+	throttle repo.PickupPINThrottle,
+	logger logger.Interface,
+) *PickupPINUseCase {
+	return &PickupPINUseCase{
+		pickupPINRepo: pickupPINRepo,
+		orderRepo:     orderRepo,
+		deliveryRepo:  deliveryRepo,
+		qrGenerator:   qrGenerator,
+		throttle:      throttle,
+		logger:        logger,
+	}
+}
+
+func (uc *PickupPINUseCase) IssuePIN(ctx context.Context, orderID, parcelAutomatID uuid.UUID) (string, *entity.PickupPIN, error) {
+	for attempt := 0; attempt < maxPickupPINIssueCollisions; attempt++ {
+		pin, pinHash, err := uc.qrGenerator.GeneratePickupPIN()
+		if err != nil {
+			return "", nil, fmt.Errorf("PickupPINUseCase - IssuePIN - GeneratePickupPIN: %w", err)
+		}
+
+		record, err := uc.pickupPINRepo.Upsert(ctx, &entity.PickupPIN{
+			OrderID:         orderID,
+			ParcelAutomatID: parcelAutomatID,
+			PINHash:         pinHash,
+			ExpiresAt:       time.Now().Add(pickupPINTTL),
+		})
+		if errors.Is(err, entityError.ErrPickupPINAlreadyExists) {
+			continue
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("PickupPINUseCase - IssuePIN - Upsert: %w", err)
+		}
+
+		uc.logger.Info("Pickup PIN issued", nil, map[string]any{
+			"orderID":         orderID,
+			"parcelAutomatID": parcelAutomatID,
+		})
+
+		return pin, record, nil
+	}
+
+	return "", nil, fmt.Errorf("PickupPINUseCase - IssuePIN: %w", entityError.ErrPickupPINAlreadyExists)
+}
+
+func (uc *PickupPINUseCase) ReissuePIN(ctx context.Context, userID, orderID uuid.UUID) (string, *entity.PickupPIN, error) {
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if order.UserID != userID {
+		return "", nil, entityError.ErrOrderNotBelongsToUser
+	}
+
+	if order.Status != "delivered" || order.LockerCellID == nil {
+		return "", nil, entityError.ErrPickupPINOrderNotEligible
+	}
+
+	delivery, err := uc.deliveryRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		return "", nil, fmt.Errorf("PickupPINUseCase - ReissuePIN - GetDelivery: %w", err)
+	}
+
+	return uc.IssuePIN(ctx, order.ID, delivery.ParcelAutomatID)
+}
+
+// ValidatePIN looks up the active PIN entered on the automat's keypad. Wrong PINs are throttled per
+// keypad, so guessing slows down without touching the PINs other customers hold.
+func (uc *PickupPINUseCase) ValidatePIN(ctx context.Context, pin string, parcelAutomatID uuid.UUID) (*entity.PickupPIN, error) {
+	if err := uc.checkThrottle(ctx, parcelAutomatID); err != nil {
+		return nil, err
+	}
+
+	if !qr.IsPickupPIN(pin) {
+		uc.recordFailure(ctx, parcelAutomatID)
+		return nil, entityError.ErrPickupPINInvalid
+	}
+
+	record, err := uc.pickupPINRepo.GetActiveByHash(ctx, parcelAutomatID, uc.qrGenerator.HashPickupPIN(pin))
+	if err != nil {
+		if !errors.Is(err, entityError.ErrPickupPINNotFound) {
+			return nil, fmt.Errorf("PickupPINUseCase - ValidatePIN - GetActiveByHash: %w", err)
+		}
+
+		uc.logger.Warn("Invalid pickup PIN entered", nil, map[string]any{
+			"parcelAutomatID": parcelAutomatID,
+		})
+		uc.recordFailure(ctx, parcelAutomatID)
+
+		return nil, entityError.ErrPickupPINInvalid
+	}
+
+	return record, nil
+}
+
+// checkThrottle fails open: a cache outage must not keep customers away from their parcels.
+func (uc *PickupPINUseCase) checkThrottle(ctx context.Context, parcelAutomatID uuid.UUID) error {
+	if uc.throttle == nil {
+		return nil
+	}
+
+	lockedFor, err := uc.throttle.LockedFor(ctx, parcelAutomatID)
+	if err != nil {
+		uc.logger.Warn("PickupPINUseCase - checkThrottle - LockedFor", err, map[string]any{
+			"parcelAutomatID": parcelAutomatID,
+		})
+		return nil
+	}
+	if lockedFor > 0 {
+		return fmt.Errorf("%w: retry in %s", entityError.ErrPickupPINThrottled, lockedFor.Round(time.Second))
+	}
+	return nil
+}
+
+func (uc *PickupPINUseCase) recordFailure(ctx context.Context, parcelAutomatID uuid.UUID) {
+	if uc.throttle == nil {
+		return
+	}
+
+	failures, err := uc.throttle.RecordFailure(ctx, parcelAutomatID, pickupPINFailureWindow)
+	if err != nil {
+		uc.logger.Warn("PickupPINUseCase - recordFailure - RecordFailure", err, map[string]any{
+			"parcelAutomatID": parcelAutomatID,
+		})
+		return
+	}
+	if failures <= pickupPINFreeFailures {
+		return
+	}
+
+	lockFor := pickupPINLockDuration(failures - pickupPINFreeFailures)
+	if err := uc.throttle.Lock(ctx, parcelAutomatID, lockFor); err != nil {
+		uc.logger.Warn("PickupPINUseCase - recordFailure - Lock", err, map[string]any{
+			"parcelAutomatID": parcelAutomatID,
+		})
+		return
+	}
+
+	uc.logger.Warn("Pickup PIN keypad locked", nil, map[string]any{
+		"parcelAutomatID": parcelAutomatID,
+		"failures":        failures,
+		"lockedFor":       lockFor.String(),
+	})
+}
+
+func pickupPINLockDuration(excessFailures int) time.Duration {
+	lockFor := pickupPINBaseLockDuration
+	for i := 1; i < excessFailures; i++ {
+		lockFor *= 2
+		if lockFor >= pickupPINMaxLockDuration {
+			return pickupPINMaxLockDuration
+		}
+	}
+	return lockFor
+}
+
+func (uc *PickupPINUseCase) RedeemPIN(ctx context.Context, id uuid.UUID) (*entity.PickupPIN, error) {
+	record, err := uc.pickupPINRepo.MarkUsed(ctx, id)
+	if err != nil {
+		if errors.Is(err, entityError.ErrPickupPINNotFound) {
+			return nil, entityError.ErrPickupPINInvalid
+		}
+		return nil, fmt.Errorf("PickupPINUseCase - RedeemPIN - MarkUsed: %w", err)
+	}
+
+	uc.logger.Info("Pickup PIN redeemed", nil, map[string]any{
+		"orderID":         record.OrderID,
+		"parcelAutomatID": record.ParcelAutomatID,
+	})
+
+	return record, nil
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPickupPINUseCase_IssuePIN_Success(t *testing.T) {
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupPINUseCase(mockPickupPINRepo, nil, nil, mockQRGenerator, nil, mockLogger)
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	automatID := uuid.New()
+
+	mockQRGenerator.On("GeneratePickupPIN").Return("123456", "hash", nil)
+	mockPickupPINRepo.On("Upsert", ctx, mock.MatchedBy(func(p *entity.PickupPIN) bool {
+		return p.OrderID == orderID && p.ParcelAutomatID == automatID && p.PINHash == "hash"
+	})).Return(&entity.PickupPIN{ID: uuid.New(), OrderID: orderID, ParcelAutomatID: automatID}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	pin, record, err := uc.IssuePIN(ctx, orderID, automatID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "123456", pin)
+	assert.Equal(t, orderID, record.OrderID)
+	mockPickupPINRepo.AssertExpectations(t)
+}
+
+func TestPickupPINUseCase_IssuePIN_RetriesOnCollision(t *testing.T) {
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupPINUseCase(mockPickupPINRepo, nil, nil, mockQRGenerator, nil, mockLogger)
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	automatID := uuid.New()
+
+	mockQRGenerator.On("GeneratePickupPIN").Return("111111", "taken", nil).Once()
+	mockQRGenerator.On("GeneratePickupPIN").Return("222222", "free", nil).Once()
+	mockPickupPINRepo.On("Upsert", ctx, mock.MatchedBy(func(p *entity.PickupPIN) bool {
+		return p.PINHash == "taken"
+	})).Return(nil, entityError.ErrPickupPINAlreadyExists)
+	mockPickupPINRepo.On("Upsert", ctx, mock.MatchedBy(func(p *entity.PickupPIN) bool {
+		return p.PINHash == "free"
+	})).Return(&entity.PickupPIN{ID: uuid.New(), OrderID: orderID}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	pin, _, err := uc.IssuePIN(ctx, orderID, automatID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "222222", pin)
+	mockQRGenerator.AssertNumberOfCalls(t, "GeneratePickupPIN", 2)
+}
+
+func TestPickupPINUseCase_ReissuePIN_NotOwner(t *testing.T) {
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupPINUseCase(new(mocks.MockPickupPINRepo), mockOrderRepo, nil, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	cellID := uuid.New()
+
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: uuid.New(), Status: "delivered", LockerCellID: &cellID}, nil)
+
+	pin, _, err := uc.ReissuePIN(ctx, uuid.New(), orderID)
+
+	assert.ErrorIs(t, err, entityError.ErrOrderNotBelongsToUser)
+	assert.Empty(t, pin)
+}
+
+func TestPickupPINUseCase_ReissuePIN_NotDelivered(t *testing.T) {
+	mockOrderRepo := new(mocks.MockOrderRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupPINUseCase(new(mocks.MockPickupPINRepo), mockOrderRepo, nil, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	orderID := uuid.New()
+
+	mockOrderRepo.On("GetByID", ctx, orderID).Return(&entity.Order{ID: orderID, UserID: userID, Status: "in_progress"}, nil)
+
+	pin, _, err := uc.ReissuePIN(ctx, userID, orderID)
+
+	assert.ErrorIs(t, err, entityError.ErrPickupPINOrderNotEligible)
+	assert.Empty(t, pin)
+}
+
+func TestPickupPINUseCase_ValidatePIN_Success(t *testing.T) {
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupPINUseCase(mockPickupPINRepo, nil, nil, mockQRGenerator, nil, mockLogger)
+
+	ctx := context.Background()
+	automatID := uuid.New()
+	record := &entity.PickupPIN{ID: uuid.New(), OrderID: uuid.New(), ParcelAutomatID: automatID}
+
+	mockQRGenerator.On("HashPickupPIN", "123456").Return("hash")
+	mockPickupPINRepo.On("GetActiveByHash", ctx, automatID, "hash").Return(record, nil)
+
+	result, err := uc.ValidatePIN(ctx, "123456", automatID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, record, result)
+}
+
+func TestPickupPINUseCase_ValidatePIN_WrongPIN(t *testing.T) {
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockThrottle := new(mocks.MockPickupPINThrottle)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupPINUseCase(mockPickupPINRepo, nil, nil, mockQRGenerator, mockThrottle, mockLogger)
+
+	ctx := context.Background()
+	automatID := uuid.New()
+
+	mockThrottle.On("LockedFor", ctx, automatID).Return(time.Duration(0), nil)
+	mockQRGenerator.On("HashPickupPIN", "000000").Return("hash")
+	mockPickupPINRepo.On("GetActiveByHash", ctx, automatID, "hash").Return(nil, entityError.ErrPickupPINNotFound)
+	mockThrottle.On("RecordFailure", ctx, automatID, pickupPINFailureWindow).Return(1, nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	result, err := uc.ValidatePIN(ctx, "000000", automatID)
+
+	assert.ErrorIs(t, err, entityError.ErrPickupPINInvalid)
+	assert.Nil(t, result)
+	mockPickupPINRepo.AssertExpectations(t)
+	mockThrottle.AssertExpectations(t)
+	mockThrottle.AssertNotCalled(t, "Lock", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPickupPINUseCase_ValidatePIN_LocksKeypadAfterFreeFailures(t *testing.T) {
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockThrottle := new(mocks.MockPickupPINThrottle)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupPINUseCase(mockPickupPINRepo, nil, nil, mockQRGenerator, mockThrottle, mockLogger)
+
+	ctx := context.Background()
+	automatID := uuid.New()
+
+	mockThrottle.On("LockedFor", ctx, automatID).Return(time.Duration(0), nil)
+	mockQRGenerator.On("HashPickupPIN", "000000").Return("hash")
+	mockPickupPINRepo.On("GetActiveByHash", ctx, automatID, "hash").Return(nil, entityError.ErrPickupPINNotFound)
+	mockThrottle.On("RecordFailure", ctx, automatID, pickupPINFailureWindow).Return(pickupPINFreeFailures+2, nil)
+	mockThrottle.On("Lock", ctx, automatID, 2*pickupPINBaseLockDuration).Return(nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	_, err := uc.ValidatePIN(ctx, "000000", automatID)
+
+	assert.ErrorIs(t, err, entityError.ErrPickupPINInvalid)
+	mockThrottle.AssertExpectations(t)
+}
+
+func TestPickupPINUseCase_ValidatePIN_Throttled(t *testing.T) {
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockThrottle := new(mocks.MockPickupPINThrottle)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupPINUseCase(mockPickupPINRepo, nil, nil, new(mocks.MockQRGenerator), mockThrottle, mockLogger)
+
+	ctx := context.Background()
+	automatID := uuid.New()
+
+	mockThrottle.On("LockedFor", ctx, automatID).Return(45*time.Second, nil)
+
+	result, err := uc.ValidatePIN(ctx, "123456", automatID)
+
+	assert.ErrorIs(t, err, entityError.ErrPickupPINThrottled)
+	assert.Nil(t, result)
+	mockPickupPINRepo.AssertNotCalled(t, "GetActiveByHash", mock.Anything, mock.Anything, mock.Anything)
+	mockThrottle.AssertNotCalled(t, "RecordFailure", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPickupPINLockDuration(t *testing.T) {
+	assert.Equal(t, pickupPINBaseLockDuration, pickupPINLockDuration(1))
+	assert.Equal(t, 4*pickupPINBaseLockDuration, pickupPINLockDuration(3))
+	assert.Equal(t, pickupPINMaxLockDuration, pickupPINLockDuration(20))
+}
+
+func TestPickupPINUseCase_ValidatePIN_MalformedPIN(t *testing.T) {
+	mockPickupPINRepo := new(mocks.MockPickupPINRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewPickupPINUseCase(mockPickupPINRepo, nil, nil, new(mocks.MockQRGenerator), nil, mockLogger)
+
+	result, err := uc.ValidatePIN(context.Background(), "12ab", uuid.New())
+
+	assert.ErrorIs(t, err, entityError.ErrPickupPINInvalid)
+	assert.Nil(t, result)
+	mockPickupPINRepo.AssertNotCalled(t, "GetActiveByHash", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -18,18 +19,29 @@ import (
 )
 
 type QRUseCase struct {
-	qrGenerator qr.QRGeneratorContract
-	userRepo    repo.UserRepo
-	minioClient minio.MinioClient
-	logger      logger.Interface
+	qrGenerator  qr.QRGeneratorContract
+	userRepo     repo.UserRepo
+	qrSecretRepo repo.UserQRSecretRepo
+	nonceCache   repo.QRNonceCache
+	minioClient  minio.MinioClient
+	logger       logger.Interface
 }
 
-func NewQRUseCase(qrGenerator qr.QRGeneratorContract, userRepo repo.UserRepo, minioClient minio.MinioClient, logger logger.Interface) *QRUseCase {
+func NewQRUseCase(
+	qrGenerator qr.QRGeneratorContract,
+	userRepo repo.UserRepo,
+	qrSecretRepo repo.UserQRSecretRepo,
+	nonceCache repo.QRNonceCache,
+	minioClient minio.MinioClient,
+	logger logger.Interface,
+) *QRUseCase {
 	return &QRUseCase{
-		qrGenerator: qrGenerator,
-		userRepo:    userRepo,
-		minioClient: minioClient,
-		logger:      logger,
+		qrGenerator:  qrGenerator,
+		userRepo:     userRepo,
+		qrSecretRepo: qrSecretRepo,
+		nonceCache:   nonceCache,
+		minioClient:  minioClient,
+		logger:       logger,
 	}
 }
 
@@ -42,7 +54,12 @@ type QRInfo struct {
 }
 
 func (uc *QRUseCase) GenerateQR(ctx context.Context, user *entity.User) (*QRInfo, string, error) {
-	qrData, qrImageBase64, err := uc.qrGenerator.GenerateQRCode(user.ID, user.GetEmail(), user.FullName)
+	generation, err := uc.qrSecretRepo.GetGeneration(ctx, user.ID)
+	if err != nil {
+		return nil, "", fmt.Errorf("QRUseCase - GenerateQR - GetGeneration: %w", err)
+	}
+
+	qrData, qrImageBase64, err := uc.qrGenerator.GenerateQRCode(user.ID, user.GetEmail(), user.FullName, generation)
 	if err != nil {
 		return nil, "", fmt.Errorf("QRUseCase - GenerateQR - GenerateQRCode: %w", err)
 	}
@@ -73,7 +90,25 @@ func (uc *QRUseCase) GenerateQR(ctx context.Context, user *entity.User) (*QRInfo
 	return qrInfo, qrImageBase64, nil
 }
 
+func (uc *QRUseCase) IssueQRSecret(ctx context.Context, userID uuid.UUID) (string, error) {
+	secret, err := qr.GenerateDynamicSecret()
+	if err != nil {
+		return "", fmt.Errorf("QRUseCase - IssueQRSecret - GenerateDynamicSecret: %w", err)
+	}
+
+	secret, err = uc.qrSecretRepo.Ensure(ctx, userID, secret)
+	if err != nil {
+		return "", fmt.Errorf("QRUseCase - IssueQRSecret - Ensure: %w", err)
+	}
+
+	return secret, nil
+}
+
 func (uc *QRUseCase) ValidateQR(ctx context.Context, qrDataJSON string) (*entity.User, error) {
+	if qr.IsDynamicQR(qrDataJSON) {
+		return uc.validateDynamicQR(ctx, qrDataJSON)
+	}
+
 	qrData, err := uc.qrGenerator.ValidateQRCode(qrDataJSON)
 	if err != nil {
 		return nil, fmt.Errorf("QRUseCase - ValidateQR - ValidateQRCode: %w", err)
@@ -84,10 +119,90 @@ func (uc *QRUseCase) ValidateQR(ctx context.Context, qrDataJSON string) (*entity
 		return nil, err
 	}
 
-	if user.GetEmail() != qrData.Email {
+	if qrData.Email != "" && user.GetEmail() != qrData.Email {
 		return nil, entityError.ErrQRUserMismatch
 	}
 
+	generation, err := uc.qrSecretRepo.GetGeneration(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("QRUseCase - ValidateQR - GetGeneration: %w", err)
+	}
+
+	if qrData.Generation < generation {
+		return nil, entityError.ErrQRRevoked
+	}
+
+	return user, nil
+}
+
+func (uc *QRUseCase) RevokeQR(ctx context.Context, userID uuid.UUID) (int, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	secret, err := qr.GenerateDynamicSecret()
+	if err != nil {
+		return 0, fmt.Errorf("QRUseCase - RevokeQR - GenerateDynamicSecret: %w", err)
+	}
+
+	generation, err := uc.qrSecretRepo.Revoke(ctx, userID, secret)
+	if err != nil {
+		return 0, fmt.Errorf("QRUseCase - RevokeQR - Revoke: %w", err)
+	}
+
+	if _, _, err := uc.refreshQRInternal(ctx, user); err != nil {
+		uc.logger.Warn("QRUseCase - RevokeQR - RefreshQR", err, map[string]any{
+			"userID": userID,
+		})
+	}
+
+	uc.logger.Info("QR codes revoked", nil, map[string]any{
+		"userID":     userID,
+		"generation": generation,
+	})
+
+	return generation, nil
+}
+
+func (uc *QRUseCase) validateDynamicQR(ctx context.Context, qrDataJSON string) (*entity.User, error) {
+	qrData, err := qr.ParseDynamicQR(qrDataJSON)
+	if err != nil {
+		uc.logger.Warn("QRUseCase - validateDynamicQR - ParseDynamicQR", err, nil)
+		return nil, fmt.Errorf("QRUseCase - validateDynamicQR: %w", entityError.ErrQRValidationFailed)
+	}
+
+	secret, err := uc.qrSecretRepo.GetByUserID(ctx, qrData.UserID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrQRSecretNotFound) {
+			return nil, fmt.Errorf("QRUseCase - validateDynamicQR: %w", entityError.ErrQRValidationFailed)
+		}
+		return nil, fmt.Errorf("QRUseCase - validateDynamicQR - GetByUserID: %w", err)
+	}
+
+	if err := qr.VerifyDynamicQR(qrData, secret, time.Now()); err != nil {
+		uc.logger.Warn("QRUseCase - validateDynamicQR - VerifyDynamicQR", err, map[string]any{
+			"userID": qrData.UserID,
+		})
+		return nil, fmt.Errorf("QRUseCase - validateDynamicQR: %w", entityError.ErrQRValidationFailed)
+	}
+
+	reserved, err := uc.nonceCache.Reserve(ctx, qrData.UserID, qrData.Nonce, qr.DynamicNonceTTL)
+	if err != nil {
+		return nil, fmt.Errorf("QRUseCase - validateDynamicQR - Reserve: %w", err)
+	}
+	if !reserved {
+		uc.logger.Warn("QRUseCase - validateDynamicQR - replayed nonce", entityError.ErrQRReplayed, map[string]any{
+			"userID": qrData.UserID,
+		})
+		return nil, entityError.ErrQRReplayed
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, qrData.UserID)
+	if err != nil {
+		return nil, err
+	}
+
 	return user, nil
 }
 
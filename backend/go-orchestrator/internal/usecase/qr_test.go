@@ -3,11 +3,13 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
 	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/qr"
 	"github.com/stretchr/testify/assert"
@@ -19,8 +21,9 @@ func TestQRUseCase_GenerateQR_Success(t *testing.T) {
 	mockUserRepo := new(mocks.MockUserRepo)
 	mockMinioClient := new(mocks.MockMinioClient)
 	mockLogger := new(mocks.MockLogger)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
 
-	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockQRSecretRepo, nil, mockMinioClient, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -42,7 +45,8 @@ func TestQRUseCase_GenerateQR_Success(t *testing.T) {
 		FullName: name,
 		Email:    &email,
 	}
-	mockQRGenerator.On("GenerateQRCode", userID, email, name).Return(qrData, qrImageBase64, nil)
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, nil)
+	mockQRGenerator.On("GenerateQRCode", userID, email, name, 0).Return(qrData, qrImageBase64, nil)
 	mockMinioClient.On("UploadFile", ctx, mock.Anything, mock.Anything, mock.Anything, "image/png").Return(nil)
 
 	result, image, err := uc.GenerateQR(ctx, user)
@@ -62,8 +66,9 @@ func TestQRUseCase_GenerateQR_GeneratorError(t *testing.T) {
 	mockUserRepo := new(mocks.MockUserRepo)
 	mockMinioClient := new(mocks.MockMinioClient)
 	mockLogger := new(mocks.MockLogger)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
 
-	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockQRSecretRepo, nil, mockMinioClient, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -75,7 +80,8 @@ func TestQRUseCase_GenerateQR_GeneratorError(t *testing.T) {
 		FullName: name,
 		Email:    &email,
 	}
-	mockQRGenerator.On("GenerateQRCode", userID, email, name).Return(nil, "", errors.New("qr generation failed"))
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, nil)
+	mockQRGenerator.On("GenerateQRCode", userID, email, name, 0).Return(nil, "", errors.New("qr generation failed"))
 
 	result, image, err := uc.GenerateQR(ctx, user)
 
@@ -91,8 +97,9 @@ func TestQRUseCase_ValidateQR_Success(t *testing.T) {
 	mockUserRepo := new(mocks.MockUserRepo)
 	mockMinioClient := new(mocks.MockMinioClient)
 	mockLogger := new(mocks.MockLogger)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
 
-	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockQRSecretRepo, nil, mockMinioClient, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -110,6 +117,7 @@ func TestQRUseCase_ValidateQR_Success(t *testing.T) {
 		FullName: "Test User",
 	}
 
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, nil)
 	mockQRGenerator.On("ValidateQRCode", qrDataJSON).Return(qrData, nil)
 	mockUserRepo.On("GetByID", ctx, userID).Return(user, nil)
 
@@ -129,7 +137,7 @@ func TestQRUseCase_ValidateQR_InvalidQR(t *testing.T) {
 	mockMinioClient := new(mocks.MockMinioClient)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, nil, nil, mockMinioClient, mockLogger)
 
 	ctx := context.Background()
 	qrDataJSON := `{"user_id":"invalid"}`
@@ -150,7 +158,7 @@ func TestQRUseCase_ValidateQR_EmailMismatch(t *testing.T) {
 	mockMinioClient := new(mocks.MockMinioClient)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, nil, nil, mockMinioClient, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -185,8 +193,9 @@ func TestQRUseCase_RefreshQR_Success(t *testing.T) {
 	mockUserRepo := new(mocks.MockUserRepo)
 	mockMinioClient := new(mocks.MockMinioClient)
 	mockLogger := new(mocks.MockLogger)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
 
-	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockQRSecretRepo, nil, mockMinioClient, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -210,7 +219,8 @@ func TestQRUseCase_RefreshQR_Success(t *testing.T) {
 	qrImageBase64 := "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNk+M9QDwADhgGAWjR9awAAAABJRU5ErkJggg=="
 
 	mockUserRepo.On("GetByID", ctx, userID).Return(user, nil)
-	mockQRGenerator.On("GenerateQRCode", userID, email, name).Return(qrData, qrImageBase64, nil)
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, nil)
+	mockQRGenerator.On("GenerateQRCode", userID, email, name, 0).Return(qrData, qrImageBase64, nil)
 	mockMinioClient.On("UploadFile", ctx, mock.Anything, mock.Anything, mock.Anything, "image/png").Return(nil)
 	mockUserRepo.On("UpdateQR", ctx, mock.MatchedBy(func(u *entity.User) bool {
 		return u.ID == userID && u.QRIssuedAt != nil && u.QRExpiresAt != nil
@@ -232,8 +242,9 @@ func TestQRUseCase_GetUserFromQR_Success(t *testing.T) {
 	mockUserRepo := new(mocks.MockUserRepo)
 	mockMinioClient := new(mocks.MockMinioClient)
 	mockLogger := new(mocks.MockLogger)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
 
-	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockQRSecretRepo, nil, mockMinioClient, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -253,6 +264,7 @@ func TestQRUseCase_GetUserFromQR_Success(t *testing.T) {
 
 	qrDataJSON := `{"user_id":"` + userID.String() + `","email":"` + email + `"}`
 
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, nil)
 	mockQRGenerator.On("ValidateQRCode", qrDataJSON).Return(qrData, nil)
 	mockUserRepo.On("GetByID", ctx, userID).Return(user, nil)
 
@@ -271,7 +283,7 @@ func TestQRUseCase_GetUserFromQR_InvalidQR(t *testing.T) {
 	mockMinioClient := new(mocks.MockMinioClient)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockMinioClient, mockLogger)
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, nil, nil, mockMinioClient, mockLogger)
 
 	ctx := context.Background()
 	qrDataJSON := "invalid"
@@ -284,3 +296,173 @@ func TestQRUseCase_GetUserFromQR_InvalidQR(t *testing.T) {
 	assert.Nil(t, result)
 	mockQRGenerator.AssertExpectations(t)
 }
+
+func newDynamicQRJSON(secret string, userID uuid.UUID, step int64, nonce string) string {
+	return `{"type":"dynamic","user_id":"` + userID.String() + `","step":` + strconv.FormatInt(step, 10) +
+		`,"nonce":"` + nonce + `","code":"` + qr.ComputeDynamicCode(secret, userID, step, nonce) + `"}`
+}
+
+func TestQRUseCase_ValidateQR_Dynamic_Success(t *testing.T) {
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+	mockNonceCache := new(mocks.MockQRNonceCache)
+	mockLogger := new(mocks.MockLogger)
+
+	uc := NewQRUseCase(new(mocks.MockQRGenerator), mockUserRepo, mockQRSecretRepo, mockNonceCache, new(mocks.MockMinioClient), mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	qrDataJSON := newDynamicQRJSON("secret", userID, qr.DynamicStep(time.Now()), "nonce-1")
+
+	mockQRSecretRepo.On("GetByUserID", ctx, userID).Return("secret", nil)
+	mockNonceCache.On("Reserve", ctx, userID, "nonce-1", qr.DynamicNonceTTL).Return(true, nil)
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID}, nil)
+
+	result, err := uc.ValidateQR(ctx, qrDataJSON)
+
+	assert.NoError(t, err)
+	assert.Equal(t, userID, result.ID)
+	mockNonceCache.AssertExpectations(t)
+}
+
+func TestQRUseCase_ValidateQR_Dynamic_Replayed(t *testing.T) {
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+	mockNonceCache := new(mocks.MockQRNonceCache)
+	mockLogger := new(mocks.MockLogger)
+
+	uc := NewQRUseCase(new(mocks.MockQRGenerator), mockUserRepo, mockQRSecretRepo, mockNonceCache, new(mocks.MockMinioClient), mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	qrDataJSON := newDynamicQRJSON("secret", userID, qr.DynamicStep(time.Now()), "nonce-1")
+
+	mockQRSecretRepo.On("GetByUserID", ctx, userID).Return("secret", nil)
+	mockNonceCache.On("Reserve", ctx, userID, "nonce-1", qr.DynamicNonceTTL).Return(false, nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	result, err := uc.ValidateQR(ctx, qrDataJSON)
+
+	assert.ErrorIs(t, err, entityError.ErrQRReplayed)
+	assert.Nil(t, result)
+	mockUserRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestQRUseCase_ValidateQR_Dynamic_Expired(t *testing.T) {
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+	mockNonceCache := new(mocks.MockQRNonceCache)
+	mockLogger := new(mocks.MockLogger)
+
+	uc := NewQRUseCase(new(mocks.MockQRGenerator), new(mocks.MockUserRepo), mockQRSecretRepo, mockNonceCache, new(mocks.MockMinioClient), mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	step := qr.DynamicStep(time.Now().Add(-5 * qr.DynamicPeriod))
+	qrDataJSON := newDynamicQRJSON("secret", userID, step, "nonce-1")
+
+	mockQRSecretRepo.On("GetByUserID", ctx, userID).Return("secret", nil)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	result, err := uc.ValidateQR(ctx, qrDataJSON)
+
+	assert.ErrorIs(t, err, entityError.ErrQRValidationFailed)
+	assert.Nil(t, result)
+	mockNonceCache.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestQRUseCase_IssueQRSecret_KeepsExisting(t *testing.T) {
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+	uc := NewQRUseCase(nil, nil, mockQRSecretRepo, nil, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockQRSecretRepo.On("Ensure", ctx, userID, mock.AnythingOfType("string")).Return("existing-secret", nil)
+
+	secret, err := uc.IssueQRSecret(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "existing-secret", secret)
+}
+
+func TestQRUseCase_ValidateQR_Revoked(t *testing.T) {
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockQRSecretRepo, nil, new(mocks.MockMinioClient), new(mocks.MockLogger))
+
+	ctx := context.Background()
+	userID := uuid.New()
+	email := "test@example.com"
+	qrDataJSON := `{"user_id":"` + userID.String() + `","email":"test@example.com","kid":"v1","gen":1}`
+
+	mockQRGenerator.On("ValidateQRCode", qrDataJSON).Return(&qr.QRData{UserID: userID, Email: email, KeyID: "v1", Generation: 1}, nil)
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID, Email: &email}, nil)
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(2, nil)
+
+	result, err := uc.ValidateQR(ctx, qrDataJSON)
+
+	assert.ErrorIs(t, err, entityError.ErrQRRevoked)
+	assert.Nil(t, result)
+}
+
+func TestQRUseCase_RevokeQR_Success(t *testing.T) {
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+	mockMinioClient := new(mocks.MockMinioClient)
+	mockLogger := new(mocks.MockLogger)
+
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockQRSecretRepo, nil, mockMinioClient, mockLogger)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	email := "test@example.com"
+	user := &entity.User{ID: userID, Email: &email, FullName: "Test User"}
+	now := time.Now()
+
+	mockUserRepo.On("GetByID", ctx, userID).Return(user, nil)
+	mockQRSecretRepo.On("Revoke", ctx, userID, mock.AnythingOfType("string")).Return(2, nil)
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(2, nil)
+	mockQRGenerator.On("GenerateQRCode", userID, email, "Test User", 2).Return(&qr.QRData{
+		UserID:     userID,
+		Email:      email,
+		FullName:   "Test User",
+		IssuedAt:   now,
+		ExpiresAt:  now.Add(qr.TTL),
+		Generation: 2,
+	}, "aW1hZ2U=", nil)
+	mockMinioClient.On("UploadFile", ctx, userID.String()+".png", mock.Anything, mock.Anything, "image/png").Return(nil)
+	mockUserRepo.On("UpdateQR", ctx, user).Return(user, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	generation, err := uc.RevokeQR(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, generation)
+	mockQRGenerator.AssertExpectations(t)
+	mockQRSecretRepo.AssertExpectations(t)
+}
+
+func TestQRUseCase_ValidateQR_CompactWithoutPersonalData(t *testing.T) {
+	mockQRGenerator := new(mocks.MockQRGenerator)
+	mockUserRepo := new(mocks.MockUserRepo)
+	mockQRSecretRepo := new(mocks.MockUserQRSecretRepo)
+
+	uc := NewQRUseCase(mockQRGenerator, mockUserRepo, mockQRSecretRepo, nil, new(mocks.MockMinioClient), new(mocks.MockLogger))
+
+	ctx := context.Background()
+	userID := uuid.New()
+	email := "test@example.com"
+	payload := qr.CompactPrefix + "ABC"
+
+	mockQRGenerator.On("ValidateQRCode", payload).Return(&qr.QRData{UserID: userID, KeyID: "v1"}, nil)
+	mockUserRepo.On("GetByID", ctx, userID).Return(&entity.User{ID: userID, Email: &email}, nil)
+	mockQRSecretRepo.On("GetGeneration", ctx, userID).Return(0, nil)
+
+	result, err := uc.ValidateQR(ctx, payload)
+
+	assert.NoError(t, err)
+	assert.Equal(t, userID, result.ID)
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
+)
+
+const (
+	telemetryMaxRange  = 7 * 24 * time.Hour
+	telemetryMaxPoints = 50000
+
+	TelemetryDefaultPoints = 2000
+)
+
+// TelemetryUseCase serves the flight history recorded by the drone-service in drone_telemetry.
+type TelemetryUseCase struct {
+	droneRepo     repo.DroneRepo
+	deliveryRepo  repo.DeliveryRepo
+	telemetryRepo repo.TelemetryRepo
+	logger        logger.Interface
+}
+
+func NewTelemetryUseCase(
+	droneRepo repo.DroneRepo,
+	deliveryRepo repo.DeliveryRepo,
+	telemetryRepo repo.TelemetryRepo,
+	logger logger.Interface,
+) *TelemetryUseCase {
+	return &TelemetryUseCase{
+		droneRepo:     droneRepo,
+		deliveryRepo:  deliveryRepo,
+		telemetryRepo: telemetryRepo,
+		logger:        logger,
+	}
+}
+
+// GetDeliveryTrack returns the track flown for the delivery. maxPoints thins the returned points
+// evenly; zero or less returns all of them.
+func (uc *TelemetryUseCase) GetDeliveryTrack(ctx context.Context, deliveryID uuid.UUID, maxPoints int) (*entity.FlightTrack, error) {
+	delivery, err := uc.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("TelemetryUseCase - GetDeliveryTrack - GetDelivery: %w", err)
+	}
+
+	points, err := uc.telemetryRepo.ListByDelivery(ctx, delivery.ID, telemetryMaxPoints+1)
+	if err != nil {
+		return nil, fmt.Errorf("TelemetryUseCase - GetDeliveryTrack - ListByDelivery: %w", err)
+	}
+	if len(points) > telemetryMaxPoints {
+		return nil, entityError.ErrTelemetryTooManyPoints
+	}
+
+	track := buildFlightTrack(points, maxPoints)
+	track.DeliveryID = &delivery.ID
+	track.DroneID = delivery.DroneID
+	return track, nil
+}
+
+// GetDroneTrack returns everything the drone reported in [from, to).
+func (uc *TelemetryUseCase) GetDroneTrack(ctx context.Context, droneID uuid.UUID, from, to time.Time, maxPoints int) (*entity.FlightTrack, error) {
+	if !to.After(from) || to.Sub(from) > telemetryMaxRange {
+		return nil, entityError.ErrTelemetryInvalidRange
+	}
+
+	// recorded_at holds the drone-service's local wall clock without a zone, so compare on the same clock.
+	from, to = from.Local(), to.Local()
+
+	if _, err := uc.droneRepo.GetByID(ctx, droneID); err != nil {
+		return nil, fmt.Errorf("TelemetryUseCase - GetDroneTrack - GetDrone: %w", err)
+	}
+
+	points, err := uc.telemetryRepo.ListByDrone(ctx, droneID, from, to, telemetryMaxPoints+1)
+	if err != nil {
+		return nil, fmt.Errorf("TelemetryUseCase - GetDroneTrack - ListByDrone: %w", err)
+	}
+	if len(points) > telemetryMaxPoints {
+		return nil, entityError.ErrTelemetryTooManyPoints
+	}
+
+	track := buildFlightTrack(points, maxPoints)
+	track.DroneID = &droneID
+	track.From = &from
+	track.To = &to
+	return track, nil
+}
+
+func buildFlightTrack(points []*entity.TelemetryPoint, maxPoints int) *entity.FlightTrack {
+	track := &entity.FlightTrack{
+		Summary: summarizeFlight(points),
+		Points:  make([]entity.TelemetryPoint, 0, len(points)),
+	}
+
+	for _, point := range thinTelemetry(points, maxPoints) {
+		track.Points = append(track.Points, *point)
+	}
+	return track
+}
+
+func summarizeFlight(points []*entity.TelemetryPoint) entity.FlightSummary {
+	summary := entity.FlightSummary{Points: len(points)}
+	if len(points) == 0 {
+		return summary
+	}
+
+	first, last := points[0], points[len(points)-1]
+	summary.StartedAt = &first.RecordedAt
+	summary.EndedAt = &last.RecordedAt
+	summary.DurationSeconds = int(last.RecordedAt.Sub(first.RecordedAt).Seconds())
+	summary.BatteryStart = first.BatteryLevel
+	summary.BatteryEnd = last.BatteryLevel
+	summary.BatteryUsed = math.Max(0, first.BatteryLevel-last.BatteryLevel)
+
+	for i, point := range points {
+		summary.MaxAltitude = math.Max(summary.MaxAltitude, point.Position.Altitude)
+		summary.MaxSpeed = math.Max(summary.MaxSpeed, point.Speed)
+		if i > 0 && hasFix(points[i-1].Position) && hasFix(point.Position) {
+			summary.DistanceMeters += distanceMeters(points[i-1].Position, point.Position)
+		}
+	}
+	summary.DistanceMeters = math.Round(summary.DistanceMeters)
+
+	return summary
+}
+
+// thinTelemetry keeps every n-th point so that at most maxPoints remain. The first and the last
+// point are always kept.
+func thinTelemetry(points []*entity.TelemetryPoint, maxPoints int) []*entity.TelemetryPoint {
+	if maxPoints <= 0 || len(points) <= maxPoints {
+		return points
+	}
+	if maxPoints == 1 {
+		return points[len(points)-1:]
+	}
+
+	step := int(math.Ceil(float64(len(points)-1) / float64(maxPoints-1)))
+	thinned := make([]*entity.TelemetryPoint, 0, maxPoints)
+	for i := 0; i < len(points)-1; i += step {
+		thinned = append(thinned, points[i])
+	}
+	return append(thinned, points[len(points)-1])
+}
+
+func hasFix(position entity.Position) bool {
+	return position.Latitude != 0 || position.Longitude != 0
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func telemetryPoints(droneID uuid.UUID, start time.Time, n int) []*entity.TelemetryPoint {
+	points := make([]*entity.TelemetryPoint, 0, n)
+	for i := 0; i < n; i++ {
+		points = append(points, &entity.TelemetryPoint{
+			DroneID:      droneID,
+			Status:       "in_transit",
+			BatteryLevel: 90 - float64(i),
+			Position:     entity.Position{Latitude: 55.75 + float64(i)*0.001, Longitude: 37.61, Altitude: 50 + float64(i)},
+			Speed:        float64(i),
+			RecordedAt:   start.Add(time.Duration(i) * 10 * time.Second),
+		})
+	}
+	return points
+}
+
+func TestTelemetryUseCase_GetDeliveryTrack(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	mockTelemetryRepo := new(mocks.MockTelemetryRepo)
+	uc := NewTelemetryUseCase(nil, mockDeliveryRepo, mockTelemetryRepo, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	droneID := uuid.New()
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(&entity.Delivery{ID: deliveryID, DroneID: &droneID}, nil)
+	mockTelemetryRepo.On("ListByDelivery", ctx, deliveryID, telemetryMaxPoints+1).Return(telemetryPoints(droneID, start, 10), nil)
+
+	track, err := uc.GetDeliveryTrack(ctx, deliveryID, 4)
+
+	require.NoError(t, err)
+	assert.Equal(t, deliveryID, *track.DeliveryID)
+	assert.Equal(t, droneID, *track.DroneID)
+	require.Len(t, track.Points, 4)
+	assert.Equal(t, start, track.Points[0].RecordedAt)
+	assert.Equal(t, start.Add(90*time.Second), track.Points[3].RecordedAt)
+
+	summary := track.Summary
+	assert.Equal(t, 10, summary.Points)
+	assert.Equal(t, 90, summary.DurationSeconds)
+	assert.Equal(t, 90.0, summary.BatteryStart)
+	assert.Equal(t, 81.0, summary.BatteryEnd)
+	assert.Equal(t, 9.0, summary.BatteryUsed)
+	assert.Equal(t, 59.0, summary.MaxAltitude)
+	assert.InDelta(t, 1001, summary.DistanceMeters, 2)
+}
+
+func TestTelemetryUseCase_GetDeliveryTrack_NotFound(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockDeliveryRepo)
+	uc := NewTelemetryUseCase(nil, mockDeliveryRepo, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	deliveryID := uuid.New()
+	mockDeliveryRepo.On("GetByID", ctx, deliveryID).Return(nil, entityError.ErrDeliveryNotFound)
+
+	_, err := uc.GetDeliveryTrack(ctx, deliveryID, 0)
+
+	assert.ErrorIs(t, err, entityError.ErrDeliveryNotFound)
+}
+
+func TestTelemetryUseCase_GetDroneTrack_InvalidRange(t *testing.T) {
+	uc := NewTelemetryUseCase(nil, nil, nil, new(mocks.MockLogger))
+
+	now := time.Now()
+	_, err := uc.GetDroneTrack(context.Background(), uuid.New(), now, now.Add(-time.Hour), 0)
+	assert.ErrorIs(t, err, entityError.ErrTelemetryInvalidRange)
+
+	_, err = uc.GetDroneTrack(context.Background(), uuid.New(), now.Add(-8*24*time.Hour), now, 0)
+	assert.ErrorIs(t, err, entityError.ErrTelemetryInvalidRange)
+}
+
+func TestTelemetryUseCase_GetDroneTrack_TooManyPoints(t *testing.T) {
+	mockDroneRepo := new(mocks.MockDroneRepo)
+	mockTelemetryRepo := new(mocks.MockTelemetryRepo)
+	uc := NewTelemetryUseCase(mockDroneRepo, nil, mockTelemetryRepo, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	droneID := uuid.New()
+	to := time.Now().Round(0)
+	from := to.Add(-time.Hour)
+
+	mockDroneRepo.On("GetByID", ctx, droneID).Return(&entity.Drone{ID: droneID}, nil)
+	mockTelemetryRepo.On("ListByDrone", ctx, droneID, from, to, telemetryMaxPoints+1).
+		Return(make([]*entity.TelemetryPoint, telemetryMaxPoints+1), nil)
+
+	_, err := uc.GetDroneTrack(ctx, droneID, from, to, 0)
+
+	assert.ErrorIs(t, err, entityError.ErrTelemetryTooManyPoints)
+}
+
+func TestTelemetryUseCase_GetDroneTrack_RangeOnServerClock(t *testing.T) {
+	serverLocal := time.Local
+	time.Local = time.UTC
+	t.Cleanup(func() { time.Local = serverLocal })
+
+	mockDroneRepo := new(mocks.MockDroneRepo)
+	mockTelemetryRepo := new(mocks.MockTelemetryRepo)
+	uc := NewTelemetryUseCase(mockDroneRepo, nil, mockTelemetryRepo, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	droneID := uuid.New()
+	from := time.Date(2026, 10, 18, 12, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	to := from.Add(time.Hour)
+
+	onServerClock := func(expected time.Time) any {
+		return mock.MatchedBy(func(got time.Time) bool {
+			return got.Location() == time.Local && got.Equal(expected) && got.Hour() == expected.UTC().Hour()
+		})
+	}
+
+	mockDroneRepo.On("GetByID", ctx, droneID).Return(&entity.Drone{ID: droneID}, nil)
+	mockTelemetryRepo.On("ListByDrone", ctx, droneID, onServerClock(from), onServerClock(to), telemetryMaxPoints+1).
+		Return([]*entity.TelemetryPoint{}, nil)
+
+	_, err := uc.GetDroneTrack(ctx, droneID, from, to, 0)
+
+	require.NoError(t, err)
+	mockTelemetryRepo.AssertExpectations(t)
+}
+
+func TestThinTelemetry(t *testing.T) {
+	points := telemetryPoints(uuid.New(), time.Now(), 10)
+
+	assert.Len(t, thinTelemetry(points, 0), 10)
+	assert.Len(t, thinTelemetry(points, 20), 10)
+
+	thinned := thinTelemetry(points, 3)
+	require.Len(t, thinned, 3)
+	assert.Same(t, points[0], thinned[0])
+	assert.Same(t, points[9], thinned[2])
+}
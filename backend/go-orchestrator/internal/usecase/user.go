@@ -18,10 +18,13 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const passwordResetCodeTTL = 10 * time.Minute
+
 type UserUseCase struct {
 	userRepo   repo.UserRepo
 	smsWebAPI  repo.SMSAeroWebAPI
 	qrWebAPI   repo.QRWebAPI
+	mailer     AccountMailer
 	jwtService *jwt.JWTService
 	validator  validator.Validator
 	logger     logger.Interface
@@ -31,6 +34,7 @@ func NewUserUseCase(
 	userRepo repo.UserRepo,
 	smsWebAPI repo.SMSAeroWebAPI,
 	qrWebAPI repo.QRWebAPI,
+	mailer AccountMailer,
 	jwtService *jwt.JWTService,
 	validator validator.Validator,
 	logger logger.Interface,
@@ -39,6 +43,7 @@ func NewUserUseCase(
 		userRepo:   userRepo,
 		smsWebAPI:  smsWebAPI,
 		qrWebAPI:   qrWebAPI,
+		mailer:     mailer,
 		jwtService: jwtService,
 		validator:  validator,
 		logger:     logger,
@@ -266,7 +271,7 @@ func (uc *UserUseCase) RequestPasswordReset(ctx context.Context, phone string) e
 	}
 
 	code := uc.generateCode()
-	expiresAt := time.Now().Add(10 * time.Minute)
+	expiresAt := time.Now().Add(passwordResetCodeTTL)
 	codePtr := &code
 
 	user.VerificationCode = codePtr
@@ -281,6 +286,14 @@ func (uc *UserUseCase) RequestPasswordReset(ctx context.Context, phone string) e
 		return uc.handleSMSError(err, "RequestPasswordReset")
 	}
 
+	if uc.mailer != nil && user.GetEmail() != "" {
+		if err := uc.mailer.SendPasswordResetEmail(ctx, user.ID, code, passwordResetCodeTTL); err != nil {
+			uc.logger.Warn("UserUseCase - RequestPasswordReset - SendPasswordResetEmail", err, map[string]any{
+				"userID": user.ID,
+			})
+		}
+	}
+
 	return nil
 }
 
@@ -25,7 +25,7 @@ func TestUserUseCase_VerifyPhoneCode_Success(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	phone := "79991234567"
@@ -65,7 +65,7 @@ func TestUserUseCase_VerifyPhoneCode_InvalidCode(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	phone := "79991234567"
@@ -94,7 +94,7 @@ func TestUserUseCase_VerifyPhoneCode_ExpiredCode(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	phone := "79991234567"
@@ -123,7 +123,7 @@ func TestUserUseCase_LoginByPhone_Success(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	phone := "79991234567"
@@ -157,7 +157,7 @@ func TestUserUseCase_LoginByPhone_NotVerified(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	phone := "79991234567"
@@ -181,7 +181,7 @@ func TestUserUseCase_LoginByCredentials_ByEmail_Success(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	email := "test@example.com"
@@ -217,7 +217,7 @@ func TestUserUseCase_LoginByCredentials_InvalidPassword(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	email := "test@example.com"
@@ -248,7 +248,7 @@ func TestUserUseCase_LoginByCredentials_PhoneNotVerified(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	email := "test@example.com"
@@ -293,7 +293,7 @@ func TestUserUseCase_LoginByCredentials_UserNotFound(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	login := "nonexistent@example.com"
@@ -316,7 +316,7 @@ func TestUserUseCase_RequestPasswordReset_Success(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	phone := "79991234567"
@@ -350,7 +350,7 @@ func TestUserUseCase_RequestPasswordReset_UserNotFound(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	phone := "79991234567"
@@ -371,7 +371,7 @@ func TestUserUseCase_ResetPassword_Success(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	phone := "79991234567"
@@ -409,7 +409,7 @@ func TestUserUseCase_ResetPassword_InvalidCode(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	phone := "79991234567"
@@ -436,7 +436,7 @@ func TestUserUseCase_GetUser_Success(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -464,7 +464,7 @@ func TestUserUseCase_GetUserByEmail_Success(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	email := "test@example.com"
@@ -492,7 +492,7 @@ func TestUserUseCase_GenerateCode(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	code := uc.generateCode()
 
@@ -508,7 +508,7 @@ func TestUserUseCase_GetUserByID_Success(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -546,7 +546,7 @@ func TestUserUseCase_GetUserByID_InvalidUUID(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	invalidUUID := "not-a-valid-uuid"
@@ -567,7 +567,7 @@ func TestUserUseCase_GetUserByID_NotFound(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -591,7 +591,7 @@ func TestUserUseCase_Register_Success(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	fullName := "Test User"
@@ -644,7 +644,7 @@ func TestUserUseCase_Register_EmailExists(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	email := "existing@example.com"
@@ -685,7 +685,7 @@ func TestUserUseCase_Register_PhoneExists(t *testing.T) {
 	jwtService := jwt.NewJWTService("test", "test", time.Hour, time.Hour)
 	mockLogger := new(mocks.MockLogger)
 
-	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, jwtService, mockValidator, mockLogger)
+	uc := NewUserUseCase(mockUserRepo, mockSMSWebAPI, mockQRWebAPI, nil, jwtService, mockValidator, mockLogger)
 
 	ctx := context.Background()
 	email := "test@example.com"
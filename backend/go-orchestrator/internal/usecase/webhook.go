@@ -0,0 +1,383 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/repo"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/pkg/logger"
+)
+
+const (
+	webhookMaxAttempts   = 8
+	webhookBaseBackoff   = 30 * time.Second
+	webhookMaxBackoff    = 6 * time.Hour
+	webhookBatchSize     = 50
+	webhookLease         = 2 * time.Minute
+	webhookSecretBytes   = 32
+	WebhookDeliveryLimit = 50
+)
+
+type WebhookPublisher interface {
+	Publish(ctx context.Context, event entity.WebhookEvent, data any) error
+}
+
+type WebhookUseCase struct {
+	subscriptionRepo repo.WebhookSubscriptionRepo
+	deliveryRepo     repo.WebhookDeliveryRepo
+	sender           repo.WebhookSender
+	logger           logger.Interface
+}
+
+func NewWebhookUseCase(
+	subscriptionRepo repo.WebhookSubscriptionRepo,
+	deliveryRepo repo.WebhookDeliveryRepo,
+	sender repo.WebhookSender,
+	logger logger.Interface,
+) *WebhookUseCase {
+	return &WebhookUseCase{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		sender:           sender,
+		logger:           logger,
+	}
+}
+
+func (uc *WebhookUseCase) CreateSubscription(ctx context.Context, rawURL string, events []entity.WebhookEvent) (*entity.WebhookSubscription, error) {
+	if err := validateWebhookURL(rawURL); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEvents(events); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - CreateSubscription - generateSecret: %w", err)
+	}
+
+	subscription, err := uc.subscriptionRepo.Create(ctx, &entity.WebhookSubscription{
+		URL:      rawURL,
+		Secret:   secret,
+		Events:   events,
+		IsActive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - CreateSubscription: %w", err)
+	}
+
+	uc.logger.Info("Webhook subscription created", nil, map[string]any{
+		"subscriptionID": subscription.ID,
+		"events":         events,
+	})
+
+	return subscription, nil
+}
+
+func (uc *WebhookUseCase) ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	subscriptions, err := uc.subscriptionRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - ListSubscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+func (uc *WebhookUseCase) GetSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	subscription, err := uc.subscriptionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - GetSubscription: %w", err)
+	}
+	return subscription, nil
+}
+
+func (uc *WebhookUseCase) UpdateSubscription(ctx context.Context, id uuid.UUID, rawURL *string, events []entity.WebhookEvent, isActive *bool) (*entity.WebhookSubscription, error) {
+	subscription, err := uc.subscriptionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - UpdateSubscription - GetByID: %w", err)
+	}
+
+	if rawURL != nil {
+		if err := validateWebhookURL(*rawURL); err != nil {
+			return nil, err
+		}
+		subscription.URL = *rawURL
+	}
+	if events != nil {
+		if err := validateWebhookEvents(events); err != nil {
+			return nil, err
+		}
+		subscription.Events = events
+	}
+	if isActive != nil {
+		subscription.IsActive = *isActive
+	}
+
+	updated, err := uc.subscriptionRepo.Update(ctx, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - UpdateSubscription: %w", err)
+	}
+	return updated, nil
+}
+
+func (uc *WebhookUseCase) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	if _, err := uc.subscriptionRepo.GetByID(ctx, id); err != nil {
+		return fmt.Errorf("WebhookUseCase - DeleteSubscription - GetByID: %w", err)
+	}
+	if err := uc.subscriptionRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("WebhookUseCase - DeleteSubscription: %w", err)
+	}
+	return nil
+}
+
+func (uc *WebhookUseCase) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]*entity.WebhookDelivery, error) {
+	if _, err := uc.subscriptionRepo.GetByID(ctx, subscriptionID); err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - ListDeliveries - GetByID: %w", err)
+	}
+
+	if limit <= 0 || limit > WebhookDeliveryLimit {
+		limit = WebhookDeliveryLimit
+	}
+
+	deliveries, err := uc.deliveryRepo.ListBySubscription(ctx, subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - ListDeliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// Publish queues the event for every active subscription. Delivery happens in the background worker.
+func (uc *WebhookUseCase) Publish(ctx context.Context, event entity.WebhookEvent, data any) error {
+	subscriptions, err := uc.subscriptionRepo.ListActiveByEvent(ctx, event)
+	if err != nil {
+		return fmt.Errorf("WebhookUseCase - Publish - ListActiveByEvent: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(entity.WebhookEnvelope{
+		ID:        uuid.New(),
+		Event:     event,
+		CreatedAt: now,
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("WebhookUseCase - Publish - Marshal: %w", err)
+	}
+
+	var errs []error
+	for _, subscription := range subscriptions {
+		_, err := uc.deliveryRepo.Create(ctx, &entity.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			Event:          event,
+			Payload:        payload,
+			Status:         entity.WebhookDeliveryPending,
+			NextAttemptAt:  &now,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("WebhookUseCase - Publish - Create: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func (uc *WebhookUseCase) StartDeliveryWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	uc.logger.Info("Webhook delivery worker started", nil, map[string]any{
+		"interval": interval.String(),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			uc.logger.Info("Webhook delivery worker stopped", nil)
+			return
+		case <-ticker.C:
+			uc.processDue(ctx, time.Now())
+		}
+	}
+}
+
+func (uc *WebhookUseCase) processDue(ctx context.Context, now time.Time) {
+	deliveries, err := uc.deliveryRepo.ClaimDue(ctx, now, now.Add(webhookLease), webhookBatchSize)
+	if err != nil {
+		uc.logger.Error("WebhookUseCase - processDue - ClaimDue", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		if _, err := uc.attempt(ctx, delivery, now); err != nil {
+			uc.logger.Error("WebhookUseCase - processDue - attempt", err, map[string]any{
+				"deliveryID": delivery.ID,
+			})
+		}
+	}
+}
+
+// Redeliver sends a copy of an earlier delivery right away. The payload, including the event id, is unchanged
+// so receivers can deduplicate; the copy gets its own delivery id and log entry.
+func (uc *WebhookUseCase) Redeliver(ctx context.Context, deliveryID uuid.UUID) (*entity.WebhookDelivery, error) {
+	original, err := uc.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - Redeliver - GetByID: %w", err)
+	}
+
+	now := time.Now()
+	leaseUntil := now.Add(webhookLease)
+	copied, err := uc.deliveryRepo.Create(ctx, &entity.WebhookDelivery{
+		SubscriptionID: original.SubscriptionID,
+		Event:          original.Event,
+		Payload:        original.Payload,
+		Status:         entity.WebhookDeliveryPending,
+		NextAttemptAt:  &leaseUntil,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - Redeliver - Create: %w", err)
+	}
+
+	delivery, err := uc.attempt(ctx, copied, now)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - Redeliver: %w", err)
+	}
+
+	uc.logger.Info("Webhook redelivered", nil, map[string]any{
+		"originalDeliveryID": deliveryID,
+		"deliveryID":         delivery.ID,
+		"status":             delivery.Status,
+	})
+
+	return delivery, nil
+}
+
+func (uc *WebhookUseCase) attempt(ctx context.Context, delivery *entity.WebhookDelivery, now time.Time) (*entity.WebhookDelivery, error) {
+	subscription, err := uc.subscriptionRepo.GetByID(ctx, delivery.SubscriptionID)
+	if err != nil && !errors.Is(err, entityError.ErrWebhookSubscriptionNotFound) {
+		return nil, fmt.Errorf("WebhookUseCase - attempt - GetSubscription: %w", err)
+	}
+	if subscription == nil || !subscription.IsActive {
+		reason := "subscription is inactive"
+		delivery.Status = entity.WebhookDeliveryFailed
+		delivery.LastError = &reason
+		delivery.NextAttemptAt = nil
+		return uc.saveAttempt(ctx, delivery)
+	}
+
+	status, sendErr := uc.sender.Deliver(ctx, subscription.URL, subscription.Secret, delivery)
+	delivery.Attempts++
+	delivery.ResponseStatus = nil
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+
+	if sendErr == nil {
+		delivery.Status = entity.WebhookDeliverySucceeded
+		delivery.LastError = nil
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &now
+		return uc.saveAttempt(ctx, delivery)
+	}
+
+	message := sendErr.Error()
+	delivery.LastError = &message
+
+	if delivery.Attempts >= webhookMaxAttempts {
+		delivery.Status = entity.WebhookDeliveryFailed
+		delivery.NextAttemptAt = nil
+		uc.logger.Warn("Webhook delivery gave up", sendErr, map[string]any{
+			"deliveryID":     delivery.ID,
+			"subscriptionID": delivery.SubscriptionID,
+			"attempts":       delivery.Attempts,
+		})
+	} else {
+		next := now.Add(webhookBackoff(delivery.Attempts))
+		delivery.Status = entity.WebhookDeliveryPending
+		delivery.NextAttemptAt = &next
+	}
+
+	return uc.saveAttempt(ctx, delivery)
+}
+
+func (uc *WebhookUseCase) saveAttempt(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	saved, err := uc.deliveryRepo.UpdateAttempt(ctx, delivery)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - saveAttempt: %w", err)
+	}
+	return saved, nil
+}
+
+// webhookBackoff doubles the delay after every failed attempt: 30s, 1m, 2m, ... capped at webhookMaxBackoff.
+func webhookBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := webhookBaseBackoff << (attempts - 1)
+	if delay <= 0 || delay > webhookMaxBackoff {
+		return webhookMaxBackoff
+	}
+	return delay
+}
+
+func validateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return entityError.ErrWebhookInvalidURL
+	}
+	return nil
+}
+
+func validateWebhookEvents(events []entity.WebhookEvent) error {
+	if len(events) == 0 {
+		return entityError.ErrWebhookNoEvents
+	}
+	for _, event := range events {
+		if !event.IsValid() {
+			return entityError.ErrWebhookInvalidEvent
+		}
+	}
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+func orderWebhookData(order *entity.Order, delivery *entity.Delivery) map[string]any {
+	data := map[string]any{
+		"order_id":          order.ID,
+		"good_id":           order.GoodID,
+		"parcel_automat_id": order.ParcelAutomatID,
+		"status":            order.Status,
+		"created_at":        order.CreatedAt,
+	}
+	if order.LockerCellID != nil {
+		data["locker_cell_id"] = *order.LockerCellID
+	}
+	if delivery != nil {
+		data["delivery_id"] = delivery.ID
+		data["delivery_status"] = delivery.Status
+		if delivery.DroneID != nil {
+			data["drone_id"] = *delivery.DroneID
+		}
+	}
+	return data
+}
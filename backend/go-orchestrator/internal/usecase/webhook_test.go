@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity"
+	entityError "github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/entity/error"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWebhookPublisher struct {
+	mock.Mock
+}
+
+func (m *mockWebhookPublisher) Publish(ctx context.Context, event entity.WebhookEvent, data any) error {
+	args := m.Called(ctx, event, data)
+	return args.Error(0)
+}
+
+func returnWebhookDelivery(_ context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	return delivery, nil
+}
+
+func TestWebhookUseCase_CreateSubscription_Validation(t *testing.T) {
+	uc := NewWebhookUseCase(new(mocks.MockWebhookSubscriptionRepo), nil, nil, new(mocks.MockLogger))
+
+	tests := []struct {
+		name    string
+		url     string
+		events  []entity.WebhookEvent
+		wantErr error
+	}{
+		{"relative url", "/hooks", []entity.WebhookEvent{entity.WebhookOrderCreated}, entityError.ErrWebhookInvalidURL},
+		{"unsupported scheme", "ftp://example.com/hooks", []entity.WebhookEvent{entity.WebhookOrderCreated}, entityError.ErrWebhookInvalidURL},
+		{"no events", "https://example.com/hooks", nil, entityError.ErrWebhookNoEvents},
+		{"unknown event", "https://example.com/hooks", []entity.WebhookEvent{"order.lost"}, entityError.ErrWebhookInvalidEvent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.CreateSubscription(context.Background(), tt.url, tt.events)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWebhookUseCase_CreateSubscription_GeneratesSecret(t *testing.T) {
+	mockSubscriptionRepo := new(mocks.MockWebhookSubscriptionRepo)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewWebhookUseCase(mockSubscriptionRepo, nil, nil, mockLogger)
+
+	ctx := context.Background()
+	events := []entity.WebhookEvent{entity.WebhookOrderCreated, entity.WebhookOrderCompleted}
+
+	mockSubscriptionRepo.On("Create", ctx, mock.MatchedBy(func(s *entity.WebhookSubscription) bool {
+		return s.URL == "https://partner.example.com/hooks" && s.IsActive && len(s.Events) == 2
+	})).Return(func(_ context.Context, s *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+		s.ID = uuid.New()
+		return s, nil
+	})
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	subscription, err := uc.CreateSubscription(ctx, "https://partner.example.com/hooks", events)
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(subscription.Secret, "whsec_"))
+	assert.Len(t, subscription.Secret, len("whsec_")+2*webhookSecretBytes)
+	mockSubscriptionRepo.AssertExpectations(t)
+}
+
+func TestWebhookUseCase_Publish_FansOutToSubscribers(t *testing.T) {
+	mockSubscriptionRepo := new(mocks.MockWebhookSubscriptionRepo)
+	mockDeliveryRepo := new(mocks.MockWebhookDeliveryRepo)
+	uc := NewWebhookUseCase(mockSubscriptionRepo, mockDeliveryRepo, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	subscriptions := []*entity.WebhookSubscription{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	mockSubscriptionRepo.On("ListActiveByEvent", ctx, entity.WebhookOrderCreated).Return(subscriptions, nil)
+
+	var payloads [][]byte
+	mockDeliveryRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.WebhookDelivery) bool {
+		return d.Event == entity.WebhookOrderCreated &&
+			d.Status == entity.WebhookDeliveryPending &&
+			d.NextAttemptAt != nil
+	})).Return(func(_ context.Context, d *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+		payloads = append(payloads, d.Payload)
+		return d, nil
+	}).Times(2)
+
+	err := uc.Publish(ctx, entity.WebhookOrderCreated, map[string]any{"order_id": orderID})
+
+	require.NoError(t, err)
+	require.Len(t, payloads, 2)
+	assert.Equal(t, payloads[0], payloads[1])
+
+	var envelope struct {
+		ID    uuid.UUID         `json:"id"`
+		Event string            `json:"event"`
+		Data  map[string]string `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(payloads[0], &envelope))
+	assert.NotEqual(t, uuid.Nil, envelope.ID)
+	assert.Equal(t, "order.created", envelope.Event)
+	assert.Equal(t, orderID.String(), envelope.Data["order_id"])
+	mockDeliveryRepo.AssertExpectations(t)
+}
+
+func TestWebhookUseCase_Publish_NoSubscribers(t *testing.T) {
+	mockSubscriptionRepo := new(mocks.MockWebhookSubscriptionRepo)
+	mockDeliveryRepo := new(mocks.MockWebhookDeliveryRepo)
+	uc := NewWebhookUseCase(mockSubscriptionRepo, mockDeliveryRepo, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	mockSubscriptionRepo.On("ListActiveByEvent", ctx, entity.WebhookOrderCancelled).Return([]*entity.WebhookSubscription{}, nil)
+
+	err := uc.Publish(ctx, entity.WebhookOrderCancelled, nil)
+
+	assert.NoError(t, err)
+	mockDeliveryRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestWebhookUseCase_Attempt_Success(t *testing.T) {
+	mockSubscriptionRepo := new(mocks.MockWebhookSubscriptionRepo)
+	mockDeliveryRepo := new(mocks.MockWebhookDeliveryRepo)
+	mockSender := new(mocks.MockWebhookSender)
+	uc := NewWebhookUseCase(mockSubscriptionRepo, mockDeliveryRepo, mockSender, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	now := time.Now()
+	subscription := &entity.WebhookSubscription{ID: uuid.New(), URL: "https://example.com/hooks", Secret: "whsec_test", IsActive: true}
+	delivery := &entity.WebhookDelivery{ID: uuid.New(), SubscriptionID: subscription.ID, Status: entity.WebhookDeliveryPending}
+
+	mockSubscriptionRepo.On("GetByID", ctx, subscription.ID).Return(subscription, nil)
+	mockSender.On("Deliver", ctx, subscription.URL, subscription.Secret, delivery).Return(200, nil)
+	mockDeliveryRepo.On("UpdateAttempt", ctx, delivery).Return(returnWebhookDelivery)
+
+	result, err := uc.attempt(ctx, delivery, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookDeliverySucceeded, result.Status)
+	assert.Equal(t, 1, result.Attempts)
+	assert.Equal(t, 200, *result.ResponseStatus)
+	assert.Equal(t, now, *result.DeliveredAt)
+	assert.Nil(t, result.NextAttemptAt)
+}
+
+func TestWebhookUseCase_Attempt_RetriesWithBackoff(t *testing.T) {
+	mockSubscriptionRepo := new(mocks.MockWebhookSubscriptionRepo)
+	mockDeliveryRepo := new(mocks.MockWebhookDeliveryRepo)
+	mockSender := new(mocks.MockWebhookSender)
+	uc := NewWebhookUseCase(mockSubscriptionRepo, mockDeliveryRepo, mockSender, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	now := time.Now()
+	subscription := &entity.WebhookSubscription{ID: uuid.New(), URL: "https://example.com/hooks", IsActive: true}
+	delivery := &entity.WebhookDelivery{ID: uuid.New(), SubscriptionID: subscription.ID, Attempts: 2, Status: entity.WebhookDeliveryPending}
+
+	mockSubscriptionRepo.On("GetByID", ctx, subscription.ID).Return(subscription, nil)
+	mockSender.On("Deliver", ctx, subscription.URL, mock.Anything, delivery).Return(503, errors.New("unexpected status 503"))
+	mockDeliveryRepo.On("UpdateAttempt", ctx, delivery).Return(returnWebhookDelivery)
+
+	result, err := uc.attempt(ctx, delivery, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookDeliveryPending, result.Status)
+	assert.Equal(t, 3, result.Attempts)
+	assert.Equal(t, 503, *result.ResponseStatus)
+	assert.Equal(t, "unexpected status 503", *result.LastError)
+	assert.Equal(t, now.Add(2*time.Minute), *result.NextAttemptAt)
+}
+
+func TestWebhookUseCase_Attempt_GivesUpAfterMaxAttempts(t *testing.T) {
+	mockSubscriptionRepo := new(mocks.MockWebhookSubscriptionRepo)
+	mockDeliveryRepo := new(mocks.MockWebhookDeliveryRepo)
+	mockSender := new(mocks.MockWebhookSender)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewWebhookUseCase(mockSubscriptionRepo, mockDeliveryRepo, mockSender, mockLogger)
+
+	ctx := context.Background()
+	subscription := &entity.WebhookSubscription{ID: uuid.New(), URL: "https://example.com/hooks", IsActive: true}
+	delivery := &entity.WebhookDelivery{ID: uuid.New(), SubscriptionID: subscription.ID, Attempts: webhookMaxAttempts - 1}
+
+	mockSubscriptionRepo.On("GetByID", ctx, subscription.ID).Return(subscription, nil)
+	mockSender.On("Deliver", ctx, subscription.URL, mock.Anything, delivery).Return(0, errors.New("connection refused"))
+	mockDeliveryRepo.On("UpdateAttempt", ctx, delivery).Return(returnWebhookDelivery)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	result, err := uc.attempt(ctx, delivery, time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookDeliveryFailed, result.Status)
+	assert.Equal(t, webhookMaxAttempts, result.Attempts)
+	assert.Nil(t, result.ResponseStatus)
+	assert.Nil(t, result.NextAttemptAt)
+}
+
+func TestWebhookUseCase_Attempt_InactiveSubscription(t *testing.T) {
+	mockSubscriptionRepo := new(mocks.MockWebhookSubscriptionRepo)
+	mockDeliveryRepo := new(mocks.MockWebhookDeliveryRepo)
+	mockSender := new(mocks.MockWebhookSender)
+	uc := NewWebhookUseCase(mockSubscriptionRepo, mockDeliveryRepo, mockSender, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	subscriptionID := uuid.New()
+	delivery := &entity.WebhookDelivery{ID: uuid.New(), SubscriptionID: subscriptionID}
+
+	mockSubscriptionRepo.On("GetByID", ctx, subscriptionID).Return(&entity.WebhookSubscription{ID: subscriptionID, IsActive: false}, nil)
+	mockDeliveryRepo.On("UpdateAttempt", ctx, delivery).Return(returnWebhookDelivery)
+
+	result, err := uc.attempt(ctx, delivery, time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookDeliveryFailed, result.Status)
+	mockSender.AssertNotCalled(t, "Deliver", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWebhookUseCase_Redeliver(t *testing.T) {
+	mockSubscriptionRepo := new(mocks.MockWebhookSubscriptionRepo)
+	mockDeliveryRepo := new(mocks.MockWebhookDeliveryRepo)
+	mockSender := new(mocks.MockWebhookSender)
+	mockLogger := new(mocks.MockLogger)
+	uc := NewWebhookUseCase(mockSubscriptionRepo, mockDeliveryRepo, mockSender, mockLogger)
+
+	ctx := context.Background()
+	subscription := &entity.WebhookSubscription{ID: uuid.New(), URL: "https://example.com/hooks", IsActive: true}
+	original := &entity.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		Event:          entity.WebhookOrderCompleted,
+		Payload:        []byte(`{"id":"evt"}`),
+		Status:         entity.WebhookDeliveryFailed,
+		Attempts:       webhookMaxAttempts,
+	}
+
+	mockDeliveryRepo.On("GetByID", ctx, original.ID).Return(original, nil)
+	mockDeliveryRepo.On("Create", ctx, mock.MatchedBy(func(d *entity.WebhookDelivery) bool {
+		return d.SubscriptionID == original.SubscriptionID &&
+			string(d.Payload) == string(original.Payload) &&
+			d.Attempts == 0
+	})).Return(func(_ context.Context, d *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+		d.ID = uuid.New()
+		return d, nil
+	})
+	mockSubscriptionRepo.On("GetByID", ctx, subscription.ID).Return(subscription, nil)
+	mockSender.On("Deliver", ctx, subscription.URL, mock.Anything, mock.Anything).Return(204, nil)
+	mockDeliveryRepo.On("UpdateAttempt", ctx, mock.Anything).Return(returnWebhookDelivery)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	result, err := uc.Redeliver(ctx, original.ID)
+
+	require.NoError(t, err)
+	assert.NotEqual(t, original.ID, result.ID)
+	assert.Equal(t, entity.WebhookDeliverySucceeded, result.Status)
+	assert.Equal(t, 1, result.Attempts)
+	assert.Equal(t, entity.WebhookDeliveryFailed, original.Status)
+}
+
+func TestWebhookUseCase_Redeliver_NotFound(t *testing.T) {
+	mockDeliveryRepo := new(mocks.MockWebhookDeliveryRepo)
+	uc := NewWebhookUseCase(nil, mockDeliveryRepo, nil, new(mocks.MockLogger))
+
+	ctx := context.Background()
+	id := uuid.New()
+	mockDeliveryRepo.On("GetByID", ctx, id).Return(nil, entityError.ErrWebhookDeliveryNotFound)
+
+	_, err := uc.Redeliver(ctx, id)
+
+	assert.ErrorIs(t, err, entityError.ErrWebhookDeliveryNotFound)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhookBackoff(1))
+	assert.Equal(t, time.Minute, webhookBackoff(2))
+	assert.Equal(t, 4*time.Minute, webhookBackoff(4))
+	assert.Equal(t, webhookMaxBackoff, webhookBackoff(20))
+	assert.Equal(t, webhookMaxBackoff, webhookBackoff(100))
+}
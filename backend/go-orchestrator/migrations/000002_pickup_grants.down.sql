@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pickup_grants;
\ No newline at end of file
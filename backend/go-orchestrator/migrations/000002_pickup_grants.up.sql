@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS pickup_grants (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL,
+    owner_id UUID NOT NULL,
+    grantee_phone VARCHAR(20),
+    token VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE pickup_grants
+ADD CONSTRAINT fk_pickup_grants_order_id FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE;
+ALTER TABLE pickup_grants
+ADD CONSTRAINT fk_pickup_grants_owner_id FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_pickup_grants_order_id ON pickup_grants(order_id);
+CREATE INDEX IF NOT EXISTS idx_pickup_grants_grantee_phone ON pickup_grants(grantee_phone);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pickup_pins;
\ No newline at end of file
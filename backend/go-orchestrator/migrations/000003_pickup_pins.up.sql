@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS pickup_pins (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL UNIQUE,
+    parcel_automat_id UUID NOT NULL,
+    pin_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE pickup_pins
+ADD CONSTRAINT fk_pickup_pins_order_id FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE;
+ALTER TABLE pickup_pins
+ADD CONSTRAINT fk_pickup_pins_parcel_automat_id FOREIGN KEY (parcel_automat_id) REFERENCES parcel_automats(id) ON DELETE CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pickup_pins_automat_pin_hash ON pickup_pins(parcel_automat_id, pin_hash)
+WHERE used_at IS NULL;
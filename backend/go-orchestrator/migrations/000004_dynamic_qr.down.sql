@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_qr_secrets;
+ALTER TABLE parcel_automats DROP COLUMN IF EXISTS allow_static_qr;
\ No newline at end of file
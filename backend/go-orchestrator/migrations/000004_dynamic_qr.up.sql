@@ -0,0 +1,11 @@
+ALTER TABLE parcel_automats
+ADD COLUMN IF NOT EXISTS allow_static_qr BOOLEAN NOT NULL DEFAULT TRUE;
+UPDATE parcel_automats
+SET allow_static_qr = TRUE;
+CREATE TABLE IF NOT EXISTS user_qr_secrets (
+    user_id UUID PRIMARY KEY,
+    secret VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE user_qr_secrets
+ADD CONSTRAINT fk_user_qr_secrets_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
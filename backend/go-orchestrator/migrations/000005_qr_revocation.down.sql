@@ -0,0 +1,2 @@
+ALTER TABLE user_qr_secrets DROP COLUMN IF EXISTS revoked_at;
+ALTER TABLE user_qr_secrets DROP COLUMN IF EXISTS generation;
\ No newline at end of file
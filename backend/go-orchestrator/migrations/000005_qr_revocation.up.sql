@@ -0,0 +1,4 @@
+ALTER TABLE user_qr_secrets
+ADD COLUMN IF NOT EXISTS generation INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE user_qr_secrets
+ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMP;
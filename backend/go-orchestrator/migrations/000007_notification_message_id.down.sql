@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_notification_deliveries_message_id;
+ALTER TABLE notification_deliveries DROP COLUMN IF EXISTS message_id;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE notification_deliveries
+ADD COLUMN IF NOT EXISTS message_id VARCHAR(255);
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_message_id ON notification_deliveries(message_id);
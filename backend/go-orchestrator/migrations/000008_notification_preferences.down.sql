@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS scheduled_notifications;
+DROP TABLE IF EXISTS notification_preferences;
\ No newline at end of file
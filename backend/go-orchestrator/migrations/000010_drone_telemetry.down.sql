@@ -0,0 +1 @@
+DROP TABLE IF EXISTS drone_telemetry;
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS drone_telemetry (
+    id BIGSERIAL PRIMARY KEY,
+    drone_id UUID NOT NULL,
+    delivery_id UUID,
+    status VARCHAR(50) NOT NULL,
+    battery_level DOUBLE PRECISION NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    altitude DOUBLE PRECISION NOT NULL,
+    speed DOUBLE PRECISION NOT NULL,
+    recorded_at TIMESTAMP NOT NULL
+);
+ALTER TABLE drone_telemetry
+ADD CONSTRAINT fk_drone_telemetry_drone_id FOREIGN KEY (drone_id) REFERENCES drones(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_drone_telemetry_drone_recorded_at ON drone_telemetry(drone_id, recorded_at);
+CREATE INDEX IF NOT EXISTS idx_drone_telemetry_delivery_recorded_at ON drone_telemetry(delivery_id, recorded_at)
+WHERE delivery_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_drone_telemetry_recorded_at ON drone_telemetry(recorded_at);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS delivery_recordings;
\ No newline at end of file
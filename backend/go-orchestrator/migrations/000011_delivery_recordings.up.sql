@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS delivery_recordings (
+    delivery_id UUID PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    video_key TEXT,
+    manifest_key TEXT,
+    frame_count INTEGER NOT NULL DEFAULT 0,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE delivery_recordings
+ADD CONSTRAINT fk_delivery_recordings_delivery_id FOREIGN KEY (delivery_id) REFERENCES deliveries(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_delivery_recordings_due ON delivery_recordings(next_attempt_at)
+WHERE status IN ('pending', 'processing');
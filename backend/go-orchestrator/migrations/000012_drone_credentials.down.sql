@@ -0,0 +1 @@
+DROP TABLE IF EXISTS drone_credentials;
\ No newline at end of file
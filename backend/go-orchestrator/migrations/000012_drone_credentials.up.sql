@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS drone_credentials (
+    drone_id UUID PRIMARY KEY,
+    secret VARCHAR(128) NOT NULL,
+    key_version INTEGER NOT NULL DEFAULT 1,
+    last_connected_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rotated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE drone_credentials
+ADD CONSTRAINT fk_drone_credentials_drone_id FOREIGN KEY (drone_id) REFERENCES drones(id) ON DELETE CASCADE;
+INSERT INTO drone_credentials (drone_id, secret)
+SELECT id,
+    'dsk_' || replace(uuid_generate_v4()::text, '-', '') || replace(uuid_generate_v4()::text, '-', '')
+FROM drones
+ON CONFLICT (drone_id) DO NOTHING;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS drone_commands (
+    id UUID PRIMARY KEY,
+    drone_id UUID NOT NULL,
+    command VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP,
+    acked_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE drone_commands
+ADD CONSTRAINT fk_drone_commands_drone_id FOREIGN KEY (drone_id) REFERENCES drones(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_drone_commands_open_by_drone ON drone_commands(drone_id, created_at)
+WHERE status IN ('pending', 'sent', 'acked');
+CREATE INDEX IF NOT EXISTS idx_drone_commands_due ON drone_commands(next_attempt_at)
+WHERE status IN ('pending', 'sent');
+CREATE INDEX IF NOT EXISTS idx_drone_commands_expiry ON drone_commands(expires_at)
+WHERE status IN ('pending', 'sent', 'acked');
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS drone_command_audit;
\ No newline at end of file
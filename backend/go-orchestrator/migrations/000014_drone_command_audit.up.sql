@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS drone_command_audit (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    drone_id UUID NOT NULL,
+    command_id UUID,
+    command VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    operator_id UUID NOT NULL,
+    operator_email VARCHAR(255) NOT NULL,
+    operator_role VARCHAR(20) NOT NULL,
+    result VARCHAR(20) NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_drone_command_audit_drone ON drone_command_audit(drone_id, created_at DESC);
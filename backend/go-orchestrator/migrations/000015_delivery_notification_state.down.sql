@@ -0,0 +1,3 @@
+ALTER TABLE deliveries DROP COLUMN IF EXISTS arrival_notified_at;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS pickup_reminded_at;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS delivered_at;
\ No newline at end of file
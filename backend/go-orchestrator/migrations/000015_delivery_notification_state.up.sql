@@ -0,0 +1,9 @@
+ALTER TABLE deliveries
+ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMP;
+ALTER TABLE deliveries
+ADD COLUMN IF NOT EXISTS pickup_reminded_at TIMESTAMP;
+ALTER TABLE deliveries
+ADD COLUMN IF NOT EXISTS arrival_notified_at TIMESTAMP;
+UPDATE deliveries
+SET delivered_at = CURRENT_TIMESTAMP
+WHERE status = 'delivered';
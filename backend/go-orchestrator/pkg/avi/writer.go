@@ -0,0 +1,214 @@
+package avi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// MaxFileSize keeps the file within the 1 GiB that plain RIFF AVI players reliably support.
+const MaxFileSize = 1 << 30
+
+var ErrFileTooLarge = errors.New("avi file size limit reached")
+
+const (
+	headerSize    = 224
+	moviListStart = 212
+	avifHasIndex  = 0x10
+	aviifKeyframe = 0x10
+)
+
+type indexEntry struct {
+	offset uint32
+	size   uint32
+}
+
+// Writer produces an MJPEG AVI: every frame is a JPEG stored as-is in a single video stream at a constant
+// frame rate. The headers are written with placeholders and rewritten by Close, so w must be seekable.
+type Writer struct {
+	w        io.WriteSeeker
+	width    int
+	height   int
+	fps      float64
+	index    []indexEntry
+	moviSize int64
+	maxFrame uint32
+	closed   bool
+}
+
+func NewWriter(w io.WriteSeeker, width, height int, fps float64) (*Writer, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("avi - NewWriter: invalid frame size %dx%d", width, height)
+	}
+	if fps <= 0 || math.IsInf(fps, 0) || math.IsNaN(fps) {
+		return nil, fmt.Errorf("avi - NewWriter: invalid frame rate %f", fps)
+	}
+
+	writer := &Writer{w: w, width: width, height: height, fps: fps}
+	if err := writer.writeHeader(); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (w *Writer) Frames() int {
+	return len(w.index)
+}
+
+func (w *Writer) WriteFrame(jpeg []byte) error {
+	if w.closed {
+		return fmt.Errorf("avi - WriteFrame: writer is closed")
+	}
+
+	size := int64(len(jpeg))
+	padded := size + size%2
+	indexSize := int64(len(w.index)+1) * 16
+	if headerSize+w.moviSize+8+padded+8+indexSize > MaxFileSize {
+		return ErrFileTooLarge
+	}
+
+	chunk := make([]byte, 8, 8+padded)
+	copy(chunk, "00dc")
+	binary.LittleEndian.PutUint32(chunk[4:], uint32(size))
+	chunk = append(chunk, jpeg...)
+	if size%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+
+	if _, err := w.w.Write(chunk); err != nil {
+		return fmt.Errorf("avi - WriteFrame - Write: %w", err)
+	}
+
+	// idx1 offsets are relative to the 'movi' fourcc.
+	w.index = append(w.index, indexEntry{offset: uint32(4 + w.moviSize), size: uint32(size)})
+	w.moviSize += 8 + padded
+	if uint32(size) > w.maxFrame {
+		w.maxFrame = uint32(size)
+	}
+	return nil
+}
+
+// Close appends the frame index and rewrites the headers with the final sizes and frame count.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	var idx bytes.Buffer
+	idx.WriteString("idx1")
+	writeUint32(&idx, uint32(len(w.index)*16))
+	for _, entry := range w.index {
+		idx.WriteString("00dc")
+		writeUint32(&idx, aviifKeyframe)
+		writeUint32(&idx, entry.offset)
+		writeUint32(&idx, entry.size)
+	}
+	if _, err := w.w.Write(idx.Bytes()); err != nil {
+		return fmt.Errorf("avi - Close - WriteIndex: %w", err)
+	}
+
+	if _, err := w.w.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("avi - Close - Seek: %w", err)
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	if _, err := w.w.Seek(0, io.SeekEnd); err != nil {
+		return fmt.Errorf("avi - Close - Seek: %w", err)
+	}
+	return nil
+}
+
+func (w *Writer) writeHeader() error {
+	frames := uint32(len(w.index))
+	fileSize := uint32(headerSize + w.moviSize + 8 + int64(frames)*16)
+	microSecPerFrame := uint32(math.Round(1e6 / w.fps))
+	rate := uint32(math.Round(w.fps * 1000))
+	maxBytesPerSec := uint32(math.Ceil(float64(w.maxFrame) * w.fps))
+
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	writeUint32(&b, fileSize-8)
+	b.WriteString("AVI ")
+
+	b.WriteString("LIST")
+	writeUint32(&b, moviListStart-20)
+	b.WriteString("hdrl")
+
+	b.WriteString("avih")
+	writeUint32(&b, 56)
+	writeUint32(&b, microSecPerFrame)
+	writeUint32(&b, maxBytesPerSec)
+	writeUint32(&b, 0)
+	writeUint32(&b, avifHasIndex)
+	writeUint32(&b, frames)
+	writeUint32(&b, 0)
+	writeUint32(&b, 1)
+	writeUint32(&b, w.maxFrame)
+	writeUint32(&b, uint32(w.width))
+	writeUint32(&b, uint32(w.height))
+	b.Write(make([]byte, 16))
+
+	b.WriteString("LIST")
+	writeUint32(&b, 4+64+48)
+	b.WriteString("strl")
+
+	b.WriteString("strh")
+	writeUint32(&b, 56)
+	b.WriteString("vids")
+	b.WriteString("MJPG")
+	writeUint32(&b, 0)
+	writeUint16(&b, 0)
+	writeUint16(&b, 0)
+	writeUint32(&b, 0)
+	writeUint32(&b, 1000)
+	writeUint32(&b, rate)
+	writeUint32(&b, 0)
+	writeUint32(&b, frames)
+	writeUint32(&b, w.maxFrame)
+	writeUint32(&b, math.MaxUint32)
+	writeUint32(&b, 0)
+	writeUint16(&b, 0)
+	writeUint16(&b, 0)
+	writeUint16(&b, uint16(w.width))
+	writeUint16(&b, uint16(w.height))
+
+	b.WriteString("strf")
+	writeUint32(&b, 40)
+	writeUint32(&b, 40)
+	writeUint32(&b, uint32(w.width))
+	writeUint32(&b, uint32(w.height))
+	writeUint16(&b, 1)
+	writeUint16(&b, 24)
+	b.WriteString("MJPG")
+	writeUint32(&b, uint32(w.width*w.height*3))
+	writeUint32(&b, 0)
+	writeUint32(&b, 0)
+	writeUint32(&b, 0)
+	writeUint32(&b, 0)
+
+	b.WriteString("LIST")
+	writeUint32(&b, uint32(4+w.moviSize))
+	b.WriteString("movi")
+
+	if b.Len() != headerSize {
+		return fmt.Errorf("avi - writeHeader: header is %d bytes, expected %d", b.Len(), headerSize)
+	}
+
+	if _, err := w.w.Write(b.Bytes()); err != nil {
+		return fmt.Errorf("avi - writeHeader - Write: %w", err)
+	}
+	return nil
+}
+
+func writeUint32(b *bytes.Buffer, v uint32) {
+	_ = binary.Write(b, binary.LittleEndian, v)
+}
+
+func writeUint16(b *bytes.Buffer, v uint16) {
+	_ = binary.Write(b, binary.LittleEndian, v)
+}
@@ -0,0 +1,114 @@
+package avi
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memFile struct {
+	data []byte
+	pos  int64
+}
+
+func (m *memFile) Write(p []byte) (int, error) {
+	end := m.pos + int64(len(p))
+	if end > int64(len(m.data)) {
+		m.data = append(m.data, make([]byte, end-int64(len(m.data)))...)
+	}
+	copy(m.data[m.pos:], p)
+	m.pos = end
+	return len(p), nil
+}
+
+func (m *memFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		m.pos = offset
+	case io.SeekCurrent:
+		m.pos += offset
+	case io.SeekEnd:
+		m.pos = int64(len(m.data)) + offset
+	}
+	if m.pos < 0 {
+		return 0, errors.New("negative position")
+	}
+	return m.pos, nil
+}
+
+func u32(b []byte, off int) uint32 {
+	return binary.LittleEndian.Uint32(b[off:])
+}
+
+func TestWriter_WritesIndexedMJPEG(t *testing.T) {
+	file := &memFile{}
+	writer, err := NewWriter(file, 640, 480, 2)
+	require.NoError(t, err)
+
+	frames := [][]byte{
+		{0xFF, 0xD8, 0x01, 0xFF, 0xD9},
+		{0xFF, 0xD8, 0x02, 0x03, 0xFF, 0xD9},
+	}
+	for _, frame := range frames {
+		require.NoError(t, writer.WriteFrame(frame))
+	}
+	require.NoError(t, writer.Close())
+	assert.Equal(t, 2, writer.Frames())
+
+	data := file.data
+	assert.Equal(t, "RIFF", string(data[0:4]))
+	assert.Equal(t, uint32(len(data)-8), u32(data, 4))
+	assert.Equal(t, "AVI ", string(data[8:12]))
+
+	// avih: microseconds per frame, total frames, width and height.
+	assert.Equal(t, "avih", string(data[24:28]))
+	assert.Equal(t, uint32(500000), u32(data, 32))
+	assert.Equal(t, uint32(2), u32(data, 48))
+	assert.Equal(t, uint32(640), u32(data, 64))
+	assert.Equal(t, uint32(480), u32(data, 68))
+
+	assert.Equal(t, "strh", string(data[100:104]))
+	assert.Equal(t, "MJPG", string(data[112:116]))
+	assert.Equal(t, uint32(2), u32(data, 140))
+
+	assert.Equal(t, "movi", string(data[220:224]))
+	moviSize := u32(data, 216)
+	assert.Equal(t, uint32(4+8+6+8+6), moviSize)
+
+	assert.Equal(t, "00dc", string(data[224:228]))
+	assert.Equal(t, uint32(5), u32(data, 228))
+	assert.Equal(t, frames[0], data[232:237])
+	// Odd sized chunks are padded to a word boundary.
+	assert.Equal(t, byte(0), data[237])
+	assert.Equal(t, "00dc", string(data[238:242]))
+	assert.Equal(t, frames[1], data[246:252])
+
+	idx := 216 + 4 + int(moviSize)
+	assert.Equal(t, "idx1", string(data[idx:idx+4]))
+	assert.Equal(t, uint32(32), u32(data, idx+4))
+	assert.Equal(t, uint32(4), u32(data, idx+16))
+	assert.Equal(t, uint32(5), u32(data, idx+20))
+	assert.Equal(t, uint32(18), u32(data, idx+32))
+	assert.Equal(t, uint32(6), u32(data, idx+36))
+	assert.Equal(t, len(data), idx+8+32)
+}
+
+func TestWriter_RejectsInvalidParameters(t *testing.T) {
+	_, err := NewWriter(&memFile{}, 0, 480, 10)
+	assert.Error(t, err)
+
+	_, err = NewWriter(&memFile{}, 640, 480, 0)
+	assert.Error(t, err)
+}
+
+func TestWriter_WriteAfterClose(t *testing.T) {
+	writer, err := NewWriter(&memFile{}, 320, 240, 10)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	assert.Error(t, writer.WriteFrame([]byte{0xFF, 0xD8}))
+}
@@ -0,0 +1,100 @@
+package geoexport
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatGPX     Format = "gpx"
+	FormatKML     Format = "kml"
+	FormatGeoJSON Format = "geojson"
+)
+
+const (
+	contentTypeGPX     = "application/gpx+xml"
+	contentTypeKML     = "application/vnd.google-earth.kml+xml"
+	contentTypeGeoJSON = "application/geo+json"
+)
+
+// Point is a single fix of a track. Battery, Status and Speed are written as format specific extensions.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+	Time      time.Time
+	Battery   float64
+	Status    string
+	Speed     float64
+}
+
+type Track struct {
+	Name        string
+	Description string
+	Points      []Point
+}
+
+// ParseFormat accepts the format names used in the format query parameter.
+func ParseFormat(value string) (Format, bool) {
+	switch Format(strings.ToLower(strings.TrimSpace(value))) {
+	case FormatGPX:
+		return FormatGPX, true
+	case FormatKML:
+		return FormatKML, true
+	case FormatGeoJSON, "json+geo":
+		return FormatGeoJSON, true
+	}
+	return "", false
+}
+
+// FromAccept picks the first export format listed in an Accept header. Quality values are ignored:
+// clients asking for a track export name a single media type.
+func FromAccept(accept string) (Format, bool) {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case contentTypeGPX:
+			return FormatGPX, true
+		case contentTypeKML:
+			return FormatKML, true
+		case contentTypeGeoJSON:
+			return FormatGeoJSON, true
+		}
+	}
+	return "", false
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatGPX:
+		return contentTypeGPX
+	case FormatKML:
+		return contentTypeKML
+	default:
+		return contentTypeGeoJSON
+	}
+}
+
+func (f Format) Extension() string {
+	return string(f)
+}
+
+func Encode(w io.Writer, format Format, track Track) error {
+	switch format {
+	case FormatGPX:
+		return EncodeGPX(w, track)
+	case FormatKML:
+		return EncodeKML(w, track)
+	case FormatGeoJSON:
+		return EncodeGeoJSON(w, track)
+	}
+	return fmt.Errorf("geoexport - Encode: unsupported format %q", format)
+}
@@ -0,0 +1,136 @@
+package geoexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTrack() Track {
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	return Track{
+		Name: "Delivery 42",
+		Points: []Point{
+			{Latitude: 55.7558, Longitude: 37.6173, Altitude: 50, Time: start, Battery: 95, Status: "taking_off", Speed: 2},
+			{Latitude: 55.7568, Longitude: 37.6183, Altitude: 80.5, Time: start.Add(2 * time.Second), Battery: 94.5, Status: "in_transit", Speed: 12.25},
+		},
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	format, ok := ParseFormat("GPX")
+	assert.True(t, ok)
+	assert.Equal(t, FormatGPX, format)
+
+	format, ok = ParseFormat("geojson")
+	assert.True(t, ok)
+	assert.Equal(t, FormatGeoJSON, format)
+
+	_, ok = ParseFormat("shp")
+	assert.False(t, ok)
+}
+
+func TestFromAccept(t *testing.T) {
+	format, ok := FromAccept("text/html, application/vnd.google-earth.kml+xml;q=0.9")
+	assert.True(t, ok)
+	assert.Equal(t, FormatKML, format)
+
+	format, ok = FromAccept("application/geo+json")
+	assert.True(t, ok)
+	assert.Equal(t, "application/geo+json", format.ContentType())
+
+	_, ok = FromAccept("application/json")
+	assert.False(t, ok)
+}
+
+func TestEncodeGPX(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeGPX(&buf, testTrack()))
+
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, xml.Header))
+	assert.Contains(t, out, `version="1.1"`)
+	assert.Contains(t, out, `xmlns="http://www.topografix.com/GPX/1/1"`)
+	assert.Contains(t, out, `<skypost:status>in_transit</skypost:status>`)
+
+	var doc struct {
+		Points []struct {
+			Lat  float64 `xml:"lat,attr"`
+			Lon  float64 `xml:"lon,attr"`
+			Ele  float64 `xml:"ele"`
+			Time string  `xml:"time"`
+		} `xml:"trk>trkseg>trkpt"`
+	}
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+	require.Len(t, doc.Points, 2)
+	assert.Equal(t, 55.7568, doc.Points[1].Lat)
+	assert.Equal(t, 37.6183, doc.Points[1].Lon)
+	assert.Equal(t, 80.5, doc.Points[1].Ele)
+	assert.Equal(t, "2026-03-01T10:00:02Z", doc.Points[1].Time)
+}
+
+func TestEncodeKML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeKML(&buf, testTrack()))
+
+	out := buf.String()
+	assert.Contains(t, out, `<coordinates>37.6173000,55.7558000,50.00 37.6183000,55.7568000,80.50</coordinates>`)
+	assert.Contains(t, out, `<when>2026-03-01T10:00:00Z</when>`)
+	assert.Contains(t, out, `<gx:coord>37.6183000 55.7568000 80.50</gx:coord>`)
+	assert.Contains(t, out, `<gx:SimpleArrayData name="battery">`)
+
+	decoder := xml.NewDecoder(&buf)
+	for {
+		if _, err := decoder.Token(); err != nil {
+			assert.Equal(t, "EOF", err.Error())
+			break
+		}
+	}
+}
+
+func TestEncodeGeoJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeGeoJSON(&buf, testTrack()))
+
+	var doc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Type        string       `json:"type"`
+				Coordinates [][3]float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties struct {
+				CoordinateProperties struct {
+					Times   []string  `json:"times"`
+					Battery []float64 `json:"battery"`
+					Status  []string  `json:"status"`
+				} `json:"coordinateProperties"`
+			} `json:"properties"`
+		} `json:"features"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	assert.Equal(t, "FeatureCollection", doc.Type)
+	require.Len(t, doc.Features, 1)
+	feature := doc.Features[0]
+	assert.Equal(t, "LineString", feature.Geometry.Type)
+	assert.Equal(t, [3]float64{37.6173, 55.7558, 50}, feature.Geometry.Coordinates[0])
+	assert.Equal(t, []float64{95, 94.5}, feature.Properties.CoordinateProperties.Battery)
+	assert.Equal(t, []string{"taking_off", "in_transit"}, feature.Properties.CoordinateProperties.Status)
+}
+
+func TestEncodeGeoJSON_SinglePointHasNullGeometry(t *testing.T) {
+	track := testTrack()
+	track.Points = track.Points[:1]
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeGeoJSON(&buf, track))
+
+	assert.Contains(t, buf.String(), `"geometry":null`)
+}
@@ -0,0 +1,89 @@
+package geoexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string            `json:"type"`
+	Geometry   *geoJSONGeometry  `json:"geometry"`
+	Properties geoJSONProperties `json:"properties"`
+}
+
+type geoJSONGeometry struct {
+	Type        string       `json:"type"`
+	Coordinates [][3]float64 `json:"coordinates"`
+}
+
+// geoJSONProperties follows the coordinateProperties convention used by togeojson and Mapbox tools:
+// every array is aligned with the LineString coordinates.
+type geoJSONProperties struct {
+	Name                 string                    `json:"name,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Times                []string                  `json:"times"`
+	CoordinateProperties geoJSONCoordinateProperty `json:"coordinateProperties"`
+}
+
+type geoJSONCoordinateProperty struct {
+	Times   []string  `json:"times"`
+	Battery []float64 `json:"battery"`
+	Status  []string  `json:"status"`
+	Speed   []float64 `json:"speed"`
+}
+
+// EncodeGeoJSON writes an RFC 7946 FeatureCollection with one LineString feature. Positions are
+// [longitude, latitude, altitude]. A track with fewer than two points has a null geometry because
+// a LineString needs at least two positions.
+func EncodeGeoJSON(w io.Writer, track Track) error {
+	n := len(track.Points)
+	coordinates := make([][3]float64, 0, n)
+	props := geoJSONCoordinateProperty{
+		Times:   make([]string, 0, n),
+		Battery: make([]float64, 0, n),
+		Status:  make([]string, 0, n),
+		Speed:   make([]float64, 0, n),
+	}
+
+	for _, p := range track.Points {
+		coordinates = append(coordinates, [3]float64{round(p.Longitude, 7), round(p.Latitude, 7), round(p.Altitude, 2)})
+		props.Times = append(props.Times, formatTime(p.Time))
+		props.Battery = append(props.Battery, round(p.Battery, 2))
+		props.Status = append(props.Status, p.Status)
+		props.Speed = append(props.Speed, round(p.Speed, 2))
+	}
+
+	feature := geoJSONFeature{
+		Type: "Feature",
+		Properties: geoJSONProperties{
+			Name:                 track.Name,
+			Description:          track.Description,
+			Times:                props.Times,
+			CoordinateProperties: props,
+		},
+	}
+	if n >= 2 {
+		feature.Geometry = &geoJSONGeometry{Type: "LineString", Coordinates: coordinates}
+	}
+
+	encoder := json.NewEncoder(w)
+	if err := encoder.Encode(geoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: []geoJSONFeature{feature},
+	}); err != nil {
+		return fmt.Errorf("geoexport - EncodeGeoJSON - Encode: %w", err)
+	}
+	return nil
+}
+
+func round(v float64, digits int) float64 {
+	scale := math.Pow(10, float64(digits))
+	return math.Round(v*scale) / scale
+}
@@ -0,0 +1,120 @@
+package geoexport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	gpxNamespace       = "http://www.topografix.com/GPX/1/1"
+	gpxSchemaLocation  = "http://www.topografix.com/GPX/1/1 http://www.topografix.com/GPX/1/1/gpx.xsd"
+	skypostNamespace   = "https://skypost.delivery/xmlns/telemetry/1"
+	gpxCreator         = "SkyPost Delivery"
+	xmlSchemaNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+type gpxDocument struct {
+	XMLName        xml.Name    `xml:"gpx"`
+	Version        string      `xml:"version,attr"`
+	Creator        string      `xml:"creator,attr"`
+	Xmlns          string      `xml:"xmlns,attr"`
+	XmlnsXSI       string      `xml:"xmlns:xsi,attr"`
+	XmlnsSkypost   string      `xml:"xmlns:skypost,attr"`
+	SchemaLocation string      `xml:"xsi:schemaLocation,attr"`
+	Metadata       gpxMetadata `xml:"metadata"`
+	Track          gpxTrack    `xml:"trk"`
+}
+
+type gpxMetadata struct {
+	Name string `xml:"name,omitempty"`
+	Desc string `xml:"desc,omitempty"`
+	Time string `xml:"time,omitempty"`
+}
+
+type gpxTrack struct {
+	Name    string          `xml:"name,omitempty"`
+	Desc    string          `xml:"desc,omitempty"`
+	Segment gpxTrackSegment `xml:"trkseg"`
+}
+
+type gpxTrackSegment struct {
+	Points []gpxTrackPoint `xml:"trkpt"`
+}
+
+type gpxTrackPoint struct {
+	Lat        string        `xml:"lat,attr"`
+	Lon        string        `xml:"lon,attr"`
+	Ele        string        `xml:"ele"`
+	Time       string        `xml:"time"`
+	Extensions gpxExtensions `xml:"extensions"`
+}
+
+type gpxExtensions struct {
+	Battery string `xml:"skypost:battery"`
+	Status  string `xml:"skypost:status"`
+	Speed   string `xml:"skypost:speed"`
+}
+
+// EncodeGPX writes the track as a GPX 1.1 document with a single segment. Battery level, status and
+// speed of every point are stored in the skypost extension namespace.
+func EncodeGPX(w io.Writer, track Track) error {
+	doc := gpxDocument{
+		Version:        "1.1",
+		Creator:        gpxCreator,
+		Xmlns:          gpxNamespace,
+		XmlnsXSI:       xmlSchemaNamespace,
+		XmlnsSkypost:   skypostNamespace,
+		SchemaLocation: gpxSchemaLocation,
+		Metadata: gpxMetadata{
+			Name: track.Name,
+			Desc: track.Description,
+		},
+		Track: gpxTrack{
+			Name: track.Name,
+			Desc: track.Description,
+		},
+	}
+	if len(track.Points) > 0 {
+		doc.Metadata.Time = formatTime(track.Points[0].Time)
+	}
+
+	doc.Track.Segment.Points = make([]gpxTrackPoint, 0, len(track.Points))
+	for _, p := range track.Points {
+		doc.Track.Segment.Points = append(doc.Track.Segment.Points, gpxTrackPoint{
+			Lat:  formatCoordinate(p.Latitude),
+			Lon:  formatCoordinate(p.Longitude),
+			Ele:  formatDecimal(p.Altitude),
+			Time: formatTime(p.Time),
+			Extensions: gpxExtensions{
+				Battery: formatDecimal(p.Battery),
+				Status:  p.Status,
+				Speed:   formatDecimal(p.Speed),
+			},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("geoexport - EncodeGPX - WriteHeader: %w", err)
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("geoexport - EncodeGPX - Encode: %w", err)
+	}
+	return nil
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func formatCoordinate(v float64) string {
+	return fmt.Sprintf("%.7f", v)
+}
+
+func formatDecimal(v float64) string {
+	return fmt.Sprintf("%.2f", v)
+}
@@ -0,0 +1,142 @@
+package geoexport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	kmlNamespace   = "http://www.opengis.net/kml/2.2"
+	kmlGxNamespace = "http://www.google.com/kml/ext/2.2"
+	kmlSchemaID    = "skypostTelemetry"
+)
+
+type kmlDocument struct {
+	XMLName xml.Name   `xml:"kml"`
+	Xmlns   string     `xml:"xmlns,attr"`
+	XmlnsGx string     `xml:"xmlns:gx,attr"`
+	Body    kmlDocBody `xml:"Document"`
+}
+
+type kmlDocBody struct {
+	Name        string         `xml:"name,omitempty"`
+	Description string         `xml:"description,omitempty"`
+	Schema      kmlSchema      `xml:"Schema"`
+	Placemarks  []kmlPlacemark `xml:"Placemark"`
+}
+
+type kmlSchema struct {
+	ID     string           `xml:"id,attr"`
+	Fields []kmlSimpleField `xml:"gx:SimpleArrayField"`
+}
+
+type kmlSimpleField struct {
+	Name        string `xml:"name,attr"`
+	Type        string `xml:"type,attr"`
+	DisplayName string `xml:"displayName"`
+}
+
+type kmlPlacemark struct {
+	Name       string         `xml:"name"`
+	LineString *kmlLineString `xml:"LineString,omitempty"`
+	Track      *kmlTrack      `xml:"gx:Track,omitempty"`
+}
+
+type kmlLineString struct {
+	AltitudeMode string `xml:"altitudeMode"`
+	Coordinates  string `xml:"coordinates"`
+}
+
+type kmlTrack struct {
+	AltitudeMode string          `xml:"altitudeMode"`
+	When         []string        `xml:"when"`
+	Coords       []string        `xml:"gx:coord"`
+	ExtendedData kmlExtendedData `xml:"ExtendedData"`
+}
+
+type kmlExtendedData struct {
+	SchemaData kmlSchemaData `xml:"SchemaData"`
+}
+
+type kmlSchemaData struct {
+	SchemaURL string               `xml:"schemaUrl,attr"`
+	Arrays    []kmlSimpleArrayData `xml:"gx:SimpleArrayData"`
+}
+
+type kmlSimpleArrayData struct {
+	Name   string   `xml:"name,attr"`
+	Values []string `xml:"gx:value"`
+}
+
+// EncodeKML writes a KML 2.2 document with two placemarks: the flown path as a LineString, which every
+// GIS tool understands, and a timed gx:Track that carries battery, status and speed per point.
+func EncodeKML(w io.Writer, track Track) error {
+	doc := kmlDocument{
+		Xmlns:   kmlNamespace,
+		XmlnsGx: kmlGxNamespace,
+		Body: kmlDocBody{
+			Name:        track.Name,
+			Description: track.Description,
+			Schema: kmlSchema{
+				ID: kmlSchemaID,
+				Fields: []kmlSimpleField{
+					{Name: "battery", Type: "float", DisplayName: "Battery, %"},
+					{Name: "status", Type: "string", DisplayName: "Status"},
+					{Name: "speed", Type: "float", DisplayName: "Speed, m/s"},
+				},
+			},
+		},
+	}
+
+	n := len(track.Points)
+	coordinates := make([]string, 0, n)
+	timed := &kmlTrack{
+		AltitudeMode: "absolute",
+		When:         make([]string, 0, n),
+		Coords:       make([]string, 0, n),
+	}
+	battery := kmlSimpleArrayData{Name: "battery", Values: make([]string, 0, n)}
+	status := kmlSimpleArrayData{Name: "status", Values: make([]string, 0, n)}
+	speed := kmlSimpleArrayData{Name: "speed", Values: make([]string, 0, n)}
+
+	for _, p := range track.Points {
+		lon, lat, alt := formatCoordinate(p.Longitude), formatCoordinate(p.Latitude), formatDecimal(p.Altitude)
+		coordinates = append(coordinates, lon+","+lat+","+alt)
+		timed.When = append(timed.When, formatTime(p.Time))
+		timed.Coords = append(timed.Coords, lon+" "+lat+" "+alt)
+		battery.Values = append(battery.Values, formatDecimal(p.Battery))
+		status.Values = append(status.Values, p.Status)
+		speed.Values = append(speed.Values, formatDecimal(p.Speed))
+	}
+	timed.ExtendedData.SchemaData = kmlSchemaData{
+		SchemaURL: "#" + kmlSchemaID,
+		Arrays:    []kmlSimpleArrayData{battery, status, speed},
+	}
+
+	if n >= 2 {
+		doc.Body.Placemarks = append(doc.Body.Placemarks, kmlPlacemark{
+			Name: "Flight path",
+			LineString: &kmlLineString{
+				AltitudeMode: "absolute",
+				Coordinates:  strings.Join(coordinates, " "),
+			},
+		})
+	}
+	doc.Body.Placemarks = append(doc.Body.Placemarks, kmlPlacemark{
+		Name:  "Telemetry",
+		Track: timed,
+	})
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("geoexport - EncodeKML - WriteHeader: %w", err)
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("geoexport - EncodeKML - Encode: %w", err)
+	}
+	return nil
+}
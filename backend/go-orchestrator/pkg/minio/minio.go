@@ -42,14 +42,18 @@ func New(cfg *config.MinIO, log logger.Interface) (*Client, error) {
 		logger:    log,
 	}
 
-	if err := client.EnsureBucket(context.Background(), cfg.BucketQR, cfg.BucketRecords); err != nil {
+	if err := client.EnsureBucket(context.Background(), cfg.BucketQR); err != nil {
+		return nil, fmt.Errorf("MinioClient - New - EnsureBucket: %w", err)
+	}
+	// Flight recordings are only handed out through presigned URLs, so that bucket stays private.
+	if err := client.ensureBucketExists(context.Background(), cfg.BucketRecords, false); err != nil {
 		return nil, fmt.Errorf("MinioClient - New - EnsureBucket: %w", err)
 	}
 
 	return client, nil
 }
 
-func (c *Client) ensureBucketExists(ctx context.Context, bucketName string) error {
+func (c *Client) ensureBucketExists(ctx context.Context, bucketName string, public bool) error {
 	exists, err := c.client.BucketExists(ctx, bucketName)
 	if err != nil {
 		return fmt.Errorf("MinioClient - ensureBucketExists - BucketExists: %w", err)
@@ -63,6 +67,13 @@ func (c *Client) ensureBucketExists(ctx context.Context, bucketName string) erro
 		c.logger.Info("Created MinIO bucket", nil, map[string]any{"bucket": bucketName})
 	}
 
+	if !public {
+		if err := c.client.SetBucketPolicy(ctx, bucketName, ""); err != nil {
+			c.logger.Warn("Failed to remove bucket policy (bucket may still be public)", err, map[string]any{"bucket": bucketName})
+		}
+		return nil
+	}
+
 	policy := fmt.Sprintf(`{
 		"Version": "2012-10-17",
 		"Statement": [{
@@ -82,7 +93,7 @@ func (c *Client) ensureBucketExists(ctx context.Context, bucketName string) erro
 
 func (c *Client) EnsureBucket(ctx context.Context, bucketNames ...string) error {
 	for _, bucketName := range bucketNames {
-		if err := c.ensureBucketExists(ctx, bucketName); err != nil {
+		if err := c.ensureBucketExists(ctx, bucketName, true); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,101 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/config"
+)
+
+// presignRegion is fixed so that presigning never has to look the bucket location up over the network.
+const presignRegion = "us-east-1"
+
+type RecordingStorage interface {
+	ListObjects(ctx context.Context, prefix string) ([]string, error)
+	GetObject(ctx context.Context, objectName string) (io.ReadCloser, error)
+	PutObject(ctx context.Context, objectName string, reader io.Reader, objectSize int64, contentType string) error
+	PresignedGetURL(ctx context.Context, objectName string, expiry time.Duration) (string, error)
+}
+
+// RecordingStore works with the records bucket that drone-service fills with video frames. URLs are signed
+// against the public MinIO address because the signature covers the host the client will connect to.
+type RecordingStore struct {
+	client    *minio.Client
+	presigner *minio.Client
+	bucket    string
+}
+
+func NewRecordingStore(cfg *config.MinIO) (*RecordingStore, error) {
+	creds := credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, "")
+
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: cfg.UseSSL,
+		Region: presignRegion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("RecordingStore - New - New: %w", err)
+	}
+
+	publicURL, err := url.Parse(cfg.PublicURL)
+	if err != nil || publicURL.Host == "" {
+		return nil, fmt.Errorf("RecordingStore - New - ParsePublicURL: invalid MinIO public URL %q", cfg.PublicURL)
+	}
+
+	presigner, err := minio.New(publicURL.Host, &minio.Options{
+		Creds:  creds,
+		Secure: publicURL.Scheme == "https",
+		Region: presignRegion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("RecordingStore - New - NewPresigner: %w", err)
+	}
+
+	return &RecordingStore{
+		client:    client,
+		presigner: presigner,
+		bucket:    cfg.BucketRecords,
+	}, nil
+}
+
+func (s *RecordingStore) ListObjects(ctx context.Context, prefix string) ([]string, error) {
+	var names []string
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf("RecordingStore - ListObjects: %w", object.Err)
+		}
+		names = append(names, object.Key)
+	}
+	return names, nil
+}
+
+func (s *RecordingStore) GetObject(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("RecordingStore - GetObject: %w", err)
+	}
+	return object, nil
+}
+
+func (s *RecordingStore) PutObject(ctx context.Context, objectName string, reader io.Reader, objectSize int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, objectName, reader, objectSize, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("RecordingStore - PutObject: %w", err)
+	}
+	return nil
+}
+
+func (s *RecordingStore) PresignedGetURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
+	signed, err := s.presigner.PresignedGetObject(ctx, s.bucket, objectName, expiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("RecordingStore - PresignedGetURL: %w", err)
+	}
+	return signed.String(), nil
+}
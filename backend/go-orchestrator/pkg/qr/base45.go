@@ -0,0 +1,64 @@
+package qr
+
+import (
+	"fmt"
+	"strings"
+)
+
+const base45Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// encodeBase45 implements RFC 9285. The output only uses QR alphanumeric mode characters.
+func encodeBase45(data []byte) string {
+	var sb strings.Builder
+	sb.Grow((len(data)/2)*3 + 2)
+
+	for i := 0; i+1 < len(data); i += 2 {
+		n := int(data[i])<<8 | int(data[i+1])
+		sb.WriteByte(base45Alphabet[n%45])
+		sb.WriteByte(base45Alphabet[(n/45)%45])
+		sb.WriteByte(base45Alphabet[n/2025])
+	}
+
+	if len(data)%2 == 1 {
+		n := int(data[len(data)-1])
+		sb.WriteByte(base45Alphabet[n%45])
+		sb.WriteByte(base45Alphabet[n/45])
+	}
+
+	return sb.String()
+}
+
+func decodeBase45(s string) ([]byte, error) {
+	if len(s)%3 == 1 {
+		return nil, fmt.Errorf("invalid base45 length %d", len(s))
+	}
+
+	values := make([]int, len(s))
+	for i := 0; i < len(s); i++ {
+		idx := strings.IndexByte(base45Alphabet, s[i])
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid base45 character %q", s[i])
+		}
+		values[i] = idx
+	}
+
+	out := make([]byte, 0, len(s)/3*2+1)
+	for i := 0; i < len(values); i += 3 {
+		if i+2 < len(values) {
+			n := values[i] + values[i+1]*45 + values[i+2]*2025
+			if n > 0xFFFF {
+				return nil, fmt.Errorf("invalid base45 chunk at %d", i)
+			}
+			out = append(out, byte(n>>8), byte(n))
+			continue
+		}
+
+		n := values[i] + values[i+1]*45
+		if n > 0xFF {
+			return nil, fmt.Errorf("invalid base45 chunk at %d", i)
+		}
+		out = append(out, byte(n))
+	}
+
+	return out, nil
+}
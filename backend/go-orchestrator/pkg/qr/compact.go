@@ -0,0 +1,119 @@
+package qr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Compact QR payload, version 1, Base45 encoded after CompactPrefix:
+//
+//	version(1) | user_id(16) | issued_at(4) | expires_at(4) | generation(4) | kid_len(1) | kid | mac(16)
+//
+// The MAC is HMAC-SHA256 over every preceding byte, truncated to compactMACSize.
+const (
+	CompactPrefix = "SP:"
+
+	compactVersion1  byte = 1
+	compactMACSize        = 16
+	compactMaxKeyID       = 32
+	compactHeaderLen      = 1 + 16 + 4 + 4 + 4 + 1
+)
+
+func IsCompactQR(payload string) bool {
+	return strings.HasPrefix(payload, CompactPrefix)
+}
+
+func encodeCompact(secret string, qrData *QRData) (payload string, mac []byte, err error) {
+	if qrData.KeyID == "" || len(qrData.KeyID) > compactMaxKeyID {
+		return "", nil, fmt.Errorf("invalid key id %q", qrData.KeyID)
+	}
+
+	body := make([]byte, 0, compactHeaderLen+len(qrData.KeyID)+compactMACSize)
+	body = append(body, compactVersion1)
+	body = append(body, qrData.UserID[:]...)
+	body = binary.BigEndian.AppendUint32(body, uint32(qrData.IssuedAt.Unix()))
+	body = binary.BigEndian.AppendUint32(body, uint32(qrData.ExpiresAt.Unix()))
+	body = binary.BigEndian.AppendUint32(body, uint32(qrData.Generation))
+	body = append(body, byte(len(qrData.KeyID)))
+	body = append(body, qrData.KeyID...)
+
+	mac = compactMAC(secret, body)
+	body = append(body, mac...)
+
+	return CompactPrefix + encodeBase45(body), mac, nil
+}
+
+func decodeCompact(payload string) (qrData *QRData, body []byte, mac []byte, err error) {
+	raw, err := decodeBase45(strings.TrimPrefix(payload, CompactPrefix))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(raw) < compactHeaderLen+compactMACSize {
+		return nil, nil, nil, fmt.Errorf("payload too short")
+	}
+
+	if raw[0] != compactVersion1 {
+		return nil, nil, nil, fmt.Errorf("unsupported compact version %d", raw[0])
+	}
+
+	keyIDLen := int(raw[compactHeaderLen-1])
+	if keyIDLen == 0 || len(raw) != compactHeaderLen+keyIDLen+compactMACSize {
+		return nil, nil, nil, fmt.Errorf("malformed payload")
+	}
+
+	userID, err := uuid.FromBytes(raw[1:17])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	bodyLen := compactHeaderLen + keyIDLen
+	qrData = &QRData{
+		UserID:     userID,
+		IssuedAt:   time.Unix(int64(binary.BigEndian.Uint32(raw[17:21])), 0),
+		ExpiresAt:  time.Unix(int64(binary.BigEndian.Uint32(raw[21:25])), 0),
+		Generation: int(binary.BigEndian.Uint32(raw[25:29])),
+		KeyID:      string(raw[compactHeaderLen:bodyLen]),
+	}
+
+	return qrData, raw[:bodyLen], raw[bodyLen:], nil
+}
+
+func compactMAC(secret string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = h.Write(body)
+	return h.Sum(nil)[:compactMACSize]
+}
+
+func (g *QRGenerator) validateCompactQRCode(payload string) (*QRData, error) {
+	qrData, body, mac, err := decodeCompact(payload)
+	if err != nil {
+		return nil, fmt.Errorf("QRGenerator - ValidateQRCode - decodeCompact: %w", err)
+	}
+
+	now := time.Now()
+	if now.After(qrData.ExpiresAt) {
+		return nil, fmt.Errorf("QRGenerator - ValidateQRCode - ValidateExpiry: qr code expired")
+	}
+
+	secrets, err := g.keyring.verificationSecrets(qrData.KeyID, qrData.IssuedAt, now)
+	if err != nil {
+		return nil, fmt.Errorf("QRGenerator - ValidateQRCode - ValidateKeyID: %w", err)
+	}
+
+	for _, secret := range secrets {
+		if hmac.Equal(mac, compactMAC(secret, body)) {
+			qrData.Signature = base64.RawURLEncoding.EncodeToString(mac)
+			return qrData, nil
+		}
+	}
+
+	return nil, fmt.Errorf("QRGenerator - ValidateQRCode - ValidateSignature: invalid signature")
+}
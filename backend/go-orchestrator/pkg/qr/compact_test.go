@@ -0,0 +1,60 @@
+package qr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase45_RoundTrip(t *testing.T) {
+	assert.Equal(t, "BB8", encodeBase45([]byte("AB")))
+	assert.Equal(t, "%69 VD92EX0", encodeBase45([]byte("Hello!!")))
+
+	for _, data := range [][]byte{{}, {0}, {0xFF}, {0xFF, 0xFF}, []byte("ietf!")} {
+		decoded, err := decodeBase45(encodeBase45(data))
+		assert.NoError(t, err)
+		assert.Equal(t, len(data), len(decoded))
+		assert.Equal(t, string(data), string(decoded))
+	}
+
+	_, err := decodeBase45("GGW")
+	assert.Error(t, err)
+	_, err = decodeBase45("abc")
+	assert.Error(t, err)
+}
+
+func TestQRGenerator_GenerateQRCode_CompactPayload(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+	userID := uuid.New()
+
+	qrData, _, err := generator.GenerateQRCode(userID, "private@example.com", "Private Name", 2)
+	assert.NoError(t, err)
+
+	assert.True(t, IsCompactQR(qrData.Payload))
+	assert.NotContains(t, qrData.Payload, "private")
+	assert.Less(t, len(qrData.Payload), 90)
+	for _, r := range qrData.Payload {
+		assert.True(t, strings.ContainsRune(base45Alphabet, r), "character %q is outside QR alphanumeric mode", r)
+	}
+
+	validatedData, err := generator.ValidateQRCode(qrData.Payload)
+	assert.NoError(t, err)
+	assert.Equal(t, userID, validatedData.UserID)
+	assert.Equal(t, 2, validatedData.Generation)
+	assert.Empty(t, validatedData.Email)
+	assert.Empty(t, validatedData.FullName)
+}
+
+func TestQRGenerator_ValidateQRCode_CompactTruncated(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+
+	qrData, _, err := generator.GenerateQRCode(uuid.New(), "test@example.com", "Test User", 0)
+	assert.NoError(t, err)
+
+	_, err = generator.ValidateQRCode(qrData.Payload[:len(qrData.Payload)-3])
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+package qr
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TypeDynamic      = "dynamic"
+	DynamicPeriod    = 30 * time.Second
+	DynamicSkewSteps = 1
+	DynamicNonceTTL  = DynamicPeriod * (2*DynamicSkewSteps + 1)
+
+	dynamicSecretBytes = 32
+	maxDynamicNonceLen = 64
+)
+
+// DynamicQRData is rendered by the mobile app and rotates every DynamicPeriod.
+type DynamicQRData struct {
+	Type   string    `json:"type"`
+	UserID uuid.UUID `json:"user_id"`
+	Step   int64     `json:"step"`
+	Nonce  string    `json:"nonce"`
+	Code   string    `json:"code"`
+}
+
+func IsDynamicQR(qrDataJSON string) bool {
+	return qrType(qrDataJSON) == TypeDynamic
+}
+
+func GenerateDynamicSecret() (string, error) {
+	buf := make([]byte, dynamicSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("QRGenerator - GenerateDynamicSecret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func DynamicStep(t time.Time) int64 {
+	return t.Unix() / int64(DynamicPeriod/time.Second)
+}
+
+// ComputeDynamicCode is base64url(HMAC-SHA256(secret, "<user_id>:<step>:<nonce>")),
+// the same derivation the app performs with the secret it receives at login.
+func ComputeDynamicCode(secret string, userID uuid.UUID, step int64, nonce string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(h, "%s:%d:%s", userID.String(), step, nonce)
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+func ParseDynamicQR(qrDataJSON string) (*DynamicQRData, error) {
+	var data DynamicQRData
+	if err := json.Unmarshal([]byte(qrDataJSON), &data); err != nil {
+		return nil, fmt.Errorf("QRGenerator - ParseDynamicQR - Unmarshal: %w", err)
+	}
+
+	if data.Type != TypeDynamic {
+		return nil, fmt.Errorf("QRGenerator - ParseDynamicQR - ValidateType: unexpected qr type %q", data.Type)
+	}
+
+	if data.UserID == uuid.Nil || data.Nonce == "" || len(data.Nonce) > maxDynamicNonceLen || data.Code == "" {
+		return nil, fmt.Errorf("QRGenerator - ParseDynamicQR - ValidateFields: malformed dynamic qr")
+	}
+
+	return &data, nil
+}
+
+func VerifyDynamicQR(data *DynamicQRData, secret string, now time.Time) error {
+	current := DynamicStep(now)
+	if data.Step < current-DynamicSkewSteps || data.Step > current+DynamicSkewSteps {
+		return fmt.Errorf("QRGenerator - VerifyDynamicQR - ValidateStep: qr code expired")
+	}
+
+	expectedCode := ComputeDynamicCode(secret, data.UserID, data.Step, data.Nonce)
+	if !hmac.Equal([]byte(data.Code), []byte(expectedCode)) {
+		return fmt.Errorf("QRGenerator - VerifyDynamicQR - ValidateCode: invalid code")
+	}
+
+	return nil
+}
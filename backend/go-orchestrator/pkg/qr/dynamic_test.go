@@ -0,0 +1,63 @@
+package qr
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDynamicQRJSON(t *testing.T, secret string, userID uuid.UUID, step int64, nonce string) string {
+	t.Helper()
+	data, err := json.Marshal(DynamicQRData{
+		Type:   TypeDynamic,
+		UserID: userID,
+		Step:   step,
+		Nonce:  nonce,
+		Code:   ComputeDynamicCode(secret, userID, step, nonce),
+	})
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func TestVerifyDynamicQR_Success(t *testing.T) {
+	secret, err := GenerateDynamicSecret()
+	assert.NoError(t, err)
+
+	now := time.Now()
+	qrJSON := newDynamicQRJSON(t, secret, uuid.New(), DynamicStep(now), "nonce-1")
+
+	assert.True(t, IsDynamicQR(qrJSON))
+	assert.False(t, IsGrantQR(qrJSON))
+
+	data, err := ParseDynamicQR(qrJSON)
+	assert.NoError(t, err)
+	assert.NoError(t, VerifyDynamicQR(data, secret, now))
+	assert.NoError(t, VerifyDynamicQR(data, secret, now.Add(DynamicPeriod)))
+}
+
+func TestVerifyDynamicQR_StepOutsideWindow(t *testing.T) {
+	now := time.Now()
+	data, err := ParseDynamicQR(newDynamicQRJSON(t, "secret", uuid.New(), DynamicStep(now)-DynamicSkewSteps-1, "nonce"))
+	assert.NoError(t, err)
+
+	assert.Error(t, VerifyDynamicQR(data, "secret", now))
+}
+
+func TestVerifyDynamicQR_WrongSecret(t *testing.T) {
+	now := time.Now()
+	data, err := ParseDynamicQR(newDynamicQRJSON(t, "secret", uuid.New(), DynamicStep(now), "nonce"))
+	assert.NoError(t, err)
+
+	assert.Error(t, VerifyDynamicQR(data, "other-secret", now))
+}
+
+func TestParseDynamicQR_Malformed(t *testing.T) {
+	_, err := ParseDynamicQR(`{"type":"dynamic","user_id":"` + uuid.NewString() + `","step":1,"code":"x"}`)
+	assert.Error(t, err)
+
+	_, err = ParseDynamicQR(`{"user_id":"` + uuid.NewString() + `","email":"a@b.c"}`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+package qr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const TypePickupGrant = "pickup_grant"
+
+type GrantQRData struct {
+	Type      string    `json:"type"`
+	GrantID   uuid.UUID `json:"grant_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	OrderID   uuid.UUID `json:"order_id"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	KeyID     string    `json:"kid,omitempty"`
+	Signature string    `json:"signature"`
+}
+
+func IsGrantQR(qrDataJSON string) bool {
+	return qrType(qrDataJSON) == TypePickupGrant
+}
+
+func qrType(qrDataJSON string) string {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal([]byte(qrDataJSON), &header); err != nil {
+		return ""
+	}
+	return header.Type
+}
+
+func (g *QRGenerator) GenerateGrantQRCode(grantID, userID, orderID uuid.UUID, expiresAt time.Time) (grantData *GrantQRData, qrImageBase64 string, err error) {
+	grantData = &GrantQRData{
+		Type:      TypePickupGrant,
+		GrantID:   grantID,
+		UserID:    userID,
+		OrderID:   orderID,
+		IssuedAt:  time.Now(),
+		ExpiresAt: expiresAt,
+		KeyID:     g.keyring.ActiveKeyID(),
+	}
+
+	signature, err := g.generateGrantSignature(grantData)
+	if err != nil {
+		return nil, "", fmt.Errorf("QRGenerator - GenerateGrantQRCode - generateGrantSignature: %w", err)
+	}
+
+	grantData.Signature = signature
+
+	jsonData, err := json.Marshal(grantData)
+	if err != nil {
+		return nil, "", fmt.Errorf("QRGenerator - GenerateGrantQRCode - Marshal: %w", err)
+	}
+
+	qrImageBase64, err = renderImage(jsonData)
+	if err != nil {
+		return nil, "", fmt.Errorf("QRGenerator - GenerateGrantQRCode - renderImage: %w", err)
+	}
+
+	return grantData, qrImageBase64, nil
+}
+
+func (g *QRGenerator) ValidateGrantQRCode(qrDataJSON string) (*GrantQRData, error) {
+	var grantData GrantQRData
+	if err := json.Unmarshal([]byte(qrDataJSON), &grantData); err != nil {
+		return nil, fmt.Errorf("QRGenerator - ValidateGrantQRCode - Unmarshal: %w", err)
+	}
+
+	if grantData.Type != TypePickupGrant {
+		return nil, fmt.Errorf("QRGenerator - ValidateGrantQRCode - ValidateType: unexpected qr type %q", grantData.Type)
+	}
+
+	now := time.Now()
+	if now.After(grantData.ExpiresAt) {
+		return nil, fmt.Errorf("QRGenerator - ValidateGrantQRCode - ValidateExpiry: qr code expired")
+	}
+
+	secrets, err := g.keyring.verificationSecrets(grantData.KeyID, grantData.IssuedAt, now)
+	if err != nil {
+		return nil, fmt.Errorf("QRGenerator - ValidateGrantQRCode - ValidateKeyID: %w", err)
+	}
+
+	for _, secret := range secrets {
+		expectedSignature, err := signGrantData(secret, &grantData)
+		if err != nil {
+			return nil, fmt.Errorf("QRGenerator - ValidateGrantQRCode - signGrantData: %w", err)
+		}
+
+		if hmac.Equal([]byte(grantData.Signature), []byte(expectedSignature)) {
+			return &grantData, nil
+		}
+	}
+
+	return nil, fmt.Errorf("QRGenerator - ValidateGrantQRCode - ValidateSignature: invalid signature")
+}
+
+func (g *QRGenerator) generateGrantSignature(grantData *GrantQRData) (string, error) {
+	secret, err := g.keyring.signingSecret(grantData.KeyID)
+	if err != nil {
+		return "", err
+	}
+
+	return signGrantData(secret, grantData)
+}
+
+func signGrantData(secret string, grantData *GrantQRData) (string, error) {
+	dataToSign := fmt.Sprintf("%s:%s:%s:%s:%d:%d",
+		grantData.Type,
+		grantData.GrantID.String(),
+		grantData.UserID.String(),
+		grantData.OrderID.String(),
+		grantData.IssuedAt.Unix(),
+		grantData.ExpiresAt.Unix(),
+	)
+	if grantData.KeyID != "" {
+		dataToSign += ":" + grantData.KeyID
+	}
+
+	h := hmac.New(sha256.New, []byte(secret))
+	if _, err := h.Write([]byte(dataToSign)); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,96 @@
+package qr
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQRGenerator_GenerateGrantQRCode_Success(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+	grantID := uuid.New()
+	userID := uuid.New()
+	orderID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	grantData, qrImageBase64, err := generator.GenerateGrantQRCode(grantID, userID, orderID, expiresAt)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, qrImageBase64)
+	assert.Equal(t, TypePickupGrant, grantData.Type)
+	assert.Equal(t, grantID, grantData.GrantID)
+	assert.Equal(t, userID, grantData.UserID)
+	assert.Equal(t, orderID, grantData.OrderID)
+	assert.NotEmpty(t, grantData.Signature)
+}
+
+func TestQRGenerator_ValidateGrantQRCode_Success(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+
+	grantData, _, err := generator.GenerateGrantQRCode(uuid.New(), uuid.New(), uuid.New(), time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	grantJSON, err := json.Marshal(grantData)
+	assert.NoError(t, err)
+
+	validatedData, err := generator.ValidateGrantQRCode(string(grantJSON))
+
+	assert.NoError(t, err)
+	assert.Equal(t, grantData.GrantID, validatedData.GrantID)
+	assert.Equal(t, grantData.OrderID, validatedData.OrderID)
+	assert.True(t, IsGrantQR(string(grantJSON)))
+}
+
+func TestQRGenerator_ValidateGrantQRCode_TamperedOrder(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+
+	grantData, _, err := generator.GenerateGrantQRCode(uuid.New(), uuid.New(), uuid.New(), time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	grantData.OrderID = uuid.New()
+
+	grantJSON, err := json.Marshal(grantData)
+	assert.NoError(t, err)
+
+	validatedData, err := generator.ValidateGrantQRCode(string(grantJSON))
+
+	assert.Error(t, err)
+	assert.Nil(t, validatedData)
+	assert.Contains(t, err.Error(), "invalid signature")
+}
+
+func TestQRGenerator_ValidateGrantQRCode_Expired(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+
+	grantData, _, err := generator.GenerateGrantQRCode(uuid.New(), uuid.New(), uuid.New(), time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+
+	grantJSON, err := json.Marshal(grantData)
+	assert.NoError(t, err)
+
+	validatedData, err := generator.ValidateGrantQRCode(string(grantJSON))
+
+	assert.Error(t, err)
+	assert.Nil(t, validatedData)
+	assert.Contains(t, err.Error(), "expired")
+}
+
+func TestQRGenerator_ValidateGrantQRCode_RejectsUserQR(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+
+	qrData, _, err := generator.GenerateQRCode(uuid.New(), "test@example.com", "Test User", 0)
+	assert.NoError(t, err)
+
+	qrDataJSON, err := json.Marshal(qrData)
+	assert.NoError(t, err)
+
+	validatedData, err := generator.ValidateGrantQRCode(string(qrDataJSON))
+
+	assert.Error(t, err)
+	assert.Nil(t, validatedData)
+	assert.False(t, IsGrantQR(string(qrDataJSON)))
+}
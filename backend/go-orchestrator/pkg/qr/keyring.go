@@ -0,0 +1,95 @@
+package qr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/config"
+)
+
+const DefaultKeyID = "v1"
+
+// Keyring signs with the active key and keeps previous keys verifiable
+// for QR codes issued within the grace period.
+type Keyring struct {
+	activeID    string
+	keys        map[string]string
+	gracePeriod time.Duration
+}
+
+func NewKeyring(cfg *config.QR) *Keyring {
+	activeID := cfg.KeyID
+	if activeID == "" {
+		activeID = DefaultKeyID
+	}
+
+	gracePeriod := cfg.KeyGracePeriod
+	if gracePeriod <= 0 {
+		gracePeriod = TTL
+	}
+
+	keys := make(map[string]string, len(cfg.PreviousKeys)+1)
+	for id, secret := range cfg.PreviousKeys {
+		keys[id] = secret
+	}
+	keys[activeID] = cfg.HMACSecret
+
+	return &Keyring{
+		activeID:    activeID,
+		keys:        keys,
+		gracePeriod: gracePeriod,
+	}
+}
+
+func (k *Keyring) ActiveKeyID() string {
+	return k.activeID
+}
+
+func (k *Keyring) ActiveSecret() string {
+	return k.keys[k.activeID]
+}
+
+func (k *Keyring) signingSecret(keyID string) (string, error) {
+	if keyID == "" {
+		return k.ActiveSecret(), nil
+	}
+
+	secret, ok := k.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("unknown key id %q", keyID)
+	}
+	return secret, nil
+}
+
+// verificationSecrets returns the secrets a QR signed under keyID may be checked against.
+// QR codes issued before key IDs existed carry no keyID and are checked against every usable key.
+func (k *Keyring) verificationSecrets(keyID string, issuedAt, now time.Time) ([]string, error) {
+	if keyID == k.activeID {
+		return []string{k.ActiveSecret()}, nil
+	}
+
+	withinGrace := now.Before(issuedAt.Add(k.gracePeriod))
+
+	if keyID == "" {
+		secrets := []string{k.ActiveSecret()}
+		if withinGrace {
+			for id, secret := range k.keys {
+				if id != k.activeID {
+					secrets = append(secrets, secret)
+				}
+			}
+		}
+		return secrets, nil
+	}
+
+	secret, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", keyID)
+	}
+
+	if !withinGrace {
+		return nil, fmt.Errorf("key %q is retired", keyID)
+	}
+
+	return []string{secret}, nil
+}
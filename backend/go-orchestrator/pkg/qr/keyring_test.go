@@ -0,0 +1,124 @@
+package qr
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQRGenerator_ValidateQRCode_PreviousKeyWithinGrace(t *testing.T) {
+	oldGenerator := NewQRGenerator(&config.QR{HMACSecret: "old-secret", KeyID: "v1"})
+	newGenerator := NewQRGenerator(&config.QR{
+		HMACSecret:     "new-secret",
+		KeyID:          "v2",
+		PreviousKeys:   map[string]string{"v1": "old-secret"},
+		KeyGracePeriod: time.Hour,
+	})
+
+	qrData, _, err := oldGenerator.GenerateQRCode(uuid.New(), "test@example.com", "Test User", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", qrData.KeyID)
+
+	validatedData, err := newGenerator.ValidateQRCode(qrData.Payload)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, validatedData.Generation)
+}
+
+func TestQRGenerator_ValidateQRCode_PreviousKeyAfterGrace(t *testing.T) {
+	oldGenerator := NewQRGenerator(&config.QR{HMACSecret: "old-secret", KeyID: "v1"})
+	newGenerator := NewQRGenerator(&config.QR{
+		HMACSecret:     "new-secret",
+		KeyID:          "v2",
+		PreviousKeys:   map[string]string{"v1": "old-secret"},
+		KeyGracePeriod: time.Hour,
+	})
+
+	now := time.Now()
+	qrData := &QRData{
+		UserID:    uuid.New(),
+		Email:     "test@example.com",
+		FullName:  "Test User",
+		IssuedAt:  now.Add(-2 * time.Hour),
+		ExpiresAt: now.Add(time.Hour),
+		KeyID:     "v1",
+	}
+	payload, _, err := encodeCompact(oldGenerator.keyring.ActiveSecret(), qrData)
+	assert.NoError(t, err)
+
+	validatedData, err := newGenerator.ValidateQRCode(payload)
+
+	assert.Error(t, err)
+	assert.Nil(t, validatedData)
+	assert.Contains(t, err.Error(), "retired")
+}
+
+func TestQRGenerator_ValidateQRCode_UnknownKeyID(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+	foreignGenerator := NewQRGenerator(&config.QR{HMACSecret: "test-secret", KeyID: "v9"})
+
+	qrData, _, err := foreignGenerator.GenerateQRCode(uuid.New(), "test@example.com", "Test User", 0)
+	assert.NoError(t, err)
+
+	_, err = generator.ValidateQRCode(qrData.Payload)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown key id")
+}
+
+func TestQRGenerator_ValidateQRCode_TamperedGeneration(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+
+	now := time.Now()
+	qrData := &QRData{
+		UserID:     uuid.New(),
+		Email:      "test@example.com",
+		FullName:   "Test User",
+		IssuedAt:   now,
+		ExpiresAt:  now.Add(time.Hour),
+		KeyID:      DefaultKeyID,
+		Generation: 1,
+	}
+	payload, _, err := encodeCompact(generator.keyring.ActiveSecret(), qrData)
+	assert.NoError(t, err)
+
+	raw, err := decodeBase45(strings.TrimPrefix(payload, CompactPrefix))
+	assert.NoError(t, err)
+	binary.BigEndian.PutUint32(raw[25:29], 5)
+
+	_, err = generator.ValidateQRCode(CompactPrefix + encodeBase45(raw))
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid signature")
+}
+
+func TestQRGenerator_ValidateQRCode_LegacyWithoutKeyID(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+	now := time.Now()
+
+	qrData := &QRData{
+		UserID:    uuid.New(),
+		Email:     "test@example.com",
+		FullName:  "Test User",
+		IssuedAt:  now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+	signature, err := signQRData(generator.keyring.ActiveSecret(), qrData)
+	assert.NoError(t, err)
+	qrData.Signature = signature
+
+	qrDataJSON, err := json.Marshal(qrData)
+	assert.NoError(t, err)
+
+	validatedData, err := generator.ValidateQRCode(string(qrDataJSON))
+
+	assert.NoError(t, err)
+	assert.Empty(t, validatedData.KeyID)
+	assert.Zero(t, validatedData.Generation)
+}
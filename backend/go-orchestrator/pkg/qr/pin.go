@@ -0,0 +1,42 @@
+package qr
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+const PickupPINLength = 6
+
+var pickupPINSpace = big.NewInt(1_000_000)
+
+func IsPickupPIN(pin string) bool {
+	if len(pin) != PickupPINLength {
+		return false
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *QRGenerator) GeneratePickupPIN() (pin string, pinHash string, err error) {
+	n, err := rand.Int(rand.Reader, pickupPINSpace)
+	if err != nil {
+		return "", "", fmt.Errorf("QRGenerator - GeneratePickupPIN - rand.Int: %w", err)
+	}
+
+	pin = fmt.Sprintf("%0*d", PickupPINLength, n.Int64())
+	return pin, g.HashPickupPIN(pin), nil
+}
+
+func (g *QRGenerator) HashPickupPIN(pin string) string {
+	h := hmac.New(sha256.New, []byte(g.pinPepper))
+	_, _ = h.Write([]byte("pickup_pin:" + pin))
+	return hex.EncodeToString(h.Sum(nil))
+}
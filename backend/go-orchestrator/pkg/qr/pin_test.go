@@ -0,0 +1,48 @@
+package qr
+
+import (
+	"testing"
+
+	"github.com/skr1ms/SkyPostDelivery/go-orchestrator/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQRGenerator_GeneratePickupPIN_Success(t *testing.T) {
+	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
+
+	pin, pinHash, err := generator.GeneratePickupPIN()
+
+	assert.NoError(t, err)
+	assert.True(t, IsPickupPIN(pin))
+	assert.Equal(t, generator.HashPickupPIN(pin), pinHash)
+	assert.NotContains(t, pinHash, pin)
+}
+
+func TestQRGenerator_HashPickupPIN_DependsOnSecret(t *testing.T) {
+	first := NewQRGenerator(&config.QR{HMACSecret: "first-secret"})
+	second := NewQRGenerator(&config.QR{HMACSecret: "second-secret"})
+
+	assert.Equal(t, first.HashPickupPIN("123456"), first.HashPickupPIN("123456"))
+	assert.NotEqual(t, first.HashPickupPIN("123456"), second.HashPickupPIN("123456"))
+	assert.NotEqual(t, first.HashPickupPIN("123456"), first.HashPickupPIN("654321"))
+}
+
+func TestQRGenerator_HashPickupPIN_SurvivesKeyRotation(t *testing.T) {
+	before := NewQRGenerator(&config.QR{HMACSecret: "old-secret", KeyID: "v1", PINPepper: "pepper"})
+	after := NewQRGenerator(&config.QR{
+		HMACSecret:   "new-secret",
+		KeyID:        "v2",
+		PreviousKeys: map[string]string{"v1": "old-secret"},
+		PINPepper:    "pepper",
+	})
+
+	assert.Equal(t, before.HashPickupPIN("123456"), after.HashPickupPIN("123456"))
+}
+
+func TestIsPickupPIN(t *testing.T) {
+	assert.True(t, IsPickupPIN("012345"))
+	assert.False(t, IsPickupPIN("12345"))
+	assert.False(t, IsPickupPIN("1234567"))
+	assert.False(t, IsPickupPIN("12a456"))
+	assert.False(t, IsPickupPIN(""))
+}
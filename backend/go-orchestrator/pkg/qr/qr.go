@@ -16,106 +16,139 @@ import (
 )
 
 type QRGeneratorContract interface {
-	GenerateQRCode(userID uuid.UUID, email, name string) (qrData *QRData, qrImageBase64 string, err error)
-	ValidateQRCode(qrDataJSON string) (qrData *QRData, err error)
-	RefreshQRCode(userID uuid.UUID, email, name string) (qrData *QRData, qrImageBase64 string, err error)
+	GenerateQRCode(userID uuid.UUID, email, name string, generation int) (qrData *QRData, qrImageBase64 string, err error)
+	ValidateQRCode(payload string) (qrData *QRData, err error)
+	RefreshQRCode(userID uuid.UUID, email, name string, generation int) (qrData *QRData, qrImageBase64 string, err error)
+	GenerateGrantQRCode(grantID, userID, orderID uuid.UUID, expiresAt time.Time) (grantData *GrantQRData, qrImageBase64 string, err error)
+	ValidateGrantQRCode(qrDataJSON string) (grantData *GrantQRData, err error)
+	GeneratePickupPIN() (pin string, pinHash string, err error)
+	HashPickupPIN(pin string) string
 }
 
 const TTL = 7 * 24 * time.Hour
 
 type QRGenerator struct {
-	hmacSecret string
+	keyring   *Keyring
+	pinPepper string
 }
 
 func NewQRGenerator(cfg *config.QR) *QRGenerator {
+	// Pickup PIN hashes are stored, so they must not follow key rotation. Without a dedicated pepper the
+	// HMAC secret is used, which matches PINs issued before the pepper existed; set one before rotating.
+	pinPepper := cfg.PINPepper
+	if pinPepper == "" {
+		pinPepper = cfg.HMACSecret
+	}
+
 	return &QRGenerator{
-		hmacSecret: cfg.HMACSecret,
+		keyring:   NewKeyring(cfg),
+		pinPepper: pinPepper,
 	}
 }
 
 type QRData struct {
-	UserID    uuid.UUID `json:"user_id"`
-	Email     string    `json:"email"`
-	FullName  string    `json:"name"`
-	IssuedAt  time.Time `json:"issued_at"`
-	ExpiresAt time.Time `json:"expires_at"`
-	Signature string    `json:"signature"`
+	UserID     uuid.UUID `json:"user_id"`
+	Email      string    `json:"email"`
+	FullName   string    `json:"name"`
+	IssuedAt   time.Time `json:"issued_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	KeyID      string    `json:"kid,omitempty"`
+	Generation int       `json:"gen,omitempty"`
+	Signature  string    `json:"signature"`
+	Payload    string    `json:"-"`
 }
 
-func (g *QRGenerator) GenerateQRCode(userID uuid.UUID, email, name string) (qrData *QRData, qrImageBase64 string, err error) {
-	now := time.Now()
+func (g *QRGenerator) GenerateQRCode(userID uuid.UUID, email, name string, generation int) (qrData *QRData, qrImageBase64 string, err error) {
+	now := time.Now().Truncate(time.Second)
 	expiresAt := now.Add(TTL)
 
 	qrData = &QRData{
-		UserID:    userID,
-		Email:     email,
-		FullName:  name,
-		IssuedAt:  now,
-		ExpiresAt: expiresAt,
+		UserID:     userID,
+		Email:      email,
+		FullName:   name,
+		IssuedAt:   now,
+		ExpiresAt:  expiresAt,
+		KeyID:      g.keyring.ActiveKeyID(),
+		Generation: generation,
 	}
 
-	signature, err := g.generateSignature(qrData)
+	payload, mac, err := encodeCompact(g.keyring.ActiveSecret(), qrData)
 	if err != nil {
-		return nil, "", fmt.Errorf("QRGenerator - GenerateQRCode - generateSignature: %w", err)
+		return nil, "", fmt.Errorf("QRGenerator - GenerateQRCode - encodeCompact: %w", err)
 	}
 
-	qrData.Signature = signature
+	qrData.Signature = base64.RawURLEncoding.EncodeToString(mac)
+	qrData.Payload = payload
 
-	jsonData, err := json.Marshal(qrData)
+	qrImageBase64, err = renderImage([]byte(payload))
 	if err != nil {
-		return nil, "", fmt.Errorf("QRGenerator - GenerateQRCode - Marshal: %w", err)
+		return nil, "", fmt.Errorf("QRGenerator - GenerateQRCode - renderImage: %w", err)
 	}
 
-	qr, err := qrcode.New(string(jsonData), qrcode.Medium)
-	if err != nil {
-		return nil, "", fmt.Errorf("QRGenerator - GenerateQRCode - New: %w", err)
-	}
-
-	qr.DisableBorder = false
-	img := qr.Image(256)
-
-	var buf bytes.Buffer
-	if err := png.Encode(&buf, img); err != nil {
-		return nil, "", fmt.Errorf("QRGenerator - GenerateQRCode - Encode: %w", err)
-	}
-
-	qrImageBase64 = base64.StdEncoding.EncodeToString(buf.Bytes())
-
 	return qrData, qrImageBase64, nil
 }
 
-func (g *QRGenerator) ValidateQRCode(qrDataJSON string) (*QRData, error) {
+// ValidateQRCode accepts both the compact payload and the legacy JSON format.
+func (g *QRGenerator) ValidateQRCode(payload string) (*QRData, error) {
+	if IsCompactQR(payload) {
+		return g.validateCompactQRCode(payload)
+	}
+
 	var qrData QRData
-	if err := json.Unmarshal([]byte(qrDataJSON), &qrData); err != nil {
+	if err := json.Unmarshal([]byte(payload), &qrData); err != nil {
 		return nil, fmt.Errorf("QRGenerator - ValidateQRCode - Unmarshal: %w", err)
 	}
 
-	if time.Now().After(qrData.ExpiresAt) {
+	now := time.Now()
+	if now.After(qrData.ExpiresAt) {
 		return nil, fmt.Errorf("QRGenerator - ValidateQRCode - ValidateExpiry: qr code expired")
 	}
 
-	originalSignature := qrData.Signature
-	qrData.Signature = ""
+	if qrData.KeyID == "" {
+		qrData.Generation = 0
+	}
 
-	expectedSignature, err := g.generateSignature(&qrData)
+	secrets, err := g.keyring.verificationSecrets(qrData.KeyID, qrData.IssuedAt, now)
 	if err != nil {
-		return nil, fmt.Errorf("QRGenerator - ValidateQRCode - generateSignature: %w", err)
+		return nil, fmt.Errorf("QRGenerator - ValidateQRCode - ValidateKeyID: %w", err)
 	}
 
-	if !hmac.Equal([]byte(originalSignature), []byte(expectedSignature)) {
-		return nil, fmt.Errorf("QRGenerator - ValidateQRCode - ValidateSignature: invalid signature")
+	for _, secret := range secrets {
+		expectedSignature, err := signQRData(secret, &qrData)
+		if err != nil {
+			return nil, fmt.Errorf("QRGenerator - ValidateQRCode - signQRData: %w", err)
+		}
+
+		if hmac.Equal([]byte(qrData.Signature), []byte(expectedSignature)) {
+			return &qrData, nil
+		}
 	}
 
-	qrData.Signature = originalSignature
+	return nil, fmt.Errorf("QRGenerator - ValidateQRCode - ValidateSignature: invalid signature")
+}
 
-	return &qrData, nil
+func (g *QRGenerator) RefreshQRCode(userID uuid.UUID, email, name string, generation int) (qrData *QRData, qrImageBase64 string, err error) {
+	return g.GenerateQRCode(userID, email, name, generation)
 }
 
-func (g *QRGenerator) RefreshQRCode(userID uuid.UUID, email, name string) (qrData *QRData, qrImageBase64 string, err error) {
-	return g.GenerateQRCode(userID, email, name)
+func renderImage(content []byte) (string, error) {
+	qr, err := qrcode.New(string(content), qrcode.Medium)
+	if err != nil {
+		return "", err
+	}
+
+	qr.DisableBorder = false
+	img := qr.Image(256)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
 }
 
-func (g *QRGenerator) generateSignature(qrData *QRData) (string, error) {
+func signQRData(secret string, qrData *QRData) (string, error) {
 	dataToSign := fmt.Sprintf("%s:%s:%s:%d:%d",
 		qrData.UserID.String(),
 		qrData.Email,
@@ -123,8 +156,11 @@ func (g *QRGenerator) generateSignature(qrData *QRData) (string, error) {
 		qrData.IssuedAt.Unix(),
 		qrData.ExpiresAt.Unix(),
 	)
+	if qrData.KeyID != "" {
+		dataToSign += fmt.Sprintf(":%s:%d", qrData.KeyID, qrData.Generation)
+	}
 
-	h := hmac.New(sha256.New, []byte(g.hmacSecret))
+	h := hmac.New(sha256.New, []byte(secret))
 	if _, err := h.Write([]byte(dataToSign)); err != nil {
 		return "", err
 	}
@@ -2,6 +2,7 @@ package qr
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,7 +16,8 @@ func TestNewQRGenerator(t *testing.T) {
 	generator := NewQRGenerator(cfg)
 
 	assert.NotNil(t, generator)
-	assert.Equal(t, cfg.HMACSecret, generator.hmacSecret)
+	assert.Equal(t, cfg.HMACSecret, generator.keyring.ActiveSecret())
+	assert.Equal(t, DefaultKeyID, generator.keyring.ActiveKeyID())
 }
 
 func TestQRGenerator_GenerateQRCode_Success(t *testing.T) {
@@ -24,7 +26,7 @@ func TestQRGenerator_GenerateQRCode_Success(t *testing.T) {
 	email := "test@example.com"
 	fullName := "Test User"
 
-	qrData, qrImageBase64, err := generator.GenerateQRCode(userID, email, fullName)
+	qrData, qrImageBase64, err := generator.GenerateQRCode(userID, email, fullName, 0)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, qrData)
@@ -44,19 +46,17 @@ func TestQRGenerator_ValidateQRCode_Success(t *testing.T) {
 	email := "test@example.com"
 	fullName := "Test User"
 
-	qrData, _, err := generator.GenerateQRCode(userID, email, fullName)
+	qrData, _, err := generator.GenerateQRCode(userID, email, fullName, 0)
 	assert.NoError(t, err)
 
-	qrDataJSON, err := json.Marshal(qrData)
-	assert.NoError(t, err)
-
-	validatedData, err := generator.ValidateQRCode(string(qrDataJSON))
+	validatedData, err := generator.ValidateQRCode(qrData.Payload)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, validatedData)
 	assert.Equal(t, qrData.UserID, validatedData.UserID)
-	assert.Equal(t, qrData.Email, validatedData.Email)
-	assert.Equal(t, qrData.FullName, validatedData.FullName)
+	assert.Equal(t, qrData.IssuedAt.Unix(), validatedData.IssuedAt.Unix())
+	assert.Equal(t, qrData.ExpiresAt.Unix(), validatedData.ExpiresAt.Unix())
+	assert.Equal(t, qrData.KeyID, validatedData.KeyID)
 	assert.Equal(t, qrData.Signature, validatedData.Signature)
 }
 
@@ -70,16 +70,13 @@ func TestQRGenerator_ValidateQRCode_ExpiredQR(t *testing.T) {
 		FullName:  "Test User",
 		IssuedAt:  time.Now().Add(-25 * time.Hour),
 		ExpiresAt: time.Now().Add(-1 * time.Hour),
+		KeyID:     DefaultKeyID,
 	}
 
-	signature, err := generator.generateSignature(qrData)
+	payload, _, err := encodeCompact(generator.keyring.ActiveSecret(), qrData)
 	assert.NoError(t, err)
-	qrData.Signature = signature
 
-	qrDataJSON, err := json.Marshal(qrData)
-	assert.NoError(t, err)
-
-	validatedData, err := generator.ValidateQRCode(string(qrDataJSON))
+	validatedData, err := generator.ValidateQRCode(payload)
 
 	assert.Error(t, err)
 	assert.Nil(t, validatedData)
@@ -113,15 +110,14 @@ func TestQRGenerator_ValidateQRCode_TamperedData(t *testing.T) {
 	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
 	userID := uuid.New()
 
-	qrData, _, err := generator.GenerateQRCode(userID, "test@example.com", "Test User")
+	qrData, _, err := generator.GenerateQRCode(userID, "test@example.com", "Test User", 0)
 	assert.NoError(t, err)
 
-	qrData.Email = "tampered@example.com"
-
-	qrDataJSON, err := json.Marshal(qrData)
+	raw, err := decodeBase45(strings.TrimPrefix(qrData.Payload, CompactPrefix))
 	assert.NoError(t, err)
+	raw[1] ^= 0xFF
 
-	validatedData, err := generator.ValidateQRCode(string(qrDataJSON))
+	validatedData, err := generator.ValidateQRCode(CompactPrefix + encodeBase45(raw))
 
 	assert.Error(t, err)
 	assert.Nil(t, validatedData)
@@ -142,13 +138,10 @@ func TestQRGenerator_ValidateQRCode_DifferentSecret(t *testing.T) {
 	generator2 := NewQRGenerator(&config.QR{HMACSecret: "secret2"})
 
 	userID := uuid.New()
-	qrData, _, err := generator1.GenerateQRCode(userID, "test@example.com", "Test User")
+	qrData, _, err := generator1.GenerateQRCode(userID, "test@example.com", "Test User", 0)
 	assert.NoError(t, err)
 
-	qrDataJSON, err := json.Marshal(qrData)
-	assert.NoError(t, err)
-
-	validatedData, err := generator2.ValidateQRCode(string(qrDataJSON))
+	validatedData, err := generator2.ValidateQRCode(qrData.Payload)
 
 	assert.Error(t, err)
 	assert.Nil(t, validatedData)
@@ -161,12 +154,12 @@ func TestQRGenerator_RefreshQRCode(t *testing.T) {
 	email := "test@example.com"
 	fullName := "Test User"
 
-	qrData1, image1, err := generator.GenerateQRCode(userID, email, fullName)
+	qrData1, image1, err := generator.GenerateQRCode(userID, email, fullName, 0)
 	assert.NoError(t, err)
 
 	time.Sleep(1 * time.Second)
 
-	qrData2, image2, err := generator.RefreshQRCode(userID, email, fullName)
+	qrData2, image2, err := generator.RefreshQRCode(userID, email, fullName, 0)
 	assert.NoError(t, err)
 
 	assert.Equal(t, userID, qrData2.UserID)
@@ -178,29 +171,29 @@ func TestQRGenerator_RefreshQRCode(t *testing.T) {
 	assert.NotEmpty(t, image2)
 }
 
-func TestQRGenerator_generateSignature(t *testing.T) {
-	generator := NewQRGenerator(&config.QR{HMACSecret: "test-secret"})
-	userID := uuid.New()
+func TestEncodeCompact_MAC(t *testing.T) {
 	now := time.Now()
-
 	qrData := &QRData{
-		UserID:    userID,
-		Email:     "test@example.com",
-		FullName:  "Test User",
+		UserID:    uuid.New(),
 		IssuedAt:  now,
 		ExpiresAt: now.Add(24 * time.Hour),
+		KeyID:     DefaultKeyID,
 	}
 
-	signature1, err := generator.generateSignature(qrData)
+	_, mac1, err := encodeCompact("test-secret", qrData)
+	assert.NoError(t, err)
+	assert.Len(t, mac1, compactMACSize)
+
+	_, mac2, err := encodeCompact("test-secret", qrData)
 	assert.NoError(t, err)
-	assert.NotEmpty(t, signature1)
+	assert.Equal(t, mac1, mac2)
 
-	signature2, err := generator.generateSignature(qrData)
+	_, mac3, err := encodeCompact("other-secret", qrData)
 	assert.NoError(t, err)
-	assert.Equal(t, signature1, signature2)
+	assert.NotEqual(t, mac1, mac3)
 
-	qrData.Email = "different@example.com"
-	signature3, err := generator.generateSignature(qrData)
+	qrData.Generation = 1
+	_, mac4, err := encodeCompact("test-secret", qrData)
 	assert.NoError(t, err)
-	assert.NotEqual(t, signature1, signature3)
+	assert.NotEqual(t, mac1, mac4)
 }
@@ -14,6 +14,7 @@ import (
 
 type RabbitMQClient interface {
 	Consume(queueName string, handler func([]byte) error) error
+	Subscribe(exchange string, handler func([]byte) error) error
 	Publish(ctx context.Context, queueName string, message any) error
 	Close() error
 }
@@ -27,6 +28,7 @@ type Client struct {
 	notifyConfirm chan amqp.Confirmation
 	notifyReturn  chan amqp.Return
 	consumers     map[string]func([]byte) error
+	subscriptions map[string]func([]byte) error
 	consumerMu    sync.RWMutex
 	logger        logger.Interface
 }
@@ -37,6 +39,7 @@ func NewClient(cfg *config.RabbitMQ, logger logger.Interface) (*Client, error) {
 		notifyConfirm: make(chan amqp.Confirmation, 1),
 		notifyReturn:  make(chan amqp.Return, 10),
 		consumers:     make(map[string]func([]byte) error),
+		subscriptions: make(map[string]func([]byte) error),
 		logger:        logger,
 	}
 
@@ -80,6 +83,11 @@ func (c *Client) init(conn *amqp.Connection) error {
 		return err
 	}
 
+	if err := c.declareExchanges(ch); err != nil {
+		_ = ch.Close()
+		return err
+	}
+
 	c.mu.Lock()
 	c.conn = conn
 	c.channel = ch
@@ -96,6 +104,13 @@ func (c *Client) init(conn *amqp.Connection) error {
 			})
 		}
 	}
+	for exchange, handler := range c.subscriptions {
+		if err := c.startSubscription(exchange, handler); err != nil {
+			c.logger.Error("Failed to restart subscription after reconnect", err, map[string]any{
+				"exchange": exchange,
+			})
+		}
+	}
 	c.consumerMu.RUnlock()
 
 	c.logger.Info("RabbitMQ client initialized successfully", nil)
@@ -139,6 +154,23 @@ func (c *Client) declareQueues(ch *amqp.Channel) error {
 	return nil
 }
 
+func (c *Client) declareExchanges(ch *amqp.Channel) error {
+	if err := ch.ExchangeDeclare(
+		ExchangeDroneTelemetry,
+		amqp.ExchangeFanout,
+		true,
+		false,
+		false,
+		false,
+		nil,
+	); err != nil {
+		return fmt.Errorf("RabbitMQClient - declareExchanges - ExchangeDeclare[%s]: %w", ExchangeDroneTelemetry, err)
+	}
+	c.logger.Info("Exchange declared", nil, map[string]any{"exchange": ExchangeDroneTelemetry})
+
+	return nil
+}
+
 func (c *Client) Publish(ctx context.Context, queueName string, message any) error {
 	c.mu.RLock()
 	if !c.isReady {
@@ -205,6 +237,76 @@ func (c *Client) Consume(queueName string, handler func([]byte) error) error {
 	return c.startConsumer(queueName, handler)
 }
 
+// Subscribe binds an exclusive, auto-deleted queue to the exchange, so each instance receives its own copy of
+// every message. Messages published while the instance is down are not replayed.
+func (c *Client) Subscribe(exchange string, handler func([]byte) error) error {
+	c.consumerMu.Lock()
+	c.subscriptions[exchange] = handler
+	c.consumerMu.Unlock()
+
+	return c.startSubscription(exchange, handler)
+}
+
+func (c *Client) startSubscription(exchange string, handler func([]byte) error) error {
+	c.mu.RLock()
+	if !c.isReady {
+		c.mu.RUnlock()
+		return ErrClientNotReady
+	}
+	ch := c.channel
+	c.mu.RUnlock()
+
+	queue, err := ch.QueueDeclare(
+		"",
+		false,
+		true,
+		true,
+		false,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("RabbitMQClient - startSubscription - QueueDeclare: %w", err)
+	}
+
+	if err := ch.QueueBind(queue.Name, "", exchange, false, nil); err != nil {
+		return fmt.Errorf("RabbitMQClient - startSubscription - QueueBind[%s]: %w", exchange, err)
+	}
+
+	msgs, err := ch.Consume(
+		queue.Name,
+		"",
+		true,
+		true,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("RabbitMQClient - startSubscription - Consume: %w", err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-c.done:
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					c.logger.Warn("Subscription channel closed", nil, map[string]any{"exchange": exchange})
+					return
+				}
+
+				if err := handler(msg.Body); err != nil {
+					c.logger.Warn("Error handling subscribed message", err, map[string]any{"exchange": exchange})
+				}
+			}
+		}
+	}()
+
+	c.logger.Info("Subscribed to exchange", nil, map[string]any{"exchange": exchange, "queue": queue.Name})
+	return nil
+}
+
 func (c *Client) startConsumer(queueName string, handler func([]byte) error) error {
 	c.mu.RLock()
 	if !c.isReady {
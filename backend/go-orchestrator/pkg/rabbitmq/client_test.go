@@ -39,6 +39,23 @@ func TestDeliveryTask_Marshaling(t *testing.T) {
 	assert.Equal(t, task.Priority, unmarshaled.Priority)
 }
 
+func TestDroneStatusUpdate_Unmarshaling(t *testing.T) {
+	droneID := uuid.New()
+	deliveryID := uuid.New()
+	body := []byte(`{"drone_id":"` + droneID.String() + `","status":"in_transit","battery_level":64.5,` +
+		`"latitude":55.75,"longitude":37.61,"altitude":80,"speed":12.5,` +
+		`"current_delivery_id":"` + deliveryID.String() + `","updated_at":1700000000}`)
+
+	var update DroneStatusUpdate
+	err := json.Unmarshal(body, &update)
+	assert.NoError(t, err)
+	assert.Equal(t, droneID, update.DroneID)
+	assert.Equal(t, "in_transit", update.Status)
+	assert.Equal(t, 12.5, update.Speed)
+	assert.Equal(t, deliveryID, *update.CurrentDeliveryID)
+	assert.Equal(t, int64(1700000000), update.UpdatedAt)
+}
+
 func TestDeliveryConfirmation_Marshaling(t *testing.T) {
 	confirmation := DeliveryConfirmation{
 		OrderID:      uuid.New(),
@@ -27,11 +27,13 @@ type DeliveryConfirmation struct {
 }
 
 type DroneStatusUpdate struct {
-	DroneID      uuid.UUID `json:"drone_id"`
-	Status       string    `json:"status"`
-	BatteryLevel float64   `json:"battery_level"`
-	Latitude     float64   `json:"latitude"`
-	Longitude    float64   `json:"longitude"`
-	Altitude     float64   `json:"altitude"`
-	UpdatedAt    int64     `json:"updated_at"`
+	DroneID           uuid.UUID  `json:"drone_id"`
+	Status            string     `json:"status"`
+	BatteryLevel      float64    `json:"battery_level"`
+	Latitude          float64    `json:"latitude"`
+	Longitude         float64    `json:"longitude"`
+	Altitude          float64    `json:"altitude"`
+	Speed             float64    `json:"speed"`
+	CurrentDeliveryID *uuid.UUID `json:"current_delivery_id,omitempty"`
+	UpdatedAt         int64      `json:"updated_at"`
 }
@@ -7,3 +7,6 @@ const (
 	QueueDeliveriesDLQ      = "deliveries.dlq"
 	QueueDeliveryReturn     = "delivery.return"
 )
+
+// ExchangeDroneTelemetry is a fanout exchange: every orchestrator instance binds its own queue and sees all samples.
+const ExchangeDroneTelemetry = "drone.telemetry"
@@ -27,9 +27,40 @@ WHERE status = $1
 ORDER BY id DESC;
 -- name: UpdateDeliveryStatus :one
 UPDATE deliveries
-SET status = $2
+SET status = $2,
+    delivered_at = CASE
+        WHEN $2 = 'delivered' THEN COALESCE(delivered_at, $3)
+        ELSE NULL
+    END,
+    pickup_reminded_at = CASE
+        WHEN $2 = 'delivered' THEN pickup_reminded_at
+        ELSE NULL
+    END,
+    arrival_notified_at = CASE
+        WHEN $2 = 'in_transit' THEN arrival_notified_at
+        ELSE NULL
+    END
 WHERE id = $1
 RETURNING *;
+-- name: ClaimDuePickupReminders :many
+UPDATE deliveries
+SET pickup_reminded_at = sqlc.arg(now)
+WHERE id IN (
+        SELECT id
+        FROM deliveries
+        WHERE status = 'delivered'
+            AND COALESCE(pickup_reminded_at, delivered_at) <= sqlc.arg(due_before)
+        ORDER BY delivered_at
+        LIMIT sqlc.arg(batch_size) FOR
+        UPDATE SKIP LOCKED
+    )
+RETURNING *;
+-- name: MarkDeliveryArrivalNotified :execrows
+UPDATE deliveries
+SET arrival_notified_at = $2
+WHERE id = $1
+    AND status = 'in_transit'
+    AND arrival_notified_at IS NULL;
 -- name: UpdateDeliveryDrone :one
 UPDATE deliveries
 SET drone_id = $2
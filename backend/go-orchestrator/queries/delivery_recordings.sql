@@ -0,0 +1,43 @@
+-- name: ScheduleDeliveryRecording :exec
+INSERT INTO delivery_recordings (delivery_id, status, next_attempt_at)
+VALUES ($1, 'pending', $2) ON CONFLICT (delivery_id) DO
+UPDATE
+SET status = 'pending',
+    attempts = 0,
+    last_error = NULL,
+    next_attempt_at = EXCLUDED.next_attempt_at,
+    updated_at = CURRENT_TIMESTAMP
+WHERE delivery_recordings.status <> 'processing';
+-- name: GetDeliveryRecording :one
+SELECT *
+FROM delivery_recordings
+WHERE delivery_id = $1;
+-- name: ClaimDueDeliveryRecordings :many
+UPDATE delivery_recordings
+SET status = 'processing',
+    next_attempt_at = sqlc.arg(lease_until),
+    updated_at = CURRENT_TIMESTAMP
+WHERE delivery_id IN (
+        SELECT delivery_id
+        FROM delivery_recordings
+        WHERE status IN ('pending', 'processing')
+            AND next_attempt_at <= sqlc.arg(now)
+        ORDER BY next_attempt_at
+        LIMIT sqlc.arg(batch_size) FOR
+        UPDATE SKIP LOCKED
+    )
+RETURNING *;
+-- name: UpdateDeliveryRecording :one
+UPDATE delivery_recordings
+SET status = $2,
+    video_key = $3,
+    manifest_key = $4,
+    frame_count = $5,
+    duration_ms = $6,
+    size_bytes = $7,
+    attempts = $8,
+    last_error = $9,
+    next_attempt_at = $10,
+    updated_at = CURRENT_TIMESTAMP
+WHERE delivery_id = $1
+RETURNING *;
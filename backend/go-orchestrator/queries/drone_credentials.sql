@@ -0,0 +1,12 @@
+-- name: GetDroneCredential :one
+SELECT *
+FROM drone_credentials
+WHERE drone_id = $1;
+-- name: RotateDroneCredential :one
+INSERT INTO drone_credentials (drone_id, secret)
+VALUES ($1, $2)
+ON CONFLICT (drone_id) DO UPDATE
+SET secret = EXCLUDED.secret,
+    key_version = drone_credentials.key_version + 1,
+    rotated_at = NOW()
+RETURNING *;
@@ -0,0 +1,14 @@
+-- name: ListDroneTelemetryByDelivery :many
+SELECT *
+FROM drone_telemetry
+WHERE delivery_id = $1
+ORDER BY recorded_at
+LIMIT $2;
+-- name: ListDroneTelemetryByDrone :many
+SELECT *
+FROM drone_telemetry
+WHERE drone_id = sqlc.arg(drone_id)
+    AND recorded_at >= sqlc.arg(from_time)
+    AND recorded_at < sqlc.arg(to_time)
+ORDER BY recorded_at
+LIMIT sqlc.arg(max_rows);
@@ -0,0 +1,18 @@
+-- name: CreateNotificationDelivery :one
+INSERT INTO notification_deliveries (
+        notification_id,
+        user_id,
+        event,
+        channel,
+        status,
+        message_id,
+        error
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+-- name: MarkNotificationDeliveryBounced :one
+UPDATE notification_deliveries
+SET status = 'bounced',
+    error = $2
+WHERE message_id = $1
+RETURNING *;
@@ -0,0 +1,24 @@
+-- name: GetNotificationPreferences :one
+SELECT *
+FROM notification_preferences
+WHERE user_id = $1;
+-- name: UpsertNotificationPreferences :one
+INSERT INTO notification_preferences (
+        user_id,
+        channels,
+        quiet_hours_start,
+        quiet_hours_end,
+        timezone,
+        language,
+        critical_override
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (user_id) DO
+UPDATE
+SET channels = EXCLUDED.channels,
+    quiet_hours_start = EXCLUDED.quiet_hours_start,
+    quiet_hours_end = EXCLUDED.quiet_hours_end,
+    timezone = EXCLUDED.timezone,
+    language = EXCLUDED.language,
+    critical_override = EXCLUDED.critical_override,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
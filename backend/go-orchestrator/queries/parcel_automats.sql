@@ -39,4 +39,9 @@ SELECT *
 FROM parcel_automats
 WHERE is_working = true
 ORDER BY city,
-    address;
\ No newline at end of file
+    address;
+-- name: UpdateParcelAutomatStaticQR :one
+UPDATE parcel_automats
+SET allow_static_qr = $2
+WHERE id = $1
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,46 @@
+-- name: CreatePickupGrant :one
+INSERT INTO pickup_grants (order_id, owner_id, grantee_phone, token, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+-- name: GetPickupGrantByID :one
+SELECT *
+FROM pickup_grants
+WHERE id = $1;
+-- name: GetPickupGrantByToken :one
+SELECT *
+FROM pickup_grants
+WHERE token = $1;
+-- name: ListPickupGrantsByOrderID :many
+SELECT *
+FROM pickup_grants
+WHERE order_id = $1
+ORDER BY created_at DESC;
+-- name: ListActivePickupGrantsByPhone :many
+SELECT *
+FROM pickup_grants
+WHERE grantee_phone = $1
+    AND used_at IS NULL
+    AND revoked_at IS NULL
+    AND expires_at > NOW()
+ORDER BY created_at DESC;
+-- name: MarkPickupGrantUsed :one
+UPDATE pickup_grants
+SET used_at = NOW()
+WHERE id = $1
+    AND used_at IS NULL
+    AND revoked_at IS NULL
+RETURNING *;
+-- name: RevokePickupGrant :one
+UPDATE pickup_grants
+SET revoked_at = NOW()
+WHERE id = $1
+    AND used_at IS NULL
+    AND revoked_at IS NULL
+RETURNING *;
+-- name: GetUsedPickupGrantByOrderID :one
+SELECT *
+FROM pickup_grants
+WHERE order_id = $1
+    AND used_at IS NOT NULL
+ORDER BY used_at DESC
+LIMIT 1;
@@ -0,0 +1,23 @@
+-- name: UpsertPickupPIN :one
+INSERT INTO pickup_pins (order_id, parcel_automat_id, pin_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (order_id) DO UPDATE
+SET parcel_automat_id = EXCLUDED.parcel_automat_id,
+    pin_hash = EXCLUDED.pin_hash,
+    expires_at = EXCLUDED.expires_at,
+    used_at = NULL,
+    created_at = NOW()
+RETURNING *;
+-- name: GetActivePickupPINByHash :one
+SELECT *
+FROM pickup_pins
+WHERE parcel_automat_id = $1
+    AND pin_hash = $2
+    AND used_at IS NULL
+    AND expires_at > NOW();
+-- name: MarkPickupPINUsed :one
+UPDATE pickup_pins
+SET used_at = NOW()
+WHERE id = $1
+    AND used_at IS NULL
+RETURNING *;
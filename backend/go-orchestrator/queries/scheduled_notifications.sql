@@ -0,0 +1,15 @@
+-- name: CreateScheduledNotification :one
+INSERT INTO scheduled_notifications (user_id, event, data, deliver_after, attempts)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+-- name: ClaimDueScheduledNotifications :many
+DELETE FROM scheduled_notifications
+WHERE id IN (
+        SELECT id
+        FROM scheduled_notifications
+        WHERE deliver_after <= $1
+        ORDER BY deliver_after
+        LIMIT $2 FOR
+        UPDATE SKIP LOCKED
+    )
+RETURNING *;
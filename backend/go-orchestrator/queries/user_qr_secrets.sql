@@ -0,0 +1,18 @@
+-- name: GetUserQRSecret :one
+SELECT *
+FROM user_qr_secrets
+WHERE user_id = $1;
+-- name: EnsureUserQRSecret :one
+INSERT INTO user_qr_secrets (user_id, secret)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET user_id = EXCLUDED.user_id
+RETURNING *;
+-- name: RevokeUserQR :one
+INSERT INTO user_qr_secrets (user_id, secret, generation, revoked_at)
+VALUES ($1, $2, 1, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET secret = EXCLUDED.secret,
+    generation = user_qr_secrets.generation + 1,
+    revoked_at = NOW()
+RETURNING *;
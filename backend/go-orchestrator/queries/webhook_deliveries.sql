@@ -0,0 +1,43 @@
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+        subscription_id,
+        event,
+        payload,
+        status,
+        next_attempt_at
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+-- name: GetWebhookDeliveryByID :one
+SELECT *
+FROM webhook_deliveries
+WHERE id = $1;
+-- name: ListWebhookDeliveriesBySubscription :many
+SELECT *
+FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = sqlc.arg(lease_until)
+WHERE id IN (
+        SELECT id
+        FROM webhook_deliveries
+        WHERE status = 'pending'
+            AND next_attempt_at <= sqlc.arg(now)
+        ORDER BY next_attempt_at
+        LIMIT sqlc.arg(batch_size) FOR
+        UPDATE SKIP LOCKED
+    )
+RETURNING *;
+-- name: UpdateWebhookDeliveryAttempt :one
+UPDATE webhook_deliveries
+SET status = $2,
+    attempts = $3,
+    response_status = $4,
+    last_error = $5,
+    next_attempt_at = $6,
+    delivered_at = $7
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,28 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (url, secret, events, is_active)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+-- name: GetWebhookSubscriptionByID :one
+SELECT *
+FROM webhook_subscriptions
+WHERE id = $1;
+-- name: ListWebhookSubscriptions :many
+SELECT *
+FROM webhook_subscriptions
+ORDER BY created_at DESC;
+-- name: ListActiveWebhookSubscriptionsByEvent :many
+SELECT *
+FROM webhook_subscriptions
+WHERE is_active = TRUE
+    AND sqlc.arg(event)::text = ANY(events);
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET url = $2,
+    events = $3,
+    is_active = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
@@ -141,4 +141,218 @@ SET battery_level = p_battery_level,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = p_drone_id;
 END;
-$$ LANGUAGE plpgsql;
\ No newline at end of file
+$$ LANGUAGE plpgsql;
+CREATE TABLE IF NOT EXISTS pickup_grants (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL,
+    owner_id UUID NOT NULL,
+    grantee_phone VARCHAR(20),
+    token VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE pickup_grants
+ADD CONSTRAINT fk_pickup_grants_order_id FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE;
+ALTER TABLE pickup_grants
+ADD CONSTRAINT fk_pickup_grants_owner_id FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_pickup_grants_order_id ON pickup_grants(order_id);
+CREATE INDEX IF NOT EXISTS idx_pickup_grants_grantee_phone ON pickup_grants(grantee_phone);
+CREATE TABLE IF NOT EXISTS pickup_pins (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL UNIQUE,
+    parcel_automat_id UUID NOT NULL,
+    pin_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE pickup_pins
+ADD CONSTRAINT fk_pickup_pins_order_id FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE;
+ALTER TABLE pickup_pins
+ADD CONSTRAINT fk_pickup_pins_parcel_automat_id FOREIGN KEY (parcel_automat_id) REFERENCES parcel_automats(id) ON DELETE CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pickup_pins_automat_pin_hash ON pickup_pins(parcel_automat_id, pin_hash)
+WHERE used_at IS NULL;
+ALTER TABLE parcel_automats
+ADD COLUMN IF NOT EXISTS allow_static_qr BOOLEAN NOT NULL DEFAULT TRUE;
+UPDATE parcel_automats
+SET allow_static_qr = TRUE;
+CREATE TABLE IF NOT EXISTS user_qr_secrets (
+    user_id UUID PRIMARY KEY,
+    secret VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE user_qr_secrets
+ADD CONSTRAINT fk_user_qr_secrets_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE user_qr_secrets
+ADD COLUMN IF NOT EXISTS generation INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE user_qr_secrets
+ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMP;
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    notification_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE notification_deliveries
+ADD CONSTRAINT fk_notification_deliveries_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_notification_id ON notification_deliveries(notification_id);
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_user_id ON notification_deliveries(user_id);
+ALTER TABLE notification_deliveries
+ADD COLUMN IF NOT EXISTS message_id VARCHAR(255);
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_message_id ON notification_deliveries(message_id);
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY,
+    channels JSONB NOT NULL DEFAULT '{}',
+    quiet_hours_start VARCHAR(5),
+    quiet_hours_end VARCHAR(5),
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Europe/Moscow',
+    language VARCHAR(8) NOT NULL DEFAULT 'ru',
+    critical_override BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE notification_preferences
+ADD CONSTRAINT fk_notification_preferences_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+CREATE TABLE IF NOT EXISTS scheduled_notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    deliver_after TIMESTAMP NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE scheduled_notifications
+ADD CONSTRAINT fk_scheduled_notifications_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_scheduled_notifications_deliver_after ON scheduled_notifications(deliver_after);
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    url TEXT NOT NULL,
+    secret VARCHAR(128) NOT NULL,
+    events TEXT [] NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subscription_id UUID NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    response_status INTEGER,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP,
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE webhook_deliveries
+ADD CONSTRAINT fk_webhook_deliveries_subscription_id FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id ON webhook_deliveries(subscription_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at)
+WHERE status = 'pending';
+CREATE TABLE IF NOT EXISTS drone_telemetry (
+    id BIGSERIAL PRIMARY KEY,
+    drone_id UUID NOT NULL,
+    delivery_id UUID,
+    status VARCHAR(50) NOT NULL,
+    battery_level DOUBLE PRECISION NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    altitude DOUBLE PRECISION NOT NULL,
+    speed DOUBLE PRECISION NOT NULL,
+    recorded_at TIMESTAMP NOT NULL
+);
+ALTER TABLE drone_telemetry
+ADD CONSTRAINT fk_drone_telemetry_drone_id FOREIGN KEY (drone_id) REFERENCES drones(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_drone_telemetry_drone_recorded_at ON drone_telemetry(drone_id, recorded_at);
+CREATE INDEX IF NOT EXISTS idx_drone_telemetry_delivery_recorded_at ON drone_telemetry(delivery_id, recorded_at)
+WHERE delivery_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_drone_telemetry_recorded_at ON drone_telemetry(recorded_at);
+CREATE TABLE IF NOT EXISTS delivery_recordings (
+    delivery_id UUID PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    video_key TEXT,
+    manifest_key TEXT,
+    frame_count INTEGER NOT NULL DEFAULT 0,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE delivery_recordings
+ADD CONSTRAINT fk_delivery_recordings_delivery_id FOREIGN KEY (delivery_id) REFERENCES deliveries(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_delivery_recordings_due ON delivery_recordings(next_attempt_at)
+WHERE status IN ('pending', 'processing');
+CREATE TABLE IF NOT EXISTS drone_credentials (
+    drone_id UUID PRIMARY KEY,
+    secret VARCHAR(128) NOT NULL,
+    key_version INTEGER NOT NULL DEFAULT 1,
+    last_connected_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rotated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE drone_credentials
+ADD CONSTRAINT fk_drone_credentials_drone_id FOREIGN KEY (drone_id) REFERENCES drones(id) ON DELETE CASCADE;
+INSERT INTO drone_credentials (drone_id, secret)
+SELECT id,
+    'dsk_' || replace(uuid_generate_v4()::text, '-', '') || replace(uuid_generate_v4()::text, '-', '')
+FROM drones
+ON CONFLICT (drone_id) DO NOTHING;
+CREATE TABLE IF NOT EXISTS drone_commands (
+    id UUID PRIMARY KEY,
+    drone_id UUID NOT NULL,
+    command VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP,
+    acked_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+ALTER TABLE drone_commands
+ADD CONSTRAINT fk_drone_commands_drone_id FOREIGN KEY (drone_id) REFERENCES drones(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_drone_commands_open_by_drone ON drone_commands(drone_id, created_at)
+WHERE status IN ('pending', 'sent', 'acked');
+CREATE INDEX IF NOT EXISTS idx_drone_commands_due ON drone_commands(next_attempt_at)
+WHERE status IN ('pending', 'sent');
+CREATE INDEX IF NOT EXISTS idx_drone_commands_expiry ON drone_commands(expires_at)
+WHERE status IN ('pending', 'sent', 'acked');
+CREATE TABLE IF NOT EXISTS drone_command_audit (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    drone_id UUID NOT NULL,
+    command_id UUID,
+    command VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    operator_id UUID NOT NULL,
+    operator_email VARCHAR(255) NOT NULL,
+    operator_role VARCHAR(20) NOT NULL,
+    result VARCHAR(20) NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_drone_command_audit_drone ON drone_command_audit(drone_id, created_at DESC);
+ALTER TABLE deliveries
+ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMP;
+ALTER TABLE deliveries
+ADD COLUMN IF NOT EXISTS pickup_reminded_at TIMESTAMP;
+ALTER TABLE deliveries
+ADD COLUMN IF NOT EXISTS arrival_notified_at TIMESTAMP;
+UPDATE deliveries
+SET delivered_at = CURRENT_TIMESTAMP
+WHERE status = 'delivered';